@@ -4,10 +4,13 @@ import (
 	"api_gateway/configs"
 	"api_gateway/internal/clients"
 	"api_gateway/internal/constants"
+	"api_gateway/internal/consumer"
 	"api_gateway/internal/middlewares"
 	"api_gateway/internal/routes"
+	"api_gateway/pkg/jwt"
 	loggerPackage "api_gateway/pkg/logger"
 	"api_gateway/pkg/rabbitmq"
+	"api_gateway/pkg/revocation"
 	"context"
 	"fmt"
 	"log"
@@ -23,10 +26,11 @@ import (
 )
 
 type App struct {
-	HttpServer        *fiber.App
-	amqpConn          *amqp.Connection
-	rabbitMQPublisher *rabbitmq.Publisher
-	logger            *loggerPackage.Logger
+	HttpServer         *fiber.App
+	amqpConn           *amqp.Connection
+	rabbitMQPublisher  *rabbitmq.Publisher
+	revocationConsumer *consumer.RevocationConsumer
+	logger             *loggerPackage.Logger
 }
 
 func NewApp() (*App, error) {
@@ -34,6 +38,7 @@ func NewApp() (*App, error) {
 	app := fiber.New(fiber.Config{
 		ReadTimeout:  time.Duration(configs.AppConfig.ReadTimeout) * time.Second,
 		WriteTimeout: time.Duration(configs.AppConfig.WriteTimeout) * time.Second,
+		BodyLimit:    configs.AppConfig.MaxUploadSize << 20,
 	})
 
 	// Connect to RabbitMQ
@@ -104,8 +109,18 @@ func NewApp() (*App, error) {
 	app.Use(loggerFiber.New())
 	app.Use(middlewares.ThrottleMiddleware(logger))
 
-	// Authentication middleware
-	authMiddleware := middlewares.NewAuthMiddleware(authClient, logger)
+	// Authentication middleware, tokens are verified against the keys of auth_service and the revocations it announces
+	keySet := jwt.NewKeySet(authClient, time.Duration(configs.AppConfig.JwksMinRefresh)*time.Second, time.Duration(configs.AppConfig.JwksMaxAge)*time.Second)
+	revocations := revocation.NewState(time.Duration(configs.AppConfig.JwtExpAccessToken) * time.Minute)
+	tokenValidator := middlewares.NewTokenValidator(authClient, jwt.NewVerifier(keySet, configs.AppConfig.JwtIssuer), revocations, configs.AppConfig.TokenCacheSize, time.Duration(configs.AppConfig.TokenCacheTTL)*time.Second, logger)
+	authMiddleware := middlewares.NewAuthMiddleware(tokenValidator, logger)
+
+	// Revocations of auth_service feed the revocation state
+	revocationConsumer, err := consumer.NewRevocationConsumer(amqpConn, revocations, logger)
+	if err != nil {
+		log.Println("Failed to create RevocationConsumer:", err)
+		return nil, err
+	}
 
 	// Routes
 	router := app.Group("/api")
@@ -114,16 +129,18 @@ func NewApp() (*App, error) {
 	routes.NewBookRoute(router, authMiddleware, bookClient, authorClient, categoryClient, logger).Routes()
 	routes.NewCategoryRoute(router, authMiddleware, categoryClient, bookClient, logger).Routes()
 	routes.NewAuthorRoute(router, authMiddleware, authorClient, bookClient, logger).Routes()
-	routes.NewUserRoute(router, authMiddleware, userClient, logger).Routes()
+	routes.NewUserRoute(router, authMiddleware, userClient, authClient, logger).Routes()
+	routes.NewRoleRoute(router, authMiddleware, authClient, logger).Routes()
 	routes.NewLoanRoute(router, authMiddleware, loanClient, logger).Routes()
 
 	log.Println("Fiber app initialized successfully")
 
 	return &App{
-		HttpServer:        app,
-		amqpConn:          amqpConn,
-		rabbitMQPublisher: rabbitMQPublisher,
-		logger:            logger,
+		HttpServer:         app,
+		amqpConn:           amqpConn,
+		rabbitMQPublisher:  rabbitMQPublisher,
+		revocationConsumer: revocationConsumer,
+		logger:             logger,
 	}, nil
 }
 
@@ -131,6 +148,13 @@ func NewApp() (*App, error) {
 func (a *App) Run() error {
 	// Defer resource cleanup
 	defer func() {
+		if a.revocationConsumer != nil {
+			log.Println("Closing revocation consumer...")
+			if err := a.revocationConsumer.Close(); err != nil {
+				log.Println("Error closing revocation consumer:", err)
+			}
+		}
+
 		if a.amqpConn != nil {
 			log.Println("Closing AMQP connection...")
 			if err := a.amqpConn.Close(); err != nil {
@@ -157,6 +181,11 @@ func (a *App) Run() error {
 		}
 	}()
 
+	// Start the revocation consumer, it stops with the application
+	consumerCtx, stopConsumer := context.WithCancel(context.Background())
+	defer stopConsumer()
+	go a.revocationConsumer.Start(consumerCtx)
+
 	// Start server in a goroutine
 	go func() {
 		address := fmt.Sprintf(":%s", configs.AppConfig.AppPort)
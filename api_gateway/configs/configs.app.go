@@ -22,6 +22,13 @@ type Config struct {
 	LoggerWorkerNum        int
 	LoggerWorkerBufferSize int
 	MaxRequestPerMinute    int
+	MaxUploadSize          int
+	JwtIssuer              string
+	TokenCacheSize         int // validated access tokens kept per instance
+	TokenCacheTTL          int // second unit, longest a verified token is kept without checking its signature again
+	JwtExpAccessToken      int // minute unit, same as the auth service, revocations are kept this long
+	JwksMinRefresh         int // second unit, shortest time between two JWKS fetches
+	JwksMaxAge             int // second unit, the JWKS is fetched again once it is this old
 } // mapstrucuture issue: should assign manually
 
 var AppConfig Config
@@ -53,6 +60,7 @@ func InitializeAppConfig() error {
 		"LOAN_SERVICE_URL":     &AppConfig.LoanServiceURL,
 		"USER_SERVICE_URL":     &AppConfig.UserServiceURL,
 		"LOGGER_WORKER_TYPE":   &AppConfig.LoggerWorkerType,
+		"JWT_ISSUER":           &AppConfig.JwtIssuer,
 	}
 
 	for key, ref := range requiredStringKeys {
@@ -89,5 +97,35 @@ func InitializeAppConfig() error {
 		return err
 	}
 
+	AppConfig.MaxUploadSize, err = getIntEnv("MAX_UPLOAD_SIZE")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.TokenCacheSize, err = getIntEnv("TOKEN_CACHE_SIZE")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.TokenCacheTTL, err = getIntEnv("TOKEN_CACHE_TTL")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.JwtExpAccessToken, err = getIntEnv("JWT_EXP_ACCESS_TOKEN")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.JwksMinRefresh, err = getIntEnv("JWKS_MIN_REFRESH")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.JwksMaxAge, err = getIntEnv("JWKS_MAX_AGE")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
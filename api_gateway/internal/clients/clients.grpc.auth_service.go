@@ -22,7 +22,29 @@ type AuthClient interface {
 	VerifyEmail(ctx context.Context, dto datatransfers.VerifyEmailRequest) (datatransfers.VerifyEmailResponse, error)
 	ValidateToken(ctx context.Context, dto datatransfers.ValidateTokenRequest) (datatransfers.ValidateTokenResponse, error)
 	RefreshToken(ctx context.Context, userId string, dto datatransfers.RefreshTokenRequest) (datatransfers.RefreshTokenResponse, error)
-	Logout(ctx context.Context, userId string) (datatransfers.LogoutResponse, error)
+	Logout(ctx context.Context, userId string, sessionId string) (datatransfers.LogoutResponse, error)
+	RequestPasswordReset(ctx context.Context, dto datatransfers.RequestPasswordResetRequest) (datatransfers.RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, dto datatransfers.ResetPasswordRequest) (datatransfers.ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, userId string, dto datatransfers.ChangePasswordRequest) (datatransfers.AccountChangeResponse, error)
+	ChangeEmail(ctx context.Context, userId string, dto datatransfers.ChangeEmailRequest) (datatransfers.AccountChangeResponse, error)
+	ConfirmEmailChange(ctx context.Context, userId string, dto datatransfers.ConfirmEmailChangeRequest) (datatransfers.AccountChangeResponse, error)
+	EnrollMFA(ctx context.Context, userId string, dto datatransfers.EnrollMFARequest) (datatransfers.EnrollMFAResponse, error)
+	ConfirmMFA(ctx context.Context, userId string, dto datatransfers.ConfirmMFAEnrollmentRequest) (datatransfers.ConfirmMFAResponse, error)
+	VerifyMFA(ctx context.Context, dto datatransfers.VerifyMFARequest) (datatransfers.LoginResponse, error)
+	SetRoleMFARequirement(ctx context.Context, role string, dto datatransfers.RoleMFARequirementRequest) (datatransfers.RoleMFARequirementResponse, error)
+	ListRoleMFARequirements(ctx context.Context) ([]datatransfers.RoleMFARequirementResponse, error)
+	UnlockAccount(ctx context.Context, dto datatransfers.UnlockAccountRequest) (datatransfers.UnlockAccountResponse, error)
+	ListSessions(ctx context.Context, userId string) ([]datatransfers.SessionResponse, error)
+	RevokeSession(ctx context.Context, userId string, sessionId string) (datatransfers.RevokeSessionResponse, error)
+	ForceLogout(ctx context.Context, userId string) (datatransfers.ForceLogoutResponse, error)
+	GetJWKS(ctx context.Context) (datatransfers.JWKSResponse, error)
+	ListPermissions(ctx context.Context) ([]datatransfers.PermissionResponse, error)
+	ListRoles(ctx context.Context) ([]datatransfers.RoleResponse, error)
+	CreateRole(ctx context.Context, dto datatransfers.CreateRoleRequest) (datatransfers.RoleResponse, error)
+	UpdateRole(ctx context.Context, name string, dto datatransfers.UpdateRoleRequest) (datatransfers.RoleResponse, error)
+	DeleteRole(ctx context.Context, name string) (datatransfers.RoleChangeResponse, error)
+	AssignRole(ctx context.Context, userId string, role string) (datatransfers.RoleChangeResponse, error)
+	UnassignRole(ctx context.Context, userId string, role string) (datatransfers.RoleChangeResponse, error)
 }
 
 type authClient struct {
@@ -104,9 +126,12 @@ func (authC *authClient) Login(ctx context.Context, dto datatransfers.LoginReque
 	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Login request succeeded", extra, nil)
 
 	return datatransfers.LoginResponse{
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
-		Message:      resp.Message,
+		AccessToken:           resp.AccessToken,
+		RefreshToken:          resp.RefreshToken,
+		Message:               resp.Message,
+		MFARequired:           resp.MfaRequired,
+		MFAToken:              resp.MfaToken,
+		MFAEnrollmentRequired: resp.MfaEnrollmentRequired,
 	}, nil
 }
 
@@ -186,10 +211,13 @@ func (authC *authClient) ValidateToken(ctx context.Context, dto datatransfers.Va
 	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ValidateToken request succeeded", extra, nil)
 
 	return datatransfers.ValidateTokenResponse{
-		Valid:  resp.Valid,
-		UserID: resp.UserId,
-		Role:   resp.Role,
-		Email:  resp.Email,
+		Valid:       resp.Valid,
+		UserID:      resp.UserId,
+		Roles:       resp.Roles,
+		Permissions: resp.Permissions,
+		Email:       resp.Email,
+		SessionID:   resp.SessionId,
+		TokenID:     resp.TokenId,
 	}, nil
 }
 
@@ -221,15 +249,17 @@ func (authC *authClient) RefreshToken(ctx context.Context, userId string, dto da
 	}, nil
 }
 
-func (authC *authClient) Logout(ctx context.Context, userId string) (datatransfers.LogoutResponse, error) {
+func (authC *authClient) Logout(ctx context.Context, userId string, sessionId string) (datatransfers.LogoutResponse, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoAuth.LogoutRequest{
-		UserId: userId,
+		UserId:    userId,
+		SessionId: sessionId,
 	}
 
 	extra := map[string]interface{}{
-		"user_id": userId,
+		"user_id":    userId,
+		"session_id": sessionId,
 	}
 
 	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending Logout request to Auth Service", extra, nil)
@@ -246,3 +276,614 @@ func (authC *authClient) Logout(ctx context.Context, userId string) (datatransfe
 		Message: resp.Message,
 	}, nil
 }
+
+func (authC *authClient) RequestPasswordReset(ctx context.Context, dto datatransfers.RequestPasswordResetRequest) (datatransfers.RequestPasswordResetResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.RequestPasswordResetRequest{
+		Email: dto.Email,
+	}
+
+	extra := map[string]interface{}{
+		"email": dto.Email,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RequestPasswordReset request to Auth Service", extra, nil)
+
+	resp, err := authC.client.RequestPasswordReset(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RequestPasswordReset request failed", extra, err)
+		return datatransfers.RequestPasswordResetResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RequestPasswordReset request succeeded", extra, nil)
+
+	return datatransfers.RequestPasswordResetResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) ResetPassword(ctx context.Context, dto datatransfers.ResetPasswordRequest) (datatransfers.ResetPasswordResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ResetPasswordRequest{
+		Email:       dto.Email,
+		Token:       dto.Token,
+		NewPassword: dto.NewPassword,
+	}
+
+	extra := map[string]interface{}{
+		"email": dto.Email,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ResetPassword request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ResetPassword(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ResetPassword request failed", extra, err)
+		return datatransfers.ResetPasswordResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ResetPassword request succeeded", extra, nil)
+
+	return datatransfers.ResetPasswordResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) ChangePassword(ctx context.Context, userId string, dto datatransfers.ChangePasswordRequest) (datatransfers.AccountChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ChangePasswordRequest{
+		UserId:          userId,
+		CurrentPassword: dto.CurrentPassword,
+		NewPassword:     dto.NewPassword,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ChangePassword request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ChangePassword(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ChangePassword request failed", extra, err)
+		return datatransfers.AccountChangeResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ChangePassword request succeeded", extra, nil)
+
+	return datatransfers.AccountChangeResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) ChangeEmail(ctx context.Context, userId string, dto datatransfers.ChangeEmailRequest) (datatransfers.AccountChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ChangeEmailRequest{
+		UserId:   userId,
+		NewEmail: dto.NewEmail,
+	}
+
+	extra := map[string]interface{}{
+		"user_id":   userId,
+		"new_email": dto.NewEmail,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ChangeEmail request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ChangeEmail(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ChangeEmail request failed", extra, err)
+		return datatransfers.AccountChangeResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ChangeEmail request succeeded", extra, nil)
+
+	return datatransfers.AccountChangeResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) ConfirmEmailChange(ctx context.Context, userId string, dto datatransfers.ConfirmEmailChangeRequest) (datatransfers.AccountChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ConfirmEmailChangeRequest{
+		UserId:   userId,
+		NewEmail: dto.NewEmail,
+		Otp:      dto.OTP,
+	}
+
+	extra := map[string]interface{}{
+		"user_id":   userId,
+		"new_email": dto.NewEmail,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ConfirmEmailChange request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ConfirmEmailChange(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ConfirmEmailChange request failed", extra, err)
+		return datatransfers.AccountChangeResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ConfirmEmailChange request succeeded", extra, nil)
+
+	return datatransfers.AccountChangeResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+// EnrollMFA enrolls the signed-in user named by userId, or the user of the challenge in dto when userId is empty
+func (authC *authClient) EnrollMFA(ctx context.Context, userId string, dto datatransfers.EnrollMFARequest) (datatransfers.EnrollMFAResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.EnrollMFARequest{
+		UserId:   userId,
+		MfaToken: dto.MFAToken,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending EnrollMFA request to Auth Service", extra, nil)
+
+	resp, err := authC.client.EnrollMFA(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "EnrollMFA request failed", extra, err)
+		return datatransfers.EnrollMFAResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "EnrollMFA request succeeded", extra, nil)
+
+	return datatransfers.EnrollMFAResponse{
+		Secret:     resp.Secret,
+		OtpauthURI: resp.OtpauthUri,
+	}, nil
+}
+
+// ConfirmMFA confirms the enrollment of the signed-in user named by userId, or of the user of the challenge in dto
+// when userId is empty, a confirmation with a challenge also returns the tokens of the login
+func (authC *authClient) ConfirmMFA(ctx context.Context, userId string, dto datatransfers.ConfirmMFAEnrollmentRequest) (datatransfers.ConfirmMFAResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ConfirmMFARequest{
+		UserId:   userId,
+		MfaToken: dto.MFAToken,
+		Code:     dto.Code,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ConfirmMFA request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ConfirmMFA(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ConfirmMFA request failed", extra, err)
+		return datatransfers.ConfirmMFAResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ConfirmMFA request succeeded", extra, nil)
+
+	return datatransfers.ConfirmMFAResponse{
+		BackupCodes:  resp.BackupCodes,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		Message:      resp.Message,
+	}, nil
+}
+
+func (authC *authClient) VerifyMFA(ctx context.Context, dto datatransfers.VerifyMFARequest) (datatransfers.LoginResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.VerifyMFARequest{
+		MfaToken: dto.MFAToken,
+		Code:     dto.Code,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending VerifyMFA request to Auth Service", nil, nil)
+
+	resp, err := authC.client.VerifyMFA(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "VerifyMFA request failed", nil, err)
+		return datatransfers.LoginResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "VerifyMFA request succeeded", nil, nil)
+
+	return datatransfers.LoginResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		Message:      resp.Message,
+	}, nil
+}
+
+func (authC *authClient) SetRoleMFARequirement(ctx context.Context, role string, dto datatransfers.RoleMFARequirementRequest) (datatransfers.RoleMFARequirementResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.SetRoleMFARequirementRequest{
+		Role:     role,
+		Required: *dto.Required,
+	}
+
+	extra := map[string]interface{}{
+		"role":     role,
+		"required": *dto.Required,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending SetRoleMFARequirement request to Auth Service", extra, nil)
+
+	resp, err := authC.client.SetRoleMFARequirement(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "SetRoleMFARequirement request failed", extra, err)
+		return datatransfers.RoleMFARequirementResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "SetRoleMFARequirement request succeeded", extra, nil)
+
+	return datatransfers.RoleMFARequirementResponse{
+		Role:     resp.Requirement.Role,
+		Required: resp.Requirement.Required,
+	}, nil
+}
+
+func (authC *authClient) ListRoleMFARequirements(ctx context.Context) ([]datatransfers.RoleMFARequirementResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListRoleMFARequirements request to Auth Service", nil, nil)
+
+	resp, err := authC.client.ListRoleMFARequirements(utils.GetProtoContext(ctx), &protoAuth.ListRoleMFARequirementsRequest{})
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListRoleMFARequirements request failed", nil, err)
+		return nil, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListRoleMFARequirements request succeeded", nil, nil)
+
+	requirements := make([]datatransfers.RoleMFARequirementResponse, 0, len(resp.Requirements))
+	for _, requirement := range resp.Requirements {
+		requirements = append(requirements, datatransfers.RoleMFARequirementResponse{
+			Role:     requirement.Role,
+			Required: requirement.Required,
+		})
+	}
+	return requirements, nil
+}
+
+func (authC *authClient) UnlockAccount(ctx context.Context, dto datatransfers.UnlockAccountRequest) (datatransfers.UnlockAccountResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.UnlockAccountRequest{
+		Email: dto.Email,
+	}
+
+	extra := map[string]interface{}{
+		"email": dto.Email,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UnlockAccount request to Auth Service", extra, nil)
+
+	resp, err := authC.client.UnlockAccount(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UnlockAccount request failed", extra, err)
+		return datatransfers.UnlockAccountResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UnlockAccount request succeeded", extra, nil)
+
+	return datatransfers.UnlockAccountResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) ListSessions(ctx context.Context, userId string) ([]datatransfers.SessionResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ListSessionsRequest{
+		UserId: userId,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListSessions request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ListSessions(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListSessions request failed", extra, err)
+		return nil, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListSessions request succeeded", extra, nil)
+
+	sessions := make([]datatransfers.SessionResponse, 0, len(resp.Sessions))
+	for _, session := range resp.Sessions {
+		sessions = append(sessions, datatransfers.SessionResponse{
+			ID:         session.Id,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IpAddress,
+			CreatedAt:  time.Unix(session.CreatedAt, 0),
+			LastUsedAt: time.Unix(session.LastUsedAt, 0),
+		})
+	}
+	return sessions, nil
+}
+
+func (authC *authClient) RevokeSession(ctx context.Context, userId string, sessionId string) (datatransfers.RevokeSessionResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.RevokeSessionRequest{
+		UserId:    userId,
+		SessionId: sessionId,
+	}
+
+	extra := map[string]interface{}{
+		"user_id":    userId,
+		"session_id": sessionId,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RevokeSession request to Auth Service", extra, nil)
+
+	resp, err := authC.client.RevokeSession(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RevokeSession request failed", extra, err)
+		return datatransfers.RevokeSessionResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RevokeSession request succeeded", extra, nil)
+
+	return datatransfers.RevokeSessionResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) ForceLogout(ctx context.Context, userId string) (datatransfers.ForceLogoutResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.ForceLogoutRequest{
+		UserId: userId,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ForceLogout request to Auth Service", extra, nil)
+
+	resp, err := authC.client.ForceLogout(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ForceLogout request failed", extra, err)
+		return datatransfers.ForceLogoutResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ForceLogout request succeeded", extra, nil)
+
+	return datatransfers.ForceLogoutResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) GetJWKS(ctx context.Context) (datatransfers.JWKSResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetJWKS request to Auth Service", nil, nil)
+
+	resp, err := authC.client.GetJWKS(utils.GetProtoContext(ctx), &protoAuth.GetJWKSRequest{})
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetJWKS request failed", nil, err)
+		return datatransfers.JWKSResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetJWKS request succeeded", nil, nil)
+
+	keys := make([]datatransfers.JWK, 0, len(resp.Keys))
+	for _, key := range resp.Keys {
+		keys = append(keys, datatransfers.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return datatransfers.JWKSResponse{
+		Keys: keys,
+	}, nil
+}
+
+func (authC *authClient) ListPermissions(ctx context.Context) ([]datatransfers.PermissionResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListPermissions request to Auth Service", nil, nil)
+
+	resp, err := authC.client.ListPermissions(utils.GetProtoContext(ctx), &protoAuth.ListPermissionsRequest{})
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListPermissions request failed", nil, err)
+		return nil, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListPermissions request succeeded", nil, nil)
+
+	permissions := make([]datatransfers.PermissionResponse, 0, len(resp.Permissions))
+	for _, permission := range resp.Permissions {
+		permissions = append(permissions, datatransfers.PermissionResponse{
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+	return permissions, nil
+}
+
+func (authC *authClient) ListRoles(ctx context.Context) ([]datatransfers.RoleResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListRoles request to Auth Service", nil, nil)
+
+	resp, err := authC.client.ListRoles(utils.GetProtoContext(ctx), &protoAuth.ListRolesRequest{})
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListRoles request failed", nil, err)
+		return nil, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListRoles request succeeded", nil, nil)
+
+	roles := make([]datatransfers.RoleResponse, 0, len(resp.Roles))
+	for _, role := range resp.Roles {
+		roles = append(roles, toRoleResponse(role))
+	}
+	return roles, nil
+}
+
+func (authC *authClient) CreateRole(ctx context.Context, dto datatransfers.CreateRoleRequest) (datatransfers.RoleResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.CreateRoleRequest{
+		Name:        dto.Name,
+		Description: dto.Description,
+		Permissions: dto.Permissions,
+	}
+
+	extra := map[string]interface{}{
+		"name":        dto.Name,
+		"permissions": dto.Permissions,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CreateRole request to Auth Service", extra, nil)
+
+	resp, err := authC.client.CreateRole(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "CreateRole request failed", extra, err)
+		return datatransfers.RoleResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CreateRole request succeeded", extra, nil)
+
+	return toRoleResponse(resp.Role), nil
+}
+
+func (authC *authClient) UpdateRole(ctx context.Context, name string, dto datatransfers.UpdateRoleRequest) (datatransfers.RoleResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.UpdateRoleRequest{
+		Name:        name,
+		Description: dto.Description,
+		Permissions: dto.Permissions,
+	}
+
+	extra := map[string]interface{}{
+		"name":        name,
+		"permissions": dto.Permissions,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UpdateRole request to Auth Service", extra, nil)
+
+	resp, err := authC.client.UpdateRole(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UpdateRole request failed", extra, err)
+		return datatransfers.RoleResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UpdateRole request succeeded", extra, nil)
+
+	return toRoleResponse(resp.Role), nil
+}
+
+func (authC *authClient) DeleteRole(ctx context.Context, name string) (datatransfers.RoleChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.DeleteRoleRequest{
+		Name: name,
+	}
+
+	extra := map[string]interface{}{
+		"name": name,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending DeleteRole request to Auth Service", extra, nil)
+
+	resp, err := authC.client.DeleteRole(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "DeleteRole request failed", extra, err)
+		return datatransfers.RoleChangeResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "DeleteRole request succeeded", extra, nil)
+
+	return datatransfers.RoleChangeResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) AssignRole(ctx context.Context, userId string, role string) (datatransfers.RoleChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.AssignRoleRequest{
+		UserId: userId,
+		Role:   role,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+		"role":    role,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending AssignRole request to Auth Service", extra, nil)
+
+	resp, err := authC.client.AssignRole(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "AssignRole request failed", extra, err)
+		return datatransfers.RoleChangeResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "AssignRole request succeeded", extra, nil)
+
+	return datatransfers.RoleChangeResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func (authC *authClient) UnassignRole(ctx context.Context, userId string, role string) (datatransfers.RoleChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuth.UnassignRoleRequest{
+		UserId: userId,
+		Role:   role,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+		"role":    role,
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UnassignRole request to Auth Service", extra, nil)
+
+	resp, err := authC.client.UnassignRole(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UnassignRole request failed", extra, err)
+		return datatransfers.RoleChangeResponse{}, err
+	}
+
+	authC.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UnassignRole request succeeded", extra, nil)
+
+	return datatransfers.RoleChangeResponse{
+		Message: resp.Message,
+	}, nil
+}
+
+func toRoleResponse(role *protoAuth.Role) datatransfers.RoleResponse {
+	return datatransfers.RoleResponse{
+		Name:        role.Name,
+		Description: role.Description,
+		BuiltIn:     role.BuiltIn,
+		Permissions: role.Permissions,
+	}
+}
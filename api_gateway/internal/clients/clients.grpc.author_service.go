@@ -20,7 +20,10 @@ type AuthorClient interface {
 	GetAuthor(ctx context.Context, id string) (datatransfers.AuthorResponse, error)
 	ListAuthors(ctx context.Context, page int, pageSize int) ([]datatransfers.AuthorResponse, int, int, error)
 	UpdateAuthor(ctx context.Context, authorId string, dto datatransfers.AuthorUpdateRequest) (datatransfers.AuthorResponse, error)
-	DeleteAuthor(ctx context.Context, id string, version int) error
+	DeleteAuthor(ctx context.Context, id string, dto datatransfers.AuthorDeleteRequest) (int, error)
+	ListDeletedAuthors(ctx context.Context, page int, pageSize int) ([]datatransfers.AuthorResponse, int, int, error)
+	RestoreAuthor(ctx context.Context, id string) (datatransfers.AuthorResponse, error)
+	MergeAuthors(ctx context.Context, survivorId string, dto datatransfers.AuthorMergeRequest) (datatransfers.AuthorResponse, int, error)
 }
 
 type authorClient struct {
@@ -184,25 +187,134 @@ func (a *authorClient) UpdateAuthor(ctx context.Context, authorId string, dto da
 	}, nil
 }
 
-func (a *authorClient) DeleteAuthor(ctx context.Context, id string, version int) error {
+// DeleteAuthor returns the number of books reassigned or deleted along with the author
+func (a *authorClient) DeleteAuthor(ctx context.Context, id string, dto datatransfers.AuthorDeleteRequest) (int, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoAuthor.DeleteAuthorRequest{
-		Id:      id,
-		Version: int32(version),
+		Id:         id,
+		Version:    int32(dto.Version),
+		OnBooks:    dto.OnBooks,
+		ReassignTo: dto.ReassignTo,
 	}
 
 	extra := map[string]interface{}{
-		"id": id,
+		"id":          id,
+		"on_books":    dto.OnBooks,
+		"reassign_to": dto.ReassignTo,
 	}
 	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending DeleteAuthor request to Author Service", extra, nil)
 
-	_, err := a.client.DeleteAuthor(utils.GetProtoContext(ctx), &reqProto)
+	resp, err := a.client.DeleteAuthor(utils.GetProtoContext(ctx), &reqProto)
 	if err != nil {
 		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "DeleteAuthor request failed", extra, err)
-		return err
+		return 0, err
 	}
+	extra["affected_books"] = resp.AffectedBooks
 	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "DeleteAuthor request succeeded", extra, nil)
 
-	return nil
+	return int(resp.AffectedBooks), nil
+}
+
+func (a *authorClient) ListDeletedAuthors(ctx context.Context, page int, pageSize int) ([]datatransfers.AuthorResponse, int, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuthor.ListAuthorsRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+
+	extra := map[string]interface{}{
+		"page":      page,
+		"page_size": pageSize,
+	}
+
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListDeletedAuthors request to Author Service", extra, nil)
+
+	resp, err := a.client.ListDeletedAuthors(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListDeletedAuthors request failed", extra, err)
+		return nil, 0, 0, err
+	}
+
+	var authors []datatransfers.AuthorResponse
+	for _, author := range resp.Authors {
+		authors = append(authors, toAuthorResponse(author))
+	}
+
+	extra["authors_count"] = len(authors)
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListDeletedAuthors request succeeded", extra, nil)
+	return authors, int(resp.TotalItems), int(resp.TotalPages), nil
+}
+
+func (a *authorClient) RestoreAuthor(ctx context.Context, id string) (datatransfers.AuthorResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuthor.RestoreAuthorRequest{
+		Id: id,
+	}
+
+	extra := map[string]interface{}{
+		"id": id,
+	}
+
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RestoreAuthor request to Author Service", extra, nil)
+
+	resp, err := a.client.RestoreAuthor(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RestoreAuthor request failed", extra, err)
+		return datatransfers.AuthorResponse{}, err
+	}
+
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RestoreAuthor request succeeded", extra, nil)
+
+	return toAuthorResponse(resp.Author), nil
+}
+
+func (a *authorClient) MergeAuthors(ctx context.Context, survivorId string, dto datatransfers.AuthorMergeRequest) (datatransfers.AuthorResponse, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoAuthor.MergeAuthorsRequest{
+		SurvivorId:      survivorId,
+		SurvivorVersion: int32(dto.Version),
+	}
+	for _, duplicate := range dto.Duplicates {
+		reqProto.Duplicates = append(reqProto.Duplicates, &protoAuthor.MergeDuplicate{
+			Id:      duplicate.Id,
+			Version: int32(duplicate.Version),
+		})
+	}
+
+	extra := map[string]interface{}{
+		"survivor_id": survivorId,
+		"duplicates":  len(dto.Duplicates),
+	}
+
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending MergeAuthors request to Author Service", extra, nil)
+
+	resp, err := a.client.MergeAuthors(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "MergeAuthors request failed", extra, err)
+		return datatransfers.AuthorResponse{}, 0, err
+	}
+	extra["affected_books"] = resp.AffectedBooks
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MergeAuthors request succeeded", extra, nil)
+
+	return toAuthorResponse(resp.Survivor), int(resp.AffectedBooks), nil
+}
+
+func toAuthorResponse(author *protoAuthor.Author) datatransfers.AuthorResponse {
+	resp := datatransfers.AuthorResponse{
+		Id:        author.Id,
+		Name:      author.Name,
+		Biography: author.Biography,
+		Version:   int(author.Version),
+		CreatedAt: time.Unix(author.CreatedAt, 0),
+		UpdatedAt: time.Unix(author.UpdatedAt, 0),
+	}
+	if author.DeletedAt != 0 {
+		deletedAt := time.Unix(author.DeletedAt, 0)
+		resp.DeletedAt = &deletedAt
+	}
+	return resp
 }
@@ -8,6 +8,8 @@ import (
 	"api_gateway/pkg/utils"
 	protoBook "api_gateway/proto/book_service"
 	"context"
+	"errors"
+	"io"
 	"log"
 	"time"
 
@@ -18,13 +20,28 @@ import (
 type BookClient interface {
 	CreateBook(ctx context.Context, dto datatransfers.BookRequest) (datatransfers.BookResponse, error)
 	GetBook(ctx context.Context, id string) (datatransfers.BookResponse, error)
+	GetBookByISBN(ctx context.Context, isbn string) (datatransfers.BookResponse, error)
 	GetBooksByAuthorId(ctx context.Context, authorId string, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error)
-	GetBooksByCategoryId(ctx context.Context, categoryId string, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error)
+	GetBooksByCategoryId(ctx context.Context, categoryId string, includeDescendants bool, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error)
 	ListBooks(ctx context.Context, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error)
+	SearchBooks(ctx context.Context, query datatransfers.BookSearchQuery) ([]datatransfers.BookSearchHitResponse, int, int, error)
 	UpdateBook(ctx context.Context, bookId string, dto datatransfers.BookUpdateRequest) (datatransfers.BookResponse, error)
 	DeleteBook(ctx context.Context, id string, version int) error
+	ListDeletedBooks(ctx context.Context, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error)
+	RestoreBook(ctx context.Context, id string) (datatransfers.BookResponse, error)
+	AddBookCopy(ctx context.Context, bookId string, dto datatransfers.BookCopyRequest) (datatransfers.BookCopyResponse, error)
+	UpdateBookCopy(ctx context.Context, id string, dto datatransfers.BookCopyUpdateRequest) (datatransfers.BookCopyResponse, error)
+	RetireBookCopy(ctx context.Context, id string, version int) (datatransfers.BookCopyResponse, error)
+	GetBookCopyByBarcode(ctx context.Context, barcode string) (datatransfers.BookCopyResponse, error)
+	ListBookCopies(ctx context.Context, bookId, status string) ([]datatransfers.BookCopyResponse, error)
+	ImportBooks(ctx context.Context, format, fileName, requestedBy string, file io.Reader) (datatransfers.BookImportJobResponse, error)
+	GetImportJob(ctx context.Context, id string) (datatransfers.BookImportJobResponse, error)
+	ExportBooks(ctx context.Context, format string) (io.ReadCloser, error)
 }
 
+// importChunkSize is the size of the chunks an import file is streamed to the book service in
+const importChunkSize = 64 << 10
+
 type bookClient struct {
 	client protoBook.BookServiceClient
 	logger *logger.Logger
@@ -49,17 +66,24 @@ func NewBookClient(logger *logger.Logger) (BookClient, error) {
 func (b *bookClient) CreateBook(ctx context.Context, dto datatransfers.BookRequest) (datatransfers.BookResponse, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 	reqProto := protoBook.CreateBookRequest{
-		Title:      dto.Title,
-		AuthorId:   dto.AuthorId,
-		CategoryId: dto.CategoryId,
-		Stock:      int32(dto.Stock),
+		Title:           dto.Title,
+		Authors:         toProtoBookAuthors(dto.Authors),
+		CategoryId:      dto.CategoryId,
+		PublicationYear: int32(dto.PublicationYear),
+		Isbn:            dto.ISBN,
+		Publisher:       dto.Publisher,
+		Language:        dto.Language,
+		PageCount:       int32(dto.PageCount),
+		Edition:         dto.Edition,
+		Description:     dto.Description,
 	}
 
 	extra := map[string]interface{}{
-		"title":       dto.Title,
-		"author_id":   dto.AuthorId,
-		"category_id": dto.CategoryId,
-		"stock":       dto.Stock,
+		"title":            dto.Title,
+		"authors":          dto.Authors,
+		"category_id":      dto.CategoryId,
+		"publication_year": dto.PublicationYear,
+		"isbn":             dto.ISBN,
 	}
 
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CreateBook request to Book Service", extra, nil)
@@ -72,16 +96,7 @@ func (b *bookClient) CreateBook(ctx context.Context, dto datatransfers.BookReque
 
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CreateBook request succeeded", extra, nil)
 
-	return datatransfers.BookResponse{
-		Id:         resp.Book.Id,
-		Title:      resp.Book.Title,
-		AuthorId:   &resp.Book.AuthorId,
-		CategoryId: &resp.Book.CategoryId,
-		Stock:      int(resp.Book.Stock),
-		Version:    int(resp.Book.Version),
-		CreatedAt:  time.Unix(resp.Book.CreatedAt, 0),
-		UpdatedAt:  time.Unix(resp.Book.UpdatedAt, 0),
-	}, nil
+	return toBookResponse(resp.Book), nil
 }
 
 func (b *bookClient) GetBook(ctx context.Context, id string) (datatransfers.BookResponse, error) {
@@ -104,16 +119,30 @@ func (b *bookClient) GetBook(ctx context.Context, id string) (datatransfers.Book
 
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetBook request succeeded", extra, nil)
 
-	return datatransfers.BookResponse{
-		Id:         resp.Book.Id,
-		Title:      resp.Book.Title,
-		AuthorId:   &resp.Book.AuthorId,
-		CategoryId: &resp.Book.CategoryId,
-		Stock:      int(resp.Book.Stock),
-		Version:    int(resp.Book.Version),
-		CreatedAt:  time.Unix(resp.Book.CreatedAt, 0),
-		UpdatedAt:  time.Unix(resp.Book.UpdatedAt, 0),
-	}, nil
+	return toBookResponse(resp.Book), nil
+}
+
+func (b *bookClient) GetBookByISBN(ctx context.Context, isbn string) (datatransfers.BookResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.GetBookByISBNRequest{
+		Isbn: isbn,
+	}
+
+	extra := map[string]interface{}{
+		"isbn": isbn,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetBookByISBN request to Book Service", extra, nil)
+
+	resp, err := b.client.GetBookByISBN(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetBookByISBN request failed", extra, err)
+		return datatransfers.BookResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetBookByISBN request succeeded", extra, nil)
+
+	return toBookResponse(resp.Book), nil
 }
 
 func (b *bookClient) ListBooks(ctx context.Context, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error) {
@@ -138,16 +167,7 @@ func (b *bookClient) ListBooks(ctx context.Context, page int, pageSize int) ([]d
 
 	var books []datatransfers.BookResponse
 	for _, book := range resp.Books {
-		books = append(books, datatransfers.BookResponse{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   &book.AuthorId,
-			CategoryId: &book.CategoryId,
-			Stock:      int(book.Stock),
-			Version:    int(book.Version),
-			CreatedAt:  time.Unix(book.CreatedAt, 0),
-			UpdatedAt:  time.Unix(book.UpdatedAt, 0),
-		})
+		books = append(books, toBookResponse(book))
 	}
 
 	extra["books_count"] = len(books)
@@ -158,24 +178,139 @@ func (b *bookClient) ListBooks(ctx context.Context, page int, pageSize int) ([]d
 	return books, int(resp.TotalItems), int(resp.TotalPages), nil
 }
 
+// ListDeletedBooks lists the books in the trash, the most recently deleted first
+func (b *bookClient) ListDeletedBooks(ctx context.Context, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.ListBooksRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}
+
+	extra := map[string]interface{}{
+		"page":      page,
+		"page_size": pageSize,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListDeletedBooks request to Book Service", extra, nil)
+
+	resp, err := b.client.ListDeletedBooks(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListDeletedBooks request failed", extra, err)
+		return nil, 0, 0, err
+	}
+
+	var books []datatransfers.BookResponse
+	for _, book := range resp.Books {
+		books = append(books, toBookResponse(book))
+	}
+
+	extra["books_count"] = len(books)
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListDeletedBooks request succeeded", extra, nil)
+
+	return books, int(resp.TotalItems), int(resp.TotalPages), nil
+}
+
+func (b *bookClient) RestoreBook(ctx context.Context, id string) (datatransfers.BookResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.RestoreBookRequest{
+		Id: id,
+	}
+
+	extra := map[string]interface{}{
+		"id": id,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RestoreBook request to Book Service", extra, nil)
+
+	resp, err := b.client.RestoreBook(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RestoreBook request failed", extra, err)
+		return datatransfers.BookResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RestoreBook request succeeded", extra, nil)
+
+	return toBookResponse(resp.Book), nil
+}
+
+func (b *bookClient) SearchBooks(ctx context.Context, query datatransfers.BookSearchQuery) ([]datatransfers.BookSearchHitResponse, int, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.SearchBooksRequest{
+		Query:         query.Query,
+		AuthorId:      query.AuthorId,
+		CategoryId:    query.CategoryId,
+		AvailableOnly: query.AvailableOnly,
+		YearFrom:      int32(query.YearFrom),
+		YearTo:        int32(query.YearTo),
+		SortBy:        query.SortBy,
+		SortOrder:     query.SortOrder,
+		Page:          int32(query.Page),
+		PageSize:      int32(query.PageSize),
+	}
+
+	extra := map[string]interface{}{
+		"query":          query.Query,
+		"author_id":      query.AuthorId,
+		"category_id":    query.CategoryId,
+		"available_only": query.AvailableOnly,
+		"year_from":      query.YearFrom,
+		"year_to":        query.YearTo,
+		"sort_by":        query.SortBy,
+		"sort_order":     query.SortOrder,
+		"page":           query.Page,
+		"page_size":      query.PageSize,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending SearchBooks request to Book Service", extra, nil)
+
+	resp, err := b.client.SearchBooks(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "SearchBooks request failed", extra, err)
+		return nil, 0, 0, err
+	}
+
+	hits := []datatransfers.BookSearchHitResponse{}
+	for _, hit := range resp.Hits {
+		hits = append(hits, datatransfers.BookSearchHitResponse{
+			Book:      toBookResponse(hit.Book),
+			Highlight: hit.Highlight,
+			Rank:      hit.Rank,
+		})
+	}
+
+	extra["hits_count"] = len(hits)
+	extra["total_items"] = resp.TotalItems
+	extra["total_pages"] = resp.TotalPages
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "SearchBooks request succeeded", extra, nil)
+
+	return hits, int(resp.TotalItems), int(resp.TotalPages), nil
+}
+
 func (b *bookClient) UpdateBook(ctx context.Context, bookId string, dto datatransfers.BookUpdateRequest) (datatransfers.BookResponse, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 	reqProto := protoBook.UpdateBookRequest{
-		Id:         bookId,
-		Title:      dto.Title,
-		AuthorId:   dto.AuthorId,
-		CategoryId: dto.CategoryId,
-		Stock:      int32(dto.Stock),
-		Version:    int32(dto.Version),
+		Id:              bookId,
+		Title:           dto.Title,
+		Authors:         toProtoBookAuthors(dto.Authors),
+		CategoryId:      dto.CategoryId,
+		PublicationYear: int32(dto.PublicationYear),
+		Isbn:            dto.ISBN,
+		Publisher:       dto.Publisher,
+		Language:        dto.Language,
+		PageCount:       int32(dto.PageCount),
+		Edition:         dto.Edition,
+		Description:     dto.Description,
+		Version:         int32(dto.Version),
 	}
 
 	extra := map[string]interface{}{
-		"id":          bookId,
-		"title":       dto.Title,
-		"author_id":   dto.AuthorId,
-		"category_id": dto.CategoryId,
-		"stock":       dto.Stock,
-		"version":     dto.Version,
+		"id":               bookId,
+		"title":            dto.Title,
+		"authors":          dto.Authors,
+		"category_id":      dto.CategoryId,
+		"publication_year": dto.PublicationYear,
+		"isbn":             dto.ISBN,
+		"version":          dto.Version,
 	}
 
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UpdateBook request to Book Service", extra, nil)
@@ -188,16 +323,7 @@ func (b *bookClient) UpdateBook(ctx context.Context, bookId string, dto datatran
 
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UpdateBook request succeeded", extra, nil)
 
-	return datatransfers.BookResponse{
-		Id:         resp.Book.Id,
-		Title:      resp.Book.Title,
-		AuthorId:   &resp.Book.AuthorId,
-		CategoryId: &resp.Book.CategoryId,
-		Stock:      int(resp.Book.Stock),
-		Version:    int(resp.Book.Version),
-		CreatedAt:  time.Unix(resp.Book.CreatedAt, 0),
-		UpdatedAt:  time.Unix(resp.Book.UpdatedAt, 0),
-	}, nil
+	return toBookResponse(resp.Book), nil
 }
 
 func (b *bookClient) DeleteBook(ctx context.Context, id string, version int) error {
@@ -225,18 +351,20 @@ func (b *bookClient) DeleteBook(ctx context.Context, id string, version int) err
 	return nil
 }
 
-func (b *bookClient) GetBooksByCategoryId(ctx context.Context, categoryId string, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error) {
+func (b *bookClient) GetBooksByCategoryId(ctx context.Context, categoryId string, includeDescendants bool, page int, pageSize int) ([]datatransfers.BookResponse, int, int, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 	reqProto := protoBook.GetBooksByCategoryRequest{
-		CategoryId: categoryId,
-		Page:       int32(page),
-		PageSize:   int32(pageSize),
+		CategoryId:         categoryId,
+		IncludeDescendants: includeDescendants,
+		Page:               int32(page),
+		PageSize:           int32(pageSize),
 	}
 
 	extra := map[string]interface{}{
-		"category_id": categoryId,
-		"page":        page,
-		"page_size":   pageSize,
+		"category_id":         categoryId,
+		"include_descendants": includeDescendants,
+		"page":                page,
+		"page_size":           pageSize,
 	}
 
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetBooksByCategory request to Book Service", extra, nil)
@@ -249,16 +377,7 @@ func (b *bookClient) GetBooksByCategoryId(ctx context.Context, categoryId string
 
 	var books []datatransfers.BookResponse
 	for _, book := range resp.Books {
-		books = append(books, datatransfers.BookResponse{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   &book.AuthorId,
-			CategoryId: &book.CategoryId,
-			Stock:      int(book.Stock),
-			Version:    int(book.Version),
-			CreatedAt:  time.Unix(book.CreatedAt, 0),
-			UpdatedAt:  time.Unix(book.UpdatedAt, 0),
-		})
+		books = append(books, toBookResponse(book))
 	}
 
 	extra["books_count"] = len(books)
@@ -293,16 +412,7 @@ func (b *bookClient) GetBooksByAuthorId(ctx context.Context, authorId string, pa
 
 	var books []datatransfers.BookResponse
 	for _, book := range resp.Books {
-		books = append(books, datatransfers.BookResponse{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   &book.AuthorId,
-			CategoryId: &book.CategoryId,
-			Stock:      int(book.Stock),
-			Version:    int(book.Version),
-			CreatedAt:  time.Unix(book.CreatedAt, 0),
-			UpdatedAt:  time.Unix(book.UpdatedAt, 0),
-		})
+		books = append(books, toBookResponse(book))
 	}
 
 	extra["books_count"] = len(books)
@@ -312,3 +422,379 @@ func (b *bookClient) GetBooksByAuthorId(ctx context.Context, authorId string, pa
 
 	return books, int(resp.TotalItems), int(resp.TotalPages), nil
 }
+
+func (b *bookClient) AddBookCopy(ctx context.Context, bookId string, dto datatransfers.BookCopyRequest) (datatransfers.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.AddBookCopyRequest{
+		BookId:        bookId,
+		Barcode:       dto.Barcode,
+		Condition:     dto.Condition,
+		ShelfLocation: dto.ShelfLocation,
+	}
+
+	extra := map[string]interface{}{
+		"book_id":        bookId,
+		"barcode":        dto.Barcode,
+		"condition":      dto.Condition,
+		"shelf_location": dto.ShelfLocation,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending AddBookCopy request to Book Service", extra, nil)
+
+	resp, err := b.client.AddBookCopy(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "AddBookCopy request failed", extra, err)
+		return datatransfers.BookCopyResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "AddBookCopy request succeeded", extra, nil)
+
+	return toBookCopyResponse(resp.Copy), nil
+}
+
+func (b *bookClient) UpdateBookCopy(ctx context.Context, id string, dto datatransfers.BookCopyUpdateRequest) (datatransfers.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.UpdateBookCopyRequest{
+		Id:            id,
+		Condition:     dto.Condition,
+		ShelfLocation: dto.ShelfLocation,
+		Version:       int32(dto.Version),
+	}
+
+	extra := map[string]interface{}{
+		"id":             id,
+		"condition":      dto.Condition,
+		"shelf_location": dto.ShelfLocation,
+		"version":        dto.Version,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UpdateBookCopy request to Book Service", extra, nil)
+
+	resp, err := b.client.UpdateBookCopy(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UpdateBookCopy request failed", extra, err)
+		return datatransfers.BookCopyResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UpdateBookCopy request succeeded", extra, nil)
+
+	return toBookCopyResponse(resp.Copy), nil
+}
+
+func (b *bookClient) RetireBookCopy(ctx context.Context, id string, version int) (datatransfers.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.RetireBookCopyRequest{
+		Id:      id,
+		Version: int32(version),
+	}
+
+	extra := map[string]interface{}{
+		"id":      id,
+		"version": version,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RetireBookCopy request to Book Service", extra, nil)
+
+	resp, err := b.client.RetireBookCopy(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RetireBookCopy request failed", extra, err)
+		return datatransfers.BookCopyResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RetireBookCopy request succeeded", extra, nil)
+
+	return toBookCopyResponse(resp.Copy), nil
+}
+
+func (b *bookClient) GetBookCopyByBarcode(ctx context.Context, barcode string) (datatransfers.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.GetBookCopyByBarcodeRequest{
+		Barcode: barcode,
+	}
+
+	extra := map[string]interface{}{
+		"barcode": barcode,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetBookCopyByBarcode request to Book Service", extra, nil)
+
+	resp, err := b.client.GetBookCopyByBarcode(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetBookCopyByBarcode request failed", extra, err)
+		return datatransfers.BookCopyResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetBookCopyByBarcode request succeeded", extra, nil)
+
+	return toBookCopyResponse(resp.Copy), nil
+}
+
+func (b *bookClient) ListBookCopies(ctx context.Context, bookId, status string) ([]datatransfers.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.ListBookCopiesRequest{
+		BookId: bookId,
+		Status: status,
+	}
+
+	extra := map[string]interface{}{
+		"book_id": bookId,
+		"status":  status,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListBookCopies request to Book Service", extra, nil)
+
+	resp, err := b.client.ListBookCopies(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListBookCopies request failed", extra, err)
+		return nil, err
+	}
+
+	copies := []datatransfers.BookCopyResponse{}
+	for _, bookCopy := range resp.Copies {
+		copies = append(copies, toBookCopyResponse(bookCopy))
+	}
+
+	extra["copies_count"] = len(copies)
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListBookCopies request succeeded", extra, nil)
+
+	return copies, nil
+}
+
+// ImportBooks streams the file to the book service, which queues it as an import job
+func (b *bookClient) ImportBooks(ctx context.Context, format, fileName, requestedBy string, file io.Reader) (datatransfers.BookImportJobResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	extra := map[string]interface{}{
+		"format":       format,
+		"file_name":    fileName,
+		"requested_by": requestedBy,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ImportBooks request to Book Service", extra, nil)
+
+	stream, err := b.client.ImportBooks(utils.GetProtoContext(ctx))
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ImportBooks request failed", extra, err)
+		return datatransfers.BookImportJobResponse{}, err
+	}
+
+	err = stream.Send(&protoBook.ImportBooksRequest{
+		Payload: &protoBook.ImportBooksRequest_Header{
+			Header: &protoBook.ImportBooksHeader{
+				Format:      format,
+				FileName:    fileName,
+				RequestedBy: requestedBy,
+			},
+		},
+	})
+
+	buf := make([]byte, importChunkSize)
+	for err == nil {
+		var n int
+		n, err = io.ReadFull(file, buf)
+		if n > 0 {
+			if sendErr := stream.Send(&protoBook.ImportBooksRequest{Payload: &protoBook.ImportBooksRequest_Chunk{Chunk: buf[:n]}}); sendErr != nil {
+				err = sendErr
+			}
+		}
+	}
+	// The service rejecting the upload ends the stream with io.EOF, its reason comes with CloseAndRecv
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to stream import file", extra, err)
+		stream.CloseSend()
+		return datatransfers.BookImportJobResponse{}, err
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ImportBooks request failed", extra, err)
+		return datatransfers.BookImportJobResponse{}, err
+	}
+
+	extra["job_id"] = resp.Job.Id
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ImportBooks request succeeded", extra, nil)
+
+	return toBookImportJobResponse(resp.Job), nil
+}
+
+func (b *bookClient) GetImportJob(ctx context.Context, id string) (datatransfers.BookImportJobResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.GetImportJobRequest{
+		Id: id,
+	}
+
+	extra := map[string]interface{}{
+		"job_id": id,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetImportJob request to Book Service", extra, nil)
+
+	resp, err := b.client.GetImportJob(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetImportJob request failed", extra, err)
+		return datatransfers.BookImportJobResponse{}, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetImportJob request succeeded", extra, nil)
+
+	return toBookImportJobResponse(resp.Job), nil
+}
+
+// ExportBooks starts the export and returns its content as a reader. The first chunk is received
+// right away so a failing export is reported before anything is written to the response.
+// The reader must be closed to release the stream when it is not read to the end.
+func (b *bookClient) ExportBooks(ctx context.Context, format string) (io.ReadCloser, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+	reqProto := protoBook.ExportBooksRequest{
+		Format: format,
+	}
+
+	extra := map[string]interface{}{
+		"format": format,
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ExportBooks request to Book Service", extra, nil)
+
+	streamCtx, cancel := context.WithCancel(utils.GetProtoContext(ctx))
+	stream, err := b.client.ExportBooks(streamCtx, &reqProto)
+	if err != nil {
+		cancel()
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ExportBooks request failed", extra, err)
+		return nil, err
+	}
+
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		cancel()
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ExportBooks request failed", extra, err)
+		return nil, err
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ExportBooks request succeeded", extra, nil)
+
+	return &exportReader{stream: stream, data: first.GetData(), done: err != nil, cancel: cancel}, nil
+}
+
+// exportReader reads the chunks of an export stream one after the other
+type exportReader struct {
+	stream protoBook.BookService_ExportBooksClient
+	data   []byte
+	done   bool
+	cancel context.CancelFunc
+}
+
+func (r *exportReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			r.cancel()
+			return 0, err
+		}
+		r.data = chunk.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func (r *exportReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+func toBookImportJobResponse(job *protoBook.ImportJob) datatransfers.BookImportJobResponse {
+	resp := datatransfers.BookImportJobResponse{
+		Id:           job.Id,
+		Format:       job.Format,
+		FileName:     job.FileName,
+		Status:       job.Status,
+		TotalRows:    int(job.TotalRows),
+		ImportedRows: int(job.ImportedRows),
+		FailedRows:   int(job.FailedRows),
+		Errors:       []datatransfers.BookImportRowErrorResponse{},
+		Error:        job.Error,
+		RequestedBy:  job.RequestedBy,
+		CreatedAt:    time.Unix(job.CreatedAt, 0),
+	}
+	for _, rowError := range job.Errors {
+		resp.Errors = append(resp.Errors, datatransfers.BookImportRowErrorResponse{
+			Row:     int(rowError.Row),
+			Message: rowError.Message,
+		})
+	}
+	if job.StartedAt != 0 {
+		startedAt := time.Unix(job.StartedAt, 0)
+		resp.StartedAt = &startedAt
+	}
+	if job.FinishedAt != 0 {
+		finishedAt := time.Unix(job.FinishedAt, 0)
+		resp.FinishedAt = &finishedAt
+	}
+	return resp
+}
+
+func toBookResponse(book *protoBook.Book) datatransfers.BookResponse {
+	resp := datatransfers.BookResponse{
+		Id:              book.Id,
+		Title:           book.Title,
+		AuthorIds:       book.AuthorIds,
+		Authors:         toBookAuthorResponses(book.Authors),
+		CategoryId:      &book.CategoryId,
+		PublicationYear: int(book.PublicationYear),
+		ISBN:            book.Isbn,
+		Publisher:       book.Publisher,
+		Language:        book.Language,
+		PageCount:       int(book.PageCount),
+		Edition:         book.Edition,
+		Description:     book.Description,
+		Stock:           int(book.Stock),
+		Version:         int(book.Version),
+		CreatedAt:       time.Unix(book.CreatedAt, 0),
+		UpdatedAt:       time.Unix(book.UpdatedAt, 0),
+	}
+	if book.DeletedAt != 0 {
+		deletedAt := time.Unix(book.DeletedAt, 0)
+		resp.DeletedAt = &deletedAt
+	}
+	return resp
+}
+
+func toProtoBookAuthors(authors []datatransfers.BookAuthorRequest) []*protoBook.BookAuthor {
+	protoAuthors := make([]*protoBook.BookAuthor, 0, len(authors))
+	for _, author := range authors {
+		protoAuthors = append(protoAuthors, &protoBook.BookAuthor{
+			AuthorId: author.AuthorId,
+			Role:     author.Role,
+		})
+	}
+	return protoAuthors
+}
+
+func toBookAuthorResponses(authors []*protoBook.BookAuthor) []datatransfers.BookAuthorResponse {
+	responses := make([]datatransfers.BookAuthorResponse, 0, len(authors))
+	for _, author := range authors {
+		responses = append(responses, datatransfers.BookAuthorResponse{
+			AuthorId: &author.AuthorId,
+			Role:     author.Role,
+		})
+	}
+	return responses
+}
+
+func toBookCopyResponse(bookCopy *protoBook.BookCopy) datatransfers.BookCopyResponse {
+	return datatransfers.BookCopyResponse{
+		Id:            bookCopy.Id,
+		BookId:        bookCopy.BookId,
+		Barcode:       bookCopy.Barcode,
+		Condition:     bookCopy.Condition,
+		ShelfLocation: bookCopy.ShelfLocation,
+		Status:        bookCopy.Status,
+		Version:       int(bookCopy.Version),
+		CreatedAt:     time.Unix(bookCopy.CreatedAt, 0),
+		UpdatedAt:     time.Unix(bookCopy.UpdatedAt, 0),
+	}
+}
@@ -20,8 +20,13 @@ type CategoryClient interface {
 	CreateCategory(ctx context.Context, dto datatransfers.CategoryRequest) (datatransfers.CategoryResponse, error)
 	GetCategory(ctx context.Context, id string) (datatransfers.CategoryResponse, error)
 	ListCategories(ctx context.Context, page int, pageSize int) ([]datatransfers.CategoryResponse, int, int, error)
+	GetCategoryTree(ctx context.Context, rootId string) ([]datatransfers.CategoryNodeResponse, error)
+	ListSubcategories(ctx context.Context, parentId string, recursive bool) ([]datatransfers.CategoryResponse, error)
 	UpdateCategory(ctx context.Context, categoryId string, dto datatransfers.CategoryUpdateRequest) (datatransfers.CategoryResponse, error)
-	DeleteCategory(ctx context.Context, id string, version int) error
+	DeleteCategory(ctx context.Context, id string, dto datatransfers.CategoryDeleteRequest) (int, error)
+	ListDeletedCategories(ctx context.Context) ([]datatransfers.CategoryResponse, int, error)
+	RestoreCategory(ctx context.Context, id string) (datatransfers.CategoryResponse, error)
+	MergeCategories(ctx context.Context, survivorId string, dto datatransfers.CategoryMergeRequest) (datatransfers.CategoryResponse, int, error)
 }
 
 type categoryClient struct {
@@ -49,11 +54,13 @@ func (c *categoryClient) CreateCategory(ctx context.Context, dto datatransfers.C
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoCategory.CreateCategoryRequest{
-		Name: dto.Name,
+		Name:     dto.Name,
+		ParentId: dto.ParentId,
 	}
 
 	extra := map[string]interface{}{
-		"category_name": dto.Name,
+		"category_name":      dto.Name,
+		"category_parent_id": dto.ParentId,
 	}
 
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CreateCategory request to Category Service", extra, nil)
@@ -66,13 +73,7 @@ func (c *categoryClient) CreateCategory(ctx context.Context, dto datatransfers.C
 
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CreateCategory request succeeded", extra, nil)
 
-	return datatransfers.CategoryResponse{
-		Id:        resp.Category.Id,
-		Name:      resp.Category.Name,
-		Version:   int(resp.Category.Version),
-		CreatedAt: time.Unix(resp.Category.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.Category.UpdatedAt, 0),
-	}, nil
+	return toCategoryResponse(resp.Category), nil
 }
 
 func (c *categoryClient) GetCategory(ctx context.Context, id string) (datatransfers.CategoryResponse, error) {
@@ -96,13 +97,7 @@ func (c *categoryClient) GetCategory(ctx context.Context, id string) (datatransf
 
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetCategory request succeeded", extra, nil)
 
-	return datatransfers.CategoryResponse{
-		Id:        resp.Category.Id,
-		Name:      resp.Category.Name,
-		Version:   int(resp.Category.Version),
-		CreatedAt: time.Unix(resp.Category.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.Category.UpdatedAt, 0),
-	}, nil
+	return toCategoryResponse(resp.Category), nil
 }
 
 func (c *categoryClient) ListCategories(ctx context.Context, page int, pageSize int) ([]datatransfers.CategoryResponse, int, int, error) {
@@ -128,13 +123,7 @@ func (c *categoryClient) ListCategories(ctx context.Context, page int, pageSize
 
 	var categories []datatransfers.CategoryResponse
 	for _, category := range resp.Categories {
-		categories = append(categories, datatransfers.CategoryResponse{
-			Id:        category.Id,
-			Name:      category.Name,
-			Version:   int(category.Version),
-			CreatedAt: time.Unix(category.CreatedAt, 0),
-			UpdatedAt: time.Unix(category.UpdatedAt, 0),
-		})
+		categories = append(categories, toCategoryResponse(category))
 	}
 
 	extra["categories_count"] = len(categories)
@@ -145,19 +134,79 @@ func (c *categoryClient) ListCategories(ctx context.Context, page int, pageSize
 	return categories, int(resp.TotalItems), int(resp.TotalPages), nil
 }
 
+// GetCategoryTree returns the subcategories of rootId as a nested tree, or the whole tree when rootId is empty
+func (c *categoryClient) GetCategoryTree(ctx context.Context, rootId string) ([]datatransfers.CategoryNodeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoCategory.GetCategoryTreeRequest{
+		RootId: rootId,
+	}
+
+	extra := map[string]interface{}{
+		"root_id": rootId,
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetCategoryTree request to Category Service", extra, nil)
+
+	resp, err := c.client.GetCategoryTree(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetCategoryTree request failed", extra, err)
+		return nil, err
+	}
+
+	extra["nodes_count"] = len(resp.Nodes)
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetCategoryTree request succeeded", extra, nil)
+
+	return toCategoryNodeResponses(resp.Nodes), nil
+}
+
+func (c *categoryClient) ListSubcategories(ctx context.Context, parentId string, recursive bool) ([]datatransfers.CategoryResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoCategory.ListSubcategoriesRequest{
+		ParentId:  parentId,
+		Recursive: recursive,
+	}
+
+	extra := map[string]interface{}{
+		"parent_id": parentId,
+		"recursive": recursive,
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListSubcategories request to Category Service", extra, nil)
+
+	resp, err := c.client.ListSubcategories(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListSubcategories request failed", extra, err)
+		return nil, err
+	}
+
+	categories := make([]datatransfers.CategoryResponse, 0, len(resp.Categories))
+	for _, category := range resp.Categories {
+		categories = append(categories, toCategoryResponse(category))
+	}
+
+	extra["categories_count"] = len(categories)
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListSubcategories request succeeded", extra, nil)
+
+	return categories, nil
+}
+
 func (c *categoryClient) UpdateCategory(ctx context.Context, categoryId string, dto datatransfers.CategoryUpdateRequest) (datatransfers.CategoryResponse, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoCategory.UpdateCategoryRequest{
-		Id:      categoryId,
-		Name:    dto.Name,
-		Version: int32(dto.Version),
+		Id:       categoryId,
+		Name:     dto.Name,
+		ParentId: dto.ParentId,
+		Version:  int32(dto.Version),
 	}
 
 	extra := map[string]interface{}{
-		"category_id":      categoryId,
-		"category_name":    dto.Name,
-		"category_version": dto.Version,
+		"category_id":        categoryId,
+		"category_name":      dto.Name,
+		"category_parent_id": dto.ParentId,
+		"category_version":   dto.Version,
 	}
 
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UpdateCategory request to Category Service", extra, nil)
@@ -170,37 +219,143 @@ func (c *categoryClient) UpdateCategory(ctx context.Context, categoryId string,
 
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UpdateCategory request succeeded", extra, nil)
 
-	return datatransfers.CategoryResponse{
-		Id:        resp.Category.Id,
-		Name:      resp.Category.Name,
-		Version:   int(resp.Category.Version),
-		CreatedAt: time.Unix(resp.Category.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.Category.UpdatedAt, 0),
-	}, nil
+	return toCategoryResponse(resp.Category), nil
 }
 
-func (c *categoryClient) DeleteCategory(ctx context.Context, id string, version int) error {
+// DeleteCategory returns the number of books reassigned or deleted along with the category
+// DeleteCategory returns the number of books reassigned or deleted along with the category
+func (c *categoryClient) DeleteCategory(ctx context.Context, id string, dto datatransfers.CategoryDeleteRequest) (int, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoCategory.DeleteCategoryRequest{
-		Id:      id,
-		Version: int32(version),
+		Id:         id,
+		Version:    int32(dto.Version),
+		OnBooks:    dto.OnBooks,
+		ReassignTo: dto.ReassignTo,
 	}
 
 	extra := map[string]interface{}{
 		"category_id":      id,
-		"category_version": version,
+		"category_version": dto.Version,
+		"on_books":         dto.OnBooks,
+		"reassign_to":      dto.ReassignTo,
 	}
 
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending DeleteCategory request to Category Service", extra, nil)
 
-	_, err := c.client.DeleteCategory(utils.GetProtoContext(ctx), &reqProto)
+	resp, err := c.client.DeleteCategory(utils.GetProtoContext(ctx), &reqProto)
 	if err != nil {
 		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "DeleteCategory request failed", extra, err)
-		return err
+		return 0, err
 	}
 
+	extra["affected_books"] = resp.AffectedBooks
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "DeleteCategory request succeeded", extra, nil)
 
-	return nil
+	return int(resp.AffectedBooks), nil
+}
+
+// ListDeletedCategories returns every category in the trash, the most recently deleted first
+func (c *categoryClient) ListDeletedCategories(ctx context.Context) ([]datatransfers.CategoryResponse, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListDeletedCategories request to Category Service", nil, nil)
+
+	resp, err := c.client.ListDeletedCategories(utils.GetProtoContext(ctx), &protoCategory.ListCategoriesRequest{})
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListDeletedCategories request failed", nil, err)
+		return nil, 0, err
+	}
+
+	var categories []datatransfers.CategoryResponse
+	for _, category := range resp.Categories {
+		categories = append(categories, toCategoryResponse(category))
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListDeletedCategories request succeeded", map[string]interface{}{"categories_count": len(categories)}, nil)
+
+	return categories, int(resp.TotalItems), nil
+}
+
+func (c *categoryClient) RestoreCategory(ctx context.Context, id string) (datatransfers.CategoryResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoCategory.RestoreCategoryRequest{
+		Id: id,
+	}
+
+	extra := map[string]interface{}{
+		"id": id,
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RestoreCategory request to Category Service", extra, nil)
+
+	resp, err := c.client.RestoreCategory(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RestoreCategory request failed", extra, err)
+		return datatransfers.CategoryResponse{}, err
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RestoreCategory request succeeded", extra, nil)
+
+	return toCategoryResponse(resp.Category), nil
+}
+
+func (c *categoryClient) MergeCategories(ctx context.Context, survivorId string, dto datatransfers.CategoryMergeRequest) (datatransfers.CategoryResponse, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoCategory.MergeCategoriesRequest{
+		SurvivorId:      survivorId,
+		SurvivorVersion: int32(dto.Version),
+	}
+	for _, duplicate := range dto.Duplicates {
+		reqProto.Duplicates = append(reqProto.Duplicates, &protoCategory.MergeDuplicate{
+			Id:      duplicate.Id,
+			Version: int32(duplicate.Version),
+		})
+	}
+
+	extra := map[string]interface{}{
+		"survivor_id": survivorId,
+		"duplicates":  len(dto.Duplicates),
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending MergeCategories request to Category Service", extra, nil)
+
+	resp, err := c.client.MergeCategories(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "MergeCategories request failed", extra, err)
+		return datatransfers.CategoryResponse{}, 0, err
+	}
+	extra["affected_books"] = resp.AffectedBooks
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MergeCategories request succeeded", extra, nil)
+
+	return toCategoryResponse(resp.Survivor), int(resp.AffectedBooks), nil
+}
+
+func toCategoryResponse(category *protoCategory.Category) datatransfers.CategoryResponse {
+	resp := datatransfers.CategoryResponse{
+		Id:        category.Id,
+		Name:      category.Name,
+		ParentId:  category.ParentId,
+		Version:   int(category.Version),
+		CreatedAt: time.Unix(category.CreatedAt, 0),
+		UpdatedAt: time.Unix(category.UpdatedAt, 0),
+	}
+	if category.DeletedAt != 0 {
+		deletedAt := time.Unix(category.DeletedAt, 0)
+		resp.DeletedAt = &deletedAt
+	}
+	return resp
+}
+
+func toCategoryNodeResponses(nodes []*protoCategory.CategoryNode) []datatransfers.CategoryNodeResponse {
+	responses := make([]datatransfers.CategoryNodeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		responses = append(responses, datatransfers.CategoryNodeResponse{
+			CategoryResponse: toCategoryResponse(node.Category),
+			Children:         toCategoryNodeResponses(node.Children),
+		})
+	}
+	return responses
 }
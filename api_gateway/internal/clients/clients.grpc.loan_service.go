@@ -19,12 +19,22 @@ import (
 type LoanClient interface {
 	CreateLoan(ctx context.Context, userId, email string, dto datatransfers.LoanRequest) (datatransfers.LoanResponse, error)
 	GetLoan(ctx context.Context, id string) (datatransfers.LoanResponse, error)
-	UpdateLoanStatus(ctx context.Context, loanId, status string, version int) (datatransfers.LoanResponse, error)
-	ListUserLoans(ctx context.Context, userId string, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error)
-	ListLoans(ctx context.Context, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error)
-	GetUserLoansByStatus(ctx context.Context, userId, status string, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error)
-	GetLoansByStatus(ctx context.Context, status string, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error)
-	ReturnLoan(ctx context.Context, id, userId, email string, returnDate time.Time, dto datatransfers.LoanReturnRequest) (datatransfers.LoanResponse, error)
+	UpdateLoanStatus(ctx context.Context, loanId, changedBy string, req datatransfers.LoanStatusUpdateRequest) (datatransfers.LoanResponse, error)
+	GetLoanHistory(ctx context.Context, loanId string) ([]datatransfers.LoanStatusChangeResponse, error)
+	ListUserLoans(ctx context.Context, userId string, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error)
+	ListLoans(ctx context.Context, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error)
+	GetUserLoansByStatus(ctx context.Context, userId, status string, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error)
+	GetLoansByStatus(ctx context.Context, status string, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error)
+	ReturnLoan(ctx context.Context, id, userId, email string, dto datatransfers.LoanReturnRequest) (datatransfers.LoanResponse, error)
+	RenewLoan(ctx context.Context, id, userId string, dto datatransfers.LoanRenewRequest) (datatransfers.LoanResponse, error)
+	CheckOutCopy(ctx context.Context, changedBy string, dto datatransfers.LoanCheckOutRequest) (datatransfers.LoanResponse, error)
+	CheckInCopy(ctx context.Context, changedBy string, dto datatransfers.LoanCheckInRequest) (datatransfers.LoanResponse, error)
+	ListUserFines(ctx context.Context, userId string, query datatransfers.FineListQuery) ([]datatransfers.FineResponse, int, int, int64, error)
+	PayFine(ctx context.Context, id, userId string, dto datatransfers.FinePayRequest) (datatransfers.FineResponse, error)
+	WaiveFine(ctx context.Context, id string, dto datatransfers.FineWaiveRequest) (datatransfers.FineResponse, error)
+	PlaceHold(ctx context.Context, userId, email string, dto datatransfers.HoldRequest) (datatransfers.HoldResponse, error)
+	CancelHold(ctx context.Context, id, userId string, dto datatransfers.HoldCancelRequest) (datatransfers.HoldResponse, error)
+	ListUserHolds(ctx context.Context, userId string, query datatransfers.HoldListQuery) ([]datatransfers.HoldResponse, int, int, error)
 }
 
 type loanClient struct {
@@ -52,17 +62,15 @@ func (l *loanClient) CreateLoan(ctx context.Context, userId, email string, dto d
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.CreateLoanRequest{
-		UserId:      userId,
-		BookId:      dto.BookId,
-		Email:       email,
-		BookVersion: int32(dto.BookVersion),
+		UserId: userId,
+		BookId: dto.BookId,
+		Email:  email,
 	}
 
 	extra := map[string]interface{}{
-		"user_id":      userId,
-		"book_id":      dto.BookId,
-		"email":        email,
-		"book_version": dto.BookVersion,
+		"user_id": userId,
+		"book_id": dto.BookId,
+		"email":   email,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CreateLoan request to Loan Service", extra, nil)
@@ -77,17 +85,7 @@ func (l *loanClient) CreateLoan(ctx context.Context, userId, email string, dto d
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CreateLoan request succeeded", extra, nil)
 
-	return datatransfers.LoanResponse{
-		Id:         resp.Loan.Id,
-		UserId:     resp.Loan.UserId,
-		BookId:     resp.Loan.BookId,
-		LoanDate:   time.Unix(resp.Loan.LoanDate, 0),
-		ReturnDate: nil,
-		Status:     resp.Loan.Status,
-		Version:    int(resp.Loan.Version),
-		CreatedAt:  time.Unix(resp.Loan.CreatedAt, 0),
-		UpdatedAt:  time.Unix(resp.Loan.UpdatedAt, 0),
-	}, nil
+	return toLoanResponse(resp.Loan), nil
 }
 
 func (l *loanClient) GetLoan(ctx context.Context, id string) (datatransfers.LoanResponse, error) {
@@ -110,39 +108,28 @@ func (l *loanClient) GetLoan(ctx context.Context, id string) (datatransfers.Loan
 		return datatransfers.LoanResponse{}, err
 	}
 
-	loanResponse := datatransfers.LoanResponse{
-		Id:        resp.Loan.Id,
-		UserId:    resp.Loan.UserId,
-		BookId:    resp.Loan.BookId,
-		LoanDate:  time.Unix(resp.Loan.LoanDate, 0),
-		Status:    resp.Loan.Status,
-		Version:   int(resp.Loan.Version),
-		CreatedAt: time.Unix(resp.Loan.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.Loan.UpdatedAt, 0),
-	}
-
-	if resp.Loan.ReturnDate != 0 {
-		returnDate := time.Unix(resp.Loan.ReturnDate, 0)
-		loanResponse.ReturnDate = &returnDate
-	}
+	loanResponse := toLoanResponse(resp.Loan)
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetLoan request succeeded", extra, nil)
 
 	return loanResponse, nil
 }
 
-func (l *loanClient) UpdateLoanStatus(ctx context.Context, loanId, status string, version int) (datatransfers.LoanResponse, error) {
+func (l *loanClient) UpdateLoanStatus(ctx context.Context, loanId, changedBy string, req datatransfers.LoanStatusUpdateRequest) (datatransfers.LoanResponse, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.UpdateLoanStatusRequest{
-		Id:      loanId,
-		Status:  status,
-		Version: int32(version),
+		Id:        loanId,
+		Status:    toProtoLoanStatus(req.Status),
+		Version:   int32(req.Version),
+		ChangedBy: changedBy,
+		Note:      req.Note,
 	}
 
 	extra := map[string]interface{}{
 		"loan_id":     loanId,
-		"loan_status": status,
+		"loan_status": req.Status,
+		"changed_by":  changedBy,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UpdateLoanStatus request to Loan Service", extra, nil)
@@ -153,41 +140,73 @@ func (l *loanClient) UpdateLoanStatus(ctx context.Context, loanId, status string
 		return datatransfers.LoanResponse{}, err
 	}
 
-	loanResponse := datatransfers.LoanResponse{
-		Id:        resp.Loan.Id,
-		UserId:    resp.Loan.UserId,
-		BookId:    resp.Loan.BookId,
-		LoanDate:  time.Unix(resp.Loan.LoanDate, 0),
-		Status:    resp.Loan.Status,
-		Version:   int(resp.Loan.Version),
-		CreatedAt: time.Unix(resp.Loan.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.Loan.UpdatedAt, 0),
-	}
-
-	if resp.Loan.ReturnDate != 0 {
-		returnDate := time.Unix(resp.Loan.ReturnDate, 0)
-		loanResponse.ReturnDate = &returnDate
-	}
+	loanResponse := toLoanResponse(resp.Loan)
 
-	extra["status"] = resp.Loan.Status
+	extra["status"] = resp.Loan.Status.String()
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UpdateLoanStatus request succeeded", extra, nil)
 
 	return loanResponse, nil
 }
 
-func (l *loanClient) ListUserLoans(ctx context.Context, userId string, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error) {
+func (l *loanClient) GetLoanHistory(ctx context.Context, loanId string) ([]datatransfers.LoanStatusChangeResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.GetLoanHistoryRequest{
+		Id: loanId,
+	}
+
+	extra := map[string]interface{}{
+		"loan_id": loanId,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetLoanHistory request to Loan Service", extra, nil)
+
+	resp, err := l.client.GetLoanHistory(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetLoanHistory request failed", extra, err)
+		return nil, err
+	}
+
+	var history []datatransfers.LoanStatusChangeResponse
+	for _, change := range resp.History {
+		changeResponse := datatransfers.LoanStatusChangeResponse{
+			Id:        change.Id,
+			LoanId:    change.LoanId,
+			ToStatus:  change.ToStatus.String(),
+			ChangedBy: change.ChangedBy,
+			Note:      change.Note,
+			CreatedAt: time.Unix(change.CreatedAt, 0),
+		}
+		if change.FromStatus != protoLoan.LoanStatus_LOAN_STATUS_UNSPECIFIED {
+			changeResponse.FromStatus = change.FromStatus.String()
+		}
+		history = append(history, changeResponse)
+	}
+
+	extra["history_count"] = len(history)
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetLoanHistory request succeeded", extra, nil)
+
+	return history, nil
+}
+
+func (l *loanClient) ListUserLoans(ctx context.Context, userId string, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.ListUserLoansRequest{
 		UserId:   userId,
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+		Options:  toLoanListOptions(query),
 	}
 
 	extra := map[string]interface{}{
-		"user_id":   userId,
-		"page":      page,
-		"page_size": pageSize,
+		"user_id":    userId,
+		"page":       query.Page,
+		"page_size":  query.PageSize,
+		"sort_by":    query.SortBy,
+		"sort_order": query.SortOrder,
+		"from":       query.From,
+		"to":         query.To,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListUserLoans request to Loan Service", extra, nil)
@@ -195,28 +214,12 @@ func (l *loanClient) ListUserLoans(ctx context.Context, userId string, page int,
 	resp, err := l.client.ListUserLoans(utils.GetProtoContext(ctx), &reqProto)
 	if err != nil {
 		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListUserLoans request failed", extra, err)
-		return nil, 0, 0, err
+		return nil, 0, 0, "", err
 	}
 
 	var loans []datatransfers.LoanResponse
 	for _, loan := range resp.Loans {
-		loanResponse := datatransfers.LoanResponse{
-			Id:        loan.Id,
-			UserId:    loan.UserId,
-			BookId:    loan.BookId,
-			LoanDate:  time.Unix(loan.LoanDate, 0),
-			Status:    loan.Status,
-			Version:   int(loan.Version),
-			CreatedAt: time.Unix(loan.CreatedAt, 0),
-			UpdatedAt: time.Unix(loan.UpdatedAt, 0),
-		}
-
-		if loan.ReturnDate != 0 {
-			returnDate := time.Unix(loan.ReturnDate, 0)
-			loanResponse.ReturnDate = &returnDate
-		}
-
-		loans = append(loans, loanResponse)
+		loans = append(loans, toLoanResponse(loan))
 	}
 
 	extra["loans_count"] = len(loans)
@@ -224,20 +227,25 @@ func (l *loanClient) ListUserLoans(ctx context.Context, userId string, page int,
 	extra["total_pages"] = resp.TotalPages
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListUserLoans request succeeded", extra, nil)
 
-	return loans, int(resp.TotalItems), int(resp.TotalPages), nil
+	return loans, int(resp.TotalItems), int(resp.TotalPages), resp.NextPageToken, nil
 }
 
-func (l *loanClient) ListLoans(ctx context.Context, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error) {
+func (l *loanClient) ListLoans(ctx context.Context, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.ListLoansRequest{
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+		Options:  toLoanListOptions(query),
 	}
 
 	extra := map[string]interface{}{
-		"page":      page,
-		"page_size": pageSize,
+		"page":       query.Page,
+		"page_size":  query.PageSize,
+		"sort_by":    query.SortBy,
+		"sort_order": query.SortOrder,
+		"from":       query.From,
+		"to":         query.To,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListLoans request to Loan Service", extra, nil)
@@ -246,28 +254,12 @@ func (l *loanClient) ListLoans(ctx context.Context, page int, pageSize int) ([]d
 	if err != nil {
 		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListLoans request failed", nil, err)
 
-		return nil, 0, 0, err
+		return nil, 0, 0, "", err
 	}
 
 	var loans []datatransfers.LoanResponse
 	for _, loan := range resp.Loans {
-		loanResponse := datatransfers.LoanResponse{
-			Id:        loan.Id,
-			UserId:    loan.UserId,
-			BookId:    loan.BookId,
-			LoanDate:  time.Unix(loan.LoanDate, 0),
-			Status:    loan.Status,
-			Version:   int(loan.Version),
-			CreatedAt: time.Unix(loan.CreatedAt, 0),
-			UpdatedAt: time.Unix(loan.UpdatedAt, 0),
-		}
-
-		if loan.ReturnDate != 0 {
-			returnDate := time.Unix(loan.ReturnDate, 0)
-			loanResponse.ReturnDate = &returnDate
-		}
-
-		loans = append(loans, loanResponse)
+		loans = append(loans, toLoanResponse(loan))
 	}
 
 	extra["loans_count"] = len(loans)
@@ -275,24 +267,29 @@ func (l *loanClient) ListLoans(ctx context.Context, page int, pageSize int) ([]d
 	extra["total_pages"] = resp.TotalPages
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListLoans request to Loan Service", map[string]interface{}{"loans_count": len(loans)}, nil)
 
-	return loans, int(resp.TotalItems), int(resp.TotalPages), nil
+	return loans, int(resp.TotalItems), int(resp.TotalPages), resp.NextPageToken, nil
 }
 
-func (l *loanClient) GetUserLoansByStatus(ctx context.Context, userId, status string, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error) {
+func (l *loanClient) GetUserLoansByStatus(ctx context.Context, userId, status string, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.GetUserLoansByStatusRequest{
 		UserId:   userId,
-		Status:   status,
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+		Status:   toProtoLoanStatus(status),
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+		Options:  toLoanListOptions(query),
 	}
 
 	extra := map[string]interface{}{
 		"user_id":     userId,
 		"loan_status": status,
-		"page":        page,
-		"page_size":   pageSize,
+		"page":        query.Page,
+		"page_size":   query.PageSize,
+		"sort_by":     query.SortBy,
+		"sort_order":  query.SortOrder,
+		"from":        query.From,
+		"to":          query.To,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetUserLoansByStatus request to Loan Service", extra, nil)
@@ -300,28 +297,12 @@ func (l *loanClient) GetUserLoansByStatus(ctx context.Context, userId, status st
 	resp, err := l.client.GetUserLoansByStatus(utils.GetProtoContext(ctx), &reqProto)
 	if err != nil {
 		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetUserLoansByStatus request failed", extra, err)
-		return nil, 0, 0, err
+		return nil, 0, 0, "", err
 	}
 
 	var loans []datatransfers.LoanResponse
 	for _, loan := range resp.Loans {
-		loanResponse := datatransfers.LoanResponse{
-			Id:        loan.Id,
-			UserId:    loan.UserId,
-			BookId:    loan.BookId,
-			LoanDate:  time.Unix(loan.LoanDate, 0),
-			Status:    loan.Status,
-			Version:   int(loan.Version),
-			CreatedAt: time.Unix(loan.CreatedAt, 0),
-			UpdatedAt: time.Unix(loan.UpdatedAt, 0),
-		}
-
-		if loan.ReturnDate != 0 {
-			returnDate := time.Unix(loan.ReturnDate, 0)
-			loanResponse.ReturnDate = &returnDate
-		}
-
-		loans = append(loans, loanResponse)
+		loans = append(loans, toLoanResponse(loan))
 	}
 
 	extra["loans_count"] = len(loans)
@@ -329,22 +310,27 @@ func (l *loanClient) GetUserLoansByStatus(ctx context.Context, userId, status st
 	extra["total_pages"] = resp.TotalPages
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetUserLoansByStatus request succeeded", extra, nil)
 
-	return loans, int(resp.TotalItems), int(resp.TotalPages), nil
+	return loans, int(resp.TotalItems), int(resp.TotalPages), resp.NextPageToken, nil
 }
 
-func (l *loanClient) GetLoansByStatus(ctx context.Context, status string, page int, pageSize int) ([]datatransfers.LoanResponse, int, int, error) {
+func (l *loanClient) GetLoansByStatus(ctx context.Context, status string, query datatransfers.LoanListQuery) ([]datatransfers.LoanResponse, int, int, string, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.GetLoansByStatusRequest{
-		Status:   status,
-		Page:     int32(page),
-		PageSize: int32(pageSize),
+		Status:   toProtoLoanStatus(status),
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+		Options:  toLoanListOptions(query),
 	}
 
 	extra := map[string]interface{}{
 		"loan_status": status,
-		"page":        page,
-		"page_size":   pageSize,
+		"page":        query.Page,
+		"page_size":   query.PageSize,
+		"sort_by":     query.SortBy,
+		"sort_order":  query.SortOrder,
+		"from":        query.From,
+		"to":          query.To,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetLoansByStatus request to Loan Service", extra, nil)
@@ -352,28 +338,12 @@ func (l *loanClient) GetLoansByStatus(ctx context.Context, status string, page i
 	resp, err := l.client.GetLoansByStatus(utils.GetProtoContext(ctx), &reqProto)
 	if err != nil {
 		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetLoansByStatus request failed", extra, err)
-		return nil, 0, 0, err
+		return nil, 0, 0, "", err
 	}
 
 	var loans []datatransfers.LoanResponse
 	for _, loan := range resp.Loans {
-		loanResponse := datatransfers.LoanResponse{
-			Id:        loan.Id,
-			UserId:    loan.UserId,
-			BookId:    loan.BookId,
-			LoanDate:  time.Unix(loan.LoanDate, 0),
-			Status:    loan.Status,
-			Version:   int(loan.Version),
-			CreatedAt: time.Unix(loan.CreatedAt, 0),
-			UpdatedAt: time.Unix(loan.UpdatedAt, 0),
-		}
-
-		if loan.ReturnDate != 0 {
-			returnDate := time.Unix(loan.ReturnDate, 0)
-			loanResponse.ReturnDate = &returnDate
-		}
-
-		loans = append(loans, loanResponse)
+		loans = append(loans, toLoanResponse(loan))
 	}
 
 	extra["loans_count"] = len(loans)
@@ -381,28 +351,24 @@ func (l *loanClient) GetLoansByStatus(ctx context.Context, status string, page i
 	extra["total_pages"] = resp.TotalPages
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetLoansByStatus request succeeded", extra, nil)
 
-	return loans, int(resp.TotalItems), int(resp.TotalPages), nil
+	return loans, int(resp.TotalItems), int(resp.TotalPages), resp.NextPageToken, nil
 }
 
-func (l *loanClient) ReturnLoan(ctx context.Context, id, userId, email string, returnDate time.Time, dto datatransfers.LoanReturnRequest) (datatransfers.LoanResponse, error) {
+func (l *loanClient) ReturnLoan(ctx context.Context, id, userId, email string, dto datatransfers.LoanReturnRequest) (datatransfers.LoanResponse, error) {
 	requestID := utils.GetRequestIDFromContext(ctx)
 
 	reqProto := protoLoan.ReturnLoanRequest{
-		Id:          id,
-		Email:       email,
-		UserId:      userId,
-		ReturnDate:  returnDate.Unix(),
-		Version:     int32(dto.Version),
-		BookVersion: int32(dto.BookVersion),
+		Id:      id,
+		Email:   email,
+		UserId:  userId,
+		Version: int32(dto.Version),
 	}
 
 	extra := map[string]interface{}{
-		"loan_id":          id,
-		"email":            email,
-		"user_id":          userId,
-		"loan_return_date": returnDate,
-		"version":          dto.Version,
-		"book_version":     dto.BookVersion,
+		"loan_id": id,
+		"email":   email,
+		"user_id": userId,
+		"version": dto.Version,
 	}
 
 	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ReturnLoan request to Loan Service", extra, nil)
@@ -413,23 +379,377 @@ func (l *loanClient) ReturnLoan(ctx context.Context, id, userId, email string, r
 		return datatransfers.LoanResponse{}, err
 	}
 
+	loanResponse := toLoanResponse(resp.Loan)
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ReturnLoan request succeeded", extra, nil)
+
+	return loanResponse, nil
+}
+
+func (l *loanClient) CheckOutCopy(ctx context.Context, changedBy string, dto datatransfers.LoanCheckOutRequest) (datatransfers.LoanResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.CheckOutCopyRequest{
+		UserId:    dto.UserId,
+		Barcode:   dto.Barcode,
+		ChangedBy: changedBy,
+	}
+
+	extra := map[string]interface{}{
+		"user_id":    dto.UserId,
+		"barcode":    dto.Barcode,
+		"changed_by": changedBy,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CheckOutCopy request to Loan Service", extra, nil)
+
+	resp, err := l.client.CheckOutCopy(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "CheckOutCopy request failed", extra, err)
+		return datatransfers.LoanResponse{}, err
+	}
+
+	extra["loan_id"] = resp.Loan.Id
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CheckOutCopy request succeeded", extra, nil)
+
+	return toLoanResponse(resp.Loan), nil
+}
+
+func (l *loanClient) CheckInCopy(ctx context.Context, changedBy string, dto datatransfers.LoanCheckInRequest) (datatransfers.LoanResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.CheckInCopyRequest{
+		Barcode:   dto.Barcode,
+		ChangedBy: changedBy,
+		Condition: dto.Condition,
+	}
+
+	extra := map[string]interface{}{
+		"barcode":    dto.Barcode,
+		"changed_by": changedBy,
+		"condition":  dto.Condition,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CheckInCopy request to Loan Service", extra, nil)
+
+	resp, err := l.client.CheckInCopy(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "CheckInCopy request failed", extra, err)
+		return datatransfers.LoanResponse{}, err
+	}
+
+	extra["loan_id"] = resp.Loan.Id
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CheckInCopy request succeeded", extra, nil)
+
+	return toLoanResponse(resp.Loan), nil
+}
+
+func (l *loanClient) RenewLoan(ctx context.Context, id, userId string, dto datatransfers.LoanRenewRequest) (datatransfers.LoanResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.RenewLoanRequest{
+		Id:      id,
+		UserId:  userId,
+		Version: int32(dto.Version),
+	}
+
+	extra := map[string]interface{}{
+		"loan_id": id,
+		"user_id": userId,
+		"version": dto.Version,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending RenewLoan request to Loan Service", extra, nil)
+
+	resp, err := l.client.RenewLoan(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RenewLoan request failed", extra, err)
+		return datatransfers.LoanResponse{}, err
+	}
+
+	extra["due_date"] = time.Unix(resp.Loan.DueDate, 0)
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "RenewLoan request succeeded", extra, nil)
+
+	return toLoanResponse(resp.Loan), nil
+}
+
+func (l *loanClient) ListUserFines(ctx context.Context, userId string, query datatransfers.FineListQuery) ([]datatransfers.FineResponse, int, int, int64, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.ListUserFinesRequest{
+		UserId:   userId,
+		Status:   query.Status,
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+	}
+
+	extra := map[string]interface{}{
+		"user_id":   userId,
+		"status":    query.Status,
+		"page":      query.Page,
+		"page_size": query.PageSize,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListUserFines request to Loan Service", extra, nil)
+
+	resp, err := l.client.ListUserFines(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListUserFines request failed", extra, err)
+		return nil, 0, 0, 0, err
+	}
+
+	var fines []datatransfers.FineResponse
+	for _, fine := range resp.Fines {
+		fines = append(fines, toFineResponse(fine))
+	}
+
+	extra["fines_count"] = len(fines)
+	extra["total_items"] = resp.TotalItems
+	extra["outstanding"] = resp.Outstanding
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListUserFines request succeeded", extra, nil)
+
+	return fines, int(resp.TotalItems), int(resp.TotalPages), resp.Outstanding, nil
+}
+
+func (l *loanClient) PayFine(ctx context.Context, id, userId string, dto datatransfers.FinePayRequest) (datatransfers.FineResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.PayFineRequest{
+		Id:      id,
+		UserId:  userId,
+		Amount:  dto.Amount,
+		Version: int32(dto.Version),
+	}
+
+	extra := map[string]interface{}{
+		"fine_id": id,
+		"user_id": userId,
+		"amount":  dto.Amount,
+		"version": dto.Version,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending PayFine request to Loan Service", extra, nil)
+
+	resp, err := l.client.PayFine(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "PayFine request failed", extra, err)
+		return datatransfers.FineResponse{}, err
+	}
+
+	extra["status"] = resp.Fine.Status
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "PayFine request succeeded", extra, nil)
+
+	return toFineResponse(resp.Fine), nil
+}
+
+func (l *loanClient) WaiveFine(ctx context.Context, id string, dto datatransfers.FineWaiveRequest) (datatransfers.FineResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.WaiveFineRequest{
+		Id:      id,
+		Note:    dto.Note,
+		Version: int32(dto.Version),
+	}
+
+	extra := map[string]interface{}{
+		"fine_id": id,
+		"note":    dto.Note,
+		"version": dto.Version,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending WaiveFine request to Loan Service", extra, nil)
+
+	resp, err := l.client.WaiveFine(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "WaiveFine request failed", extra, err)
+		return datatransfers.FineResponse{}, err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "WaiveFine request succeeded", extra, nil)
+
+	return toFineResponse(resp.Fine), nil
+}
+
+func (l *loanClient) PlaceHold(ctx context.Context, userId, email string, dto datatransfers.HoldRequest) (datatransfers.HoldResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.PlaceHoldRequest{
+		UserId: userId,
+		Email:  email,
+		BookId: dto.BookId,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+		"email":   email,
+		"book_id": dto.BookId,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending PlaceHold request to Loan Service", extra, nil)
+
+	resp, err := l.client.PlaceHold(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "PlaceHold request failed", extra, err)
+		return datatransfers.HoldResponse{}, err
+	}
+
+	extra["hold_id"] = resp.Hold.Id
+	extra["queue_position"] = resp.Hold.QueuePosition
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "PlaceHold request succeeded", extra, nil)
+
+	return toHoldResponse(resp.Hold), nil
+}
+
+func (l *loanClient) CancelHold(ctx context.Context, id, userId string, dto datatransfers.HoldCancelRequest) (datatransfers.HoldResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.CancelHoldRequest{
+		Id:      id,
+		UserId:  userId,
+		Version: int32(dto.Version),
+	}
+
+	extra := map[string]interface{}{
+		"hold_id": id,
+		"user_id": userId,
+		"version": dto.Version,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CancelHold request to Loan Service", extra, nil)
+
+	resp, err := l.client.CancelHold(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "CancelHold request failed", extra, err)
+		return datatransfers.HoldResponse{}, err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CancelHold request succeeded", extra, nil)
+
+	return toHoldResponse(resp.Hold), nil
+}
+
+func (l *loanClient) ListUserHolds(ctx context.Context, userId string, query datatransfers.HoldListQuery) ([]datatransfers.HoldResponse, int, int, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoLoan.ListUserHoldsRequest{
+		UserId:   userId,
+		Status:   query.Status,
+		Page:     int32(query.Page),
+		PageSize: int32(query.PageSize),
+	}
+
+	extra := map[string]interface{}{
+		"user_id":   userId,
+		"status":    query.Status,
+		"page":      query.Page,
+		"page_size": query.PageSize,
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListUserHolds request to Loan Service", extra, nil)
+
+	resp, err := l.client.ListUserHolds(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListUserHolds request failed", extra, err)
+		return nil, 0, 0, err
+	}
+
+	var holds []datatransfers.HoldResponse
+	for _, hold := range resp.Holds {
+		holds = append(holds, toHoldResponse(hold))
+	}
+
+	extra["holds_count"] = len(holds)
+	extra["total_items"] = resp.TotalItems
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListUserHolds request succeeded", extra, nil)
+
+	return holds, int(resp.TotalItems), int(resp.TotalPages), nil
+}
+
+// toHoldResponse converts a loan service hold into the gateway response, zero ready and expiry times mean not ready yet
+func toHoldResponse(hold *protoLoan.Hold) datatransfers.HoldResponse {
+	holdResponse := datatransfers.HoldResponse{
+		Id:            hold.Id,
+		UserId:        hold.UserId,
+		BookId:        hold.BookId,
+		Status:        hold.Status,
+		QueuePosition: int(hold.QueuePosition),
+		Version:       int(hold.Version),
+		CreatedAt:     time.Unix(hold.CreatedAt, 0),
+		UpdatedAt:     time.Unix(hold.UpdatedAt, 0),
+	}
+
+	if hold.ReadyAt != 0 {
+		readyAt := time.Unix(hold.ReadyAt, 0)
+		holdResponse.ReadyAt = &readyAt
+	}
+	if hold.ExpiresAt != 0 {
+		expiresAt := time.Unix(hold.ExpiresAt, 0)
+		holdResponse.ExpiresAt = &expiresAt
+	}
+
+	return holdResponse
+}
+
+// toFineResponse converts a loan service fine into the gateway response
+func toFineResponse(fine *protoLoan.Fine) datatransfers.FineResponse {
+	return datatransfers.FineResponse{
+		Id:         fine.Id,
+		UserId:     fine.UserId,
+		LoanId:     fine.LoanId,
+		Type:       fine.Type,
+		Amount:     fine.Amount,
+		PaidAmount: fine.PaidAmount,
+		Status:     fine.Status,
+		Version:    int(fine.Version),
+		CreatedAt:  time.Unix(fine.CreatedAt, 0),
+		UpdatedAt:  time.Unix(fine.UpdatedAt, 0),
+	}
+}
+
+// toLoanResponse converts a loan service loan into the gateway response, a zero return date means not returned yet
+func toLoanResponse(loan *protoLoan.Loan) datatransfers.LoanResponse {
 	loanResponse := datatransfers.LoanResponse{
-		Id:        resp.Loan.Id,
-		UserId:    resp.Loan.UserId,
-		BookId:    resp.Loan.BookId,
-		LoanDate:  time.Unix(resp.Loan.LoanDate, 0),
-		Status:    resp.Loan.Status,
-		Version:   int(resp.Loan.Version),
-		CreatedAt: time.Unix(resp.Loan.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.Loan.UpdatedAt, 0),
-	}
-
-	if resp.Loan.ReturnDate != 0 {
-		returnDate := time.Unix(resp.Loan.ReturnDate, 0)
+		Id:           loan.Id,
+		UserId:       loan.UserId,
+		BookId:       loan.BookId,
+		CopyId:       loan.CopyId,
+		LoanDate:     time.Unix(loan.LoanDate, 0),
+		DueDate:      time.Unix(loan.DueDate, 0),
+		RenewalCount: int(loan.RenewalCount),
+		Status:       loan.Status.String(),
+		Version:      int(loan.Version),
+		CreatedAt:    time.Unix(loan.CreatedAt, 0),
+		UpdatedAt:    time.Unix(loan.UpdatedAt, 0),
+	}
+
+	if loan.ReturnDate != 0 {
+		returnDate := time.Unix(loan.ReturnDate, 0)
 		loanResponse.ReturnDate = &returnDate
 	}
 
-	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ReturnLoan request succeeded", extra, nil)
+	return loanResponse
+}
 
-	return loanResponse, nil
+// toProtoLoanStatus maps a status name onto the loan service enum, unknown names map to LOAN_STATUS_UNSPECIFIED
+func toProtoLoanStatus(status string) protoLoan.LoanStatus {
+	return protoLoan.LoanStatus(protoLoan.LoanStatus_value[status])
+}
+
+// toLoanListOptions converts the gateway listing query into loan service listing options.
+// Dates are interpreted as whole days in the local time zone, so `to` covers the entire day.
+func toLoanListOptions(query datatransfers.LoanListQuery) *protoLoan.LoanListOptions {
+	options := &protoLoan.LoanListOptions{
+		SortBy:    query.SortBy,
+		SortOrder: query.SortOrder,
+		PageToken: query.PageToken,
+	}
+
+	if from, err := time.ParseInLocation(time.DateOnly, query.From, time.Local); err == nil {
+		options.FromDate = from.Unix()
+	}
+	if to, err := time.ParseInLocation(time.DateOnly, query.To, time.Local); err == nil {
+		options.ToDate = to.AddDate(0, 0, 1).Add(-time.Second).Unix()
+	}
+
+	return options
 }
@@ -20,6 +20,11 @@ type UserClient interface {
 	GetUserById(ctx context.Context, userId string) (datatransfers.UserResponse, error)
 	GetUserByEmail(ctx context.Context, email string) (datatransfers.UserResponse, error)
 	ListUsers(ctx context.Context, page int, pageSize int) ([]datatransfers.UserResponse, int, int, error)
+	GetUserEntitlements(ctx context.Context, userId string) (datatransfers.EntitlementsResponse, error)
+	ListMembershipTiers(ctx context.Context) ([]datatransfers.MembershipTierResponse, error)
+	CreateMembershipTier(ctx context.Context, req datatransfers.MembershipTierRequest) (datatransfers.MembershipTierResponse, error)
+	UpdateMembershipTier(ctx context.Context, tierId string, req datatransfers.MembershipTierRequest) (datatransfers.MembershipTierResponse, error)
+	AssignMembershipTier(ctx context.Context, userId string, req datatransfers.TierAssignRequest) (datatransfers.UserResponse, error)
 }
 
 type userClient struct {
@@ -64,15 +69,7 @@ func (u *userClient) GetUserById(ctx context.Context, userId string) (datatransf
 
 	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetUserById request succeeded", extra, nil)
 
-	return datatransfers.UserResponse{
-		Id:        resp.User.Id,
-		Username:  resp.User.Username,
-		Email:     resp.User.Email,
-		Verified:  resp.User.Verified,
-		Role:      resp.User.Role,
-		CreatedAt: time.Unix(resp.User.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.User.UpdatedAt, 0),
-	}, nil
+	return toUserResponse(resp.User), nil
 }
 
 func (u *userClient) GetUserByEmail(ctx context.Context, email string) (datatransfers.UserResponse, error) {
@@ -96,15 +93,7 @@ func (u *userClient) GetUserByEmail(ctx context.Context, email string) (datatran
 
 	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetUserByEmail request succeeded", extra, nil)
 
-	return datatransfers.UserResponse{
-		Id:        resp.User.Id,
-		Username:  resp.User.Username,
-		Email:     resp.User.Email,
-		Verified:  resp.User.Verified,
-		Role:      resp.User.Role,
-		CreatedAt: time.Unix(resp.User.CreatedAt, 0),
-		UpdatedAt: time.Unix(resp.User.UpdatedAt, 0),
-	}, nil
+	return toUserResponse(resp.User), nil
 }
 
 func (u *userClient) ListUsers(ctx context.Context, page int, pageSize int) ([]datatransfers.UserResponse, int, int, error) {
@@ -130,15 +119,7 @@ func (u *userClient) ListUsers(ctx context.Context, page int, pageSize int) ([]d
 
 	var users []datatransfers.UserResponse
 	for _, user := range resp.Users {
-		users = append(users, datatransfers.UserResponse{
-			Id:        user.Id,
-			Username:  user.Username,
-			Email:     user.Email,
-			Verified:  user.Verified,
-			Role:      user.Role,
-			CreatedAt: time.Unix(user.CreatedAt, 0),
-			UpdatedAt: time.Unix(user.UpdatedAt, 0),
-		})
+		users = append(users, toUserResponse(user))
 	}
 
 	extra["users_count"] = len(users)
@@ -148,3 +129,162 @@ func (u *userClient) ListUsers(ctx context.Context, page int, pageSize int) ([]d
 
 	return users, int(resp.TotalItems), int(resp.TotalPages), nil
 }
+
+func (u *userClient) GetUserEntitlements(ctx context.Context, userId string) (datatransfers.EntitlementsResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoUser.GetUserEntitlementsRequest{
+		UserId: userId,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending GetUserEntitlements request to User Service", extra, nil)
+
+	resp, err := u.client.GetUserEntitlements(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "GetUserEntitlements request failed", extra, err)
+		return datatransfers.EntitlementsResponse{}, err
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "GetUserEntitlements request succeeded", extra, nil)
+
+	return datatransfers.EntitlementsResponse{
+		UserId:             resp.UserId,
+		TierId:             resp.TierId,
+		TierName:           resp.TierName,
+		MaxConcurrentLoans: int(resp.MaxConcurrentLoans),
+		LoanDurationDays:   int(resp.LoanDurationDays),
+		MaxRenewals:        int(resp.MaxRenewals),
+	}, nil
+}
+
+func (u *userClient) ListMembershipTiers(ctx context.Context) ([]datatransfers.MembershipTierResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending ListMembershipTiers request to User Service", nil, nil)
+
+	resp, err := u.client.ListMembershipTiers(utils.GetProtoContext(ctx), &protoUser.ListMembershipTiersRequest{})
+	if err != nil {
+		u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListMembershipTiers request failed", nil, err)
+		return nil, err
+	}
+
+	var tiers []datatransfers.MembershipTierResponse
+	for _, tier := range resp.Tiers {
+		tiers = append(tiers, toMembershipTierResponse(tier))
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "ListMembershipTiers request succeeded", map[string]interface{}{"tiers_count": len(tiers)}, nil)
+
+	return tiers, nil
+}
+
+func (u *userClient) CreateMembershipTier(ctx context.Context, req datatransfers.MembershipTierRequest) (datatransfers.MembershipTierResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoUser.CreateMembershipTierRequest{
+		Name:               req.Name,
+		MaxConcurrentLoans: int32(req.MaxConcurrentLoans),
+		LoanDurationDays:   int32(req.LoanDurationDays),
+		MaxRenewals:        int32(req.MaxRenewals),
+	}
+
+	extra := map[string]interface{}{
+		"name": req.Name,
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending CreateMembershipTier request to User Service", extra, nil)
+
+	resp, err := u.client.CreateMembershipTier(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "CreateMembershipTier request failed", extra, err)
+		return datatransfers.MembershipTierResponse{}, err
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "CreateMembershipTier request succeeded", extra, nil)
+
+	return toMembershipTierResponse(resp.Tier), nil
+}
+
+func (u *userClient) UpdateMembershipTier(ctx context.Context, tierId string, req datatransfers.MembershipTierRequest) (datatransfers.MembershipTierResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoUser.UpdateMembershipTierRequest{
+		Id:                 tierId,
+		Name:               req.Name,
+		MaxConcurrentLoans: int32(req.MaxConcurrentLoans),
+		LoanDurationDays:   int32(req.LoanDurationDays),
+		MaxRenewals:        int32(req.MaxRenewals),
+	}
+
+	extra := map[string]interface{}{
+		"tier_id": tierId,
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending UpdateMembershipTier request to User Service", extra, nil)
+
+	resp, err := u.client.UpdateMembershipTier(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UpdateMembershipTier request failed", extra, err)
+		return datatransfers.MembershipTierResponse{}, err
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "UpdateMembershipTier request succeeded", extra, nil)
+
+	return toMembershipTierResponse(resp.Tier), nil
+}
+
+func (u *userClient) AssignMembershipTier(ctx context.Context, userId string, req datatransfers.TierAssignRequest) (datatransfers.UserResponse, error) {
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	reqProto := protoUser.AssignMembershipTierRequest{
+		UserId: userId,
+		TierId: req.TierId,
+	}
+
+	extra := map[string]interface{}{
+		"user_id": userId,
+		"tier_id": req.TierId,
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sending AssignMembershipTier request to User Service", extra, nil)
+
+	resp, err := u.client.AssignMembershipTier(utils.GetProtoContext(ctx), &reqProto)
+	if err != nil {
+		u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "AssignMembershipTier request failed", extra, err)
+		return datatransfers.UserResponse{}, err
+	}
+
+	u.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "AssignMembershipTier request succeeded", extra, nil)
+
+	return toUserResponse(resp.User), nil
+}
+
+func toUserResponse(user *protoUser.User) datatransfers.UserResponse {
+	return datatransfers.UserResponse{
+		Id:        user.Id,
+		Username:  user.Username,
+		Email:     user.Email,
+		Verified:  user.Verified,
+		Roles:     user.Roles,
+		TierId:    user.TierId,
+		CreatedAt: time.Unix(user.CreatedAt, 0),
+		UpdatedAt: time.Unix(user.UpdatedAt, 0),
+	}
+}
+
+func toMembershipTierResponse(tier *protoUser.MembershipTier) datatransfers.MembershipTierResponse {
+	return datatransfers.MembershipTierResponse{
+		Id:                 tier.Id,
+		Name:               tier.Name,
+		MaxConcurrentLoans: int(tier.MaxConcurrentLoans),
+		LoanDurationDays:   int(tier.LoanDurationDays),
+		MaxRenewals:        int(tier.MaxRenewals),
+		IsDefault:          tier.IsDefault,
+		CreatedAt:          time.Unix(tier.CreatedAt, 0),
+		UpdatedAt:          time.Unix(tier.UpdatedAt, 0),
+	}
+}
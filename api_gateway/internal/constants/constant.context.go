@@ -4,6 +4,10 @@ type contextKey string
 
 const (
 	ContextRequestIDKey contextKey = "requestID"
+	ContextClientIPKey  contextKey = "clientIP"
+	ContextUserAgentKey contextKey = "userAgent"
 
 	ContextProtoRequestIDKey = "request-id"
+	ContextProtoClientIPKey  = "client-ip"
+	ContextProtoUserAgentKey = "client-user-agent"
 )
@@ -2,9 +2,11 @@ package constants
 
 const (
 	ExchangeTypeDirect = "direct"
+	ExchangeTypeFanout = "fanout"
 
-	LogExchange = "log_exchange"
-	LogQueue    = "log_queue"
+	LogExchange        = "log_exchange"
+	LogQueue           = "log_queue"
+	RevocationExchange = "revocation_exchange" // fanout, every instance binds a queue of its own
 
 	LogServiceApiGateway = "api-gateway"
 
@@ -0,0 +1,14 @@
+package constants
+
+// Permissions the routes require, auth_service grants them through the roles of a user
+const (
+	PermissionBooksWrite      = "books:write"
+	PermissionCopiesManage    = "copies:manage"
+	PermissionAuthorsWrite    = "authors:write"
+	PermissionCategoriesWrite = "categories:write"
+	PermissionCatalogueAdmin  = "catalogue:admin"
+	PermissionLoansManage     = "loans:manage"
+	PermissionUsersRead       = "users:read"
+	PermissionUsersManage     = "users:manage"
+	PermissionRolesManage     = "roles:manage"
+)
@@ -0,0 +1,133 @@
+package consumer
+
+import (
+	"api_gateway/internal/constants"
+	"api_gateway/internal/models"
+	"api_gateway/pkg/logger"
+	"api_gateway/pkg/utils"
+	"context"
+	"encoding/json"
+	"log"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// RevocationSink learns of the revocations received and of when they are received at all
+type RevocationSink interface {
+	Connected()
+	Disconnected()
+	Apply(event models.RevocationEvent)
+}
+
+// RevocationConsumer receives the revocations of the auth service on a queue of this instance only, so that
+// every instance of the gateway knows of them. The sink is told when the consumer starts and stops, the
+// revocations made while it was not consuming are only known to the auth service.
+type RevocationConsumer struct {
+	channel *amqp.Channel
+	queue   string
+	sink    RevocationSink
+	logger  *logger.Logger
+}
+
+func NewRevocationConsumer(conn *amqp.Connection, sink RevocationSink, logger *logger.Logger) (*RevocationConsumer, error) {
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.ExchangeDeclare(
+		constants.RevocationExchange, // Exchange name
+		constants.ExchangeTypeFanout, // Exchange type
+		true,                         // Durable
+		false,                        // Auto-deleted
+		false,                        // Internal
+		false,                        // No-wait
+		nil,                          // Arguments
+	)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	// Server named and exclusive, the queue goes away with the instance
+	queue, err := ch.QueueDeclare(
+		"",    // Name
+		false, // Durable
+		true,  // Delete when unused
+		true,  // Exclusive
+		false, // No-wait
+		nil,   // Arguments
+	)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	err = ch.QueueBind(
+		queue.Name,                   // Queue name
+		"",                           // Routing key (ignored by a fanout exchange)
+		constants.RevocationExchange, // Exchange name
+		false,                        // No-wait
+		nil,                          // Arguments
+	)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+
+	return &RevocationConsumer{
+		channel: ch,
+		queue:   queue.Name,
+		sink:    sink,
+		logger:  logger,
+	}, nil
+}
+
+// Start hands every revocation received to the sink until ctx is cancelled or the channel closes
+func (c *RevocationConsumer) Start(ctx context.Context) {
+	msgs, err := c.channel.Consume(
+		c.queue, // Queue
+		"",      // Consumer
+		true,    // Auto-ack, applying a revocation cannot fail
+		true,    // Exclusive
+		false,   // No-local
+		false,   // No-wait
+		nil,     // Args
+	)
+	if err != nil {
+		log.Printf("[%s] Failed to start consuming revocations: %v\n", utils.GetLocation(), err)
+		return
+	}
+	log.Printf("[%s] Revocation consumer started on queue %s\n", utils.GetLocation(), c.queue)
+	c.sink.Connected()
+	defer c.sink.Disconnected()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("[%s] Revocation consumer stopped\n", utils.GetLocation())
+			return
+		case d, ok := <-msgs:
+			if !ok {
+				log.Printf("[%s] Revocation channel closed, tokens are checked with auth_service from now on\n", utils.GetLocation())
+				return
+			}
+
+			var event models.RevocationEvent
+			if err := json.Unmarshal(d.Body, &event); err != nil {
+				c.logger.LogMessage(utils.GetLocation(), "unknown", constants.LogLevelError, "Failed to parse revocation event", nil, err)
+				continue
+			}
+
+			c.sink.Apply(event)
+			c.logger.LogMessage(utils.GetLocation(), event.XCorrelationID, constants.LogLevelInfo, "Revocation event handled", map[string]interface{}{
+				"type": event.Type,
+			}, nil)
+		}
+	}
+}
+
+// Close closes the consumer channel
+func (c *RevocationConsumer) Close() error {
+	return c.channel.Close()
+}
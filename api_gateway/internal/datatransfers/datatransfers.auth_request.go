@@ -27,3 +27,52 @@ type ValidateTokenRequest struct {
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token" validate:"required"`
 }
+
+type RequestPasswordResetRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Email       string `json:"email" validate:"required,email"`
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"` // checked against the password policy of the auth service
+}
+
+type VerifyMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"` // authenticator code or backup code
+}
+
+// EnrollMFARequest starts the enrollment a login asked for, a signed-in user enrolls without a body
+type EnrollMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+}
+
+type ConfirmMFARequest struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type ConfirmMFAEnrollmentRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type UnlockAccountRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type RoleMFARequirementRequest struct {
+	Required *bool `json:"required" validate:"required"`
+}
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=2,max=50"`
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions" validate:"unique"`
+}
+
+// UpdateRoleRequest replaces the description and the permissions of a role
+type UpdateRoleRequest struct {
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions" validate:"unique"`
+}
@@ -21,16 +21,22 @@ type VerifyEmailResponse struct {
 }
 
 type LoginResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	Message      string `json:"message"`
+	AccessToken           string `json:"access_token"`
+	RefreshToken          string `json:"refresh_token"`
+	Message               string `json:"message"`
+	MFARequired           bool   `json:"mfa_required"`
+	MFAToken              string `json:"mfa_token,omitempty"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required,omitempty"`
 }
 
 type ValidateTokenResponse struct {
-	Valid  bool   `json:"valid"`
-	UserID string `json:"user_id"`
-	Role   string `json:"role"`
-	Email  string `json:"email"`
+	Valid       bool     `json:"valid"`
+	UserID      string   `json:"user_id"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+	Email       string   `json:"email"`
+	SessionID   string   `json:"session_id"`
+	TokenID     string   `json:"token_id"`
 }
 
 type RefreshTokenResponse struct {
@@ -41,3 +47,82 @@ type RefreshTokenResponse struct {
 type LogoutResponse struct {
 	Message string `json:"message"`
 }
+
+type RequestPasswordResetResponse struct {
+	Message string `json:"message"`
+}
+
+type ResetPasswordResponse struct {
+	Message string `json:"message"`
+}
+
+type EnrollMFAResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+}
+
+type ConfirmMFAResponse struct {
+	BackupCodes  []string `json:"backup_codes"`
+	AccessToken  string   `json:"access_token,omitempty"`
+	RefreshToken string   `json:"refresh_token,omitempty"`
+	Message      string   `json:"message"`
+}
+
+type RoleMFARequirementResponse struct {
+	Role     string `json:"role"`
+	Required bool   `json:"required"`
+}
+
+type PermissionResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RoleResponse struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	BuiltIn     bool     `json:"built_in"`
+	Permissions []string `json:"permissions"`
+}
+
+type RoleChangeResponse struct {
+	Message string `json:"message"`
+}
+
+type UnlockAccountResponse struct {
+	Message string `json:"message"`
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	Current    bool      `json:"current"` // the session of the access token making the request
+}
+
+type RevokeSessionResponse struct {
+	Message string `json:"message"`
+}
+
+type ForceLogoutResponse struct {
+	Message string `json:"message"`
+}
+
+// JWK is a public key that verifies the tokens, RSA keys fill n and e, Ed25519 keys crv and x
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSResponse is a JSON Web Key Set, served as is rather than wrapped so standard verifiers can read it
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
@@ -13,4 +13,18 @@ type AuthorUpdateRequest struct {
 
 type AuthorDeleteRequest struct {
 	Version int `json:"version" validate:"required"`
+	// OnBooks chooses what happens to the books of the author: reject (default), reassign to ReassignTo or cascade
+	OnBooks    string `json:"on_books" validate:"omitempty,oneof=reject reassign cascade"`
+	ReassignTo string `json:"reassign_to" validate:"required_if=OnBooks reassign,omitempty,uuid4"`
+}
+
+// AuthorMergeRequest merges the duplicates into the author of the path, each at the version the caller last saw
+type AuthorMergeRequest struct {
+	Version    int                     `json:"version" validate:"required,min=1"`
+	Duplicates []MergeDuplicateRequest `json:"duplicates" validate:"required,min=1,dive"`
+}
+
+type MergeDuplicateRequest struct {
+	Id      string `json:"id" validate:"required,uuid4"`
+	Version int    `json:"version" validate:"required,min=1"`
 }
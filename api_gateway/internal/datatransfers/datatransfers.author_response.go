@@ -11,4 +11,5 @@ type AuthorResponse struct {
 	Version     int             `json:"version"`
 	CreatedAt   time.Time       `json:"created_at,omitempty"`
 	UpdatedAt   time.Time       `json:"updated_at,omitempty"`
+	DeletedAt   *time.Time      `json:"deleted_at,omitempty"` // set for an author in the trash
 }
@@ -1,20 +1,78 @@
 package datatransfers
 
+type BookAuthorRequest struct {
+	AuthorId string `json:"author_id" validate:"required,uuid4"`
+	Role     string `json:"role" validate:"omitempty,oneof=author editor translator"`
+}
+
 type BookRequest struct {
-	Title      string `json:"title" validate:"required,min=3,max=255"`
-	AuthorId   string `json:"author_id" validate:"required,uuid4"`
-	CategoryId string `json:"category_id" validate:"required,uuid4"`
-	Stock      int    `json:"stock" validate:"required,min=0"`
+	Title           string              `json:"title" validate:"required,min=3,max=255"`
+	Authors         []BookAuthorRequest `json:"authors" validate:"required,min=1,max=20,dive"`
+	CategoryId      string              `json:"category_id" validate:"required,uuid4"`
+	PublicationYear int                 `json:"publication_year" validate:"min=0,max=9999"`
+	ISBN            string              `json:"isbn" validate:"omitempty,max=17"`
+	Publisher       string              `json:"publisher" validate:"max=255"`
+	Language        string              `json:"language" validate:"omitempty,min=2,max=3,lowercase,alpha"`
+	PageCount       int                 `json:"page_count" validate:"min=0,max=100000"`
+	Edition         string              `json:"edition" validate:"max=50"`
+	Description     string              `json:"description" validate:"max=5000"`
 }
 
 type BookUpdateRequest struct {
-	Title      string `json:"title" validate:"required,min=3,max=255"`
-	AuthorId   string `json:"author_id" validate:"required,uuid4"`
-	CategoryId string `json:"category_id" validate:"required,uuid4"`
-	Stock      int    `json:"stock" validate:"required,min=0"`
-	Version    int    `json:"version" validate:"required,min=1"`
+	Title           string              `json:"title" validate:"required,min=3,max=255"`
+	Authors         []BookAuthorRequest `json:"authors" validate:"required,min=1,max=20,dive"`
+	CategoryId      string              `json:"category_id" validate:"required,uuid4"`
+	PublicationYear int                 `json:"publication_year" validate:"min=0,max=9999"`
+	ISBN            string              `json:"isbn" validate:"omitempty,max=17"`
+	Publisher       string              `json:"publisher" validate:"max=255"`
+	Language        string              `json:"language" validate:"omitempty,min=2,max=3,lowercase,alpha"`
+	PageCount       int                 `json:"page_count" validate:"min=0,max=100000"`
+	Edition         string              `json:"edition" validate:"max=50"`
+	Description     string              `json:"description" validate:"max=5000"`
+	Version         int                 `json:"version" validate:"required,min=1"`
+}
+
+type BookSearchQuery struct {
+	Query         string `query:"q" validate:"max=255"`
+	AuthorId      string `query:"authorId" validate:"omitempty,uuid4"`
+	CategoryId    string `query:"categoryId" validate:"omitempty,uuid4"`
+	AvailableOnly bool   `query:"available"`
+	YearFrom      int    `query:"yearFrom" validate:"min=0,max=9999"`
+	YearTo        int    `query:"yearTo" validate:"min=0,max=9999"`
+	SortBy        string `query:"sortBy" validate:"omitempty,oneof=relevance title publication_year created_at"`
+	SortOrder     string `query:"sortOrder" validate:"omitempty,oneof=asc desc"`
+	Page          int    `query:"page" validate:"min=1"`
+	PageSize      int    `query:"pageSize" validate:"min=1,max=100"`
 }
 
 type BookDeleteRequest struct {
 	Version int `json:"version" validate:"required:min=1"`
 }
+
+type BookCopyRequest struct {
+	Barcode       string `json:"barcode" validate:"required,min=1,max=64"`
+	Condition     string `json:"condition" validate:"required,oneof=NEW GOOD FAIR POOR DAMAGED"`
+	ShelfLocation string `json:"shelf_location" validate:"max=100"`
+}
+
+type BookCopyUpdateRequest struct {
+	Condition     string `json:"condition" validate:"required,oneof=NEW GOOD FAIR POOR DAMAGED"`
+	ShelfLocation string `json:"shelf_location" validate:"max=100"`
+	Version       int    `json:"version" validate:"required,min=1"`
+}
+
+type BookCopyRetireRequest struct {
+	Version int `json:"version" validate:"required,min=1"`
+}
+
+type BookCopyListQuery struct {
+	Status string `query:"status" validate:"omitempty,oneof=AVAILABLE ON_LOAN RETIRED"`
+}
+
+type BookImportRequest struct {
+	Format string `form:"format" validate:"omitempty,oneof=csv marc21"` // inferred from the file extension when empty
+}
+
+type BookExportQuery struct {
+	Format string `query:"format" validate:"omitempty,oneof=csv marc21"` // defaults to csv
+}
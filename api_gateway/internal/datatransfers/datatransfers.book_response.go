@@ -3,14 +3,67 @@ package datatransfers
 import "time"
 
 type BookResponse struct {
-	Id         string            `json:"id"`
-	Title      string            `json:"title"`
-	AuthorId   *string           `json:"author_id,omitempty"`
-	Author     *AuthorResponse   `json:"author,omitempty"`
-	CategoryId *string           `json:"category_id,omitempty"`
-	Category   *CategoryResponse `json:"category,omitempty"`
-	Stock      int               `json:"stock"`
-	Version    int               `json:"version"`
-	CreatedAt  time.Time         `json:"created_at"`
-	UpdatedAt  time.Time         `json:"updated_at"`
+	Id              string               `json:"id"`
+	Title           string               `json:"title"`
+	AuthorIds       []string             `json:"author_ids,omitempty"`
+	Authors         []BookAuthorResponse `json:"authors,omitempty"`
+	CategoryId      *string              `json:"category_id,omitempty"`
+	Category        *CategoryResponse    `json:"category,omitempty"`
+	PublicationYear int                  `json:"publication_year,omitempty"`
+	ISBN            string               `json:"isbn,omitempty"`
+	Publisher       string               `json:"publisher,omitempty"`
+	Language        string               `json:"language,omitempty"`
+	PageCount       int                  `json:"page_count,omitempty"`
+	Edition         string               `json:"edition,omitempty"`
+	Description     string               `json:"description,omitempty"`
+	Stock           int                  `json:"stock"`
+	Version         int                  `json:"version"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+	DeletedAt       *time.Time           `json:"deleted_at,omitempty"` // set for a book in the trash
+}
+
+type BookAuthorResponse struct {
+	AuthorId *string         `json:"author_id,omitempty"`
+	Author   *AuthorResponse `json:"author,omitempty"`
+	Role     string          `json:"role"`
+}
+
+type BookSearchHitResponse struct {
+	Book      BookResponse `json:"book"`
+	Highlight string       `json:"highlight"`
+	Rank      float32      `json:"rank"`
+}
+
+type BookCopyResponse struct {
+	Id            string    `json:"id"`
+	BookId        string    `json:"book_id"`
+	Barcode       string    `json:"barcode"`
+	Condition     string    `json:"condition"`
+	ShelfLocation string    `json:"shelf_location"`
+	Status        string    `json:"status"`
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type BookImportJobResponse struct {
+	Id           string                       `json:"id"`
+	Format       string                       `json:"format"`
+	FileName     string                       `json:"file_name"`
+	Status       string                       `json:"status"`
+	TotalRows    int                          `json:"total_rows"`
+	ImportedRows int                          `json:"imported_rows"`
+	FailedRows   int                          `json:"failed_rows"`
+	Errors       []BookImportRowErrorResponse `json:"errors"`
+	Error        string                       `json:"error,omitempty"`
+	RequestedBy  string                       `json:"requested_by,omitempty"`
+	CreatedAt    time.Time                    `json:"created_at"`
+	StartedAt    *time.Time                   `json:"started_at,omitempty"`
+	FinishedAt   *time.Time                   `json:"finished_at,omitempty"`
+}
+
+type BookImportRowErrorResponse struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
 }
@@ -1,10 +1,25 @@
 package datatransfers
 
 type CategoryRequest struct {
-	Name string `json:"name" validate:"required,min=3,max=100"`
+	Name     string `json:"name" validate:"required,min=3,max=100"`
+	ParentId string `json:"parent_id" validate:"omitempty,uuid4"`
 }
 
 type CategoryUpdateRequest struct {
-	Name    string `json:"name" validate:"required,min=3,max=100"`
-	Version int    `json:"version" validate:"required,min=1"`
+	Name     string `json:"name" validate:"required,min=3,max=100"`
+	ParentId string `json:"parent_id" validate:"omitempty,uuid4"` // Empty moves the category to the top level
+	Version  int    `json:"version" validate:"required,min=1"`
+}
+
+type CategoryDeleteRequest struct {
+	Version int `json:"version" validate:"required,min=1"`
+	// OnBooks chooses what happens to the books of the category: reject (default), reassign to ReassignTo or cascade
+	OnBooks    string `json:"on_books" validate:"omitempty,oneof=reject reassign cascade"`
+	ReassignTo string `json:"reassign_to" validate:"required_if=OnBooks reassign,omitempty,uuid4"`
+}
+
+// CategoryMergeRequest merges the duplicates into the category of the path, their subcategories move under it
+type CategoryMergeRequest struct {
+	Version    int                     `json:"version" validate:"required,min=1"`
+	Duplicates []MergeDuplicateRequest `json:"duplicates" validate:"required,min=1,dive"`
 }
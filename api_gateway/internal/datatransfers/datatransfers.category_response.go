@@ -5,9 +5,17 @@ import "time"
 type CategoryResponse struct {
 	Id          string          `json:"id"`
 	Name        string          `json:"name"`
+	ParentId    string          `json:"parent_id,omitempty"`
 	SampleBooks *[]BookResponse `json:"sample_books,omitempty"` // Sample of book (previous scenario no longer valid because now book service use pagination)
 	TotalBooks  *int            `json:"total_books,omitempty"`
 	Version     int             `json:"version"`
 	CreatedAt   time.Time       `json:"created_at"`
 	UpdatedAt   time.Time       `json:"updated_at"`
+	DeletedAt   *time.Time      `json:"deleted_at,omitempty"` // set for a category in the trash
+}
+
+// CategoryNodeResponse is a category of the category tree with its subcategories
+type CategoryNodeResponse struct {
+	CategoryResponse
+	Children []CategoryNodeResponse `json:"children"`
 }
@@ -1,16 +1,70 @@
 package datatransfers
 
 type LoanRequest struct {
-	BookId      string `json:"book_id" validate:"required,uuid4"`
-	BookVersion int    `json:"book_version" validate:"required,min=1"`
+	BookId string `json:"book_id" validate:"required,uuid4"`
 }
 
 type LoanStatusUpdateRequest struct {
-	Status  string `json:"status" validate:"required,oneof=BORROWED RETURNED OVERDUE LOST"`
+	Status  string `json:"status" validate:"required,oneof=RETURNED OVERDUE LOST FOUND"`
 	Version int    `json:"version" validate:"required,min=1"`
+	Note    string `json:"note" validate:"max=255"`
 }
 
 type LoanReturnRequest struct {
-	Version     int `json:"version" validate:"required,min=1"`
-	BookVersion int `json:"book_version" validate:"required,min=1"`
+	Version int `json:"version" validate:"required,min=1"`
+}
+
+type LoanCheckOutRequest struct {
+	UserId  string `json:"user_id" validate:"required,uuid4"`
+	Barcode string `json:"barcode" validate:"required,min=1,max=64"`
+}
+
+type LoanCheckInRequest struct {
+	Barcode   string `json:"barcode" validate:"required,min=1,max=64"`
+	Condition string `json:"condition" validate:"omitempty,oneof=NEW GOOD FAIR POOR DAMAGED"`
+}
+
+type LoanRenewRequest struct {
+	Version int `json:"version" validate:"required,min=1"`
+}
+
+type LoanListQuery struct {
+	Status    string `query:"status" validate:"omitempty,oneof=BORROWED RETURNED OVERDUE LOST FOUND"`
+	Page      int    `query:"page" validate:"min=1"`
+	PageSize  int    `query:"pageSize" validate:"min=1,max=100"`
+	SortBy    string `query:"sortBy" validate:"omitempty,oneof=loan_date updated_at"`
+	SortOrder string `query:"sortOrder" validate:"omitempty,oneof=asc desc"`
+	From      string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To        string `query:"to" validate:"omitempty,datetime=2006-01-02"`
+	PageToken string `query:"pageToken"`
+}
+
+type FineListQuery struct {
+	Status   string `query:"status" validate:"omitempty,oneof=UNPAID PAID WAIVED"`
+	Page     int    `query:"page" validate:"min=1"`
+	PageSize int    `query:"pageSize" validate:"min=1,max=100"`
+}
+
+type FinePayRequest struct {
+	Amount  int64 `json:"amount" validate:"required,min=1"`
+	Version int   `json:"version" validate:"required,min=1"`
+}
+
+type FineWaiveRequest struct {
+	Note    string `json:"note" validate:"max=255"`
+	Version int    `json:"version" validate:"required,min=1"`
+}
+
+type HoldRequest struct {
+	BookId string `json:"book_id" validate:"required,uuid4"`
+}
+
+type HoldCancelRequest struct {
+	Version int `json:"version" validate:"required,min=1"`
+}
+
+type HoldListQuery struct {
+	Status   string `query:"status" validate:"omitempty,oneof=WAITING READY FULFILLED CANCELLED EXPIRED"`
+	Page     int    `query:"page" validate:"min=1"`
+	PageSize int    `query:"pageSize" validate:"min=1,max=100"`
 }
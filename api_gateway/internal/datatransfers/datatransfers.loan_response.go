@@ -3,13 +3,52 @@ package datatransfers
 import "time"
 
 type LoanResponse struct {
-	Id         string     `json:"id"`
-	UserId     string     `json:"user_id"`
-	BookId     string     `json:"book_id"`
-	LoanDate   time.Time  `json:"loan_date"`
-	ReturnDate *time.Time `json:"return_date"`
-	Status     string     `json:"status"`
-	Version    int        `json:"version"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
+	Id           string     `json:"id"`
+	UserId       string     `json:"user_id"`
+	BookId       string     `json:"book_id"`
+	CopyId       string     `json:"copy_id"`
+	LoanDate     time.Time  `json:"loan_date"`
+	DueDate      time.Time  `json:"due_date"`
+	ReturnDate   *time.Time `json:"return_date"`
+	RenewalCount int        `json:"renewal_count"`
+	Status       string     `json:"status"`
+	Version      int        `json:"version"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type LoanStatusChangeResponse struct {
+	Id         string    `json:"id"`
+	LoanId     string    `json:"loan_id"`
+	FromStatus string    `json:"from_status"` // empty for the initial BORROWED entry
+	ToStatus   string    `json:"to_status"`
+	ChangedBy  string    `json:"changed_by"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type FineResponse struct {
+	Id         string    `json:"id"`
+	UserId     string    `json:"user_id"`
+	LoanId     string    `json:"loan_id"`
+	Type       string    `json:"type"`
+	Amount     int64     `json:"amount"`
+	PaidAmount int64     `json:"paid_amount"`
+	Status     string    `json:"status"`
+	Version    int       `json:"version"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type HoldResponse struct {
+	Id            string     `json:"id"`
+	UserId        string     `json:"user_id"`
+	BookId        string     `json:"book_id"`
+	Status        string     `json:"status"`
+	QueuePosition int        `json:"queue_position"`
+	ReadyAt       *time.Time `json:"ready_at"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	Version       int        `json:"version"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
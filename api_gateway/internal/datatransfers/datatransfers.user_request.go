@@ -0,0 +1,26 @@
+package datatransfers
+
+type MembershipTierRequest struct {
+	Name               string `json:"name" validate:"required,max=100"`
+	MaxConcurrentLoans int    `json:"max_concurrent_loans" validate:"required,min=1"`
+	LoanDurationDays   int    `json:"loan_duration_days" validate:"required,min=1"`
+	MaxRenewals        int    `json:"max_renewals" validate:"min=0"`
+}
+
+type TierAssignRequest struct {
+	TierId string `json:"tier_id" validate:"omitempty,uuid4"` // empty moves the user back to the default tier
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required"` // checked against the password policy of the auth service
+}
+
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email" validate:"required,email"`
+}
+
+type ConfirmEmailChangeRequest struct {
+	NewEmail string `json:"new_email" validate:"required,email"`
+	OTP      string `json:"otp" validate:"required,len=6"`
+}
@@ -7,7 +7,32 @@ type UserResponse struct {
 	Email     string    `json:"email"`
 	Username  string    `json:"username"`
 	Verified  bool      `json:"verified"`
-	Role      string    `json:"role"`
+	Roles     []string  `json:"roles"`
+	TierId    string    `json:"tier_id,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type MembershipTierResponse struct {
+	Id                 string    `json:"id"`
+	Name               string    `json:"name"`
+	MaxConcurrentLoans int       `json:"max_concurrent_loans"`
+	LoanDurationDays   int       `json:"loan_duration_days"`
+	MaxRenewals        int       `json:"max_renewals"`
+	IsDefault          bool      `json:"is_default"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type EntitlementsResponse struct {
+	UserId             string `json:"user_id"`
+	TierId             string `json:"tier_id"`
+	TierName           string `json:"tier_name"`
+	MaxConcurrentLoans int    `json:"max_concurrent_loans"`
+	LoanDurationDays   int    `json:"loan_duration_days"`
+	MaxRenewals        int    `json:"max_renewals"`
+}
+
+type AccountChangeResponse struct {
+	Message string `json:"message"`
+}
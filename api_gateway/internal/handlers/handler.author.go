@@ -247,13 +247,125 @@ func (a *AuthorHandler) DeleteAuthorByIdHandler(c *fiber.Ctx) error {
 	}
 
 	// Call client to delete author by id
-	err := a.client.DeleteAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), authorId, req.Version)
+	// on_books chooses whether the books of the author block the delete, move to another author or go with it
+	extra["on_books"] = req.OnBooks
+	affectedBooks, err := a.client.DeleteAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), authorId, req)
 	if err != nil {
 		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to delete author", extra, err)
 		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to delete author", err))
 	}
 
+	extra["affected_books"] = affectedBooks
 	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Author deleted successfully", extra, nil)
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+func (a *AuthorHandler) GetDeletedAuthorsHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("pageSize", "10"))
+
+	extra := map[string]interface{}{
+		"method":    c.Method(),
+		"url":       c.OriginalURL(),
+		"page":      page,
+		"page_size": pageSize,
+	}
+
+	authors, totalItems, totalPages, err := a.client.ListDeletedAuthors(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		page,
+		pageSize,
+	)
+	if err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get deleted author list", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get deleted author list", err))
+	}
+
+	extra["authors_count"] = len(authors)
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Deleted authors data fetched successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Deleted author data fetched successfully", map[string]interface{}{
+		"authors": authors,
+		"pagination": map[string]interface{}{
+			"currentPage": page,
+			"page_size":   pageSize,
+			"totalItems":  totalItems,
+			"totalPages":  totalPages,
+		},
+	}))
+}
+
+func (a *AuthorHandler) RestoreAuthorByIdHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	authorId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":    c.Method(),
+		"url":       c.OriginalURL(),
+		"author_id": authorId,
+	}
+
+	resp, err := a.client.RestoreAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), authorId)
+	if err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to restore author", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to restore author", err))
+	}
+
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Author restored successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Author with id '%s' restored successfully", authorId), resp))
+}
+
+// MergeAuthorsHandler folds the duplicates into the author of the path, the books of the duplicates move to it
+func (a *AuthorHandler) MergeAuthorsHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	authorId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":    c.Method(),
+		"url":       c.OriginalURL(),
+		"author_id": authorId,
+	}
+
+	// Parse the request body
+	var req datatransfers.AuthorMergeRequest
+	if err := c.BodyParser(&req); err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse merge authors request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["duplicates"] = len(req.Duplicates)
+	resp, affectedBooks, err := a.client.MergeAuthors(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), authorId, req)
+	if err != nil {
+		a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to merge authors", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to merge authors", err))
+	}
+
+	extra["affected_books"] = affectedBooks
+	a.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Authors merged successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Authors merged into author with id '%s' successfully", authorId), map[string]interface{}{
+		"author":         resp,
+		"affected_books": affectedBooks,
+	}))
+}
@@ -115,12 +115,19 @@ func (authH *AuthHandler) LoginHandler(c *fiber.Ctx) error {
 
 	extra["email"] = req.Email
 
-	resp, err := authH.client.Login(context.WithValue(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), constants.ContextRequestIDKey, requestID), req)
+	// The client IP lets the auth service lock out an IP guessing passwords across accounts
+	resp, err := authH.client.Login(clientContext(c, requestID), req)
 	if err != nil {
 		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to login", extra, err)
 		return c.Status(fiber.StatusUnauthorized).JSON(datatransfers.ResponseError("Failed to login", err))
 	}
 
+	if resp.MFARequired {
+		// The password was right, the tokens come from the MFA routes with the mfa_token
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User login requires a second factor", extra, nil)
+		return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Two-factor authentication required", resp))
+	}
+
 	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User login successful", extra, nil)
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Login successful", resp))
 }
@@ -256,7 +263,7 @@ func (authH *AuthHandler) RefreshTokenHandler(c *fiber.Ctx) error {
 
 	extra["refresh_token"] = req.RefreshToken
 
-	resp, err := authH.client.RefreshToken(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, req)
+	resp, err := authH.client.RefreshToken(clientContext(c, requestID), userID, req)
 	if err != nil {
 		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to refresh token", extra, err)
 		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to refresh token", err))
@@ -273,6 +280,7 @@ func (authH *AuthHandler) LogoutHandler(c *fiber.Ctx) error {
 	}
 
 	userID := c.Locals("userID").(string)
+	sessionID, _ := c.Locals("sessionID").(string)
 
 	extra := map[string]interface{}{
 		"method": c.Method(),
@@ -280,8 +288,10 @@ func (authH *AuthHandler) LogoutHandler(c *fiber.Ctx) error {
 	}
 
 	extra["user_id"] = userID
+	extra["session_id"] = sessionID
 
-	resp, err := authH.client.Logout(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID)
+	// Only the session of the access token ends and the token stops working, the other devices stay signed in
+	resp, err := authH.client.Logout(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, sessionID)
 	if err != nil {
 		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to logout", extra, err)
 		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to logout", err))
@@ -290,3 +300,142 @@ func (authH *AuthHandler) LogoutHandler(c *fiber.Ctx) error {
 	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Logout successful", extra, nil)
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Logout successful", resp))
 }
+
+func (authH *AuthHandler) RequestPasswordResetHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.RequestPasswordResetRequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse request password reset request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["email"] = req.Email
+
+	resp, err := authH.client.RequestPasswordReset(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to request password reset", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to request password reset", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password reset requested successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Password reset requested successfully", resp))
+}
+
+func (authH *AuthHandler) ResetPasswordHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse reset password request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	// The token and the new password are credentials, only the email is logged
+	extra["email"] = req.Email
+
+	resp, err := authH.client.ResetPassword(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to reset password", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to reset password", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password reset successful", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Password reset successful", resp))
+}
+
+// UnlockAccountHandler lifts the login lockouts of an account before they run out
+func (authH *AuthHandler) UnlockAccountHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.UnlockAccountRequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse unlock account request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["email"] = req.Email
+
+	resp, err := authH.client.UnlockAccount(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to unlock account", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to unlock account", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Account unlocked successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Account unlocked successfully", resp))
+}
+
+// JWKSHandler serves the public keys of the auth service as a JSON Web Key Set. Verifiers may cache it for
+// a while, a token with an unknown kid tells them to fetch it again.
+func (authH *AuthHandler) JWKSHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	resp, err := authH.client.GetJWKS(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get JWKS", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get JWKS", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "JWKS retrieved successfully", extra, nil)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// clientContext carries the request ID and the device of the client to the auth service, which locks out
+// IPs guessing passwords and shows the device in the sessions of the user
+func clientContext(c *fiber.Ctx, requestID string) context.Context {
+	ctx := context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID)
+	ctx = context.WithValue(ctx, constants.ContextClientIPKey, c.IP())
+	return context.WithValue(ctx, constants.ContextUserAgentKey, c.Get(fiber.HeaderUserAgent))
+}
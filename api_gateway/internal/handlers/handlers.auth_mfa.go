@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"api_gateway/internal/constants"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/pkg/utils"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// VerifyMFAHandler exchanges the mfa_token of a login and an authenticator or backup code for the tokens
+func (authH *AuthHandler) VerifyMFAHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.VerifyMFARequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse verify MFA request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := authH.client.VerifyMFA(clientContext(c, requestID), req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to verify second factor", extra, err)
+		return c.Status(fiber.StatusUnauthorized).JSON(datatransfers.ResponseError("Failed to verify second factor", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User login successful", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Login successful", resp))
+}
+
+// EnrollMFAHandler starts the enrollment a login asked for because the role of the user requires 2FA
+func (authH *AuthHandler) EnrollMFAHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.EnrollMFARequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse enroll MFA request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := authH.client.EnrollMFA(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), "", req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to start MFA enrollment", extra, err)
+		return c.Status(fiber.StatusUnauthorized).JSON(datatransfers.ResponseError("Failed to start MFA enrollment", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA enrollment started", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("MFA enrollment started", resp))
+}
+
+// ConfirmMFAEnrollmentHandler turns 2FA on for the user of a login that asked for the enrollment and completes the login
+func (authH *AuthHandler) ConfirmMFAEnrollmentHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.ConfirmMFAEnrollmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse confirm MFA request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := authH.client.ConfirmMFA(clientContext(c, requestID), "", req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to confirm MFA enrollment", extra, err)
+		return c.Status(fiber.StatusUnauthorized).JSON(datatransfers.ResponseError("Failed to confirm MFA enrollment", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA enabled and user login successful", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Two-factor authentication enabled", resp))
+}
+
+func (authH *AuthHandler) ListRoleMFARequirementsHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	resp, err := authH.client.ListRoleMFARequirements(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list MFA requirements", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list MFA requirements", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA requirements retrieved successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("MFA requirements retrieved successfully", resp))
+}
+
+// SetRoleMFARequirementHandler makes 2FA mandatory or optional for the members of a role, from their next login
+func (authH *AuthHandler) SetRoleMFARequirementHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	role := c.Params("role")
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+		"role":   role,
+	}
+
+	var req datatransfers.RoleMFARequirementRequest
+	if err := c.BodyParser(&req); err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse MFA requirement request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := authH.client.SetRoleMFARequirement(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), role, req)
+	if err != nil {
+		authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to update MFA requirement", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to update MFA requirement", err))
+	}
+
+	authH.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA requirement updated successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("MFA requirement updated successfully", resp))
+}
@@ -53,9 +53,9 @@ func (b *BookHandler) CreateBookHandler(c *fiber.Ctx) error {
 	}
 
 	extra["book_title"] = req.Title
-	extra["book_author_id"] = req.AuthorId
+	extra["book_authors"] = req.Authors
 	extra["book_category_id"] = req.CategoryId
-	extra["book_stock"] = req.Stock
+	extra["book_publication_year"] = req.PublicationYear
 
 	resp, err := b.client.CreateBook(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), req)
 	if err != nil {
@@ -93,10 +93,8 @@ func (b *BookHandler) GetBookByIdHandler(c *fiber.Ctx) error {
 
 	if includeAuthor || includeCategory {
 		if includeAuthor {
-			author, err := b.authorClient.GetAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), *resp.AuthorId)
-			if err == nil {
-				resp.Author = &author
-				resp.AuthorId = nil
+			if err := b.includeAuthors(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), &resp); err != nil {
+				b.logger.LogMessage(utils.GetLocation(), requestID, "error", "Failed to get authors of book", extra, err)
 			}
 		}
 
@@ -113,6 +111,30 @@ func (b *BookHandler) GetBookByIdHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Book data with id '%s' fetched successfully", bookId), resp))
 }
 
+func (b *BookHandler) GetBookByISBNHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	isbn := c.Params("isbn")
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+		"isbn":   isbn,
+	}
+
+	resp, err := b.client.GetBookByISBN(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), isbn)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, "error", "Failed to get book by ISBN", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get book", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched book data by ISBN", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Book data with ISBN '%s' fetched successfully", isbn), resp))
+}
+
 func (b *BookHandler) GetBooksByAuthorIdHandler(c *fiber.Ctx) error {
 	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
 	if !ok || requestID == "" {
@@ -151,14 +173,10 @@ func (b *BookHandler) GetBooksByAuthorIdHandler(c *fiber.Ctx) error {
 	if includeAuthor || includeCategory {
 		for i := range books {
 			if includeAuthor {
-				author, err := b.authorClient.GetAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), *books[i].AuthorId)
-				if err != nil {
+				if err := b.includeAuthors(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), &books[i]); err != nil {
 					b.logger.LogMessage(utils.GetLocation(), requestID, "error", "Failed to get author by ID", extra, err)
 					return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get author", err))
 				}
-
-				books[i].Author = &author
-				books[i].AuthorId = nil
 			}
 
 			if includeCategory {
@@ -198,23 +216,26 @@ func (b *BookHandler) GetBooksByCategoryIdHandler(c *fiber.Ctx) error {
 	categoryId := c.Params("categoryId")
 	includeAuthor := c.Query("includeAuthor", "false") == "true"
 	includeCategory := c.Query("includeCategory", "false") == "true"
+	includeDescendants := c.Query("includeDescendants", "false") == "true"
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	pageSize, _ := strconv.Atoi(c.Query("pageSize", "10"))
 
 	extra := map[string]interface{}{
-		"method":          c.Method(),
-		"url":             c.OriginalURL(),
-		"category_id":     categoryId,
-		"includeAuthor":   includeAuthor,
-		"includeCategory": includeCategory,
-		"page":            page,
-		"page_size":       pageSize,
+		"method":             c.Method(),
+		"url":                c.OriginalURL(),
+		"category_id":        categoryId,
+		"includeAuthor":      includeAuthor,
+		"includeCategory":    includeCategory,
+		"includeDescendants": includeDescendants,
+		"page":               page,
+		"page_size":          pageSize,
 	}
 
-	// Fetch books by category
+	// Fetch books by category, with includeDescendants also the books of its subcategories
 	books, totalItems, totalPages, err := b.client.GetBooksByCategoryId(
 		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
 		categoryId,
+		includeDescendants,
 		page,
 		pageSize,
 	)
@@ -227,14 +248,10 @@ func (b *BookHandler) GetBooksByCategoryIdHandler(c *fiber.Ctx) error {
 	if includeAuthor || includeCategory {
 		for i := range books {
 			if includeAuthor {
-				author, err := b.authorClient.GetAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), *books[i].AuthorId)
-				if err != nil {
+				if err := b.includeAuthors(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), &books[i]); err != nil {
 					b.logger.LogMessage(utils.GetLocation(), requestID, "error", "Failed to get author by ID", extra, err)
 					return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get author", err))
 				}
-
-				books[i].Author = &author
-				books[i].AuthorId = nil
 			}
 
 			if includeCategory {
@@ -299,14 +316,10 @@ func (b *BookHandler) GetAllBooksHandler(c *fiber.Ctx) error {
 	if includeAuthor || includeCategory {
 		for i := range books {
 			if includeAuthor {
-				author, err := b.authorClient.GetAuthor(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), *books[i].AuthorId)
-				if err != nil {
+				if err := b.includeAuthors(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), &books[i]); err != nil {
 					b.logger.LogMessage(utils.GetLocation(), requestID, "error", "Failed to get author by ID", extra, err)
 					return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get author", err))
 				}
-
-				books[i].Author = &author
-				books[i].AuthorId = nil
 			}
 
 			if includeCategory {
@@ -337,6 +350,54 @@ func (b *BookHandler) GetAllBooksHandler(c *fiber.Ctx) error {
 	}))
 }
 
+func (b *BookHandler) SearchBooksHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	query := datatransfers.BookSearchQuery{Page: 1, PageSize: 10}
+	if err := c.QueryParser(&query); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse search books query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["query"] = query.Query
+	extra["page"] = query.Page
+	extra["page_size"] = query.PageSize
+
+	hits, totalItems, totalPages, err := b.client.SearchBooks(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), query)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to search books", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to search books", err))
+	}
+
+	extra["hits_count"] = len(hits)
+	extra["total_items"] = totalItems
+	extra["total_pages"] = totalPages
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books searched successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Books searched successfully", map[string]interface{}{
+		"hits": hits,
+		"pagination": map[string]interface{}{
+			"currentPage": query.Page,
+			"page_size":   query.PageSize,
+			"totalItems":  totalItems,
+			"totalPages":  totalPages,
+		},
+	}))
+}
+
 func (b *BookHandler) UpdateBookByIdHandler(c *fiber.Ctx) error {
 	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
 	if !ok || requestID == "" {
@@ -364,9 +425,9 @@ func (b *BookHandler) UpdateBookByIdHandler(c *fiber.Ctx) error {
 	}
 
 	extra["book_title"] = req.Title
-	extra["book_author_id"] = req.AuthorId
+	extra["book_authors"] = req.Authors
 	extra["book_category_id"] = req.CategoryId
-	extra["book_stock"] = req.Stock
+	extra["book_publication_year"] = req.PublicationYear
 
 	resp, err := b.client.UpdateBook(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), bookId, req)
 	if err != nil {
@@ -416,3 +477,196 @@ func (b *BookHandler) DeleteBookByIdHandler(c *fiber.Ctx) error {
 	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book deleted successfully", extra, nil)
 	return c.Status(fiber.StatusNoContent).JSON(datatransfers.ResponseSuccess("Book deleted successfully", nil))
 }
+
+func (b *BookHandler) AddBookCopyHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	bookId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"book_id": bookId,
+	}
+
+	var req datatransfers.BookCopyRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse add book copy request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["copy_barcode"] = req.Barcode
+
+	resp, err := b.client.AddBookCopy(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), bookId, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to add book copy", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to add book copy", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy added successfully", extra, nil)
+	return c.Status(fiber.StatusCreated).JSON(datatransfers.ResponseSuccess("Book copy added successfully", resp))
+}
+
+func (b *BookHandler) ListBookCopiesHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	bookId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"book_id": bookId,
+	}
+
+	var query datatransfers.BookCopyListQuery
+	if err := c.QueryParser(&query); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse list book copies query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["status"] = query.Status
+
+	copies, err := b.client.ListBookCopies(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), bookId, query.Status)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list book copies", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list book copies", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copies fetched successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Copies of book with id '%s' fetched successfully", bookId), copies))
+}
+
+func (b *BookHandler) GetBookCopyByBarcodeHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	barcode := c.Params("barcode")
+
+	extra := map[string]interface{}{
+		"method":       c.Method(),
+		"url":          c.OriginalURL(),
+		"copy_barcode": barcode,
+	}
+
+	resp, err := b.client.GetBookCopyByBarcode(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), barcode)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get book copy by barcode", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get book copy", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy fetched successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Book copy with barcode '%s' fetched successfully", barcode), resp))
+}
+
+func (b *BookHandler) UpdateBookCopyHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	copyId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"copy_id": copyId,
+	}
+
+	var req datatransfers.BookCopyUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse update book copy request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["copy_condition"] = req.Condition
+	extra["copy_version"] = req.Version
+
+	resp, err := b.client.UpdateBookCopy(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), copyId, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to update book copy", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to update book copy", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy updated successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Book copy updated successfully", resp))
+}
+
+func (b *BookHandler) RetireBookCopyHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	copyId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"copy_id": copyId,
+	}
+
+	var req datatransfers.BookCopyRetireRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse retire book copy request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["copy_version"] = req.Version
+
+	resp, err := b.client.RetireBookCopy(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), copyId, req.Version)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retire book copy", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to retire book copy", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy retired successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Book copy retired successfully", resp))
+}
+
+// includeAuthors expands every author credited on the book, replacing the author ids with the authors
+func (b *BookHandler) includeAuthors(ctx context.Context, book *datatransfers.BookResponse) error {
+	for i := range book.Authors {
+		author, err := b.authorClient.GetAuthor(ctx, *book.Authors[i].AuthorId)
+		if err != nil {
+			return err
+		}
+
+		book.Authors[i].Author = &author
+		book.Authors[i].AuthorId = nil
+	}
+
+	book.AuthorIds = nil
+	return nil
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"api_gateway/internal/constants"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/pkg/utils"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	catalogueFormatCSV    = "csv"
+	catalogueFormatMARC21 = "marc21"
+)
+
+// catalogueFormatsByExtension tells the format of an uploaded file sent without one
+var catalogueFormatsByExtension = map[string]string{
+	".csv":  catalogueFormatCSV,
+	".mrc":  catalogueFormatMARC21,
+	".marc": catalogueFormatMARC21,
+	".iso":  catalogueFormatMARC21,
+}
+
+// ImportBooksHandler queues the uploaded catalogue file for import, the job is followed with GetImportJobHandler
+func (b *BookHandler) ImportBooksHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.BookImportRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse import request", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Missing import file", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, map[string]string{"file": "file is required"}))
+	}
+
+	format := req.Format
+	if format == "" {
+		format = catalogueFormatsByExtension[strings.ToLower(filepath.Ext(fileHeader.Filename))]
+	}
+	if format == "" {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Unknown import file format", extra, nil)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, map[string]string{"format": "format must be csv or marc21 when the file extension does not tell"}))
+	}
+
+	extra["format"] = format
+	extra["file_name"] = fileHeader.Filename
+	extra["size"] = fileHeader.Size
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to open import file", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to read import file", err))
+	}
+	defer file.Close()
+
+	userID, _ := c.Locals("userID").(string)
+	resp, err := b.client.ImportBooks(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), format, fileHeader.Filename, userID, file)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to import books", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to import books", err))
+	}
+
+	extra["job_id"] = resp.Id
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book import queued", extra, nil)
+	return c.Status(fiber.StatusAccepted).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Import of '%s' queued successfully", fileHeader.Filename), resp))
+}
+
+func (b *BookHandler) GetImportJobHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	jobId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+		"job_id": jobId,
+	}
+
+	resp, err := b.client.GetImportJob(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), jobId)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get import job", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get import job", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched import job", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Import job with id '%s' fetched successfully", jobId), resp))
+}
+
+// ExportBooksHandler streams the whole catalogue as a file download
+func (b *BookHandler) ExportBooksHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var query datatransfers.BookExportQuery
+	if err := c.QueryParser(&query); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse export query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	format := query.Format
+	if format == "" {
+		format = catalogueFormatCSV
+	}
+	extra["format"] = format
+
+	// The export is read while the response is written, after the handler returns,
+	// so it must not depend on the request context
+	reader, err := b.client.ExportBooks(context.WithValue(context.Background(), constants.ContextRequestIDKey, requestID), format)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to export books", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to export books", err))
+	}
+
+	contentType, extension := "text/csv; charset=utf-8", "csv"
+	if format == catalogueFormatMARC21 {
+		contentType, extension = "application/marc", "mrc"
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="books-%s.%s"`, time.Now().Format("20060102"), extension))
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Exporting books", extra, nil)
+	return c.Status(fiber.StatusOK).SendStream(reader)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"api_gateway/internal/constants"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/pkg/utils"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func (b *BookHandler) GetDeletedBooksHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("pageSize", "10"))
+
+	extra := map[string]interface{}{
+		"method":    c.Method(),
+		"url":       c.OriginalURL(),
+		"page":      page,
+		"page_size": pageSize,
+	}
+
+	books, totalItems, totalPages, err := b.client.ListDeletedBooks(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		page,
+		pageSize,
+	)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list deleted books", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list deleted books", err))
+	}
+
+	extra["books_count"] = len(books)
+	extra["total_items"] = totalItems
+	extra["total_pages"] = totalPages
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched deleted books", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Deleted book data fetched successfully", map[string]interface{}{
+		"books": books,
+		"pagination": map[string]interface{}{
+			"currentPage": page,
+			"page_size":   pageSize,
+			"totalItems":  totalItems,
+			"totalPages":  totalPages,
+		},
+	}))
+}
+
+func (b *BookHandler) RestoreBookByIdHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	bookId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"book_id": bookId,
+	}
+
+	book, err := b.client.RestoreBook(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), bookId)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to restore book", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to restore book", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book restored successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Book with id '%s' restored successfully", bookId), book))
+}
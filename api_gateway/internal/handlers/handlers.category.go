@@ -74,11 +74,14 @@ func (c *CategoryHandler) GetCategoryByIdHandler(ctx *fiber.Ctx) error {
 
 	categoryId := ctx.Params("id")
 	includeBooks := ctx.Query("includeBooks", "false") == "true"
+	includeDescendants := ctx.Query("includeDescendants", "false") == "true"
 
 	extra := map[string]interface{}{
-		"method":      ctx.Method(),
-		"url":         ctx.OriginalURL(),
-		"category_id": categoryId,
+		"method":             ctx.Method(),
+		"url":                ctx.OriginalURL(),
+		"category_id":        categoryId,
+		"includeBooks":       includeBooks,
+		"includeDescendants": includeDescendants,
 	}
 
 	// Call client to get category by id
@@ -92,6 +95,7 @@ func (c *CategoryHandler) GetCategoryByIdHandler(ctx *fiber.Ctx) error {
 	if includeBooks {
 		books, totalItems, _, err := c.bookClient.GetBooksByCategoryId(
 			context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), resp.Id,
+			includeDescendants,
 			1,
 			10,
 		)
@@ -116,6 +120,7 @@ func (c *CategoryHandler) GetAllCategoriesHandler(ctx *fiber.Ctx) error {
 	}
 
 	includeBooks := ctx.Query("includeBooks", "false") == "true"
+	tree := ctx.Query("tree", "false") == "true"
 	page, _ := strconv.Atoi(ctx.Query("page", "1"))
 	pageSize, _ := strconv.Atoi(ctx.Query("pageSize", "10"))
 
@@ -123,10 +128,29 @@ func (c *CategoryHandler) GetAllCategoriesHandler(ctx *fiber.Ctx) error {
 		"method":       ctx.Method(),
 		"url":          ctx.OriginalURL(),
 		"includeBooks": includeBooks,
+		"tree":         tree,
 		"page":         page,
 		"page_size":    pageSize,
 	}
 
+	// With tree, return the categories nested under their parents instead of a page of the flat list
+	if tree {
+		rootId := ctx.Query("rootId")
+		extra["root_id"] = rootId
+
+		nodes, err := c.client.GetCategoryTree(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), rootId)
+		if err != nil {
+			c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get category tree", extra, err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get category tree", err))
+		}
+
+		extra["nodes_count"] = len(nodes)
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched category tree successfully", extra, nil)
+		return ctx.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Category tree fetched successfully", map[string]interface{}{
+			"categories": nodes,
+		}))
+	}
+
 	// Call client to get all categories
 	categories, totalItems, totalPages, err := c.client.ListCategories(
 		context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID),
@@ -143,6 +167,7 @@ func (c *CategoryHandler) GetAllCategoriesHandler(ctx *fiber.Ctx) error {
 		for i := range categories {
 			books, totalItems, _, err := c.bookClient.GetBooksByCategoryId(
 				context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), categories[i].Id,
+				false,
 				1,
 				10,
 			)
@@ -171,6 +196,37 @@ func (c *CategoryHandler) GetAllCategoriesHandler(ctx *fiber.Ctx) error {
 	}))
 }
 
+func (c *CategoryHandler) ListSubcategoriesHandler(ctx *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := ctx.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	categoryId := ctx.Params("id")
+	recursive := ctx.Query("recursive", "false") == "true"
+
+	extra := map[string]interface{}{
+		"method":      ctx.Method(),
+		"url":         ctx.OriginalURL(),
+		"category_id": categoryId,
+		"recursive":   recursive,
+	}
+
+	// Call client to list the direct subcategories, or with recursive every descendant
+	categories, err := c.client.ListSubcategories(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), categoryId, recursive)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list subcategories", extra, err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list subcategories", err))
+	}
+
+	extra["categories_count"] = len(categories)
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched subcategories successfully", extra, nil)
+	return ctx.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Subcategories of category with id '%s' fetched successfully", categoryId), map[string]interface{}{
+		"categories": categories,
+	}))
+}
+
 func (c *CategoryHandler) UpdateCategoryHandler(ctx *fiber.Ctx) error {
 	// Retrieve requestID from context
 	requestID, ok := ctx.Locals(constants.ContextRequestIDKey).(string)
@@ -225,9 +281,9 @@ func (c *CategoryHandler) DeleteCategoryHandler(ctx *fiber.Ctx) error {
 	}
 
 	// Parse the request body
-	var req datatransfers.BookDeleteRequest
+	var req datatransfers.CategoryDeleteRequest
 	if err := ctx.BodyParser(&req); err != nil {
-		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse update book request body", extra, err)
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse delete category request body", extra, err)
 		return ctx.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
 	}
 
@@ -238,14 +294,110 @@ func (c *CategoryHandler) DeleteCategoryHandler(ctx *fiber.Ctx) error {
 	}
 
 	extra["category_version"] = req.Version
+	extra["on_books"] = req.OnBooks
 
-	// Call client to delete category
-	err := c.client.DeleteCategory(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), categoryId, req.Version)
+	// Call client to delete category, on_books chooses whether its books block the delete, move to another category or go with it
+	affectedBooks, err := c.client.DeleteCategory(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), categoryId, req)
 	if err != nil {
 		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to delete category", extra, err)
 		return ctx.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to delete category", err))
 	}
 
+	extra["affected_books"] = affectedBooks
 	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category deleted successfully", extra, nil)
 	return ctx.SendStatus(fiber.StatusNoContent)
 }
+
+func (c *CategoryHandler) GetDeletedCategoriesHandler(ctx *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := ctx.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": ctx.Method(),
+		"url":    ctx.OriginalURL(),
+	}
+
+	categories, totalItems, err := c.client.ListDeletedCategories(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list deleted categories", extra, err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list deleted categories", err))
+	}
+
+	extra["categories_count"] = len(categories)
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched deleted categories successfully", extra, nil)
+	return ctx.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Deleted category data fetched successfully", map[string]interface{}{
+		"categories": categories,
+		"totalItems": totalItems,
+	}))
+}
+
+func (c *CategoryHandler) RestoreCategoryHandler(ctx *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := ctx.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	categoryId := ctx.Params("id")
+
+	extra := map[string]interface{}{
+		"method":      ctx.Method(),
+		"url":         ctx.OriginalURL(),
+		"category_id": categoryId,
+	}
+
+	resp, err := c.client.RestoreCategory(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), categoryId)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to restore category", extra, err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to restore category", err))
+	}
+
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category restored successfully", extra, nil)
+	return ctx.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Category with id '%s' restored successfully", categoryId), resp))
+}
+
+// MergeCategoriesHandler folds the duplicates into the category of the path, their books and subcategories move to it
+func (c *CategoryHandler) MergeCategoriesHandler(ctx *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := ctx.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	categoryId := ctx.Params("id")
+	extra := map[string]interface{}{
+		"method":      ctx.Method(),
+		"url":         ctx.OriginalURL(),
+		"category_id": categoryId,
+	}
+
+	// Parse the request body
+	var req datatransfers.CategoryMergeRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse merge categories request body", extra, err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["duplicates"] = len(req.Duplicates)
+	resp, affectedBooks, err := c.client.MergeCategories(context.WithValue(ctx.Context(), constants.ContextRequestIDKey, requestID), categoryId, req)
+	if err != nil {
+		c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to merge categories", extra, err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to merge categories", err))
+	}
+
+	extra["affected_books"] = affectedBooks
+	c.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Categories merged successfully", extra, nil)
+	return ctx.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("Categories merged into category with id '%s' successfully", categoryId), map[string]interface{}{
+		"category":       resp,
+		"affected_books": affectedBooks,
+	}))
+}
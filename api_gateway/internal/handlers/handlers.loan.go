@@ -8,8 +8,6 @@ import (
 	"api_gateway/pkg/utils"
 	"context"
 	"fmt"
-	"strconv"
-	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -110,7 +108,6 @@ func (l *LoanHandler) ReturnLoanHandler(c *fiber.Ctx) error {
 		loanId,
 		userID,
 		userEmail,
-		time.Now(),
 		req,
 	)
 	if err != nil {
@@ -123,6 +120,134 @@ func (l *LoanHandler) ReturnLoanHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Loan status updated successfully", resp))
 }
 
+func (l *LoanHandler) CheckOutCopyHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	changedBy := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":     c.Method(),
+		"url":        c.OriginalURL(),
+		"changed_by": changedBy,
+	}
+
+	var req datatransfers.LoanCheckOutRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse check out copy request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["user_id"] = req.UserId
+	extra["copy_barcode"] = req.Barcode
+
+	resp, err := l.client.CheckOutCopy(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), changedBy, req)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to check out copy", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to check out copy", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Copy checked out successfully", extra, nil)
+
+	return c.Status(fiber.StatusCreated).JSON(datatransfers.ResponseSuccess("Copy checked out successfully", resp))
+}
+
+func (l *LoanHandler) CheckInCopyHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	changedBy := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":     c.Method(),
+		"url":        c.OriginalURL(),
+		"changed_by": changedBy,
+	}
+
+	var req datatransfers.LoanCheckInRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse check in copy request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["copy_barcode"] = req.Barcode
+	extra["copy_condition"] = req.Condition
+
+	resp, err := l.client.CheckInCopy(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), changedBy, req)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to check in copy", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to check in copy", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Copy checked in successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Copy checked in successfully", resp))
+}
+
+func (l *LoanHandler) RenewLoanHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	loanId := c.Params("id")
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"loan_id": loanId,
+		"user_id": userID,
+	}
+
+	// Parse the request body
+	var req datatransfers.LoanRenewRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse renew loan request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := l.client.RenewLoan(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		loanId,
+		userID,
+		req,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to renew loan", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to renew loan", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan renewed successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Loan renewed successfully", resp))
+}
+
 func (l *LoanHandler) GetLoanHandler(c *fiber.Ctx) error {
 	// Retrieve requestID from context
 	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
@@ -181,8 +306,8 @@ func (l *LoanHandler) UpdateLoanStatusHandler(c *fiber.Ctx) error {
 	resp, err := l.client.UpdateLoanStatus(
 		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
 		loanId,
-		req.Status,
-		req.Version,
+		c.Locals("userID").(string),
+		req,
 	)
 	if err != nil {
 		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to update loan status", extra, err)
@@ -194,6 +319,32 @@ func (l *LoanHandler) UpdateLoanStatusHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Loan status updated successfully", resp))
 }
 
+func (l *LoanHandler) GetLoanHistoryHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	loanId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"loan_id": loanId,
+	}
+
+	resp, err := l.client.GetLoanHistory(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), loanId)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get loan history", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get loan history", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan history fetched successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess(fmt.Sprintf("History of loan with id '%s' fetched successfully", loanId), resp))
+}
+
 func (l *LoanHandler) ListUserLoansHandler(c *fiber.Ctx) error {
 	// Retrieve requestID from context
 	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
@@ -202,40 +353,49 @@ func (l *LoanHandler) ListUserLoansHandler(c *fiber.Ctx) error {
 	}
 
 	userId := c.Locals("userID").(string)
-	status := c.Query("status")
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	pageSize, _ := strconv.Atoi(c.Query("pageSize", "10"))
 
 	extra := map[string]interface{}{
-		"method":    c.Method(),
-		"url":       c.OriginalURL(),
-		"user_id":   userId,
-		"status":    status,
-		"page":      page,
-		"page_size": pageSize,
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
 	}
 
+	query := datatransfers.LoanListQuery{Page: 1, PageSize: 10}
+	if err := c.QueryParser(&query); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse list user loans query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["status"] = query.Status
+	extra["page"] = query.Page
+	extra["page_size"] = query.PageSize
+
 	var (
-		loans      []datatransfers.LoanResponse
-		totalItems int
-		totalPages int
-		err        error
+		loans         []datatransfers.LoanResponse
+		totalItems    int
+		totalPages    int
+		nextPageToken string
+		err           error
 	)
 
-	if status != "" {
-		loans, totalItems, totalPages, err = l.client.GetUserLoansByStatus(
+	if query.Status != "" {
+		loans, totalItems, totalPages, nextPageToken, err = l.client.GetUserLoansByStatus(
 			context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
 			userId,
-			status,
-			page,
-			pageSize,
+			query.Status,
+			query,
 		)
 	} else {
-		loans, totalItems, totalPages, err = l.client.ListUserLoans(
+		loans, totalItems, totalPages, nextPageToken, err = l.client.ListUserLoans(
 			context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
 			userId,
-			page,
-			pageSize,
+			query,
 		)
 	}
 
@@ -251,10 +411,11 @@ func (l *LoanHandler) ListUserLoansHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("List user loans fetched successfully", map[string]interface{}{
 		"loans": loans,
 		"pagination": map[string]interface{}{
-			"currentPage": page,
-			"page_size":   pageSize,
-			"totalItems":  totalItems,
-			"totalPages":  totalPages,
+			"currentPage":   query.Page,
+			"page_size":     query.PageSize,
+			"totalItems":    totalItems,
+			"totalPages":    totalPages,
+			"nextPageToken": nextPageToken,
 		},
 	}))
 }
@@ -266,37 +427,45 @@ func (l *LoanHandler) ListLoansHandler(c *fiber.Ctx) error {
 		requestID = "unknown"
 	}
 
-	status := c.Query("status")
-	page, _ := strconv.Atoi(c.Query("page", "1"))
-	pageSize, _ := strconv.Atoi(c.Query("pageSize", "10"))
-
 	extra := map[string]interface{}{
-		"method":    c.Method(),
-		"url":       c.OriginalURL(),
-		"status":    status,
-		"page":      page,
-		"page_size": pageSize,
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
 	}
 
+	query := datatransfers.LoanListQuery{Page: 1, PageSize: 10}
+	if err := c.QueryParser(&query); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse list loans query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["status"] = query.Status
+	extra["page"] = query.Page
+	extra["page_size"] = query.PageSize
+
 	var (
-		loans      []datatransfers.LoanResponse
-		totalItems int
-		totalPages int
-		err        error
+		loans         []datatransfers.LoanResponse
+		totalItems    int
+		totalPages    int
+		nextPageToken string
+		err           error
 	)
 
-	if status != "" {
-		loans, totalItems, totalPages, err = l.client.GetLoansByStatus(
+	if query.Status != "" {
+		loans, totalItems, totalPages, nextPageToken, err = l.client.GetLoansByStatus(
 			context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
-			status,
-			page,
-			pageSize,
+			query.Status,
+			query,
 		)
 	} else {
-		loans, totalItems, totalPages, err = l.client.ListLoans(
+		loans, totalItems, totalPages, nextPageToken, err = l.client.ListLoans(
 			context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
-			page,
-			pageSize,
+			query,
 		)
 	}
 
@@ -312,8 +481,304 @@ func (l *LoanHandler) ListLoansHandler(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("List loans fetched successfully", map[string]interface{}{
 		"loans": loans,
 		"pagination": map[string]interface{}{
-			"currentPage": page,
-			"page_size":   pageSize,
+			"currentPage":   query.Page,
+			"page_size":     query.PageSize,
+			"totalItems":    totalItems,
+			"totalPages":    totalPages,
+			"nextPageToken": nextPageToken,
+		},
+	}))
+}
+
+func (l *LoanHandler) ListUserFinesHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userId := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
+	}
+
+	query := datatransfers.FineListQuery{Page: 1, PageSize: 10}
+	if err := c.QueryParser(&query); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse list user fines query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["status"] = query.Status
+	extra["page"] = query.Page
+	extra["page_size"] = query.PageSize
+
+	fines, totalItems, totalPages, outstanding, err := l.client.ListUserFines(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		userId,
+		query,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list user fines", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list fines", err))
+	}
+
+	extra["fines_count"] = len(fines)
+	extra["total_items"] = totalItems
+	extra["outstanding"] = outstanding
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "List user fines fetched successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("List user fines fetched successfully", map[string]interface{}{
+		"fines":       fines,
+		"outstanding": outstanding,
+		"pagination": map[string]interface{}{
+			"currentPage": query.Page,
+			"page_size":   query.PageSize,
+			"totalItems":  totalItems,
+			"totalPages":  totalPages,
+		},
+	}))
+}
+
+func (l *LoanHandler) PayFineHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	fineId := c.Params("id")
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"fine_id": fineId,
+		"user_id": userID,
+	}
+
+	// Parse the request body
+	var req datatransfers.FinePayRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse pay fine request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := l.client.PayFine(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		fineId,
+		userID,
+		req,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to pay fine", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to pay fine", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fine paid successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Fine paid successfully", resp))
+}
+
+func (l *LoanHandler) WaiveFineHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	fineId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"fine_id": fineId,
+	}
+
+	// Parse the request body
+	var req datatransfers.FineWaiveRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse waive fine request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := l.client.WaiveFine(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		fineId,
+		req,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to waive fine", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to waive fine", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fine waived successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Fine waived successfully", resp))
+}
+
+func (l *LoanHandler) PlaceHoldHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+	userEmail := c.Locals("email").(string)
+
+	extra := map[string]interface{}{
+		"method":     c.Method(),
+		"url":        c.OriginalURL(),
+		"user_id":    userID,
+		"user_email": userEmail,
+	}
+
+	// Parse the request body
+	var req datatransfers.HoldRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse place hold request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["book_id"] = req.BookId
+
+	resp, err := l.client.PlaceHold(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		userID,
+		userEmail,
+		req,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to place hold", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to place hold", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Hold placed successfully", extra, nil)
+
+	return c.Status(fiber.StatusCreated).JSON(datatransfers.ResponseSuccess("Hold placed successfully", resp))
+}
+
+func (l *LoanHandler) CancelHoldHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	holdId := c.Params("id")
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"hold_id": holdId,
+		"user_id": userID,
+	}
+
+	// Parse the request body
+	var req datatransfers.HoldCancelRequest
+	if err := c.BodyParser(&req); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse cancel hold request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := l.client.CancelHold(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		holdId,
+		userID,
+		req,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to cancel hold", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to cancel hold", err))
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Hold cancelled successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Hold cancelled successfully", resp))
+}
+
+func (l *LoanHandler) ListUserHoldsHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userId := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
+	}
+
+	query := datatransfers.HoldListQuery{Page: 1, PageSize: 10}
+	if err := c.QueryParser(&query); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse list user holds query", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid query parameters", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(query); err != nil {
+		extra["errors"] = errorsMap
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["status"] = query.Status
+	extra["page"] = query.Page
+	extra["page_size"] = query.PageSize
+
+	holds, totalItems, totalPages, err := l.client.ListUserHolds(
+		context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID),
+		userId,
+		query,
+	)
+	if err != nil {
+		l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list user holds", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list holds", err))
+	}
+
+	extra["holds_count"] = len(holds)
+	extra["total_items"] = totalItems
+	l.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "List user holds fetched successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("List user holds fetched successfully", map[string]interface{}{
+		"holds": holds,
+		"pagination": map[string]interface{}{
+			"currentPage": query.Page,
+			"page_size":   query.PageSize,
 			"totalItems":  totalItems,
 			"totalPages":  totalPages,
 		},
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/pkg/logger"
+	"api_gateway/pkg/utils"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type RoleHandler struct {
+	client clients.AuthClient
+	logger *logger.Logger
+}
+
+func NewRoleHandler(client clients.AuthClient, logger *logger.Logger) RoleHandler {
+	return RoleHandler{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ListPermissionsHandler lists the permissions a role can grant
+func (h *RoleHandler) ListPermissionsHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	resp, err := h.client.ListPermissions(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list permissions", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list permissions", err))
+	}
+
+	h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Permissions retrieved successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Permissions retrieved successfully", resp))
+}
+
+func (h *RoleHandler) ListRolesHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	resp, err := h.client.ListRoles(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list roles", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list roles", err))
+	}
+
+	h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Roles retrieved successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Roles retrieved successfully", resp))
+}
+
+func (h *RoleHandler) CreateRoleHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.CreateRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse role request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+	extra["name"] = req.Name
+
+	resp, err := h.client.CreateRole(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), req)
+	if err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to create role", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to create role", err))
+	}
+
+	h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role created successfully", extra, nil)
+	return c.Status(fiber.StatusCreated).JSON(datatransfers.ResponseSuccess("Role created successfully", resp))
+}
+
+// UpdateRoleHandler replaces the permissions of a role, its members get them with their next refresh
+func (h *RoleHandler) UpdateRoleHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	name := c.Params("name")
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+		"name":   name,
+	}
+
+	var req datatransfers.UpdateRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse role request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := h.client.UpdateRole(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), name, req)
+	if err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to update role", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to update role", err))
+	}
+
+	h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role updated successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Role updated successfully", resp))
+}
+
+func (h *RoleHandler) DeleteRoleHandler(c *fiber.Ctx) error {
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	name := c.Params("name")
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+		"name":   name,
+	}
+
+	resp, err := h.client.DeleteRole(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), name)
+	if err != nil {
+		h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to delete role", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to delete role", err))
+	}
+
+	h.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role deleted successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Role deleted successfully", resp))
+}
@@ -13,14 +13,16 @@ import (
 )
 
 type UserHandler struct {
-	client clients.UserClient
-	logger *logger.Logger
+	client     clients.UserClient
+	authClient clients.AuthClient
+	logger     *logger.Logger
 }
 
-func NewUserHandler(client clients.UserClient, logger *logger.Logger) UserHandler {
+func NewUserHandler(client clients.UserClient, authClient clients.AuthClient, logger *logger.Logger) UserHandler {
 	return UserHandler{
-		client: client,
-		logger: logger,
+		client:     client,
+		authClient: authClient,
+		logger:     logger,
 	}
 }
 
@@ -93,3 +95,167 @@ func (b *UserHandler) GetMe(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("User data fetched successfully", resp))
 }
+
+func (b *UserHandler) GetMyEntitlementsHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	userID := c.Locals("userID").(string)
+
+	resp, err := b.client.GetUserEntitlements(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get user entitlements", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get user entitlements", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched user entitlements successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("User entitlements fetched successfully", resp))
+}
+
+func (b *UserHandler) ListMembershipTiersHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	tiers, err := b.client.ListMembershipTiers(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get membership tiers", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to get membership tiers", err))
+	}
+
+	extra["tiers_count"] = len(tiers)
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fetched membership tiers successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Membership tiers fetched successfully", tiers))
+}
+
+func (b *UserHandler) CreateMembershipTierHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	extra := map[string]interface{}{
+		"method": c.Method(),
+		"url":    c.OriginalURL(),
+	}
+
+	var req datatransfers.MembershipTierRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse create membership tier request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["name"] = req.Name
+
+	resp, err := b.client.CreateMembershipTier(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to create membership tier", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to create membership tier", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier created successfully", extra, nil)
+
+	return c.Status(fiber.StatusCreated).JSON(datatransfers.ResponseSuccess("Membership tier created successfully", resp))
+}
+
+func (b *UserHandler) UpdateMembershipTierHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	tierId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"tier_id": tierId,
+	}
+
+	var req datatransfers.MembershipTierRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse update membership tier request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := b.client.UpdateMembershipTier(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), tierId, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to update membership tier", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to update membership tier", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier updated successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Membership tier updated successfully", resp))
+}
+
+func (b *UserHandler) AssignMembershipTierHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
+	}
+
+	var req datatransfers.TierAssignRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse assign membership tier request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["tier_id"] = req.TierId
+
+	resp, err := b.client.AssignMembershipTier(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userId, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to assign membership tier", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to assign membership tier", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier assigned successfully", extra, nil)
+
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Membership tier assigned successfully", resp))
+}
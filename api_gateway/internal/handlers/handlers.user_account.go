@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"api_gateway/internal/constants"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/pkg/utils"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ChangePasswordHandler sets a new password for the logged-in user, every session has to log in again
+func (b *UserHandler) ChangePasswordHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userID,
+	}
+
+	var req datatransfers.ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse change password request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := b.authClient.ChangePassword(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to change password", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to change password", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password changed successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Password changed successfully", resp))
+}
+
+// ChangeEmailHandler sends an OTP to the new email, the email only changes once the OTP is confirmed
+func (b *UserHandler) ChangeEmailHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userID,
+	}
+
+	var req datatransfers.ChangeEmailRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse change email request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["new_email"] = req.NewEmail
+
+	resp, err := b.authClient.ChangeEmail(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to request email change", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to request email change", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Email change requested successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Email change requested successfully", resp))
+}
+
+// ConfirmEmailChangeHandler swaps the email once the OTP matches, every session has to log in again
+func (b *UserHandler) ConfirmEmailChangeHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userID,
+	}
+
+	var req datatransfers.ConfirmEmailChangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse confirm email change request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	extra["new_email"] = req.NewEmail
+
+	resp, err := b.authClient.ConfirmEmailChange(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, req)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to confirm email change", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to confirm email change", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Email changed successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Email changed successfully", resp))
+}
+
+// EnrollMyMFAHandler starts a TOTP enrollment for the logged-in user, 2FA stays off until the code is confirmed
+func (b *UserHandler) EnrollMyMFAHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userID,
+	}
+
+	resp, err := b.authClient.EnrollMFA(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, datatransfers.EnrollMFARequest{})
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to start MFA enrollment", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to start MFA enrollment", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA enrollment started", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("MFA enrollment started", resp))
+}
+
+// ConfirmMyMFAHandler turns 2FA on for the logged-in user and returns the backup codes
+func (b *UserHandler) ConfirmMyMFAHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userID,
+	}
+
+	var req datatransfers.ConfirmMFARequest
+	if err := c.BodyParser(&req); err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to parse confirm MFA request body", extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError("Invalid request body", err))
+	}
+
+	if errorsMap, err := utils.ValidatePayloads(req); err != nil {
+		extra["errors"] = errorsMap
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, constants.ErrValidationMessage, extra, err)
+		return c.Status(fiber.StatusBadRequest).JSON(datatransfers.ResponseError(constants.ErrValidationMessage, errorsMap))
+	}
+
+	resp, err := b.authClient.ConfirmMFA(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, datatransfers.ConfirmMFAEnrollmentRequest{Code: req.Code})
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to confirm MFA enrollment", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to confirm MFA enrollment", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA enabled", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Two-factor authentication enabled", resp))
+}
+
+// ListMySessionsHandler lists the devices the logged-in user is signed in on, flagging the one making the request
+func (b *UserHandler) ListMySessionsHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+	sessionID, _ := c.Locals("sessionID").(string)
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userID,
+	}
+
+	sessions, err := b.authClient.ListSessions(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to list sessions", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to list sessions", err))
+	}
+
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == sessionID
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sessions listed successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Sessions listed successfully", sessions))
+}
+
+// RevokeMySessionHandler signs one device of the logged-in user out
+func (b *UserHandler) RevokeMySessionHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userID := c.Locals("userID").(string)
+	sessionID := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":     c.Method(),
+		"url":        c.OriginalURL(),
+		"user_id":    userID,
+		"session_id": sessionID,
+	}
+
+	resp, err := b.authClient.RevokeSession(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userID, sessionID)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to revoke session", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to revoke session", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Session revoked successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Session revoked successfully", resp))
+}
+
+// ForceLogoutHandler signs a user out on every device, the access tokens already issued stop working at once
+func (b *UserHandler) ForceLogoutHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userId := c.Params("id")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
+	}
+
+	resp, err := b.authClient.ForceLogout(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userId)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to force logout", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to force logout", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User logged out everywhere", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("User logged out everywhere", resp))
+}
+
+// AssignRoleHandler gives a user a role, the tokens of the user are revoked so the next refresh carries it
+func (b *UserHandler) AssignRoleHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userId := c.Params("id")
+	role := c.Params("role")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
+		"role":    role,
+	}
+
+	resp, err := b.authClient.AssignRole(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userId, role)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to assign role", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to assign role", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role assigned successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Role assigned successfully", resp))
+}
+
+// UnassignRoleHandler takes a role from a user, the last admin cannot lose theirs
+func (b *UserHandler) UnassignRoleHandler(c *fiber.Ctx) error {
+	// Retrieve requestID from context
+	requestID, ok := c.Locals(constants.ContextRequestIDKey).(string)
+	if !ok || requestID == "" {
+		requestID = "unknown"
+	}
+
+	userId := c.Params("id")
+	role := c.Params("role")
+
+	extra := map[string]interface{}{
+		"method":  c.Method(),
+		"url":     c.OriginalURL(),
+		"user_id": userId,
+		"role":    role,
+	}
+
+	resp, err := b.authClient.UnassignRole(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), userId, role)
+	if err != nil {
+		b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to unassign role", extra, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(datatransfers.ResponseError("Failed to unassign role", err))
+	}
+
+	b.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role unassigned successfully", extra, nil)
+	return c.Status(fiber.StatusOK).JSON(datatransfers.ResponseSuccess("Role unassigned successfully", resp))
+}
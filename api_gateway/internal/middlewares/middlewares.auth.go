@@ -1,7 +1,6 @@
 package middlewares
 
 import (
-	"api_gateway/internal/clients"
 	"api_gateway/internal/constants"
 	"api_gateway/internal/datatransfers"
 	"api_gateway/pkg/logger"
@@ -14,14 +13,14 @@ import (
 )
 
 type AuthMiddleware struct {
-	authClient clients.AuthClient
-	logger     *logger.Logger
+	tokens *TokenValidator
+	logger *logger.Logger
 }
 
-func NewAuthMiddleware(authClient clients.AuthClient, logger *logger.Logger) AuthMiddleware {
+func NewAuthMiddleware(tokens *TokenValidator, logger *logger.Logger) AuthMiddleware {
 	return AuthMiddleware{
-		authClient: authClient,
-		logger:     logger,
+		tokens: tokens,
+		logger: logger,
 	}
 }
 
@@ -100,10 +99,7 @@ func (m *AuthMiddleware) Authenticate() fiber.Handler {
 		}
 		token := tokenParts[1]
 
-		dto := datatransfers.ValidateTokenRequest{
-			Token: token,
-		}
-		res, err := m.authClient.ValidateToken(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), dto) // Call auth_service to validate token
+		res, err := m.tokens.Validate(context.WithValue(c.Context(), constants.ContextRequestIDKey, requestID), token) // Verified locally, auth_service is only asked about tokens issued before the revocation feed connected
 		if err != nil || !res.Valid {
 			// logger.Log.Error("Authentication failed",
 			// 	zap.String("request_id", requestID),
@@ -131,17 +127,19 @@ func (m *AuthMiddleware) Authenticate() fiber.Handler {
 			return c.Status(fiber.StatusUnauthorized).JSON(datatransfers.ResponseError("Middleware authentication failed", err))
 		}
 
-		// Pass role and userID to context for further usage
-		c.Locals("role", res.Role)
+		// Pass roles, permissions and userID to context for further usage
+		c.Locals("roles", res.Roles)
+		c.Locals("permissions", res.Permissions)
 		c.Locals("userID", res.UserID)
 		c.Locals("email", res.Email)
+		c.Locals("sessionID", res.SessionID)
 
 		// Log successful authentication
 		// logger.Log.Info("Authentication successful",
 		// 	zap.String("request_id", requestID),
 		// 	zap.String("method", c.Method()),
 		// 	zap.String("url", c.OriginalURL()),
-		// 	zap.String("role", res.Role),
+		// 	zap.Strings("roles", res.Roles),
 		// 	zap.String("user_id", res.UserID),
 		// 	zap.String("email", res.Email),
 		// 	zap.String(constants.LoggerCategory, constants.LoggerCategoryMiddleware),
@@ -157,7 +155,7 @@ func (m *AuthMiddleware) Authenticate() fiber.Handler {
 		// 	Extra: map[string]interface{}{
 		// 		"method":  c.Method(),
 		// 		"url":     c.OriginalURL(),
-		// 		"role":    res.Role,
+		// 		"roles":   res.Roles,
 		// 		"user_id": res.UserID,
 		// 		"email":   res.Email,
 		// 	},
@@ -165,7 +163,7 @@ func (m *AuthMiddleware) Authenticate() fiber.Handler {
 		// 	log.Printf("[%s] Failed to publish log to RabbitMQ: %v\n", utils.GetLocation(), err)
 		// }
 
-		extra["role"] = res.Role
+		extra["roles"] = res.Roles
 		extra["user_id"] = res.UserID
 		extra["email"] = res.Email
 
@@ -175,27 +173,28 @@ func (m *AuthMiddleware) Authenticate() fiber.Handler {
 	}
 }
 
-// Middleware to authorize certain roles
-func (m *AuthMiddleware) HasAuthority(allowedRoles []string) fiber.Handler {
+// Middleware to authorize the holders of a permission, whichever role grants it
+func (m *AuthMiddleware) RequirePermission(permission string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Get request ID from context to track specific request
-		requestID := c.Locals(constants.ContextRequestIDKey).(string)
-		role := c.Locals("role").(string)
+		requestID, _ := c.Locals(constants.ContextRequestIDKey).(string)
+		permissions, _ := c.Locals("permissions").([]string)
 
 		extra := map[string]interface{}{
-			"method": c.Method(),
-			"url":    c.OriginalURL(),
-			"role":   role,
+			"method":     c.Method(),
+			"url":        c.OriginalURL(),
+			"permission": permission,
+			"roles":      c.Locals("roles"),
 		}
 
-		// Log role check attempt
-		m.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Checking user role for access", extra, nil)
+		// Log permission check attempt
+		m.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Checking user permission for access", extra, nil)
 
-		// Check if the user's role is authorized
-		for _, allowedRole := range allowedRoles {
-			if role == allowedRole {
+		// Check if one of the user's roles grants the permission
+		for _, granted := range permissions {
+			if granted == permission {
 				// Log authorized access
-				m.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Access granted based on role", extra, nil)
+				m.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Access granted based on permission", extra, nil)
 
 				return c.Next() // Access granted
 			}
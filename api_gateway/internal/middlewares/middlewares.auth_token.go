@@ -0,0 +1,133 @@
+package middlewares
+
+import (
+	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/pkg/jwt"
+	"api_gateway/pkg/logger"
+	"api_gateway/pkg/lru"
+	"api_gateway/pkg/revocation"
+	"api_gateway/pkg/utils"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// ErrTokenRevoked is returned for a token the revocation feed announced as revoked
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// TokenValidator validates access tokens for Authenticate. The signature is checked locally against the keys of
+// the auth service and the revocations against the ones announced on the revocation feed. Only a token issued before
+// the feed connected is checked with the auth service as well, it may have been revoked while nobody listened.
+// Verified tokens are cached until they expire or cacheTTL passed, whichever comes first.
+type TokenValidator struct {
+	authClient  clients.AuthClient
+	verifier    *jwt.Verifier
+	revocations *revocation.State
+	cache       *lru.Cache[string, verifiedToken]
+	cacheTTL    time.Duration
+	logger      *logger.Logger
+}
+
+// verifiedToken is a token whose signature was checked
+type verifiedToken struct {
+	res       datatransfers.ValidateTokenResponse
+	issuedAt  time.Time
+	expiresAt time.Time // of the cache entry
+	// checkedWith is the connection of the feed the auth service was asked under, its answer holds as long as
+	// the feed stays connected since every later revocation comes through it
+	checkedWith time.Time
+}
+
+func (t verifiedToken) revocationToken() revocation.Token {
+	return revocation.Token{
+		ID:        t.res.TokenID,
+		UserID:    t.res.UserID,
+		SessionID: t.res.SessionID,
+		IssuedAt:  t.issuedAt,
+	}
+}
+
+func NewTokenValidator(authClient clients.AuthClient, verifier *jwt.Verifier, revocations *revocation.State, cacheSize int, cacheTTL time.Duration, logger *logger.Logger) *TokenValidator {
+	return &TokenValidator{
+		authClient:  authClient,
+		verifier:    verifier,
+		revocations: revocations,
+		cache:       lru.New[string, verifiedToken](cacheSize),
+		cacheTTL:    cacheTTL,
+		logger:      logger,
+	}
+}
+
+// Validate returns the user of a valid access token that was not revoked. When the auth service has to be asked
+// and does not answer, the token is refused.
+func (v *TokenValidator) Validate(ctx context.Context, token string) (datatransfers.ValidateTokenResponse, error) {
+	key := tokenCacheKey(token)
+	verified, cached := v.cache.Get(key)
+	if !cached {
+		var err error
+		if verified, err = v.verify(ctx, token); err != nil {
+			return datatransfers.ValidateTokenResponse{}, err
+		}
+	}
+
+	revocationToken := verified.revocationToken()
+	if v.revocations.Revoked(revocationToken) {
+		return datatransfers.ValidateTokenResponse{}, ErrTokenRevoked
+	}
+
+	// A token issued before the feed connected may have been revoked while nobody listened
+	connectedAt := v.revocations.ConnectedAt()
+	if !v.revocations.Covers(revocationToken) && (connectedAt.IsZero() || !verified.checkedWith.Equal(connectedAt)) {
+		res, err := v.authClient.ValidateToken(ctx, datatransfers.ValidateTokenRequest{Token: token})
+		if err != nil {
+			v.logger.LogMessage(utils.GetLocation(), utils.GetRequestIDFromContext(ctx), constants.LogLevelWarn, "Failed to check revocation of a token issued before the revocation feed connected", map[string]interface{}{"user_id": verified.res.UserID}, err)
+			return datatransfers.ValidateTokenResponse{}, err
+		}
+		if !res.Valid {
+			return datatransfers.ValidateTokenResponse{}, errors.New("token is not valid")
+		}
+		verified.checkedWith = connectedAt
+		cached = false
+	}
+
+	if !cached {
+		v.cache.Add(key, verified, verified.expiresAt)
+	}
+	return verified.res, nil
+}
+
+// verify checks the signature of a token the cache does not hold
+func (v *TokenValidator) verify(ctx context.Context, token string) (verifiedToken, error) {
+	claims, err := v.verifier.Verify(ctx, token)
+	if err != nil {
+		return verifiedToken{}, err
+	}
+
+	expiresAt := time.Now().Add(v.cacheTTL)
+	if claims.ExpiresAt.Time.Before(expiresAt) {
+		expiresAt = claims.ExpiresAt.Time
+	}
+	return verifiedToken{
+		res: datatransfers.ValidateTokenResponse{
+			Valid:       true,
+			UserID:      claims.UserID,
+			Roles:       claims.Roles,
+			Permissions: claims.Permissions,
+			Email:       claims.Email,
+			SessionID:   claims.SessionID,
+			TokenID:     claims.ID,
+		},
+		issuedAt:  claims.IssuedAtTime(), // without iat the token counts as issued before the feed connected
+		expiresAt: expiresAt,
+	}, nil
+}
+
+// tokenCacheKey keys the cache by a hash, the tokens themselves are not kept in memory
+func tokenCacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,184 @@
+package middlewares
+
+import (
+	"api_gateway/internal/clients"
+	"api_gateway/internal/datatransfers"
+	"api_gateway/internal/models"
+	"api_gateway/pkg/jwt"
+	"api_gateway/pkg/revocation"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAuthClient publishes one Ed25519 key and answers ValidateToken with err, any other call panics
+type fakeAuthClient struct {
+	clients.AuthClient
+	public      ed25519.PublicKey
+	err         error
+	validations int
+}
+
+func (c *fakeAuthClient) GetJWKS(ctx context.Context) (datatransfers.JWKSResponse, error) {
+	return datatransfers.JWKSResponse{Keys: []datatransfers.JWK{{
+		Kty: "OKP",
+		Kid: "key-1",
+		Use: "sig",
+		Alg: golangJWT.SigningMethodEdDSA.Alg(),
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(c.public),
+	}}}, nil
+}
+
+func (c *fakeAuthClient) ValidateToken(ctx context.Context, dto datatransfers.ValidateTokenRequest) (datatransfers.ValidateTokenResponse, error) {
+	c.validations++
+	if c.err != nil {
+		return datatransfers.ValidateTokenResponse{}, c.err
+	}
+	return datatransfers.ValidateTokenResponse{Valid: true}, nil
+}
+
+type testTokens struct {
+	private ed25519.PrivateKey
+}
+
+func (k testTokens) sign(t *testing.T, id string, issuedAt time.Time) string {
+	t.Helper()
+	claims := &jwt.Claims{
+		UserID:        "user-1",
+		TokenType:     "access",
+		SessionID:     "session-1",
+		IssuedAtMilli: issuedAt.UnixMilli(),
+		RegisteredClaims: golangJWT.RegisteredClaims{
+			ID:        id,
+			Issuer:    "auth-service",
+			IssuedAt:  golangJWT.NewNumericDate(issuedAt),
+			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(15 * time.Minute)),
+		},
+	}
+	token := golangJWT.NewWithClaims(golangJWT.SigningMethodEdDSA, claims)
+	token.Header["kid"] = "key-1"
+	signed, err := token.SignedString(k.private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func newTestTokenValidator(t *testing.T) (*TokenValidator, *fakeAuthClient, *revocation.State, testTokens) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authClient := &fakeAuthClient{public: public}
+	revocations := revocation.NewState(15 * time.Minute)
+	verifier := jwt.NewVerifier(jwt.NewKeySet(authClient, time.Minute, time.Hour), "auth-service")
+	return NewTokenValidator(authClient, verifier, revocations, 16, time.Minute, nil), authClient, revocations, testTokens{private: private}
+}
+
+func TestValidateChecksTokensIssuedAfterTheFeedConnectedLocally(t *testing.T) {
+	validator, authClient, revocations, tokens := newTestTokenValidator(t)
+	revocations.Connected()
+	authClient.err = status.Error(codes.Unavailable, "auth service down")
+	token := tokens.sign(t, "token-1", time.Now().Add(time.Minute))
+
+	for i := 0; i < 2; i++ {
+		res, err := validator.Validate(context.Background(), token)
+		if err != nil || res.UserID != "user-1" || res.TokenID != "token-1" {
+			t.Fatalf("Validate() = %+v, %v, want user-1", res, err)
+		}
+	}
+	if authClient.validations != 0 {
+		t.Fatalf("ValidateToken() called %d times, want none", authClient.validations)
+	}
+
+	// A cached token is checked against the revocations on every use
+	revocations.Apply(models.RevocationEvent{Type: models.RevocationSession, SessionID: "session-1"})
+	if _, err := validator.Validate(context.Background(), token); !errors.Is(err, ErrTokenRevoked) {
+		t.Fatalf("Validate() of a revoked session error = %v, want ErrTokenRevoked", err)
+	}
+}
+
+func TestValidateKeepsTokensIssuedInTheSameSecondAfterARevocation(t *testing.T) {
+	validator, _, revocations, tokens := newTestTokenValidator(t)
+	revocations.Connected()
+	// The user is revoked halfway through a second, a token is issued a millisecond before and one after it
+	validAfter := time.Now().Add(time.Minute).Truncate(time.Second).Add(500 * time.Millisecond)
+	revocations.Apply(models.RevocationEvent{Type: models.RevocationUser, UserID: "user-1", ValidAfter: validAfter.UnixMilli()})
+
+	fresh := tokens.sign(t, "token-1", validAfter.Add(time.Millisecond))
+	if _, err := validator.Validate(context.Background(), fresh); err != nil {
+		t.Fatalf("Validate() of a token issued just after the revocation error = %v", err)
+	}
+	older := tokens.sign(t, "token-2", validAfter.Add(-time.Millisecond))
+	if _, err := validator.Validate(context.Background(), older); !errors.Is(err, ErrTokenRevoked) {
+		t.Fatalf("Validate() of a token issued just before the revocation error = %v, want ErrTokenRevoked", err)
+	}
+}
+
+func TestValidateAsksAuthServiceAboutOlderTokens(t *testing.T) {
+	validator, authClient, revocations, tokens := newTestTokenValidator(t)
+	revocations.Connected()
+	token := tokens.sign(t, "token-1", time.Now().Add(-time.Minute))
+
+	for i := 0; i < 2; i++ {
+		if _, err := validator.Validate(context.Background(), token); err != nil {
+			t.Fatalf("Validate() error = %v", err)
+		}
+	}
+	// The answer holds while the feed stays connected
+	if authClient.validations != 1 {
+		t.Fatalf("ValidateToken() called %d times, want once", authClient.validations)
+	}
+
+	// Revocations may have been missed while reconnecting, so the auth service is asked again
+	revocations.Disconnected()
+	time.Sleep(time.Millisecond)
+	revocations.Connected()
+	if _, err := validator.Validate(context.Background(), token); err != nil {
+		t.Fatalf("Validate() after a reconnect error = %v", err)
+	}
+	if authClient.validations != 2 {
+		t.Fatalf("ValidateToken() called %d times, want twice", authClient.validations)
+	}
+}
+
+func TestValidateRefusesTokensWhenAuthServiceIsUnreachable(t *testing.T) {
+	validator, authClient, revocations, tokens := newTestTokenValidator(t)
+	authClient.err = status.Error(codes.Unavailable, "auth service down")
+
+	// Without the feed every token has to be checked with the auth service
+	token := tokens.sign(t, "token-1", time.Now().Add(time.Minute))
+	if _, err := validator.Validate(context.Background(), token); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Validate() without the feed error = %v, want Unavailable", err)
+	}
+
+	revocations.Connected()
+	older := tokens.sign(t, "token-2", time.Now().Add(-time.Minute))
+	if _, err := validator.Validate(context.Background(), older); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Validate() of an older token error = %v, want Unavailable", err)
+	}
+}
+
+func TestValidateRejectsForgedTokens(t *testing.T) {
+	validator, authClient, revocations, _ := newTestTokenValidator(t)
+	revocations.Connected()
+
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	forged := testTokens{private: otherKey}.sign(t, "token-1", time.Now().Add(time.Minute))
+	if _, err := validator.Validate(context.Background(), forged); err == nil {
+		t.Fatal("Validate() accepted a token signed with another key")
+	}
+	if authClient.validations != 0 {
+		t.Fatalf("ValidateToken() called %d times for a forged token", authClient.validations)
+	}
+}
@@ -0,0 +1,18 @@
+package models
+
+// Kinds of revocation the auth service announces
+const (
+	RevocationToken   = "token"
+	RevocationSession = "session"
+	RevocationUser    = "user"
+)
+
+// RevocationEvent is what the auth service publishes on the revocation exchange when it revokes access tokens
+type RevocationEvent struct {
+	XCorrelationID string `json:"X-Correlation-ID"`
+	Type           string `json:"type"`
+	TokenID        string `json:"token_id,omitempty"`
+	SessionID      string `json:"session_id,omitempty"`
+	UserID         string `json:"user_id,omitempty"`
+	ValidAfter     int64  `json:"valid_after,omitempty"` // unix milliseconds, tokens of the user issued before then are revoked
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
 	"api_gateway/internal/handlers"
 	"api_gateway/internal/middlewares"
 	"api_gateway/pkg/logger"
@@ -26,12 +27,29 @@ func NewAuthRoute(router fiber.Router, authMiddleware middlewares.AuthMiddleware
 }
 
 func (r *authRoutes) Routes() {
+	// Public keys verifying the tokens, at the well-known location verifiers look for
+	r.router.Get("/.well-known/jwks.json", r.handler.JWKSHandler)
+
 	route := r.router.Group("/auth")
 	route.Post("/register", r.handler.RegisterHandler)
 	route.Post("/send-otp", r.handler.SendOtpHandler)
 	route.Post("/verify-email", r.handler.VerifyEmailHandler)
 	route.Post("/login", r.handler.LoginHandler)
 	route.Post("/validate-token", r.handler.ValidateTokenHandler)
+	route.Post("/forgot-password", r.handler.RequestPasswordResetHandler)
+	route.Post("/reset-password", r.handler.ResetPasswordHandler)
 	route.Post("/refresh-token", r.authMiddleware.Authenticate(), r.handler.RefreshTokenHandler)
 	route.Post("/logout", r.authMiddleware.Authenticate(), r.handler.LogoutHandler)
+
+	// Second factor of a login, authenticated by the mfa_token the login returned
+	route.Post("/mfa/verify", r.handler.VerifyMFAHandler)
+	route.Post("/mfa/enroll", r.handler.EnrollMFAHandler)
+	route.Post("/mfa/enroll/confirm", r.handler.ConfirmMFAEnrollmentHandler)
+
+	// Admin routes (authentication and permission required)
+	canManageRoles := r.authMiddleware.RequirePermission(constants.PermissionRolesManage)
+	canManageUsers := r.authMiddleware.RequirePermission(constants.PermissionUsersManage)
+	route.Get("/mfa/requirements", r.authMiddleware.Authenticate(), canManageRoles, r.handler.ListRoleMFARequirementsHandler)
+	route.Put("/mfa/requirements/:role", r.authMiddleware.Authenticate(), canManageRoles, r.handler.SetRoleMFARequirementHandler)
+	route.Post("/unlock", r.authMiddleware.Authenticate(), canManageUsers, r.handler.UnlockAccountHandler)
 }
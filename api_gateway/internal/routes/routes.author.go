@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
 	"api_gateway/internal/handlers"
 	"api_gateway/internal/middlewares"
 	"api_gateway/pkg/logger"
@@ -30,13 +31,17 @@ func (r *authorRoutes) Routes() {
 
 	// Public routes (authentication required)
 	route.Use(r.authMiddleware.Authenticate())
+	canWriteAuthors := r.authMiddleware.RequirePermission(constants.PermissionAuthorsWrite)
+	canAdministerCatalogue := r.authMiddleware.RequirePermission(constants.PermissionCatalogueAdmin)
+
 	route.Get("", r.handler.GetAllAuthorsHandler)
+	route.Get("/trash", canAdministerCatalogue, r.handler.GetDeletedAuthorsHandler) // registered before /:id so it is not taken for an id
 	route.Get("/:id", r.handler.GetAuthorByIdHandler)
 
-	// Admin routes (authentication and authorization required)
-	adminOnly := r.authMiddleware.HasAuthority([]string{"admin"})
-	route.Post("", adminOnly, r.handler.CreateAuthorHandler)
-	route.Put("/:id", adminOnly, r.handler.UpdateAuthorByIdHandler)
-	route.Delete("/:id", adminOnly, r.handler.DeleteAuthorByIdHandler)
-
+	// Staff routes (authentication and permission required)
+	route.Post("", canWriteAuthors, r.handler.CreateAuthorHandler)
+	route.Put("/:id", canWriteAuthors, r.handler.UpdateAuthorByIdHandler)
+	route.Delete("/:id", canWriteAuthors, r.handler.DeleteAuthorByIdHandler)
+	route.Post("/:id/restore", canAdministerCatalogue, r.handler.RestoreAuthorByIdHandler)
+	route.Post("/:id/merge", canAdministerCatalogue, r.handler.MergeAuthorsHandler)
 }
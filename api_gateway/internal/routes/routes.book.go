@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
 	"api_gateway/internal/handlers"
 	"api_gateway/internal/middlewares"
 	"api_gateway/pkg/logger"
@@ -30,14 +31,28 @@ func (r *bookRoutes) Routes() {
 
 	// Public routes (authentication required)
 	route.Use(r.authMiddleware.Authenticate())
+	canWriteBooks := r.authMiddleware.RequirePermission(constants.PermissionBooksWrite)
+	canAdministerCatalogue := r.authMiddleware.RequirePermission(constants.PermissionCatalogueAdmin)
+	canManageCopies := r.authMiddleware.RequirePermission(constants.PermissionCopiesManage)
 	route.Get("", r.handler.GetAllBooksHandler)
+	route.Get("/search", r.handler.SearchBooksHandler)
+	route.Get("/export", canWriteBooks, r.handler.ExportBooksHandler) // registered before /:id so it is not taken for an id
+	route.Get("/trash", canAdministerCatalogue, r.handler.GetDeletedBooksHandler)
+	route.Get("/isbn/:isbn", r.handler.GetBookByISBNHandler)
 	route.Get("/:id", r.handler.GetBookByIdHandler)
 	route.Get("/author/:authorId", r.handler.GetBooksByAuthorIdHandler)
 	route.Get("/category/:categoryId", r.handler.GetBooksByCategoryIdHandler)
 
-	// Admin routes (authentication and authorization required)
-	adminOnly := r.authMiddleware.HasAuthority([]string{"admin"})
-	route.Post("", adminOnly, r.handler.CreateBookHandler)
-	route.Put("/:id", adminOnly, r.handler.UpdateBookByIdHandler)
-	route.Delete("/:id", adminOnly, r.handler.DeleteBookByIdHandler)
+	// Staff routes (authentication and permission required)
+	route.Post("/imports", canWriteBooks, r.handler.ImportBooksHandler)
+	route.Get("/imports/:id", canWriteBooks, r.handler.GetImportJobHandler)
+	route.Post("", canWriteBooks, r.handler.CreateBookHandler)
+	route.Put("/:id", canWriteBooks, r.handler.UpdateBookByIdHandler)
+	route.Delete("/:id", canWriteBooks, r.handler.DeleteBookByIdHandler)
+	route.Post("/:id/restore", canAdministerCatalogue, r.handler.RestoreBookByIdHandler)
+	route.Get("/copies/:barcode", canManageCopies, r.handler.GetBookCopyByBarcodeHandler)
+	route.Put("/copies/:id", canManageCopies, r.handler.UpdateBookCopyHandler)
+	route.Post("/copies/:id/retire", canManageCopies, r.handler.RetireBookCopyHandler)
+	route.Get("/:id/copies", canManageCopies, r.handler.ListBookCopiesHandler)
+	route.Post("/:id/copies", canManageCopies, r.handler.AddBookCopyHandler)
 }
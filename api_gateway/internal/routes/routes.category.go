@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
 	"api_gateway/internal/handlers"
 	"api_gateway/internal/middlewares"
 	"api_gateway/pkg/logger"
@@ -30,13 +31,18 @@ func (r *categoryRoutes) Routes() {
 
 	// Public routes (authentication required)
 	route.Use(r.authMiddleware.Authenticate())
+	canWriteCategories := r.authMiddleware.RequirePermission(constants.PermissionCategoriesWrite)
+	canAdministerCatalogue := r.authMiddleware.RequirePermission(constants.PermissionCatalogueAdmin)
+
 	route.Get("", r.handler.GetAllCategoriesHandler)
+	route.Get("/trash", canAdministerCatalogue, r.handler.GetDeletedCategoriesHandler) // registered before /:id so it is not taken for an id
 	route.Get("/:id", r.handler.GetCategoryByIdHandler)
-
-	// Admin routes (authentication and authorization required)
-	adminOnly := r.authMiddleware.HasAuthority([]string{"admin"})
-	route.Post("", adminOnly, r.handler.CreateCategoryHandler)
-	route.Put("/:id", adminOnly, r.handler.UpdateCategoryHandler)
-	route.Delete("/:id", adminOnly, r.handler.DeleteCategoryHandler)
-
+	route.Get("/:id/subcategories", r.handler.ListSubcategoriesHandler)
+
+	// Staff routes (authentication and permission required)
+	route.Post("", canWriteCategories, r.handler.CreateCategoryHandler)
+	route.Put("/:id", canWriteCategories, r.handler.UpdateCategoryHandler)
+	route.Delete("/:id", canWriteCategories, r.handler.DeleteCategoryHandler)
+	route.Post("/:id/restore", canAdministerCatalogue, r.handler.RestoreCategoryHandler)
+	route.Post("/:id/merge", canAdministerCatalogue, r.handler.MergeCategoriesHandler)
 }
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
 	"api_gateway/internal/handlers"
 	"api_gateway/internal/middlewares"
 	"api_gateway/pkg/logger"
@@ -32,13 +33,35 @@ func (r *loanRoutes) Routes() {
 	route.Use(r.authMiddleware.Authenticate())
 	route.Post("", r.handler.CreateLoanHandler)
 	route.Post("/:id/return", r.handler.ReturnLoanHandler)
+	route.Post("/:id/renew", r.handler.RenewLoanHandler)
 	route.Get("", r.handler.ListUserLoansHandler)
 
-	// Admin routes (authentication and authorization required)
-	adminOnly := r.authMiddleware.HasAuthority([]string{"admin"})
-	route.Patch("/:id/status", adminOnly, r.handler.UpdateLoanStatusHandler)
-	route.Get("/all", adminOnly, r.handler.ListLoansHandler)
+	// Staff routes (authentication and permission required)
+	canManageLoans := r.authMiddleware.RequirePermission(constants.PermissionLoansManage)
+	route.Patch("/:id/status", canManageLoans, r.handler.UpdateLoanStatusHandler)
+	route.Get("/:id/history", canManageLoans, r.handler.GetLoanHistoryHandler)
+	route.Get("/all", canManageLoans, r.handler.ListLoansHandler)
+	route.Post("/checkout", canManageLoans, r.handler.CheckOutCopyHandler)
+	route.Post("/checkin", canManageLoans, r.handler.CheckInCopyHandler)
 
 	// avoid wildcard effect on `/all` endpoint
 	route.Get("/:id", r.handler.GetLoanHandler)
+
+	fineRoute := r.router.Group("/fines")
+
+	// Public routes (authentication required)
+	fineRoute.Use(r.authMiddleware.Authenticate())
+	fineRoute.Get("", r.handler.ListUserFinesHandler)
+	fineRoute.Post("/:id/pay", r.handler.PayFineHandler)
+
+	// Staff routes (authentication and permission required)
+	fineRoute.Post("/:id/waive", canManageLoans, r.handler.WaiveFineHandler)
+
+	holdRoute := r.router.Group("/holds")
+
+	// Public routes (authentication required)
+	holdRoute.Use(r.authMiddleware.Authenticate())
+	holdRoute.Post("", r.handler.PlaceHoldHandler)
+	holdRoute.Get("", r.handler.ListUserHoldsHandler)
+	holdRoute.Post("/:id/cancel", r.handler.CancelHoldHandler)
 }
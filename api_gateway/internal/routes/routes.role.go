@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
+	"api_gateway/internal/handlers"
+	"api_gateway/internal/middlewares"
+	"api_gateway/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type roleRoutes struct {
+	router         fiber.Router
+	authMiddleware middlewares.AuthMiddleware
+	handler        handlers.RoleHandler
+}
+
+func NewRoleRoute(router fiber.Router, authMiddleware middlewares.AuthMiddleware, client clients.AuthClient, logger *logger.Logger) *roleRoutes {
+	handler := handlers.NewRoleHandler(client, logger)
+
+	return &roleRoutes{
+		router:         router,
+		authMiddleware: authMiddleware,
+		handler:        handler,
+	}
+}
+
+func (r *roleRoutes) Routes() {
+	route := r.router.Group("/roles")
+
+	// Admin routes (authentication and permission required)
+	route.Use(r.authMiddleware.Authenticate(), r.authMiddleware.RequirePermission(constants.PermissionRolesManage))
+	route.Get("", r.handler.ListRolesHandler)
+	route.Get("/permissions", r.handler.ListPermissionsHandler)
+	route.Post("", r.handler.CreateRoleHandler)
+	route.Put("/:name", r.handler.UpdateRoleHandler)
+	route.Delete("/:name", r.handler.DeleteRoleHandler)
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api_gateway/internal/clients"
+	"api_gateway/internal/constants"
 	"api_gateway/internal/handlers"
 	"api_gateway/internal/middlewares"
 	"api_gateway/pkg/logger"
@@ -15,8 +16,8 @@ type userRoute struct {
 	handler        handlers.UserHandler
 }
 
-func NewUserRoute(router fiber.Router, authMiddleware middlewares.AuthMiddleware, client clients.UserClient, logger *logger.Logger) *userRoute {
-	handler := handlers.NewUserHandler(client, logger)
+func NewUserRoute(router fiber.Router, authMiddleware middlewares.AuthMiddleware, client clients.UserClient, authClient clients.AuthClient, logger *logger.Logger) *userRoute {
+	handler := handlers.NewUserHandler(client, authClient, logger)
 
 	return &userRoute{
 		router:         router,
@@ -31,8 +32,25 @@ func (r *userRoute) Routes() {
 	// Public routes (authentication required)
 	route.Use(r.authMiddleware.Authenticate())
 	route.Get("/me", r.handler.GetMe)
-
-	// Admin routes (authentication and authorization required)
-	adminOnly := r.authMiddleware.HasAuthority([]string{"admin"})
-	route.Get("", adminOnly, r.handler.GetAllUsersHandler)
+	route.Get("/me/entitlements", r.handler.GetMyEntitlementsHandler)
+	route.Put("/me/password", r.handler.ChangePasswordHandler)
+	route.Post("/me/email", r.handler.ChangeEmailHandler)
+	route.Post("/me/email/confirm", r.handler.ConfirmEmailChangeHandler)
+	route.Post("/me/mfa", r.handler.EnrollMyMFAHandler)
+	route.Post("/me/mfa/confirm", r.handler.ConfirmMyMFAHandler)
+	route.Get("/me/sessions", r.handler.ListMySessionsHandler)
+	route.Delete("/me/sessions/:id", r.handler.RevokeMySessionHandler)
+
+	// Staff routes (authentication and permission required)
+	canReadUsers := r.authMiddleware.RequirePermission(constants.PermissionUsersRead)
+	canManageUsers := r.authMiddleware.RequirePermission(constants.PermissionUsersManage)
+	canManageRoles := r.authMiddleware.RequirePermission(constants.PermissionRolesManage)
+	route.Get("", canReadUsers, r.handler.GetAllUsersHandler)
+	route.Get("/tiers", canReadUsers, r.handler.ListMembershipTiersHandler)
+	route.Post("/tiers", canManageUsers, r.handler.CreateMembershipTierHandler)
+	route.Put("/tiers/:id", canManageUsers, r.handler.UpdateMembershipTierHandler)
+	route.Put("/:id/tier", canManageUsers, r.handler.AssignMembershipTierHandler)
+	route.Delete("/:id/sessions", canManageUsers, r.handler.ForceLogoutHandler)
+	route.Put("/:id/roles/:role", canManageRoles, r.handler.AssignRoleHandler)
+	route.Delete("/:id/roles/:role", canManageRoles, r.handler.UnassignRoleHandler)
 }
@@ -0,0 +1,76 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+const tokenTypeAccess = "access"
+
+// Claims mirror the claims the auth service signs into its tokens
+type Claims struct {
+	UserID      string
+	Roles       []string
+	Permissions []string
+	Email       string
+	TokenType   string
+	SessionID   string
+	// IssuedAtMilli is iat in unix milliseconds, the registered iat only keeps whole seconds and revocations are
+	// compared to the millisecond
+	IssuedAtMilli int64
+	golangJWT.RegisteredClaims
+}
+
+// IssuedAtTime returns when the token was issued to the millisecond, tokens without IssuedAtMilli fall back to iat
+// and tokens without either to the zero time
+func (c Claims) IssuedAtTime() time.Time {
+	if c.IssuedAtMilli != 0 {
+		return time.UnixMilli(c.IssuedAtMilli)
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
+// Verifier checks access tokens against the keys of the auth service without calling it for every token
+type Verifier struct {
+	keys   *KeySet
+	issuer string
+}
+
+func NewVerifier(keys *KeySet, issuer string) *Verifier {
+	return &Verifier{
+		keys:   keys,
+		issuer: issuer,
+	}
+}
+
+// Verify checks the signature, issuer, expiry and type of an access token. Whether it was revoked
+// is up to the auth service, a valid token here is only known to be genuine.
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (Claims, error) {
+	var claims Claims
+	token, err := golangJWT.ParseWithClaims(tokenString, &claims,
+		func(token *golangJWT.Token) (interface{}, error) {
+			return v.keys.verificationKey(ctx, token)
+		},
+		golangJWT.WithValidMethods([]string{golangJWT.SigningMethodRS256.Alg(), golangJWT.SigningMethodEdDSA.Alg()}),
+		golangJWT.WithIssuer(v.issuer),
+		golangJWT.WithExpirationRequired(),
+	)
+	if err != nil {
+		return Claims{}, fmt.Errorf("token is not valid: %w", err)
+	}
+	if !token.Valid {
+		return Claims{}, errors.New("token is not valid")
+	}
+
+	if claims.TokenType != tokenTypeAccess {
+		return Claims{}, errors.New("invalid token type")
+	}
+	return claims, nil
+}
@@ -0,0 +1,155 @@
+package jwt
+
+import (
+	"api_gateway/internal/clients"
+	"api_gateway/internal/datatransfers"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sync"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+type publicKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// KeySet holds the public keys of the auth service. A token signed with a key it does not know yet makes it
+// fetch the JWKS again, at most once per minRefresh so that tokens with made up kids cannot flood the auth service.
+// The keys are also fetched again once they are older than maxAge, so keys the auth service retired are dropped.
+type KeySet struct {
+	authClient clients.AuthClient
+	minRefresh time.Duration
+	maxAge     time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]publicKey
+	fetchedAt time.Time
+
+	refreshMu   sync.Mutex // one fetch at a time
+	attemptedAt time.Time  // of the last fetch, successful or not, guarded by refreshMu
+}
+
+// NewKeySet fetches the keys on first use, so the gateway starts even while the auth service does not answer yet
+func NewKeySet(authClient clients.AuthClient, minRefresh, maxAge time.Duration) *KeySet {
+	return &KeySet{
+		authClient: authClient,
+		minRefresh: minRefresh,
+		maxAge:     maxAge,
+		keys:       make(map[string]publicKey),
+	}
+}
+
+// verificationKey returns the public key of the kid, as long as the token claims the algorithm of the key
+func (s *KeySet) verificationKey(ctx context.Context, token *golangJWT.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	// While the auth service does not answer, the keys fetched last stay in use
+	if s.stale() {
+		if err := s.refresh(ctx); err != nil {
+			log.Printf("Failed to refresh the JWKS, keeping the keys fetched before: %v", err)
+		}
+	}
+
+	key, ok := s.lookup(kid)
+	if !ok {
+		if err := s.refresh(ctx); err != nil {
+			return nil, err
+		}
+		if key, ok = s.lookup(kid); !ok {
+			return nil, ErrUnknownKey
+		}
+	}
+	if token.Method.Alg() != key.alg {
+		return nil, fmt.Errorf("key %s does not sign with %s", kid, token.Method.Alg())
+	}
+	return key.key, nil
+}
+
+// stale tells whether the keys were fetched more than maxAge ago
+func (s *KeySet) stale() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return !s.fetchedAt.IsZero() && time.Since(s.fetchedAt) >= s.maxAge
+}
+
+func (s *KeySet) lookup(kid string) (publicKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// refresh replaces the keys with the JWKS of the auth service, unless it was fetched less than minRefresh ago.
+// A failed fetch counts as well, so an auth service that does not answer is not asked on every token.
+func (s *KeySet) refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	if time.Since(s.attemptedAt) < s.minRefresh {
+		return nil
+	}
+	s.attemptedAt = time.Now()
+
+	jwks, err := s.authClient.GetJWKS(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]publicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := parseJWK(jwk)
+		if err != nil {
+			log.Printf("Skipping key %s of the JWKS: %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	log.Printf("JWKS fetched with %d key(s)", len(keys))
+	return nil
+}
+
+func parseJWK(jwk datatransfers.JWK) (publicKey, error) {
+	switch {
+	case jwk.Kty == "RSA" && jwk.Alg == golangJWT.SigningMethodRS256.Alg():
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return publicKey{}, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return publicKey{}, err
+		}
+		return publicKey{
+			alg: jwk.Alg,
+			key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())},
+		}, nil
+	case jwk.Kty == "OKP" && jwk.Crv == "Ed25519" && jwk.Alg == golangJWT.SigningMethodEdDSA.Alg():
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return publicKey{}, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return publicKey{}, errors.New("invalid Ed25519 key size")
+		}
+		return publicKey{alg: jwk.Alg, key: ed25519.PublicKey(x)}, nil
+	default:
+		return publicKey{}, fmt.Errorf("unsupported key type %s with algorithm %s", jwk.Kty, jwk.Alg)
+	}
+}
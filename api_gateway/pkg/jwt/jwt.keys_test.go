@@ -0,0 +1,111 @@
+package jwt
+
+import (
+	"api_gateway/internal/clients"
+	"api_gateway/internal/datatransfers"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+// fakeAuthClient publishes the Ed25519 keys it holds by kid, any call but GetJWKS panics
+type fakeAuthClient struct {
+	clients.AuthClient
+	keys    map[string]ed25519.PublicKey
+	err     error
+	fetches int
+}
+
+func (c *fakeAuthClient) GetJWKS(ctx context.Context) (datatransfers.JWKSResponse, error) {
+	c.fetches++
+	if c.err != nil {
+		return datatransfers.JWKSResponse{}, c.err
+	}
+	var jwks datatransfers.JWKSResponse
+	for kid, public := range c.keys {
+		jwks.Keys = append(jwks.Keys, datatransfers.JWK{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: golangJWT.SigningMethodEdDSA.Alg(),
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(public),
+		})
+	}
+	return jwks, nil
+}
+
+func signTestToken(t *testing.T, kid string, private ed25519.PrivateKey) string {
+	t.Helper()
+	claims := &Claims{
+		UserID:    "user-1",
+		TokenType: tokenTypeAccess,
+		RegisteredClaims: golangJWT.RegisteredClaims{
+			Issuer:    "auth-service",
+			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+	token := golangJWT.NewWithClaims(golangJWT.SigningMethodEdDSA, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestKeySetDropsRetiredKeysAfterMaxAge(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authClient := &fakeAuthClient{keys: map[string]ed25519.PublicKey{"key-1": public}}
+	verifier := NewVerifier(NewKeySet(authClient, 0, 20*time.Millisecond), "auth-service")
+	token := signTestToken(t, "key-1", private)
+
+	if _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if _, err := verifier.Verify(context.Background(), token); err != nil || authClient.fetches != 1 {
+		t.Fatalf("Verify() with fresh keys = %v after %d fetches, want one fetch", err, authClient.fetches)
+	}
+
+	// The auth service retired the key, once the keys are too old it is no longer trusted
+	delete(authClient.keys, "key-1")
+	time.Sleep(30 * time.Millisecond)
+	if _, err := verifier.Verify(context.Background(), token); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("Verify() with a retired key error = %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestKeySetKeepsKeysWhileAuthServiceIsDown(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authClient := &fakeAuthClient{keys: map[string]ed25519.PublicKey{"key-1": public}}
+	verifier := NewVerifier(NewKeySet(authClient, 10*time.Millisecond, 20*time.Millisecond), "auth-service")
+	token := signTestToken(t, "key-1", private)
+
+	if _, err := verifier.Verify(context.Background(), token); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	authClient.err = errors.New("auth service down")
+	time.Sleep(30 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if _, err := verifier.Verify(context.Background(), token); err != nil {
+			t.Fatalf("Verify() with stale keys error = %v", err)
+		}
+	}
+	// The failed fetch counts against minRefresh, so the auth service is not asked on every token
+	if authClient.fetches != 2 {
+		t.Fatalf("GetJWKS() called %d times, want twice", authClient.fetches)
+	}
+}
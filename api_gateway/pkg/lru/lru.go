@@ -0,0 +1,93 @@
+package lru
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache holds at most capacity entries and drops the least recently used one to make room for a new one.
+// Every entry also expires on its own, an expired entry is never returned. It is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List // most recently used first
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return &Cache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value of the key unless it expired, and marks it as the most recently used
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	element, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+	e := element.Value.(*entry[K, V])
+	if !time.Now().Before(e.expiresAt) {
+		c.remove(element)
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return e.value, true
+}
+
+// Add stores the value until expiresAt, evicting the least recently used entry when the cache is full
+func (c *Cache[K, V]) Add(key K, value V, expiresAt time.Time) {
+	if c.capacity <= 0 || !time.Now().Before(expiresAt) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		e := element.Value.(*entry[K, V])
+		e.value, e.expiresAt = value, expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		c.remove(c.order.Back())
+	}
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+}
+
+// RemoveFunc removes the entries whose value matches and returns how many it removed
+func (c *Cache[K, V]) RemoveFunc(match func(value V) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if match(element.Value.(*entry[K, V]).value) {
+			c.remove(element)
+			removed++
+		}
+		element = next
+	}
+	return removed
+}
+
+// remove drops an entry, the caller holds the lock
+func (c *Cache[K, V]) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.items, element.Value.(*entry[K, V]).key)
+}
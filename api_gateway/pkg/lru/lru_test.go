@@ -0,0 +1,106 @@
+package lru
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := New[string, int](2)
+	expiresAt := time.Now().Add(time.Minute)
+
+	cache.Add("a", 1, expiresAt)
+	cache.Add("b", 2, expiresAt)
+	cache.Get("a") // b is now the least recently used
+	cache.Add("c", 3, expiresAt)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("Get(b) found the least recently used entry")
+	}
+	for key, want := range map[string]int{"a": 1, "c": 3} {
+		if got, ok := cache.Get(key); !ok || got != want {
+			t.Fatalf("Get(%s) = %d, %v, want %d", key, got, ok, want)
+		}
+	}
+
+	// Updating an entry does not evict another one
+	cache.Add("a", 10, expiresAt)
+	if got, _ := cache.Get("a"); got != 10 {
+		t.Fatalf("Get(a) = %d, want the updated value", got)
+	}
+	if _, ok := cache.Get("c"); !ok {
+		t.Fatal("Get(c) lost an entry to an update")
+	}
+}
+
+func TestCacheExpires(t *testing.T) {
+	cache := New[string, int](2)
+
+	cache.Add("expired", 1, time.Now().Add(-time.Second))
+	if _, ok := cache.Get("expired"); ok {
+		t.Fatal("Get() returned an entry added already expired")
+	}
+
+	cache.Add("short", 1, time.Now().Add(5*time.Millisecond))
+	time.Sleep(10 * time.Millisecond)
+	if _, ok := cache.Get("short"); ok {
+		t.Fatal("Get() returned an expired entry")
+	}
+	if len(cache.items) != 0 || cache.order.Len() != 0 {
+		t.Fatalf("expired entry kept, items = %d, order = %d", len(cache.items), cache.order.Len())
+	}
+}
+
+func TestCacheWithoutCapacity(t *testing.T) {
+	cache := New[string, int](0)
+	cache.Add("a", 1, time.Now().Add(time.Minute))
+	if _, ok := cache.Get("a"); ok {
+		t.Fatal("Get() found an entry in a cache without capacity")
+	}
+}
+
+func TestCacheRemoveFunc(t *testing.T) {
+	cache := New[string, int](4)
+	expiresAt := time.Now().Add(time.Minute)
+	for i := 1; i <= 4; i++ {
+		cache.Add(fmt.Sprint(i), i, expiresAt)
+	}
+
+	if removed := cache.RemoveFunc(func(value int) bool { return value%2 == 0 }); removed != 2 {
+		t.Fatalf("RemoveFunc() = %d, want 2", removed)
+	}
+	for i := 1; i <= 4; i++ {
+		if _, ok := cache.Get(fmt.Sprint(i)); ok != (i%2 == 1) {
+			t.Fatalf("Get(%d) found = %v after removing the even values", i, ok)
+		}
+	}
+}
+
+// TestCacheConcurrentUse is meant for the race detector, the capacity is never exceeded
+func TestCacheConcurrentUse(t *testing.T) {
+	cache := New[int, int](8)
+	expiresAt := time.Now().Add(time.Minute)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				key := (worker*200 + i) % 32
+				cache.Add(key, i, expiresAt)
+				cache.Get(key)
+				if i%50 == 0 {
+					cache.RemoveFunc(func(value int) bool { return value%7 == 0 })
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	if len(cache.items) > 8 || len(cache.items) != cache.order.Len() {
+		t.Fatalf("items = %d, order = %d, want at most 8 and both in step", len(cache.items), cache.order.Len())
+	}
+}
@@ -0,0 +1,130 @@
+package revocation
+
+import (
+	"api_gateway/internal/models"
+	"sync"
+	"time"
+)
+
+// clockSkew is how far the clocks of the gateway and the auth service may be apart, a token issued this close to
+// the connection of the feed counts as issued before it
+const clockSkew = 5 * time.Second
+
+// Token is what the state needs to know of an access token to tell whether it was revoked
+type Token struct {
+	ID        string // jti
+	UserID    string
+	SessionID string
+	IssuedAt  time.Time
+}
+
+// State holds the revocations announced by the auth service, fed by the revocation consumer. It only learns of
+// the revocations made while the feed is connected, ConnectedAt tells since when that is. An entry only has to
+// outlive the tokens it revokes, so it is dropped after the lifetime of an access token.
+type State struct {
+	tokenLifetime time.Duration
+
+	mu          sync.RWMutex
+	tokens      map[string]time.Time // jti to the time the entry is dropped
+	sessions    map[string]time.Time // session ID to the time the entry is dropped
+	users       map[string]time.Time // user ID to the time tokens issued before it are revoked
+	connectedAt time.Time            // zero while the feed is down
+}
+
+// NewState keeps the revocations for tokenLifetime, the lifetime of an access token
+func NewState(tokenLifetime time.Duration) *State {
+	return &State{
+		tokenLifetime: tokenLifetime,
+		tokens:        make(map[string]time.Time),
+		sessions:      make(map[string]time.Time),
+		users:         make(map[string]time.Time),
+	}
+}
+
+// Connected marks the start of the feed, the revocations from then on are known
+func (s *State) Connected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connectedAt = time.Now()
+}
+
+// Disconnected marks the end of the feed, from then on no revocation is known to be complete
+func (s *State) Disconnected() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.connectedAt = time.Time{}
+}
+
+// ConnectedAt returns since when the feed is connected, zero while it is down
+func (s *State) ConnectedAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.connectedAt
+}
+
+// Covers tells whether every revocation of the token would be known, that is when it was issued after the feed
+// connected. A token issued before may have been revoked while the gateway was not listening.
+func (s *State) Covers(token Token) bool {
+	connectedAt := s.ConnectedAt()
+	return !connectedAt.IsZero() && token.IssuedAt.After(connectedAt.Add(clockSkew))
+}
+
+// Apply records the revocation of an event and drops the entries past the lifetime of an access token
+func (s *State) Apply(event models.RevocationEvent) {
+	now := time.Now()
+	until := now.Add(s.tokenLifetime)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(now)
+	switch event.Type {
+	case models.RevocationToken:
+		if event.TokenID != "" {
+			s.tokens[event.TokenID] = until
+		}
+	case models.RevocationSession:
+		if event.SessionID != "" {
+			s.sessions[event.SessionID] = until
+		}
+	case models.RevocationUser:
+		validAfter := time.UnixMilli(event.ValidAfter)
+		if event.UserID != "" && validAfter.After(s.users[event.UserID]) {
+			s.users[event.UserID] = validAfter
+		}
+	}
+}
+
+// Revoked tells whether a revocation the state knows of covers the token
+func (s *State) Revoked(token Token) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, found := s.tokens[token.ID]; found && token.ID != "" {
+		return true
+	}
+	if _, found := s.sessions[token.SessionID]; found && token.SessionID != "" {
+		return true
+	}
+	validAfter, found := s.users[token.UserID]
+	return found && token.IssuedAt.Before(validAfter)
+}
+
+// prune drops the entries that outlived every token they revoke, the caller holds the lock
+func (s *State) prune(now time.Time) {
+	for id, until := range s.tokens {
+		if now.After(until) {
+			delete(s.tokens, id)
+		}
+	}
+	for id, until := range s.sessions {
+		if now.After(until) {
+			delete(s.sessions, id)
+		}
+	}
+	for id, validAfter := range s.users {
+		if now.After(validAfter.Add(s.tokenLifetime)) {
+			delete(s.users, id)
+		}
+	}
+}
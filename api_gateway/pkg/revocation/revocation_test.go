@@ -0,0 +1,77 @@
+package revocation
+
+import (
+	"api_gateway/internal/models"
+	"testing"
+	"time"
+)
+
+func TestStateRevoked(t *testing.T) {
+	state := NewState(15 * time.Minute)
+	cutoff := time.Now().Truncate(time.Millisecond)
+	state.Apply(models.RevocationEvent{Type: models.RevocationToken, TokenID: "token-1"})
+	state.Apply(models.RevocationEvent{Type: models.RevocationSession, SessionID: "session-1"})
+	state.Apply(models.RevocationEvent{Type: models.RevocationUser, UserID: "user-1", ValidAfter: cutoff.UnixMilli()})
+	// An older cutoff announced late does not move the cutoff back
+	state.Apply(models.RevocationEvent{Type: models.RevocationUser, UserID: "user-1", ValidAfter: cutoff.Add(-time.Minute).UnixMilli()})
+
+	tests := []struct {
+		name  string
+		token Token
+		want  bool
+	}{
+		{name: "revoked token", token: Token{ID: "token-1", UserID: "user-2", IssuedAt: cutoff}, want: true},
+		{name: "revoked session", token: Token{ID: "token-2", UserID: "user-2", SessionID: "session-1", IssuedAt: cutoff}, want: true},
+		{name: "untouched token", token: Token{ID: "token-2", UserID: "user-2", SessionID: "session-2", IssuedAt: cutoff}},
+		{name: "without IDs", token: Token{UserID: "user-2", IssuedAt: cutoff}},
+		{name: "issued before the user cutoff", token: Token{UserID: "user-1", IssuedAt: cutoff.Add(-time.Millisecond)}, want: true},
+		{name: "issued at the user cutoff", token: Token{UserID: "user-1", IssuedAt: cutoff}},
+		{name: "issued after the user cutoff", token: Token{UserID: "user-1", IssuedAt: cutoff.Add(time.Second)}},
+		{name: "issued a millisecond after the user cutoff", token: Token{UserID: "user-1", IssuedAt: cutoff.Add(time.Millisecond)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := state.Revoked(tt.token); got != tt.want {
+				t.Fatalf("Revoked(%+v) = %v, want %v", tt.token, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatePrunesAfterTokenLifetime(t *testing.T) {
+	state := NewState(time.Millisecond)
+	state.Apply(models.RevocationEvent{Type: models.RevocationToken, TokenID: "token-1"})
+	state.Apply(models.RevocationEvent{Type: models.RevocationUser, UserID: "user-1", ValidAfter: time.Now().UnixMilli()})
+
+	time.Sleep(5 * time.Millisecond)
+	state.Apply(models.RevocationEvent{Type: models.RevocationSession, SessionID: "session-1"})
+
+	if len(state.tokens) != 0 || len(state.users) != 0 || len(state.sessions) != 1 {
+		t.Fatalf("tokens = %v, users = %v, sessions = %v, want only the new session left", state.tokens, state.users, state.sessions)
+	}
+}
+
+func TestStateCovers(t *testing.T) {
+	state := NewState(15 * time.Minute)
+	if state.Covers(Token{IssuedAt: time.Now().Add(time.Hour)}) {
+		t.Fatal("Covers() before the feed connected = true")
+	}
+
+	state.Connected()
+	connectedAt := state.ConnectedAt()
+	if state.Covers(Token{IssuedAt: connectedAt.Add(-time.Minute)}) {
+		t.Fatal("Covers() of a token issued before the feed connected = true")
+	}
+	if state.Covers(Token{IssuedAt: connectedAt.Add(clockSkew / 2)}) {
+		t.Fatal("Covers() of a token issued within the clock skew = true")
+	}
+	if !state.Covers(Token{IssuedAt: connectedAt.Add(clockSkew + time.Second)}) {
+		t.Fatal("Covers() of a token issued after the feed connected = false")
+	}
+
+	state.Disconnected()
+	if !state.ConnectedAt().IsZero() || state.Covers(Token{IssuedAt: connectedAt.Add(time.Hour)}) {
+		t.Fatal("Covers() after the feed disconnected = true")
+	}
+}
@@ -28,11 +28,17 @@ func GetRequestIDFromContext(ctx context.Context) string {
 	return requestID
 }
 
-// GetProtoContext adds a request ID to the gRPC metadata context.
+// GetProtoContext adds a request ID, and the client IP when the context has one, to the gRPC metadata context.
 func GetProtoContext(ctx context.Context) context.Context {
 	requestID := GetRequestIDFromContext(ctx)
 
 	md := metadata.Pairs(constants.ContextProtoRequestIDKey, requestID) // all upercase md key automatically convert to lower
+	if clientIP, ok := ctx.Value(constants.ContextClientIPKey).(string); ok && clientIP != "" {
+		md.Set(constants.ContextProtoClientIPKey, clientIP)
+	}
+	if userAgent, ok := ctx.Value(constants.ContextUserAgentKey).(string); ok && userAgent != "" {
+		md.Set(constants.ContextProtoUserAgentKey, userAgent)
+	}
 
 	return metadata.NewOutgoingContext(ctx, md)
 }
@@ -35,6 +35,134 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on BookAuthor with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookAuthor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookAuthor with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookAuthorMultiError, or
+// nil if none found.
+func (m *BookAuthor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookAuthor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAuthorId()) < 1 {
+		err := BookAuthorValidationError{
+			field:  "AuthorId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _BookAuthor_Role_InLookup[m.GetRole()]; !ok {
+		err := BookAuthorValidationError{
+			field:  "Role",
+			reason: "value must be in list [ author editor translator]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BookAuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookAuthorMultiError is an error wrapping multiple validation errors
+// returned by BookAuthor.ValidateAll() if the designated constraints aren't met.
+type BookAuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookAuthorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookAuthorMultiError) AllErrors() []error { return m }
+
+// BookAuthorValidationError is the validation error returned by
+// BookAuthor.Validate if the designated constraints aren't met.
+type BookAuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookAuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookAuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookAuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookAuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookAuthorValidationError) ErrorName() string { return "BookAuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookAuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookAuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookAuthorValidationError{}
+
+var _BookAuthor_Role_InLookup = map[string]struct{}{
+	"":           {},
+	"author":     {},
+	"editor":     {},
+	"translator": {},
+}
+
 // Validate checks the field values on Book with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -60,8 +188,6 @@ func (m *Book) validate(all bool) error {
 
 	// no validation rules for Title
 
-	// no validation rules for AuthorId
-
 	// no validation rules for CategoryId
 
 	// no validation rules for Stock
@@ -72,6 +198,56 @@ func (m *Book) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for PublicationYear
+
+	// no validation rules for Isbn
+
+	// no validation rules for Publisher
+
+	// no validation rules for Language
+
+	// no validation rules for PageCount
+
+	// no validation rules for Edition
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DeletedAt
+
 	if len(errors) > 0 {
 		return BookMultiError(errors)
 	}
@@ -182,9 +358,9 @@ func (m *CreateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetAuthorId()) < 1 {
+	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
 		err := CreateBookRequestValidationError{
-			field:  "AuthorId",
+			field:  "CategoryId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -193,10 +369,98 @@ func (m *CreateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
+	if val := m.GetPublicationYear(); val < 0 || val > 9999 {
 		err := CreateBookRequestValidationError{
-			field:  "CategoryId",
-			reason: "value length must be at least 1 runes",
+			field:  "PublicationYear",
+			reason: "value must be inside range [0, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn()) > 17 {
+		err := CreateBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be at most 17 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateBookRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := CreateBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^([0-9][0-9 -]{8,15}[0-9Xx])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPublisher()) > 255 {
+		err := CreateBookRequestValidationError{
+			field:  "Publisher",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 8 {
+		err := CreateBookRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 8 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateBookRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := CreateBookRequestValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^([a-z]{2,3})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageCount(); val < 0 || val > 100000 {
+		err := CreateBookRequestValidationError{
+			field:  "PageCount",
+			reason: "value must be inside range [0, 100000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEdition()) > 50 {
+		err := CreateBookRequestValidationError{
+			field:  "Edition",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 5000 {
+		err := CreateBookRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 5000 runes",
 		}
 		if !all {
 			return err
@@ -204,10 +468,10 @@ func (m *CreateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStock() < 0 {
+	if l := len(m.GetAuthors()); l < 1 || l > 20 {
 		err := CreateBookRequestValidationError{
-			field:  "Stock",
-			reason: "value must be greater than or equal to 0",
+			field:  "Authors",
+			reason: "value must contain between 1 and 20 items, inclusive",
 		}
 		if !all {
 			return err
@@ -215,6 +479,40 @@ func (m *CreateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateBookRequestValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateBookRequestValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateBookRequestValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateBookRequestMultiError(errors)
 	}
@@ -295,6 +593,10 @@ var _ interface {
 	ErrorName() string
 } = CreateBookRequestValidationError{}
 
+var _CreateBookRequest_Isbn_Pattern = regexp.MustCompile("^([0-9][0-9 -]{8,15}[0-9Xx])?$")
+
+var _CreateBookRequest_Language_Pattern = regexp.MustCompile("^([a-z]{2,3})?$")
+
 // Validate checks the field values on CreateBookResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -537,46 +839,159 @@ var _ interface {
 	ErrorName() string
 } = GetBookRequestValidationError{}
 
-// Validate checks the field values on GetBookResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetBookResponse) Validate() error {
+// Validate checks the field values on GetBookByISBNRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookByISBNRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookResponse with the rules
+// ValidateAll checks the field values on GetBookByISBNRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookResponseMultiError, or nil if none found.
-func (m *GetBookResponse) ValidateAll() error {
+// GetBookByISBNRequestMultiError, or nil if none found.
+func (m *GetBookByISBNRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookResponse) validate(all bool) error {
+func (m *GetBookByISBNRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBook()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookResponseValidationError{
-					field:  "Book",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookResponseValidationError{
-					field:  "Book",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if l := utf8.RuneCountInString(m.GetIsbn()); l < 10 || l > 17 {
+		err := GetBookByISBNRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be between 10 and 17 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookByISBNRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookByISBNRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookByISBNRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookByISBNRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookByISBNRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookByISBNRequestMultiError) AllErrors() []error { return m }
+
+// GetBookByISBNRequestValidationError is the validation error returned by
+// GetBookByISBNRequest.Validate if the designated constraints aren't met.
+type GetBookByISBNRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookByISBNRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookByISBNRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookByISBNRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookByISBNRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookByISBNRequestValidationError) ErrorName() string {
+	return "GetBookByISBNRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookByISBNRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookByISBNRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookByISBNRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookByISBNRequestValidationError{}
+
+// Validate checks the field values on GetBookResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookResponseMultiError, or nil if none found.
+func (m *GetBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
 	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
@@ -856,6 +1271,8 @@ func (m *GetBooksByCategoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IncludeDescendants
+
 	if len(errors) > 0 {
 		return GetBooksByCategoryRequestMultiError(errors)
 	}
@@ -1198,32 +1615,32 @@ var _ interface {
 	ErrorName() string
 } = ListBooksResponseValidationError{}
 
-// Validate checks the field values on UpdateBookRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookRequest) Validate() error {
+// Validate checks the field values on SearchBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBooksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookRequest with the rules
+// ValidateAll checks the field values on SearchBooksRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookRequestMultiError, or nil if none found.
-func (m *UpdateBookRequest) ValidateAll() error {
+// SearchBooksRequestMultiError, or nil if none found.
+func (m *SearchBooksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookRequest) validate(all bool) error {
+func (m *SearchBooksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := UpdateBookRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
+	if utf8.RuneCountInString(m.GetQuery()) > 255 {
+		err := SearchBooksRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 255 runes",
 		}
 		if !all {
 			return err
@@ -1231,10 +1648,16 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetTitle()) < 1 {
-		err := UpdateBookRequestValidationError{
-			field:  "Title",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for AuthorId
+
+	// no validation rules for CategoryId
+
+	// no validation rules for AvailableOnly
+
+	if val := m.GetYearFrom(); val < 0 || val > 9999 {
+		err := SearchBooksRequestValidationError{
+			field:  "YearFrom",
+			reason: "value must be inside range [0, 9999]",
 		}
 		if !all {
 			return err
@@ -1242,10 +1665,10 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetAuthorId()) < 1 {
-		err := UpdateBookRequestValidationError{
-			field:  "AuthorId",
-			reason: "value length must be at least 1 runes",
+	if val := m.GetYearTo(); val < 0 || val > 9999 {
+		err := SearchBooksRequestValidationError{
+			field:  "YearTo",
+			reason: "value must be inside range [0, 9999]",
 		}
 		if !all {
 			return err
@@ -1253,10 +1676,10 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
-		err := UpdateBookRequestValidationError{
-			field:  "CategoryId",
-			reason: "value length must be at least 1 runes",
+	if _, ok := _SearchBooksRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := SearchBooksRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ relevance title publication_year created_at]",
 		}
 		if !all {
 			return err
@@ -1264,10 +1687,10 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStock() < 0 {
-		err := UpdateBookRequestValidationError{
-			field:  "Stock",
-			reason: "value must be greater than or equal to 0",
+	if _, ok := _SearchBooksRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := SearchBooksRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
 		}
 		if !all {
 			return err
@@ -1275,9 +1698,9 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetVersion() < 1 {
-		err := UpdateBookRequestValidationError{
-			field:  "Version",
+	if m.GetPage() < 1 {
+		err := SearchBooksRequestValidationError{
+			field:  "Page",
 			reason: "value must be greater than or equal to 1",
 		}
 		if !all {
@@ -1286,20 +1709,31 @@ func (m *UpdateBookRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := SearchBooksRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return UpdateBookRequestMultiError(errors)
+		return SearchBooksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateBookRequest.ValidateAll() if the designated constraints
+// SearchBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchBooksRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateBookRequestMultiError []error
+type SearchBooksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookRequestMultiError) Error() string {
+func (m SearchBooksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1308,11 +1742,11 @@ func (m UpdateBookRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookRequestMultiError) AllErrors() []error { return m }
+func (m SearchBooksRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookRequestValidationError is the validation error returned by
-// UpdateBookRequest.Validate if the designated constraints aren't met.
-type UpdateBookRequestValidationError struct {
+// SearchBooksRequestValidationError is the validation error returned by
+// SearchBooksRequest.Validate if the designated constraints aren't met.
+type SearchBooksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1320,24 +1754,3344 @@ type UpdateBookRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookRequestValidationError) Field() string { return e.field }
+func (e SearchBooksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookRequestValidationError) Reason() string { return e.reason }
+func (e SearchBooksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookRequestValidationError) Cause() error { return e.cause }
+func (e SearchBooksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookRequestValidationError) Key() bool { return e.key }
+func (e SearchBooksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookRequestValidationError) ErrorName() string {
-	return "UpdateBookRequestValidationError"
+func (e SearchBooksRequestValidationError) ErrorName() string {
+	return "SearchBooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBooksRequestValidationError{}
+
+var _SearchBooksRequest_SortBy_InLookup = map[string]struct{}{
+	"":                 {},
+	"relevance":        {},
+	"title":            {},
+	"publication_year": {},
+	"created_at":       {},
+}
+
+var _SearchBooksRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on BookSearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookSearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookSearchHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookSearchHitMultiError, or
+// nil if none found.
+func (m *BookSearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookSearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookSearchHitValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookSearchHitValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookSearchHitValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Highlight
+
+	// no validation rules for Rank
+
+	if len(errors) > 0 {
+		return BookSearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookSearchHitMultiError is an error wrapping multiple validation errors
+// returned by BookSearchHit.ValidateAll() if the designated constraints
+// aren't met.
+type BookSearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookSearchHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookSearchHitMultiError) AllErrors() []error { return m }
+
+// BookSearchHitValidationError is the validation error returned by
+// BookSearchHit.Validate if the designated constraints aren't met.
+type BookSearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookSearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookSearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookSearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookSearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookSearchHitValidationError) ErrorName() string { return "BookSearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookSearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookSearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookSearchHitValidationError{}
+
+// Validate checks the field values on SearchBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchBooksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchBooksResponseMultiError, or nil if none found.
+func (m *SearchBooksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchBooksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchBooksResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchBooksResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchBooksResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return SearchBooksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchBooksResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchBooksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchBooksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchBooksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchBooksResponseMultiError) AllErrors() []error { return m }
+
+// SearchBooksResponseValidationError is the validation error returned by
+// SearchBooksResponse.Validate if the designated constraints aren't met.
+type SearchBooksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchBooksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchBooksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchBooksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchBooksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchBooksResponseValidationError) ErrorName() string {
+	return "SearchBooksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchBooksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchBooksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchBooksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchBooksResponseValidationError{}
+
+// Validate checks the field values on UpdateBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookRequestMultiError, or nil if none found.
+func (m *UpdateBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UpdateBookRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := UpdateBookRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
+		err := UpdateBookRequestValidationError{
+			field:  "CategoryId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := UpdateBookRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPublicationYear(); val < 0 || val > 9999 {
+		err := UpdateBookRequestValidationError{
+			field:  "PublicationYear",
+			reason: "value must be inside range [0, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIsbn()) > 17 {
+		err := UpdateBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value length must be at most 17 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateBookRequest_Isbn_Pattern.MatchString(m.GetIsbn()) {
+		err := UpdateBookRequestValidationError{
+			field:  "Isbn",
+			reason: "value does not match regex pattern \"^([0-9][0-9 -]{8,15}[0-9Xx])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPublisher()) > 255 {
+		err := UpdateBookRequestValidationError{
+			field:  "Publisher",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLanguage()) > 8 {
+		err := UpdateBookRequestValidationError{
+			field:  "Language",
+			reason: "value length must be at most 8 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateBookRequest_Language_Pattern.MatchString(m.GetLanguage()) {
+		err := UpdateBookRequestValidationError{
+			field:  "Language",
+			reason: "value does not match regex pattern \"^([a-z]{2,3})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageCount(); val < 0 || val > 100000 {
+		err := UpdateBookRequestValidationError{
+			field:  "PageCount",
+			reason: "value must be inside range [0, 100000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEdition()) > 50 {
+		err := UpdateBookRequestValidationError{
+			field:  "Edition",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 5000 {
+		err := UpdateBookRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 5000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetAuthors()); l < 1 || l > 20 {
+		err := UpdateBookRequestValidationError{
+			field:  "Authors",
+			reason: "value must contain between 1 and 20 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAuthors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateBookRequestValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateBookRequestValidationError{
+						field:  fmt.Sprintf("Authors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateBookRequestValidationError{
+					field:  fmt.Sprintf("Authors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookRequestValidationError is the validation error returned by
+// UpdateBookRequest.Validate if the designated constraints aren't met.
+type UpdateBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookRequestValidationError) ErrorName() string {
+	return "UpdateBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookRequestValidationError{}
+
+var _UpdateBookRequest_Isbn_Pattern = regexp.MustCompile("^([0-9][0-9 -]{8,15}[0-9Xx])?$")
+
+var _UpdateBookRequest_Language_Pattern = regexp.MustCompile("^([a-z]{2,3})?$")
+
+// Validate checks the field values on UpdateBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookResponseMultiError, or nil if none found.
+func (m *UpdateBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookResponseValidationError{
+					field:  "Book",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookResponseValidationError{
+				field:  "Book",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBookResponseValidationError is the validation error returned by
+// UpdateBookResponse.Validate if the designated constraints aren't met.
+type UpdateBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookResponseValidationError) ErrorName() string {
+	return "UpdateBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookResponseValidationError{}
+
+// Validate checks the field values on DeleteBookRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookRequestMultiError, or nil if none found.
+func (m *DeleteBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeleteBookRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := DeleteBookRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookRequestValidationError is the validation error returned by
+// DeleteBookRequest.Validate if the designated constraints aren't met.
+type DeleteBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookRequestValidationError) ErrorName() string {
+	return "DeleteBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookRequestValidationError{}
+
+// Validate checks the field values on DeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookResponseMultiError, or nil if none found.
+func (m *DeleteBookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteBookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteBookResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteBookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookResponseValidationError is the validation error returned by
+// DeleteBookResponse.Validate if the designated constraints aren't met.
+type DeleteBookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookResponseValidationError) ErrorName() string {
+	return "DeleteBookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookResponseValidationError{}
+
+// Validate checks the field values on RestoreBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreBookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreBookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreBookRequestMultiError, or nil if none found.
+func (m *RestoreBookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreBookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RestoreBookRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreBookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreBookRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreBookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreBookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreBookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreBookRequestMultiError) AllErrors() []error { return m }
+
+// RestoreBookRequestValidationError is the validation error returned by
+// RestoreBookRequest.Validate if the designated constraints aren't met.
+type RestoreBookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreBookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreBookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreBookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreBookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreBookRequestValidationError) ErrorName() string {
+	return "RestoreBookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreBookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreBookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreBookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreBookRequestValidationError{}
+
+// Validate checks the field values on BookCopy with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookCopy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookCopy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookCopyMultiError, or nil
+// if none found.
+func (m *BookCopy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookCopy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BookId
+
+	// no validation rules for Barcode
+
+	// no validation rules for Condition
+
+	// no validation rules for ShelfLocation
+
+	// no validation rules for Status
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return BookCopyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookCopyMultiError is an error wrapping multiple validation errors returned
+// by BookCopy.ValidateAll() if the designated constraints aren't met.
+type BookCopyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookCopyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookCopyMultiError) AllErrors() []error { return m }
+
+// BookCopyValidationError is the validation error returned by
+// BookCopy.Validate if the designated constraints aren't met.
+type BookCopyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookCopyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookCopyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookCopyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookCopyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookCopyValidationError) ErrorName() string { return "BookCopyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookCopyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookCopy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookCopyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookCopyValidationError{}
+
+// Validate checks the field values on AddBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBookCopyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBookCopyRequestMultiError, or nil if none found.
+func (m *AddBookCopyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBookCopyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookId()) < 1 {
+		err := AddBookCopyRequestValidationError{
+			field:  "BookId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetBarcode()); l < 1 || l > 64 {
+		err := AddBookCopyRequestValidationError{
+			field:  "Barcode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AddBookCopyRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := AddBookCopyRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [NEW GOOD FAIR POOR DAMAGED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetShelfLocation()) > 100 {
+		err := AddBookCopyRequestValidationError{
+			field:  "ShelfLocation",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddBookCopyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBookCopyRequestMultiError is an error wrapping multiple validation errors
+// returned by AddBookCopyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddBookCopyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBookCopyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBookCopyRequestMultiError) AllErrors() []error { return m }
+
+// AddBookCopyRequestValidationError is the validation error returned by
+// AddBookCopyRequest.Validate if the designated constraints aren't met.
+type AddBookCopyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBookCopyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBookCopyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBookCopyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBookCopyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBookCopyRequestValidationError) ErrorName() string {
+	return "AddBookCopyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBookCopyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBookCopyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBookCopyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBookCopyRequestValidationError{}
+
+var _AddBookCopyRequest_Condition_InLookup = map[string]struct{}{
+	"NEW":     {},
+	"GOOD":    {},
+	"FAIR":    {},
+	"POOR":    {},
+	"DAMAGED": {},
+}
+
+// Validate checks the field values on UpdateBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookCopyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookCopyRequestMultiError, or nil if none found.
+func (m *UpdateBookCopyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookCopyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UpdateBookCopyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateBookCopyRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := UpdateBookCopyRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [NEW GOOD FAIR POOR DAMAGED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetShelfLocation()) > 100 {
+		err := UpdateBookCopyRequestValidationError{
+			field:  "ShelfLocation",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := UpdateBookCopyRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookCopyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookCopyRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookCopyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookCopyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookCopyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookCopyRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookCopyRequestValidationError is the validation error returned by
+// UpdateBookCopyRequest.Validate if the designated constraints aren't met.
+type UpdateBookCopyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookCopyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookCopyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookCopyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookCopyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookCopyRequestValidationError) ErrorName() string {
+	return "UpdateBookCopyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookCopyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookCopyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookCopyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookCopyRequestValidationError{}
+
+var _UpdateBookCopyRequest_Condition_InLookup = map[string]struct{}{
+	"NEW":     {},
+	"GOOD":    {},
+	"FAIR":    {},
+	"POOR":    {},
+	"DAMAGED": {},
+}
+
+// Validate checks the field values on RetireBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetireBookCopyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetireBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetireBookCopyRequestMultiError, or nil if none found.
+func (m *RetireBookCopyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetireBookCopyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RetireBookCopyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := RetireBookCopyRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetireBookCopyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetireBookCopyRequestMultiError is an error wrapping multiple validation
+// errors returned by RetireBookCopyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RetireBookCopyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetireBookCopyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetireBookCopyRequestMultiError) AllErrors() []error { return m }
+
+// RetireBookCopyRequestValidationError is the validation error returned by
+// RetireBookCopyRequest.Validate if the designated constraints aren't met.
+type RetireBookCopyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetireBookCopyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetireBookCopyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetireBookCopyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetireBookCopyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetireBookCopyRequestValidationError) ErrorName() string {
+	return "RetireBookCopyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetireBookCopyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetireBookCopyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetireBookCopyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetireBookCopyRequestValidationError{}
+
+// Validate checks the field values on GetBookCopyByBarcodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookCopyByBarcodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookCopyByBarcodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookCopyByBarcodeRequestMultiError, or nil if none found.
+func (m *GetBookCopyByBarcodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookCopyByBarcodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarcode()) < 1 {
+		err := GetBookCopyByBarcodeRequestValidationError{
+			field:  "Barcode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookCopyByBarcodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookCopyByBarcodeRequestMultiError is an error wrapping multiple
+// validation errors returned by GetBookCopyByBarcodeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetBookCopyByBarcodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookCopyByBarcodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookCopyByBarcodeRequestMultiError) AllErrors() []error { return m }
+
+// GetBookCopyByBarcodeRequestValidationError is the validation error returned
+// by GetBookCopyByBarcodeRequest.Validate if the designated constraints
+// aren't met.
+type GetBookCopyByBarcodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookCopyByBarcodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookCopyByBarcodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookCopyByBarcodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookCopyByBarcodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookCopyByBarcodeRequestValidationError) ErrorName() string {
+	return "GetBookCopyByBarcodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookCopyByBarcodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookCopyByBarcodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookCopyByBarcodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookCopyByBarcodeRequestValidationError{}
+
+// Validate checks the field values on ListBookCopiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookCopiesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookCopiesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookCopiesRequestMultiError, or nil if none found.
+func (m *ListBookCopiesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookCopiesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookId()) < 1 {
+		err := ListBookCopiesRequestValidationError{
+			field:  "BookId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListBookCopiesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListBookCopiesRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ AVAILABLE ON_LOAN RETIRED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListBookCopiesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookCopiesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookCopiesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookCopiesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookCopiesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookCopiesRequestMultiError) AllErrors() []error { return m }
+
+// ListBookCopiesRequestValidationError is the validation error returned by
+// ListBookCopiesRequest.Validate if the designated constraints aren't met.
+type ListBookCopiesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookCopiesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookCopiesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookCopiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookCopiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookCopiesRequestValidationError) ErrorName() string {
+	return "ListBookCopiesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookCopiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookCopiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookCopiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookCopiesRequestValidationError{}
+
+var _ListBookCopiesRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"AVAILABLE": {},
+	"ON_LOAN":   {},
+	"RETIRED":   {},
+}
+
+// Validate checks the field values on ListBookCopiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookCopiesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookCopiesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookCopiesResponseMultiError, or nil if none found.
+func (m *ListBookCopiesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookCopiesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCopies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookCopiesResponseValidationError{
+						field:  fmt.Sprintf("Copies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookCopiesResponseValidationError{
+						field:  fmt.Sprintf("Copies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookCopiesResponseValidationError{
+					field:  fmt.Sprintf("Copies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBookCopiesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookCopiesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookCopiesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookCopiesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookCopiesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookCopiesResponseMultiError) AllErrors() []error { return m }
+
+// ListBookCopiesResponseValidationError is the validation error returned by
+// ListBookCopiesResponse.Validate if the designated constraints aren't met.
+type ListBookCopiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookCopiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookCopiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookCopiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookCopiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookCopiesResponseValidationError) ErrorName() string {
+	return "ListBookCopiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookCopiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookCopiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookCopiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookCopiesResponseValidationError{}
+
+// Validate checks the field values on CheckOutBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckOutBookCopyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckOutBookCopyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckOutBookCopyRequestMultiError, or nil if none found.
+func (m *CheckOutBookCopyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckOutBookCopyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofTargetPresent := false
+	switch v := m.Target.(type) {
+	case *CheckOutBookCopyRequest_BookId:
+		if v == nil {
+			err := CheckOutBookCopyRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTargetPresent = true
+
+		if utf8.RuneCountInString(m.GetBookId()) < 1 {
+			err := CheckOutBookCopyRequestValidationError{
+				field:  "BookId",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CheckOutBookCopyRequest_Barcode:
+		if v == nil {
+			err := CheckOutBookCopyRequestValidationError{
+				field:  "Target",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTargetPresent = true
+
+		if utf8.RuneCountInString(m.GetBarcode()) < 1 {
+			err := CheckOutBookCopyRequestValidationError{
+				field:  "Barcode",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTargetPresent {
+		err := CheckOutBookCopyRequestValidationError{
+			field:  "Target",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckOutBookCopyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckOutBookCopyRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckOutBookCopyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckOutBookCopyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckOutBookCopyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckOutBookCopyRequestMultiError) AllErrors() []error { return m }
+
+// CheckOutBookCopyRequestValidationError is the validation error returned by
+// CheckOutBookCopyRequest.Validate if the designated constraints aren't met.
+type CheckOutBookCopyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckOutBookCopyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckOutBookCopyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckOutBookCopyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckOutBookCopyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckOutBookCopyRequestValidationError) ErrorName() string {
+	return "CheckOutBookCopyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckOutBookCopyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckOutBookCopyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckOutBookCopyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckOutBookCopyRequestValidationError{}
+
+// Validate checks the field values on CheckInBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckInBookCopyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckInBookCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckInBookCopyRequestMultiError, or nil if none found.
+func (m *CheckInBookCopyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckInBookCopyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CheckInBookCopyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CheckInBookCopyRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := CheckInBookCopyRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [ NEW GOOD FAIR POOR DAMAGED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckInBookCopyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckInBookCopyRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckInBookCopyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckInBookCopyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckInBookCopyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckInBookCopyRequestMultiError) AllErrors() []error { return m }
+
+// CheckInBookCopyRequestValidationError is the validation error returned by
+// CheckInBookCopyRequest.Validate if the designated constraints aren't met.
+type CheckInBookCopyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckInBookCopyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckInBookCopyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckInBookCopyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckInBookCopyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckInBookCopyRequestValidationError) ErrorName() string {
+	return "CheckInBookCopyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckInBookCopyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckInBookCopyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckInBookCopyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckInBookCopyRequestValidationError{}
+
+var _CheckInBookCopyRequest_Condition_InLookup = map[string]struct{}{
+	"":        {},
+	"NEW":     {},
+	"GOOD":    {},
+	"FAIR":    {},
+	"POOR":    {},
+	"DAMAGED": {},
+}
+
+// Validate checks the field values on BookCopyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BookCopyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookCopyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookCopyResponseMultiError, or nil if none found.
+func (m *BookCopyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookCopyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCopy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookCopyResponseValidationError{
+					field:  "Copy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookCopyResponseValidationError{
+					field:  "Copy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCopy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookCopyResponseValidationError{
+				field:  "Copy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookCopyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookCopyResponseMultiError is an error wrapping multiple validation errors
+// returned by BookCopyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BookCopyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookCopyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookCopyResponseMultiError) AllErrors() []error { return m }
+
+// BookCopyResponseValidationError is the validation error returned by
+// BookCopyResponse.Validate if the designated constraints aren't met.
+type BookCopyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookCopyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookCopyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookCopyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookCopyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookCopyResponseValidationError) ErrorName() string { return "BookCopyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookCopyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookCopyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookCopyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookCopyResponseValidationError{}
+
+// Validate checks the field values on ImportBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportBooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBooksRequestMultiError, or nil if none found.
+func (m *ImportBooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *ImportBooksRequest_Header:
+		if v == nil {
+			err := ImportBooksRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetHeader()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportBooksRequestValidationError{
+						field:  "Header",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportBooksRequestValidationError{
+						field:  "Header",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportBooksRequestValidationError{
+					field:  "Header",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImportBooksRequest_Chunk:
+		if v == nil {
+			err := ImportBooksRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ImportBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBooksRequestMultiError) AllErrors() []error { return m }
+
+// ImportBooksRequestValidationError is the validation error returned by
+// ImportBooksRequest.Validate if the designated constraints aren't met.
+type ImportBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBooksRequestValidationError) ErrorName() string {
+	return "ImportBooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBooksRequestValidationError{}
+
+// Validate checks the field values on ImportBooksHeader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportBooksHeader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportBooksHeader with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportBooksHeaderMultiError, or nil if none found.
+func (m *ImportBooksHeader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportBooksHeader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportBooksHeader_Format_InLookup[m.GetFormat()]; !ok {
+		err := ImportBooksHeaderValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv marc21]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFileName()) > 255 {
+		err := ImportBooksHeaderValidationError{
+			field:  "FileName",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RequestedBy
+
+	if len(errors) > 0 {
+		return ImportBooksHeaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportBooksHeaderMultiError is an error wrapping multiple validation errors
+// returned by ImportBooksHeader.ValidateAll() if the designated constraints
+// aren't met.
+type ImportBooksHeaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportBooksHeaderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportBooksHeaderMultiError) AllErrors() []error { return m }
+
+// ImportBooksHeaderValidationError is the validation error returned by
+// ImportBooksHeader.Validate if the designated constraints aren't met.
+type ImportBooksHeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportBooksHeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportBooksHeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportBooksHeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportBooksHeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportBooksHeaderValidationError) ErrorName() string {
+	return "ImportBooksHeaderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportBooksHeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportBooksHeader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportBooksHeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportBooksHeaderValidationError{}
+
+var _ImportBooksHeader_Format_InLookup = map[string]struct{}{
+	"csv":    {},
+	"marc21": {},
+}
+
+// Validate checks the field values on ImportRowError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportRowError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportRowErrorMultiError,
+// or nil if none found.
+func (m *ImportRowError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImportRowErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowErrorMultiError is an error wrapping multiple validation errors
+// returned by ImportRowError.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowErrorMultiError) AllErrors() []error { return m }
+
+// ImportRowErrorValidationError is the validation error returned by
+// ImportRowError.Validate if the designated constraints aren't met.
+type ImportRowErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowErrorValidationError) ErrorName() string { return "ImportRowErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowErrorValidationError{}
+
+// Validate checks the field values on ImportJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportJobMultiError, or nil
+// if none found.
+func (m *ImportJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Format
+
+	// no validation rules for FileName
+
+	// no validation rules for Status
+
+	// no validation rules for TotalRows
+
+	// no validation rules for ImportedRows
+
+	// no validation rules for FailedRows
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportJobValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportJobValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for RequestedBy
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for StartedAt
+
+	// no validation rules for FinishedAt
+
+	if len(errors) > 0 {
+		return ImportJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobMultiError is an error wrapping multiple validation errors returned
+// by ImportJob.ValidateAll() if the designated constraints aren't met.
+type ImportJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobMultiError) AllErrors() []error { return m }
+
+// ImportJobValidationError is the validation error returned by
+// ImportJob.Validate if the designated constraints aren't met.
+type ImportJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobValidationError) ErrorName() string { return "ImportJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobValidationError{}
+
+// Validate checks the field values on ImportJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportJobResponseMultiError, or nil if none found.
+func (m *ImportJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportJobResponseMultiError is an error wrapping multiple validation errors
+// returned by ImportJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImportJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportJobResponseMultiError) AllErrors() []error { return m }
+
+// ImportJobResponseValidationError is the validation error returned by
+// ImportJobResponse.Validate if the designated constraints aren't met.
+type ImportJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportJobResponseValidationError) ErrorName() string {
+	return "ImportJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportJobResponseValidationError{}
+
+// Validate checks the field values on GetImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetImportJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetImportJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetImportJobRequestMultiError, or nil if none found.
+func (m *GetImportJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetImportJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetImportJobRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetImportJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetImportJobRequestMultiError is an error wrapping multiple validation
+// errors returned by GetImportJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetImportJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetImportJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetImportJobRequestMultiError) AllErrors() []error { return m }
+
+// GetImportJobRequestValidationError is the validation error returned by
+// GetImportJobRequest.Validate if the designated constraints aren't met.
+type GetImportJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetImportJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetImportJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetImportJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetImportJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetImportJobRequestValidationError) ErrorName() string {
+	return "GetImportJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetImportJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetImportJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetImportJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetImportJobRequestValidationError{}
+
+// Validate checks the field values on ExportBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportBooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportBooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportBooksRequestMultiError, or nil if none found.
+func (m *ExportBooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportBooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ExportBooksRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportBooksRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [csv marc21]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportBooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportBooksRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportBooksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportBooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportBooksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportBooksRequestMultiError) AllErrors() []error { return m }
+
+// ExportBooksRequestValidationError is the validation error returned by
+// ExportBooksRequest.Validate if the designated constraints aren't met.
+type ExportBooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportBooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportBooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportBooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportBooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportBooksRequestValidationError) ErrorName() string {
+	return "ExportBooksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookRequestValidationError) Error() string {
+func (e ExportBooksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1349,14 +5103,14 @@ func (e UpdateBookRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookRequest.%s: %s%s",
+		"invalid %sExportBooksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookRequestValidationError{}
+var _ error = ExportBooksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1364,73 +5118,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookRequestValidationError{}
+} = ExportBooksRequestValidationError{}
 
-// Validate checks the field values on UpdateBookResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookResponse) Validate() error {
+var _ExportBooksRequest_Format_InLookup = map[string]struct{}{
+	"csv":    {},
+	"marc21": {},
+}
+
+// Validate checks the field values on ExportBooksChunk with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportBooksChunk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookResponse with the rules
+// ValidateAll checks the field values on ExportBooksChunk with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookResponseMultiError, or nil if none found.
-func (m *UpdateBookResponse) ValidateAll() error {
+// ExportBooksChunkMultiError, or nil if none found.
+func (m *ExportBooksChunk) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookResponse) validate(all bool) error {
+func (m *ExportBooksChunk) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBook()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateBookResponseValidationError{
-					field:  "Book",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateBookResponseValidationError{
-					field:  "Book",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetBook()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateBookResponseValidationError{
-				field:  "Book",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Data
 
 	if len(errors) > 0 {
-		return UpdateBookResponseMultiError(errors)
+		return ExportBooksChunkMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookResponseMultiError is an error wrapping multiple validation errors
-// returned by UpdateBookResponse.ValidateAll() if the designated constraints
+// ExportBooksChunkMultiError is an error wrapping multiple validation errors
+// returned by ExportBooksChunk.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateBookResponseMultiError []error
+type ExportBooksChunkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookResponseMultiError) Error() string {
+func (m ExportBooksChunkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1439,11 +5171,11 @@ func (m UpdateBookResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookResponseMultiError) AllErrors() []error { return m }
+func (m ExportBooksChunkMultiError) AllErrors() []error { return m }
 
-// UpdateBookResponseValidationError is the validation error returned by
-// UpdateBookResponse.Validate if the designated constraints aren't met.
-type UpdateBookResponseValidationError struct {
+// ExportBooksChunkValidationError is the validation error returned by
+// ExportBooksChunk.Validate if the designated constraints aren't met.
+type ExportBooksChunkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1451,24 +5183,22 @@ type UpdateBookResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookResponseValidationError) Field() string { return e.field }
+func (e ExportBooksChunkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookResponseValidationError) Reason() string { return e.reason }
+func (e ExportBooksChunkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookResponseValidationError) Cause() error { return e.cause }
+func (e ExportBooksChunkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookResponseValidationError) Key() bool { return e.key }
+func (e ExportBooksChunkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookResponseValidationError) ErrorName() string {
-	return "UpdateBookResponseValidationError"
-}
+func (e ExportBooksChunkValidationError) ErrorName() string { return "ExportBooksChunkValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookResponseValidationError) Error() string {
+func (e ExportBooksChunkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1480,14 +5210,14 @@ func (e UpdateBookResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookResponse.%s: %s%s",
+		"invalid %sExportBooksChunk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookResponseValidationError{}
+var _ error = ExportBooksChunkValidationError{}
 
 var _ interface {
 	Field() string
@@ -1495,33 +5225,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookResponseValidationError{}
+} = ExportBooksChunkValidationError{}
 
-// Validate checks the field values on UpdateBookStockRequest with the rules
+// Validate checks the field values on CountBooksByAuthorRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookStockRequest) Validate() error {
+func (m *CountBooksByAuthorRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookStockRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CountBooksByAuthorRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookStockRequestMultiError, or nil if none found.
-func (m *UpdateBookStockRequest) ValidateAll() error {
+// CountBooksByAuthorRequestMultiError, or nil if none found.
+func (m *CountBooksByAuthorRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookStockRequest) validate(all bool) error {
+func (m *CountBooksByAuthorRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := UpdateBookStockRequestValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetAuthorId()) < 1 {
+		err := CountBooksByAuthorRequestValidationError{
+			field:  "AuthorId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1530,42 +5260,20 @@ func (m *UpdateBookStockRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStock() < 0 {
-		err := UpdateBookStockRequestValidationError{
-			field:  "Stock",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetVersion() < 1 {
-		err := UpdateBookStockRequestValidationError{
-			field:  "Version",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return UpdateBookStockRequestMultiError(errors)
+		return CountBooksByAuthorRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookStockRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookStockRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateBookStockRequestMultiError []error
+// CountBooksByAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by CountBooksByAuthorRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CountBooksByAuthorRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookStockRequestMultiError) Error() string {
+func (m CountBooksByAuthorRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1574,11 +5282,11 @@ func (m UpdateBookStockRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookStockRequestMultiError) AllErrors() []error { return m }
+func (m CountBooksByAuthorRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookStockRequestValidationError is the validation error returned by
-// UpdateBookStockRequest.Validate if the designated constraints aren't met.
-type UpdateBookStockRequestValidationError struct {
+// CountBooksByAuthorRequestValidationError is the validation error returned by
+// CountBooksByAuthorRequest.Validate if the designated constraints aren't met.
+type CountBooksByAuthorRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1586,24 +5294,24 @@ type UpdateBookStockRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookStockRequestValidationError) Field() string { return e.field }
+func (e CountBooksByAuthorRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookStockRequestValidationError) Reason() string { return e.reason }
+func (e CountBooksByAuthorRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookStockRequestValidationError) Cause() error { return e.cause }
+func (e CountBooksByAuthorRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookStockRequestValidationError) Key() bool { return e.key }
+func (e CountBooksByAuthorRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookStockRequestValidationError) ErrorName() string {
-	return "UpdateBookStockRequestValidationError"
+func (e CountBooksByAuthorRequestValidationError) ErrorName() string {
+	return "CountBooksByAuthorRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookStockRequestValidationError) Error() string {
+func (e CountBooksByAuthorRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1615,14 +5323,14 @@ func (e UpdateBookStockRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookStockRequest.%s: %s%s",
+		"invalid %sCountBooksByAuthorRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookStockRequestValidationError{}
+var _ error = CountBooksByAuthorRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1630,46 +5338,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookStockRequestValidationError{}
+} = CountBooksByAuthorRequestValidationError{}
 
-// Validate checks the field values on UpdateBookStockResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CountBooksByCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookStockResponse) Validate() error {
+func (m *CountBooksByCategoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookStockResponse with the
+// ValidateAll checks the field values on CountBooksByCategoryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateBookStockResponseMultiError, or nil if none found.
-func (m *UpdateBookStockResponse) ValidateAll() error {
+// CountBooksByCategoryRequestMultiError, or nil if none found.
+func (m *CountBooksByCategoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookStockResponse) validate(all bool) error {
+func (m *CountBooksByCategoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
+		err := CountBooksByCategoryRequestValidationError{
+			field:  "CategoryId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UpdateBookStockResponseMultiError(errors)
+		return CountBooksByCategoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookStockResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookStockResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateBookStockResponseMultiError []error
+// CountBooksByCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by CountBooksByCategoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CountBooksByCategoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookStockResponseMultiError) Error() string {
+func (m CountBooksByCategoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1678,11 +5395,12 @@ func (m UpdateBookStockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookStockResponseMultiError) AllErrors() []error { return m }
+func (m CountBooksByCategoryRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookStockResponseValidationError is the validation error returned by
-// UpdateBookStockResponse.Validate if the designated constraints aren't met.
-type UpdateBookStockResponseValidationError struct {
+// CountBooksByCategoryRequestValidationError is the validation error returned
+// by CountBooksByCategoryRequest.Validate if the designated constraints
+// aren't met.
+type CountBooksByCategoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1690,24 +5408,24 @@ type UpdateBookStockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookStockResponseValidationError) Field() string { return e.field }
+func (e CountBooksByCategoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookStockResponseValidationError) Reason() string { return e.reason }
+func (e CountBooksByCategoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookStockResponseValidationError) Cause() error { return e.cause }
+func (e CountBooksByCategoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookStockResponseValidationError) Key() bool { return e.key }
+func (e CountBooksByCategoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookStockResponseValidationError) ErrorName() string {
-	return "UpdateBookStockResponseValidationError"
+func (e CountBooksByCategoryRequestValidationError) ErrorName() string {
+	return "CountBooksByCategoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookStockResponseValidationError) Error() string {
+func (e CountBooksByCategoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1719,14 +5437,14 @@ func (e UpdateBookStockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookStockResponse.%s: %s%s",
+		"invalid %sCountBooksByCategoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookStockResponseValidationError{}
+var _ error = CountBooksByCategoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1734,66 +5452,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookStockResponseValidationError{}
+} = CountBooksByCategoryRequestValidationError{}
 
-// Validate checks the field values on DeleteBookRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookRequest) Validate() error {
+// Validate checks the field values on CountBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CountBooksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookRequest with the rules
+// ValidateAll checks the field values on CountBooksResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBookRequestMultiError, or nil if none found.
-func (m *DeleteBookRequest) ValidateAll() error {
+// CountBooksResponseMultiError, or nil if none found.
+func (m *CountBooksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookRequest) validate(all bool) error {
+func (m *CountBooksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := DeleteBookRequestValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetVersion() < 1 {
-		err := DeleteBookRequestValidationError{
-			field:  "Version",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for TotalBooks
 
 	if len(errors) > 0 {
-		return DeleteBookRequestMultiError(errors)
+		return CountBooksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteBookRequest.ValidateAll() if the designated constraints
+// CountBooksResponseMultiError is an error wrapping multiple validation errors
+// returned by CountBooksResponse.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteBookRequestMultiError []error
+type CountBooksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookRequestMultiError) Error() string {
+func (m CountBooksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1802,11 +5500,11 @@ func (m DeleteBookRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookRequestMultiError) AllErrors() []error { return m }
+func (m CountBooksResponseMultiError) AllErrors() []error { return m }
 
-// DeleteBookRequestValidationError is the validation error returned by
-// DeleteBookRequest.Validate if the designated constraints aren't met.
-type DeleteBookRequestValidationError struct {
+// CountBooksResponseValidationError is the validation error returned by
+// CountBooksResponse.Validate if the designated constraints aren't met.
+type CountBooksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1814,24 +5512,24 @@ type DeleteBookRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookRequestValidationError) Field() string { return e.field }
+func (e CountBooksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookRequestValidationError) Reason() string { return e.reason }
+func (e CountBooksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookRequestValidationError) Cause() error { return e.cause }
+func (e CountBooksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookRequestValidationError) Key() bool { return e.key }
+func (e CountBooksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookRequestValidationError) ErrorName() string {
-	return "DeleteBookRequestValidationError"
+func (e CountBooksResponseValidationError) ErrorName() string {
+	return "CountBooksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookRequestValidationError) Error() string {
+func (e CountBooksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1843,14 +5541,14 @@ func (e DeleteBookRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookRequest.%s: %s%s",
+		"invalid %sCountBooksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookRequestValidationError{}
+var _ error = CountBooksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1858,46 +5556,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookRequestValidationError{}
+} = CountBooksResponseValidationError{}
 
-// Validate checks the field values on DeleteBookResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReassignBooksByAuthorRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBookResponse) Validate() error {
+func (m *ReassignBooksByAuthorRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBookResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReassignBooksByAuthorRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBookResponseMultiError, or nil if none found.
-func (m *DeleteBookResponse) ValidateAll() error {
+// ReassignBooksByAuthorRequestMultiError, or nil if none found.
+func (m *ReassignBooksByAuthorRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBookResponse) validate(all bool) error {
+func (m *ReassignBooksByAuthorRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if utf8.RuneCountInString(m.GetAuthorId()) < 1 {
+		err := ReassignBooksByAuthorRequestValidationError{
+			field:  "AuthorId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewAuthorId()) < 1 {
+		err := ReassignBooksByAuthorRequestValidationError{
+			field:  "NewAuthorId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteBookResponseMultiError(errors)
+		return ReassignBooksByAuthorRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBookResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteBookResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteBookResponseMultiError []error
+// ReassignBooksByAuthorRequestMultiError is an error wrapping multiple
+// validation errors returned by ReassignBooksByAuthorRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReassignBooksByAuthorRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBookResponseMultiError) Error() string {
+func (m ReassignBooksByAuthorRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1906,11 +5624,12 @@ func (m DeleteBookResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBookResponseMultiError) AllErrors() []error { return m }
+func (m ReassignBooksByAuthorRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBookResponseValidationError is the validation error returned by
-// DeleteBookResponse.Validate if the designated constraints aren't met.
-type DeleteBookResponseValidationError struct {
+// ReassignBooksByAuthorRequestValidationError is the validation error returned
+// by ReassignBooksByAuthorRequest.Validate if the designated constraints
+// aren't met.
+type ReassignBooksByAuthorRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1918,24 +5637,24 @@ type DeleteBookResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBookResponseValidationError) Field() string { return e.field }
+func (e ReassignBooksByAuthorRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBookResponseValidationError) Reason() string { return e.reason }
+func (e ReassignBooksByAuthorRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBookResponseValidationError) Cause() error { return e.cause }
+func (e ReassignBooksByAuthorRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBookResponseValidationError) Key() bool { return e.key }
+func (e ReassignBooksByAuthorRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBookResponseValidationError) ErrorName() string {
-	return "DeleteBookResponseValidationError"
+func (e ReassignBooksByAuthorRequestValidationError) ErrorName() string {
+	return "ReassignBooksByAuthorRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBookResponseValidationError) Error() string {
+func (e ReassignBooksByAuthorRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1947,14 +5666,14 @@ func (e DeleteBookResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBookResponse.%s: %s%s",
+		"invalid %sReassignBooksByAuthorRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBookResponseValidationError{}
+var _ error = ReassignBooksByAuthorRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1962,33 +5681,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBookResponseValidationError{}
+} = ReassignBooksByAuthorRequestValidationError{}
 
-// Validate checks the field values on IncrementBookStockRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReassignBooksByCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IncrementBookStockRequest) Validate() error {
+func (m *ReassignBooksByCategoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IncrementBookStockRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// IncrementBookStockRequestMultiError, or nil if none found.
-func (m *IncrementBookStockRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReassignBooksByCategoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReassignBooksByCategoryRequestMultiError, or nil if none found.
+func (m *ReassignBooksByCategoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IncrementBookStockRequest) validate(all bool) error {
+func (m *ReassignBooksByCategoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := IncrementBookStockRequestValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
+		err := ReassignBooksByCategoryRequestValidationError{
+			field:  "CategoryId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1997,10 +5716,10 @@ func (m *IncrementBookStockRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetVersion() < 1 {
-		err := IncrementBookStockRequestValidationError{
-			field:  "Version",
-			reason: "value must be greater than or equal to 1",
+	if utf8.RuneCountInString(m.GetNewCategoryId()) < 1 {
+		err := ReassignBooksByCategoryRequestValidationError{
+			field:  "NewCategoryId",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -2009,19 +5728,19 @@ func (m *IncrementBookStockRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return IncrementBookStockRequestMultiError(errors)
+		return ReassignBooksByCategoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IncrementBookStockRequestMultiError is an error wrapping multiple validation
-// errors returned by IncrementBookStockRequest.ValidateAll() if the
-// designated constraints aren't met.
-type IncrementBookStockRequestMultiError []error
+// ReassignBooksByCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by ReassignBooksByCategoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReassignBooksByCategoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IncrementBookStockRequestMultiError) Error() string {
+func (m ReassignBooksByCategoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2030,11 +5749,12 @@ func (m IncrementBookStockRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IncrementBookStockRequestMultiError) AllErrors() []error { return m }
+func (m ReassignBooksByCategoryRequestMultiError) AllErrors() []error { return m }
 
-// IncrementBookStockRequestValidationError is the validation error returned by
-// IncrementBookStockRequest.Validate if the designated constraints aren't met.
-type IncrementBookStockRequestValidationError struct {
+// ReassignBooksByCategoryRequestValidationError is the validation error
+// returned by ReassignBooksByCategoryRequest.Validate if the designated
+// constraints aren't met.
+type ReassignBooksByCategoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2042,24 +5762,24 @@ type IncrementBookStockRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IncrementBookStockRequestValidationError) Field() string { return e.field }
+func (e ReassignBooksByCategoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IncrementBookStockRequestValidationError) Reason() string { return e.reason }
+func (e ReassignBooksByCategoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IncrementBookStockRequestValidationError) Cause() error { return e.cause }
+func (e ReassignBooksByCategoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IncrementBookStockRequestValidationError) Key() bool { return e.key }
+func (e ReassignBooksByCategoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IncrementBookStockRequestValidationError) ErrorName() string {
-	return "IncrementBookStockRequestValidationError"
+func (e ReassignBooksByCategoryRequestValidationError) ErrorName() string {
+	return "ReassignBooksByCategoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IncrementBookStockRequestValidationError) Error() string {
+func (e ReassignBooksByCategoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2071,14 +5791,14 @@ func (e IncrementBookStockRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIncrementBookStockRequest.%s: %s%s",
+		"invalid %sReassignBooksByCategoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IncrementBookStockRequestValidationError{}
+var _ error = ReassignBooksByCategoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2086,46 +5806,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IncrementBookStockRequestValidationError{}
+} = ReassignBooksByCategoryRequestValidationError{}
 
-// Validate checks the field values on IncrementBookStockResponse with the
+// Validate checks the field values on DeleteBooksByAuthorRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IncrementBookStockResponse) Validate() error {
+func (m *DeleteBooksByAuthorRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IncrementBookStockResponse with the
+// ValidateAll checks the field values on DeleteBooksByAuthorRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IncrementBookStockResponseMultiError, or nil if none found.
-func (m *IncrementBookStockResponse) ValidateAll() error {
+// DeleteBooksByAuthorRequestMultiError, or nil if none found.
+func (m *DeleteBooksByAuthorRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IncrementBookStockResponse) validate(all bool) error {
+func (m *DeleteBooksByAuthorRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if utf8.RuneCountInString(m.GetAuthorId()) < 1 {
+		err := DeleteBooksByAuthorRequestValidationError{
+			field:  "AuthorId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IncrementBookStockResponseMultiError(errors)
+		return DeleteBooksByAuthorRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IncrementBookStockResponseMultiError is an error wrapping multiple
-// validation errors returned by IncrementBookStockResponse.ValidateAll() if
+// DeleteBooksByAuthorRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBooksByAuthorRequest.ValidateAll() if
 // the designated constraints aren't met.
-type IncrementBookStockResponseMultiError []error
+type DeleteBooksByAuthorRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IncrementBookStockResponseMultiError) Error() string {
+func (m DeleteBooksByAuthorRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2134,11 +5863,11 @@ func (m IncrementBookStockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IncrementBookStockResponseMultiError) AllErrors() []error { return m }
+func (m DeleteBooksByAuthorRequestMultiError) AllErrors() []error { return m }
 
-// IncrementBookStockResponseValidationError is the validation error returned
-// by IncrementBookStockResponse.Validate if the designated constraints aren't met.
-type IncrementBookStockResponseValidationError struct {
+// DeleteBooksByAuthorRequestValidationError is the validation error returned
+// by DeleteBooksByAuthorRequest.Validate if the designated constraints aren't met.
+type DeleteBooksByAuthorRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2146,24 +5875,24 @@ type IncrementBookStockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IncrementBookStockResponseValidationError) Field() string { return e.field }
+func (e DeleteBooksByAuthorRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IncrementBookStockResponseValidationError) Reason() string { return e.reason }
+func (e DeleteBooksByAuthorRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IncrementBookStockResponseValidationError) Cause() error { return e.cause }
+func (e DeleteBooksByAuthorRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IncrementBookStockResponseValidationError) Key() bool { return e.key }
+func (e DeleteBooksByAuthorRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IncrementBookStockResponseValidationError) ErrorName() string {
-	return "IncrementBookStockResponseValidationError"
+func (e DeleteBooksByAuthorRequestValidationError) ErrorName() string {
+	return "DeleteBooksByAuthorRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IncrementBookStockResponseValidationError) Error() string {
+func (e DeleteBooksByAuthorRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2175,14 +5904,14 @@ func (e IncrementBookStockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIncrementBookStockResponse.%s: %s%s",
+		"invalid %sDeleteBooksByAuthorRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IncrementBookStockResponseValidationError{}
+var _ error = DeleteBooksByAuthorRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2190,33 +5919,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IncrementBookStockResponseValidationError{}
+} = DeleteBooksByAuthorRequestValidationError{}
 
-// Validate checks the field values on DecrementBookStockRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteBooksByCategoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DecrementBookStockRequest) Validate() error {
+func (m *DeleteBooksByCategoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DecrementBookStockRequest with the
+// ValidateAll checks the field values on DeleteBooksByCategoryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DecrementBookStockRequestMultiError, or nil if none found.
-func (m *DecrementBookStockRequest) ValidateAll() error {
+// DeleteBooksByCategoryRequestMultiError, or nil if none found.
+func (m *DeleteBooksByCategoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DecrementBookStockRequest) validate(all bool) error {
+func (m *DeleteBooksByCategoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := DecrementBookStockRequestValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetCategoryId()) < 1 {
+		err := DeleteBooksByCategoryRequestValidationError{
+			field:  "CategoryId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2225,31 +5954,20 @@ func (m *DecrementBookStockRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetVersion() < 1 {
-		err := DecrementBookStockRequestValidationError{
-			field:  "Version",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return DecrementBookStockRequestMultiError(errors)
+		return DeleteBooksByCategoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DecrementBookStockRequestMultiError is an error wrapping multiple validation
-// errors returned by DecrementBookStockRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DecrementBookStockRequestMultiError []error
+// DeleteBooksByCategoryRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBooksByCategoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteBooksByCategoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DecrementBookStockRequestMultiError) Error() string {
+func (m DeleteBooksByCategoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2258,11 +5976,12 @@ func (m DecrementBookStockRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DecrementBookStockRequestMultiError) AllErrors() []error { return m }
+func (m DeleteBooksByCategoryRequestMultiError) AllErrors() []error { return m }
 
-// DecrementBookStockRequestValidationError is the validation error returned by
-// DecrementBookStockRequest.Validate if the designated constraints aren't met.
-type DecrementBookStockRequestValidationError struct {
+// DeleteBooksByCategoryRequestValidationError is the validation error returned
+// by DeleteBooksByCategoryRequest.Validate if the designated constraints
+// aren't met.
+type DeleteBooksByCategoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2270,24 +5989,24 @@ type DecrementBookStockRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DecrementBookStockRequestValidationError) Field() string { return e.field }
+func (e DeleteBooksByCategoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DecrementBookStockRequestValidationError) Reason() string { return e.reason }
+func (e DeleteBooksByCategoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DecrementBookStockRequestValidationError) Cause() error { return e.cause }
+func (e DeleteBooksByCategoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DecrementBookStockRequestValidationError) Key() bool { return e.key }
+func (e DeleteBooksByCategoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DecrementBookStockRequestValidationError) ErrorName() string {
-	return "DecrementBookStockRequestValidationError"
+func (e DeleteBooksByCategoryRequestValidationError) ErrorName() string {
+	return "DeleteBooksByCategoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DecrementBookStockRequestValidationError) Error() string {
+func (e DeleteBooksByCategoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2299,14 +6018,14 @@ func (e DecrementBookStockRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDecrementBookStockRequest.%s: %s%s",
+		"invalid %sDeleteBooksByCategoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DecrementBookStockRequestValidationError{}
+var _ error = DeleteBooksByCategoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2314,46 +6033,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DecrementBookStockRequestValidationError{}
+} = DeleteBooksByCategoryRequestValidationError{}
 
-// Validate checks the field values on DecrementBookStockResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DecrementBookStockResponse) Validate() error {
+// Validate checks the field values on BulkBooksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BulkBooksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DecrementBookStockResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BulkBooksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DecrementBookStockResponseMultiError, or nil if none found.
-func (m *DecrementBookStockResponse) ValidateAll() error {
+// BulkBooksResponseMultiError, or nil if none found.
+func (m *BulkBooksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DecrementBookStockResponse) validate(all bool) error {
+func (m *BulkBooksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for AffectedBooks
 
 	if len(errors) > 0 {
-		return DecrementBookStockResponseMultiError(errors)
+		return BulkBooksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DecrementBookStockResponseMultiError is an error wrapping multiple
-// validation errors returned by DecrementBookStockResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DecrementBookStockResponseMultiError []error
+// BulkBooksResponseMultiError is an error wrapping multiple validation errors
+// returned by BulkBooksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BulkBooksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DecrementBookStockResponseMultiError) Error() string {
+func (m BulkBooksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2362,11 +6081,11 @@ func (m DecrementBookStockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DecrementBookStockResponseMultiError) AllErrors() []error { return m }
+func (m BulkBooksResponseMultiError) AllErrors() []error { return m }
 
-// DecrementBookStockResponseValidationError is the validation error returned
-// by DecrementBookStockResponse.Validate if the designated constraints aren't met.
-type DecrementBookStockResponseValidationError struct {
+// BulkBooksResponseValidationError is the validation error returned by
+// BulkBooksResponse.Validate if the designated constraints aren't met.
+type BulkBooksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2374,24 +6093,24 @@ type DecrementBookStockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DecrementBookStockResponseValidationError) Field() string { return e.field }
+func (e BulkBooksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DecrementBookStockResponseValidationError) Reason() string { return e.reason }
+func (e BulkBooksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DecrementBookStockResponseValidationError) Cause() error { return e.cause }
+func (e BulkBooksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DecrementBookStockResponseValidationError) Key() bool { return e.key }
+func (e BulkBooksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DecrementBookStockResponseValidationError) ErrorName() string {
-	return "DecrementBookStockResponseValidationError"
+func (e BulkBooksResponseValidationError) ErrorName() string {
+	return "BulkBooksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DecrementBookStockResponseValidationError) Error() string {
+func (e BulkBooksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2403,14 +6122,14 @@ func (e DecrementBookStockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDecrementBookStockResponse.%s: %s%s",
+		"invalid %sBulkBooksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DecrementBookStockResponseValidationError{}
+var _ error = BulkBooksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2418,4 +6137,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DecrementBookStockResponseValidationError{}
+} = BulkBooksResponseValidationError{}
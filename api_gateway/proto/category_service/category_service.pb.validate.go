@@ -67,6 +67,10 @@ func (m *Category) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for ParentId
+
+	// no validation rules for DeletedAt
+
 	if len(errors) > 0 {
 		return CategoryMultiError(errors)
 	}
@@ -144,6 +148,168 @@ var _ interface {
 	ErrorName() string
 } = CategoryValidationError{}
 
+// Validate checks the field values on CategoryNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryNodeMultiError, or
+// nil if none found.
+func (m *CategoryNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CategoryNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CategoryNodeValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CategoryNodeValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CategoryNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryNodeMultiError is an error wrapping multiple validation errors
+// returned by CategoryNode.ValidateAll() if the designated constraints aren't met.
+type CategoryNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryNodeMultiError) AllErrors() []error { return m }
+
+// CategoryNodeValidationError is the validation error returned by
+// CategoryNode.Validate if the designated constraints aren't met.
+type CategoryNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryNodeValidationError) ErrorName() string { return "CategoryNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryNodeValidationError{}
+
 // Validate checks the field values on CreateCategoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -177,6 +343,8 @@ func (m *CreateCategoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ParentId
+
 	if len(errors) > 0 {
 		return CreateCategoryRequestMultiError(errors)
 	}
@@ -951,6 +1119,8 @@ func (m *UpdateCategoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ParentId
+
 	if len(errors) > 0 {
 		return UpdateCategoryRequestMultiError(errors)
 	}
@@ -1206,6 +1376,19 @@ func (m *DeleteCategoryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _DeleteCategoryRequest_OnBooks_InLookup[m.GetOnBooks()]; !ok {
+		err := DeleteCategoryRequestValidationError{
+			field:  "OnBooks",
+			reason: "value must be in list [ reject reassign cascade]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ReassignTo
+
 	if len(errors) > 0 {
 		return DeleteCategoryRequestMultiError(errors)
 	}
@@ -1286,6 +1469,13 @@ var _ interface {
 	ErrorName() string
 } = DeleteCategoryRequestValidationError{}
 
+var _DeleteCategoryRequest_OnBooks_InLookup = map[string]struct{}{
+	"":         {},
+	"reject":   {},
+	"reassign": {},
+	"cascade":  {},
+}
+
 // Validate checks the field values on DeleteCategoryResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1310,6 +1500,8 @@ func (m *DeleteCategoryResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for AffectedBooks
+
 	if len(errors) > 0 {
 		return DeleteCategoryResponseMultiError(errors)
 	}
@@ -1389,3 +1581,1277 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteCategoryResponseValidationError{}
+
+// Validate checks the field values on RestoreCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreCategoryRequestMultiError, or nil if none found.
+func (m *RestoreCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RestoreCategoryRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreCategoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreCategoryRequestMultiError) AllErrors() []error { return m }
+
+// RestoreCategoryRequestValidationError is the validation error returned by
+// RestoreCategoryRequest.Validate if the designated constraints aren't met.
+type RestoreCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreCategoryRequestValidationError) ErrorName() string {
+	return "RestoreCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreCategoryRequestValidationError{}
+
+// Validate checks the field values on MergeCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeCategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeCategoriesRequestMultiError, or nil if none found.
+func (m *MergeCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSurvivorId()) < 1 {
+		err := MergeCategoriesRequestValidationError{
+			field:  "SurvivorId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSurvivorVersion() < 1 {
+		err := MergeCategoriesRequestValidationError{
+			field:  "SurvivorVersion",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetDuplicates()) < 1 {
+		err := MergeCategoriesRequestValidationError{
+			field:  "Duplicates",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDuplicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MergeCategoriesRequestValidationError{
+						field:  fmt.Sprintf("Duplicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MergeCategoriesRequestValidationError{
+						field:  fmt.Sprintf("Duplicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MergeCategoriesRequestValidationError{
+					field:  fmt.Sprintf("Duplicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MergeCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeCategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeCategoriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MergeCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeCategoriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// MergeCategoriesRequestValidationError is the validation error returned by
+// MergeCategoriesRequest.Validate if the designated constraints aren't met.
+type MergeCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeCategoriesRequestValidationError) ErrorName() string {
+	return "MergeCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeCategoriesRequestValidationError{}
+
+// Validate checks the field values on MergeDuplicate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MergeDuplicate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeDuplicate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MergeDuplicateMultiError,
+// or nil if none found.
+func (m *MergeDuplicate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeDuplicate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := MergeDuplicateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := MergeDuplicateValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeDuplicateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeDuplicateMultiError is an error wrapping multiple validation errors
+// returned by MergeDuplicate.ValidateAll() if the designated constraints
+// aren't met.
+type MergeDuplicateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeDuplicateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeDuplicateMultiError) AllErrors() []error { return m }
+
+// MergeDuplicateValidationError is the validation error returned by
+// MergeDuplicate.Validate if the designated constraints aren't met.
+type MergeDuplicateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeDuplicateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeDuplicateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeDuplicateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeDuplicateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeDuplicateValidationError) ErrorName() string { return "MergeDuplicateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeDuplicateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeDuplicate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeDuplicateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeDuplicateValidationError{}
+
+// Validate checks the field values on MergeCategoriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeCategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeCategoriesResponseMultiError, or nil if none found.
+func (m *MergeCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSurvivor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MergeCategoriesResponseValidationError{
+					field:  "Survivor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MergeCategoriesResponseValidationError{
+					field:  "Survivor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSurvivor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MergeCategoriesResponseValidationError{
+				field:  "Survivor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AffectedBooks
+
+	if len(errors) > 0 {
+		return MergeCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeCategoriesResponseMultiError is an error wrapping multiple validation
+// errors returned by MergeCategoriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MergeCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeCategoriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// MergeCategoriesResponseValidationError is the validation error returned by
+// MergeCategoriesResponse.Validate if the designated constraints aren't met.
+type MergeCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeCategoriesResponseValidationError) ErrorName() string {
+	return "MergeCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeCategoriesResponseValidationError{}
+
+// Validate checks the field values on ResolveCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveCategoryRequestMultiError, or nil if none found.
+func (m *ResolveCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 50 {
+		err := ResolveCategoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResolveCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveCategoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveCategoryRequestMultiError) AllErrors() []error { return m }
+
+// ResolveCategoryRequestValidationError is the validation error returned by
+// ResolveCategoryRequest.Validate if the designated constraints aren't met.
+type ResolveCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveCategoryRequestValidationError) ErrorName() string {
+	return "ResolveCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveCategoryRequestValidationError{}
+
+// Validate checks the field values on ResolveCategoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveCategoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveCategoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveCategoryResponseMultiError, or nil if none found.
+func (m *ResolveCategoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveCategoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCategory()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveCategoryResponseValidationError{
+					field:  "Category",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCategory()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveCategoryResponseValidationError{
+				field:  "Category",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return ResolveCategoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveCategoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveCategoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveCategoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveCategoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveCategoryResponseMultiError) AllErrors() []error { return m }
+
+// ResolveCategoryResponseValidationError is the validation error returned by
+// ResolveCategoryResponse.Validate if the designated constraints aren't met.
+type ResolveCategoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveCategoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveCategoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveCategoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveCategoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveCategoryResponseValidationError) ErrorName() string {
+	return "ResolveCategoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveCategoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveCategoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveCategoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveCategoryResponseValidationError{}
+
+// Validate checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryTreeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeRequestMultiError, or nil if none found.
+func (m *GetCategoryTreeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryTreeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RootId
+
+	if len(errors) > 0 {
+		return GetCategoryTreeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryTreeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryTreeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryTreeRequestMultiError) AllErrors() []error { return m }
+
+// GetCategoryTreeRequestValidationError is the validation error returned by
+// GetCategoryTreeRequest.Validate if the designated constraints aren't met.
+type GetCategoryTreeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryTreeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryTreeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryTreeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryTreeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryTreeRequestValidationError) ErrorName() string {
+	return "GetCategoryTreeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryTreeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryTreeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryTreeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryTreeRequestValidationError{}
+
+// Validate checks the field values on GetCategoryTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCategoryTreeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCategoryTreeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCategoryTreeResponseMultiError, or nil if none found.
+func (m *GetCategoryTreeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCategoryTreeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCategoryTreeResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCategoryTreeResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCategoryTreeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCategoryTreeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCategoryTreeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCategoryTreeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCategoryTreeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCategoryTreeResponseMultiError) AllErrors() []error { return m }
+
+// GetCategoryTreeResponseValidationError is the validation error returned by
+// GetCategoryTreeResponse.Validate if the designated constraints aren't met.
+type GetCategoryTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCategoryTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCategoryTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCategoryTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCategoryTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCategoryTreeResponseValidationError) ErrorName() string {
+	return "GetCategoryTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCategoryTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCategoryTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCategoryTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCategoryTreeResponseValidationError{}
+
+// Validate checks the field values on ListSubcategoriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubcategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubcategoriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubcategoriesRequestMultiError, or nil if none found.
+func (m *ListSubcategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubcategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetParentId()) < 1 {
+		err := ListSubcategoriesRequestValidationError{
+			field:  "ParentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Recursive
+
+	if len(errors) > 0 {
+		return ListSubcategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubcategoriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSubcategoriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubcategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubcategoriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubcategoriesRequestMultiError) AllErrors() []error { return m }
+
+// ListSubcategoriesRequestValidationError is the validation error returned by
+// ListSubcategoriesRequest.Validate if the designated constraints aren't met.
+type ListSubcategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubcategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubcategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubcategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubcategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubcategoriesRequestValidationError) ErrorName() string {
+	return "ListSubcategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubcategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubcategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubcategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubcategoriesRequestValidationError{}
+
+// Validate checks the field values on ListSubcategoriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubcategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubcategoriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubcategoriesResponseMultiError, or nil if none found.
+func (m *ListSubcategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubcategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSubcategoriesResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSubcategoriesResponseValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSubcategoriesResponseValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSubcategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubcategoriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSubcategoriesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSubcategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubcategoriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubcategoriesResponseMultiError) AllErrors() []error { return m }
+
+// ListSubcategoriesResponseValidationError is the validation error returned by
+// ListSubcategoriesResponse.Validate if the designated constraints aren't met.
+type ListSubcategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubcategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubcategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubcategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubcategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubcategoriesResponseValidationError) ErrorName() string {
+	return "ListSubcategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubcategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubcategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubcategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubcategoriesResponseValidationError{}
@@ -21,21 +21,85 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Loan lifecycle, the allowed moves are
+// BORROWED -> RETURNED | OVERDUE | LOST, OVERDUE -> RETURNED | LOST and LOST -> FOUND.
+// RETURNED and FOUND are final.
+type LoanStatus int32
+
+const (
+	LoanStatus_LOAN_STATUS_UNSPECIFIED LoanStatus = 0
+	LoanStatus_BORROWED                LoanStatus = 1
+	LoanStatus_RETURNED                LoanStatus = 2
+	LoanStatus_OVERDUE                 LoanStatus = 3
+	LoanStatus_LOST                    LoanStatus = 4
+	LoanStatus_FOUND                   LoanStatus = 5 // A lost copy came back to the library
+)
+
+// Enum value maps for LoanStatus.
+var (
+	LoanStatus_name = map[int32]string{
+		0: "LOAN_STATUS_UNSPECIFIED",
+		1: "BORROWED",
+		2: "RETURNED",
+		3: "OVERDUE",
+		4: "LOST",
+		5: "FOUND",
+	}
+	LoanStatus_value = map[string]int32{
+		"LOAN_STATUS_UNSPECIFIED": 0,
+		"BORROWED":                1,
+		"RETURNED":                2,
+		"OVERDUE":                 3,
+		"LOST":                    4,
+		"FOUND":                   5,
+	}
+)
+
+func (x LoanStatus) Enum() *LoanStatus {
+	p := new(LoanStatus)
+	*p = x
+	return p
+}
+
+func (x LoanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_loan_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LoanStatus) Type() protoreflect.EnumType {
+	return &file_loan_service_proto_enumTypes[0]
+}
+
+func (x LoanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoanStatus.Descriptor instead.
+func (LoanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{0}
+}
+
 // Loan message to represent loan data
 type Loan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BookId     string `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	LoanDate   int64  `protobuf:"varint,4,opt,name=loan_date,json=loanDate,proto3" json:"loan_date,omitempty"`       // unix time
-	ReturnDate int64  `protobuf:"varint,5,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"` // unix time
-	Status     string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                            // Loan status (e.g., BORROWED, RETURNED, LOST)
-	Version    int32  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt  int64  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix time
-	UpdatedAt  int64  `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // unit time
+	Id           string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       string     `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BookId       string     `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	LoanDate     int64      `protobuf:"varint,4,opt,name=loan_date,json=loanDate,proto3" json:"loan_date,omitempty"`       // unix time
+	ReturnDate   int64      `protobuf:"varint,5,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"` // unix time
+	Status       LoanStatus `protobuf:"varint,6,opt,name=status,proto3,enum=loan_service.LoanStatus" json:"status,omitempty"`
+	Version      int32      `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt    int64      `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`             // unix time
+	UpdatedAt    int64      `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`             // unit time
+	DueDate      int64      `protobuf:"varint,10,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // unix time
+	RenewalCount int32      `protobuf:"varint,11,opt,name=renewal_count,json=renewalCount,proto3" json:"renewal_count,omitempty"`
+	CopyId       string     `protobuf:"bytes,12,opt,name=copy_id,json=copyId,proto3" json:"copy_id,omitempty"` // Physical copy lent
 }
 
 func (x *Loan) Reset() {
@@ -105,11 +169,11 @@ func (x *Loan) GetReturnDate() int64 {
 	return 0
 }
 
-func (x *Loan) GetStatus() string {
+func (x *Loan) GetStatus() LoanStatus {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return LoanStatus_LOAN_STATUS_UNSPECIFIED
 }
 
 func (x *Loan) GetVersion() int32 {
@@ -133,15 +197,35 @@ func (x *Loan) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Loan) GetDueDate() int64 {
+	if x != nil {
+		return x.DueDate
+	}
+	return 0
+}
+
+func (x *Loan) GetRenewalCount() int32 {
+	if x != nil {
+		return x.RenewalCount
+	}
+	return 0
+}
+
+func (x *Loan) GetCopyId() string {
+	if x != nil {
+		return x.CopyId
+	}
+	return ""
+}
+
 type CreateLoanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // User ID must be non-empty
-	BookId      string `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`                 // Book ID must be non-empty
-	Email       string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                 // Must be a valid email address
-	BookVersion int32  `protobuf:"varint,4,opt,name=book_version,json=bookVersion,proto3" json:"book_version,omitempty"` // Book version must be >= 1
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	BookId string `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"` // Book ID must be non-empty
+	Email  string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                 // Must be a valid email address
 }
 
 func (x *CreateLoanRequest) Reset() {
@@ -197,24 +281,15 @@ func (x *CreateLoanRequest) GetEmail() string {
 	return ""
 }
 
-func (x *CreateLoanRequest) GetBookVersion() int32 {
-	if x != nil {
-		return x.BookVersion
-	}
-	return 0
-}
-
 type ReturnLoanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                       // ID must be non-empty
-	UserId      string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // User ID must be non-empty
-	Email       string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                 // Must be a valid email address
-	ReturnDate  int64  `protobuf:"varint,4,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"`    // Return date must be >= 0
-	Version     int32  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`                            // Version must be >= 1
-	BookVersion int32  `protobuf:"varint,6,opt,name=book_version,json=bookVersion,proto3" json:"book_version,omitempty"` // Book version must be >= 1
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // ID must be non-empty
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Email   string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                 // Must be a valid email address
+	Version int32  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`            // Version must be >= 1
 }
 
 func (x *ReturnLoanRequest) Reset() {
@@ -270,23 +345,72 @@ func (x *ReturnLoanRequest) GetEmail() string {
 	return ""
 }
 
-func (x *ReturnLoanRequest) GetReturnDate() int64 {
+func (x *ReturnLoanRequest) GetVersion() int32 {
 	if x != nil {
-		return x.ReturnDate
+		return x.Version
 	}
 	return 0
 }
 
-func (x *ReturnLoanRequest) GetVersion() int32 {
+type RenewLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // ID must be non-empty
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Version int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`            // Version must be >= 1
+}
+
+func (x *RenewLoanRequest) Reset() {
+	*x = RenewLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewLoanRequest) ProtoMessage() {}
+
+func (x *RenewLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewLoanRequest.ProtoReflect.Descriptor instead.
+func (*RenewLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RenewLoanRequest) GetId() string {
 	if x != nil {
-		return x.Version
+		return x.Id
 	}
-	return 0
+	return ""
+}
+
+func (x *RenewLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *ReturnLoanRequest) GetBookVersion() int32 {
+func (x *RenewLoanRequest) GetVersion() int32 {
 	if x != nil {
-		return x.BookVersion
+		return x.Version
 	}
 	return 0
 }
@@ -302,7 +426,7 @@ type GetLoanRequest struct {
 func (x *GetLoanRequest) Reset() {
 	*x = GetLoanRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[3]
+		mi := &file_loan_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -315,7 +439,7 @@ func (x *GetLoanRequest) String() string {
 func (*GetLoanRequest) ProtoMessage() {}
 
 func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[3]
+	mi := &file_loan_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +452,7 @@ func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
 func (*GetLoanRequest) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{3}
+	return file_loan_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetLoanRequest) GetId() string {
@@ -343,15 +467,17 @@ type UpdateLoanStatusRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`            // ID must be non-empty
-	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`    // Status must be non-empty
-	Version int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Version must be >= 1
+	Id        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                       // ID must be non-empty
+	Status    LoanStatus `protobuf:"varint,2,opt,name=status,proto3,enum=loan_service.LoanStatus" json:"status,omitempty"` // Target status of the transition
+	Version   int32      `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                            // Version must be >= 1
+	ChangedBy string     `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`        // ID of the staff member making the change
+	Note      string     `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`                                   // Reason of the change
 }
 
 func (x *UpdateLoanStatusRequest) Reset() {
 	*x = UpdateLoanStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[4]
+		mi := &file_loan_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -364,7 +490,7 @@ func (x *UpdateLoanStatusRequest) String() string {
 func (*UpdateLoanStatusRequest) ProtoMessage() {}
 
 func (x *UpdateLoanStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[4]
+	mi := &file_loan_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +503,7 @@ func (x *UpdateLoanStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLoanStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLoanStatusRequest) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{4}
+	return file_loan_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateLoanStatusRequest) GetId() string {
@@ -387,11 +513,11 @@ func (x *UpdateLoanStatusRequest) GetId() string {
 	return ""
 }
 
-func (x *UpdateLoanStatusRequest) GetStatus() string {
+func (x *UpdateLoanStatusRequest) GetStatus() LoanStatus {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return LoanStatus_LOAN_STATUS_UNSPECIFIED
 }
 
 func (x *UpdateLoanStatusRequest) GetVersion() int32 {
@@ -401,33 +527,48 @@ func (x *UpdateLoanStatusRequest) GetVersion() int32 {
 	return 0
 }
 
-type ListUserLoansRequest struct {
+func (x *UpdateLoanStatusRequest) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *UpdateLoanStatusRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// CheckOutCopyRequest lends the scanned copy to a patron at the circulation desk
+type CheckOutCopyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
-	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                  // Page must be >= 1
-	PageSize int32  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`          // Page size must be >= 1
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // Patron ID must be non-empty
+	Barcode   string `protobuf:"bytes,2,opt,name=barcode,proto3" json:"barcode,omitempty"`                      // Barcode must be non-empty
+	ChangedBy string `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // ID of the staff member at the desk
 }
 
-func (x *ListUserLoansRequest) Reset() {
-	*x = ListUserLoansRequest{}
+func (x *CheckOutCopyRequest) Reset() {
+	*x = CheckOutCopyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[5]
+		mi := &file_loan_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserLoansRequest) String() string {
+func (x *CheckOutCopyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserLoansRequest) ProtoMessage() {}
+func (*CheckOutCopyRequest) ProtoMessage() {}
 
-func (x *ListUserLoansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[5]
+func (x *CheckOutCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,58 +579,60 @@ func (x *ListUserLoansRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserLoansRequest.ProtoReflect.Descriptor instead.
-func (*ListUserLoansRequest) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CheckOutCopyRequest.ProtoReflect.Descriptor instead.
+func (*CheckOutCopyRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListUserLoansRequest) GetUserId() string {
+func (x *CheckOutCopyRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListUserLoansRequest) GetPage() int32 {
+func (x *CheckOutCopyRequest) GetBarcode() string {
 	if x != nil {
-		return x.Page
+		return x.Barcode
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUserLoansRequest) GetPageSize() int32 {
+func (x *CheckOutCopyRequest) GetChangedBy() string {
 	if x != nil {
-		return x.PageSize
+		return x.ChangedBy
 	}
-	return 0
+	return ""
 }
 
-type ListLoansRequest struct {
+// CheckInCopyRequest returns the active loan of the scanned copy at the circulation desk
+type CheckInCopyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`         // Page must be >= 1
-	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // Page size must be >= 1
+	Barcode   string `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`                      // Barcode must be non-empty
+	ChangedBy string `protobuf:"bytes,2,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // ID of the staff member at the desk
+	Condition string `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`                  // Condition of the copy on return, empty keeps the current one
 }
 
-func (x *ListLoansRequest) Reset() {
-	*x = ListLoansRequest{}
+func (x *CheckInCopyRequest) Reset() {
+	*x = CheckInCopyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[6]
+		mi := &file_loan_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLoansRequest) String() string {
+func (x *CheckInCopyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoansRequest) ProtoMessage() {}
+func (*CheckInCopyRequest) ProtoMessage() {}
 
-func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[6]
+func (x *CheckInCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,50 +643,57 @@ func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
-func (*ListLoansRequest) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CheckInCopyRequest.ProtoReflect.Descriptor instead.
+func (*CheckInCopyRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListLoansRequest) GetPage() int32 {
+func (x *CheckInCopyRequest) GetBarcode() string {
 	if x != nil {
-		return x.Page
+		return x.Barcode
 	}
-	return 0
+	return ""
 }
 
-func (x *ListLoansRequest) GetPageSize() int32 {
+func (x *CheckInCopyRequest) GetChangedBy() string {
 	if x != nil {
-		return x.PageSize
+		return x.ChangedBy
 	}
-	return 0
+	return ""
 }
 
-type LoanResponse struct {
+func (x *CheckInCopyRequest) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type GetLoanHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID must be non-empty
 }
 
-func (x *LoanResponse) Reset() {
-	*x = LoanResponse{}
+func (x *GetLoanHistoryRequest) Reset() {
+	*x = GetLoanHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[7]
+		mi := &file_loan_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoanResponse) String() string {
+func (x *GetLoanHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoanResponse) ProtoMessage() {}
+func (*GetLoanHistoryRequest) ProtoMessage() {}
 
-func (x *LoanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[7]
+func (x *GetLoanHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,45 +704,50 @@ func (x *LoanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoanResponse.ProtoReflect.Descriptor instead.
-func (*LoanResponse) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetLoanHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LoanResponse) GetLoan() *Loan {
+func (x *GetLoanHistoryRequest) GetId() string {
 	if x != nil {
-		return x.Loan
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListLoansResponse struct {
+// LoanStatusChange is one recorded transition of a loan, from_status is unspecified for the initial BORROWED entry
+type LoanStatusChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loans      []*Loan `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`            // Must contain at least one loan
-	TotalItems int32   `protobuf:"varint,2,opt,name=totalItems,proto3" json:"totalItems,omitempty"` // Total number of items
-	TotalPages int32   `protobuf:"varint,3,opt,name=totalPages,proto3" json:"totalPages,omitempty"` // Total number of pages
+	Id         string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LoanId     string     `protobuf:"bytes,2,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	FromStatus LoanStatus `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=loan_service.LoanStatus" json:"from_status,omitempty"`
+	ToStatus   LoanStatus `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=loan_service.LoanStatus" json:"to_status,omitempty"`
+	ChangedBy  string     `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // User ID of the actor, or "system" for the sweepers
+	Note       string     `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt  int64      `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // unix time
 }
 
-func (x *ListLoansResponse) Reset() {
-	*x = ListLoansResponse{}
+func (x *LoanStatusChange) Reset() {
+	*x = LoanStatusChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[8]
+		mi := &file_loan_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListLoansResponse) String() string {
+func (x *LoanStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoansResponse) ProtoMessage() {}
+func (*LoanStatusChange) ProtoMessage() {}
 
-func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[8]
+func (x *LoanStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,60 +758,85 @@ func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
-func (*ListLoansResponse) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use LoanStatusChange.ProtoReflect.Descriptor instead.
+func (*LoanStatusChange) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListLoansResponse) GetLoans() []*Loan {
+func (x *LoanStatusChange) GetId() string {
 	if x != nil {
-		return x.Loans
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListLoansResponse) GetTotalItems() int32 {
+func (x *LoanStatusChange) GetLoanId() string {
 	if x != nil {
-		return x.TotalItems
+		return x.LoanId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListLoansResponse) GetTotalPages() int32 {
+func (x *LoanStatusChange) GetFromStatus() LoanStatus {
 	if x != nil {
-		return x.TotalPages
+		return x.FromStatus
+	}
+	return LoanStatus_LOAN_STATUS_UNSPECIFIED
+}
+
+func (x *LoanStatusChange) GetToStatus() LoanStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return LoanStatus_LOAN_STATUS_UNSPECIFIED
+}
+
+func (x *LoanStatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *LoanStatusChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *LoanStatusChange) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type GetUserLoansByStatusRequest struct {
+type LoanHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
-	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // Status must be non-empty
-	Page     int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                  // Page must be >= 1
-	PageSize int32  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`          // Page size must be >= 1
+	History []*LoanStatusChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"` // Oldest change first
 }
 
-func (x *GetUserLoansByStatusRequest) Reset() {
-	*x = GetUserLoansByStatusRequest{}
+func (x *LoanHistoryResponse) Reset() {
+	*x = LoanHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[9]
+		mi := &file_loan_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserLoansByStatusRequest) String() string {
+func (x *LoanHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLoansByStatusRequest) ProtoMessage() {}
+func (*LoanHistoryResponse) ProtoMessage() {}
 
-func (x *GetUserLoansByStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[9]
+func (x *LoanHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,66 +847,1334 @@ func (x *GetUserLoansByStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLoansByStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetUserLoansByStatusRequest) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LoanHistoryResponse.ProtoReflect.Descriptor instead.
+func (*LoanHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUserLoansByStatusRequest) GetUserId() string {
+func (x *LoanHistoryResponse) GetHistory() []*LoanStatusChange {
 	if x != nil {
-		return x.UserId
+		return x.History
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUserLoansByStatusRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
+// Sorting, date range and cursor options shared by every loan listing
+type LoanListOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortBy    string `protobuf:"bytes,1,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // Defaults to loan_date
+	SortOrder string `protobuf:"bytes,2,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // Defaults to desc
+	FromDate  int64  `protobuf:"varint,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`   // unix time, inclusive lower bound on loan_date (0 = unbounded)
+	ToDate    int64  `protobuf:"varint,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`         // unix time, inclusive upper bound on loan_date (0 = unbounded)
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Keyset cursor taken from ListLoansResponse.next_page_token, overrides page when set
+}
+
+func (x *LoanListOptions) Reset() {
+	*x = LoanListOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanListOptions) ProtoMessage() {}
+
+func (x *LoanListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanListOptions.ProtoReflect.Descriptor instead.
+func (*LoanListOptions) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoanListOptions) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *LoanListOptions) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *LoanListOptions) GetFromDate() int64 {
+	if x != nil {
+		return x.FromDate
+	}
+	return 0
+}
+
+func (x *LoanListOptions) GetToDate() int64 {
+	if x != nil {
+		return x.ToDate
+	}
+	return 0
+}
+
+func (x *LoanListOptions) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserLoansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string           `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Page     int32            `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                  // Page must be >= 1
+	PageSize int32            `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`          // Page size must be between 1 and 100
+	Options  *LoanListOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ListUserLoansRequest) Reset() {
+	*x = ListUserLoansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserLoansRequest) ProtoMessage() {}
+
+func (x *ListUserLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListUserLoansRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListUserLoansRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserLoansRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserLoansRequest) GetOptions() *LoanListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListLoansRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     int32            `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`         // Page must be >= 1
+	PageSize int32            `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // Page size must be between 1 and 100
+	Options  *LoanListOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLoansRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetOptions() *LoanListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type LoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+}
+
+func (x *LoanResponse) Reset() {
+	*x = LoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanResponse) ProtoMessage() {}
+
+func (x *LoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanResponse.ProtoReflect.Descriptor instead.
+func (*LoanResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+type ListLoansResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loans         []*Loan `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`                                        // Must contain at least one loan
+	TotalItems    int32   `protobuf:"varint,2,opt,name=totalItems,proto3" json:"totalItems,omitempty"`                             // Total number of items
+	TotalPages    int32   `protobuf:"varint,3,opt,name=totalPages,proto3" json:"totalPages,omitempty"`                             // Total number of pages
+	NextPageToken string  `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Cursor for the next page, empty when there are no more loans
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListLoansResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListLoansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetUserLoansByStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string           `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Status   LoanStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=loan_service.LoanStatus" json:"status,omitempty"`
+	Page     int32            `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`         // Page must be >= 1
+	PageSize int32            `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // Page size must be between 1 and 100
+	Options  *LoanListOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GetUserLoansByStatusRequest) Reset() {
+	*x = GetUserLoansByStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserLoansByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLoansByStatusRequest) ProtoMessage() {}
+
+func (x *GetUserLoansByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLoansByStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLoansByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserLoansByStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserLoansByStatusRequest) GetStatus() LoanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoanStatus_LOAN_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserLoansByStatusRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserLoansByStatusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserLoansByStatusRequest) GetOptions() *LoanListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GetLoansByStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   LoanStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=loan_service.LoanStatus" json:"status,omitempty"`
+	Page     int32            `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // Page must be >= 1
+	PageSize int32            `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // Page size must be between 1 and 100
+	Options  *LoanListOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GetLoansByStatusRequest) Reset() {
+	*x = GetLoansByStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoansByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoansByStatusRequest) ProtoMessage() {}
+
+func (x *GetLoansByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoansByStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetLoansByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetLoansByStatusRequest) GetStatus() LoanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LoanStatus_LOAN_STATUS_UNSPECIFIED
+}
+
+func (x *GetLoansByStatusRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetLoansByStatusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLoansByStatusRequest) GetOptions() *LoanListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// Fine message to represent a charge against a patron, amounts are in rupiah
+type Fine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LoanId     string `protobuf:"bytes,3,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Type       string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // Fine type (e.g., OVERDUE, LOST)
+	Amount     int64  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	PaidAmount int64  `protobuf:"varint,6,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	Status     string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // Fine status (e.g., UNPAID, PAID, WAIVED)
+	Version    int32  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`  // unix time
+	UpdatedAt  int64  `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // unix time
+}
+
+func (x *Fine) Reset() {
+	*x = Fine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fine) ProtoMessage() {}
+
+func (x *Fine) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fine.ProtoReflect.Descriptor instead.
+func (*Fine) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Fine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Fine) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Fine) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Fine) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Fine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Fine) GetPaidAmount() int64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *Fine) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Fine) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Fine) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Fine) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListUserFinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // Empty lists every status
+	Page     int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                  // Page must be >= 1
+	PageSize int32  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`          // Page size must be between 1 and 100
+}
+
+func (x *ListUserFinesRequest) Reset() {
+	*x = ListUserFinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserFinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserFinesRequest) ProtoMessage() {}
+
+func (x *ListUserFinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserFinesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserFinesRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListUserFinesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserFinesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListUserFinesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserFinesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fines       []*Fine `protobuf:"bytes,1,rep,name=fines,proto3" json:"fines,omitempty"`
+	TotalItems  int32   `protobuf:"varint,2,opt,name=totalItems,proto3" json:"totalItems,omitempty"`   // Total number of items
+	TotalPages  int32   `protobuf:"varint,3,opt,name=totalPages,proto3" json:"totalPages,omitempty"`   // Total number of pages
+	Outstanding int64   `protobuf:"varint,4,opt,name=outstanding,proto3" json:"outstanding,omitempty"` // Sum still owed across every unpaid fine of the user
+}
+
+func (x *ListFinesResponse) Reset() {
+	*x = ListFinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinesResponse) ProtoMessage() {}
+
+func (x *ListFinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinesResponse.ProtoReflect.Descriptor instead.
+func (*ListFinesResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListFinesResponse) GetFines() []*Fine {
+	if x != nil {
+		return x.Fines
+	}
+	return nil
+}
+
+func (x *ListFinesResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListFinesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *ListFinesResponse) GetOutstanding() int64 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+type PayFineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // ID must be non-empty
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Amount  int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`              // Amount must be > 0
+	Version int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`            // Version must be >= 1
+}
+
+func (x *PayFineRequest) Reset() {
+	*x = PayFineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayFineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayFineRequest) ProtoMessage() {}
+
+func (x *PayFineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayFineRequest.ProtoReflect.Descriptor instead.
+func (*PayFineRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PayFineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PayFineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PayFineRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayFineRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type WaiveFineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`            // ID must be non-empty
+	Note    string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`        // Reason of the waiver
+	Version int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Version must be >= 1
+}
+
+func (x *WaiveFineRequest) Reset() {
+	*x = WaiveFineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaiveFineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaiveFineRequest) ProtoMessage() {}
+
+func (x *WaiveFineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaiveFineRequest.ProtoReflect.Descriptor instead.
+func (*WaiveFineRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WaiveFineRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaiveFineRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *WaiveFineRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type FineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fine *Fine `protobuf:"bytes,1,opt,name=fine,proto3" json:"fine,omitempty"`
+}
+
+func (x *FineResponse) Reset() {
+	*x = FineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FineResponse) ProtoMessage() {}
+
+func (x *FineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FineResponse.ProtoReflect.Descriptor instead.
+func (*FineResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FineResponse) GetFine() *Fine {
+	if x != nil {
+		return x.Fine
+	}
+	return nil
+}
+
+// Hold message to represent a place in the FIFO queue of a book
+type Hold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BookId        string `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                     // Hold status (e.g., WAITING, READY, FULFILLED, CANCELLED, EXPIRED)
+	QueuePosition int32  `protobuf:"varint,5,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"` // 1-based place among waiting holds, 0 when not waiting
+	ReadyAt       int64  `protobuf:"varint,6,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`                   // unix time, 0 until READY
+	ExpiresAt     int64  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`             // unix time, end of the pickup window once READY
+	Version       int32  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`  // unix time
+	UpdatedAt     int64  `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // unix time
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Hold) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Hold) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *Hold) GetReadyAt() int64 {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return 0
+}
+
+func (x *Hold) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Hold) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Hold) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Hold) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type PlaceHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Email  string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                 // Must be a valid email address
+	BookId string `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"` // Book ID must be non-empty
+}
+
+func (x *PlaceHoldRequest) Reset() {
+	*x = PlaceHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceHoldRequest) ProtoMessage() {}
+
+func (x *PlaceHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceHoldRequest.ProtoReflect.Descriptor instead.
+func (*PlaceHoldRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PlaceHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PlaceHoldRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+type CancelHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // ID must be non-empty
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Version int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`            // Version must be >= 1
+}
+
+func (x *CancelHoldRequest) Reset() {
+	*x = CancelHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelHoldRequest) ProtoMessage() {}
+
+func (x *CancelHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelHoldRequest.ProtoReflect.Descriptor instead.
+func (*CancelHoldRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelHoldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelHoldRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ListUserHoldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID must be non-empty
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // Empty lists every status
+	Page     int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                  // Page must be >= 1
+	PageSize int32  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`          // Page size must be between 1 and 100
+}
+
+func (x *ListUserHoldsRequest) Reset() {
+	*x = ListUserHoldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserHoldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserHoldsRequest) ProtoMessage() {}
+
+func (x *ListUserHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListUserHoldsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserHoldsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListUserHoldsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserHoldsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type HoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hold *Hold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+}
+
+func (x *HoldResponse) Reset() {
+	*x = HoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loan_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetUserLoansByStatusRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
+func (x *HoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldResponse) ProtoMessage() {}
+
+func (x *HoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserLoansByStatusRequest) GetPageSize() int32 {
+// Deprecated: Use HoldResponse.ProtoReflect.Descriptor instead.
+func (*HoldResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HoldResponse) GetHold() *Hold {
 	if x != nil {
-		return x.PageSize
+		return x.Hold
 	}
-	return 0
+	return nil
 }
 
-type GetLoansByStatusRequest struct {
+type ListHoldsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`      // Status must be non-empty
-	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // Page must be >= 1
-	PageSize int32  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // Page size must be >= 1
+	Holds      []*Hold `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	TotalItems int32   `protobuf:"varint,2,opt,name=totalItems,proto3" json:"totalItems,omitempty"` // Total number of items
+	TotalPages int32   `protobuf:"varint,3,opt,name=totalPages,proto3" json:"totalPages,omitempty"` // Total number of pages
 }
 
-func (x *GetLoansByStatusRequest) Reset() {
-	*x = GetLoansByStatusRequest{}
+func (x *ListHoldsResponse) Reset() {
+	*x = ListHoldsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loan_service_proto_msgTypes[10]
+		mi := &file_loan_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLoansByStatusRequest) String() string {
+func (x *ListHoldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoansByStatusRequest) ProtoMessage() {}
+func (*ListHoldsResponse) ProtoMessage() {}
 
-func (x *GetLoansByStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_service_proto_msgTypes[10]
+func (x *ListHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,28 +2185,28 @@ func (x *GetLoansByStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoansByStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetLoansByStatusRequest) Descriptor() ([]byte, []int) {
-	return file_loan_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ListHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_loan_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetLoansByStatusRequest) GetStatus() string {
+func (x *ListHoldsResponse) GetHolds() []*Hold {
 	if x != nil {
-		return x.Status
+		return x.Holds
 	}
-	return ""
+	return nil
 }
 
-func (x *GetLoansByStatusRequest) GetPage() int32 {
+func (x *ListHoldsResponse) GetTotalItems() int32 {
 	if x != nil {
-		return x.Page
+		return x.TotalItems
 	}
 	return 0
 }
 
-func (x *GetLoansByStatusRequest) GetPageSize() int32 {
+func (x *ListHoldsResponse) GetTotalPages() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.TotalPages
 	}
 	return 0
 }
@@ -769,7 +2217,7 @@ var file_loan_service_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x01, 0x0a, 0x04,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a, 0x04,
 	0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
@@ -778,136 +2226,398 @@ var file_loan_service_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x6e, 0x44,
 	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61,
 	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x62, 0x6f,
-	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x0c, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
-	0x01, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe6,
-	0x01, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x28,
-	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x28, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x0c, 0x62,
-	0x6f, 0x6f, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6b,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x76, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x6f, 0x70, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x6f, 0x70, 0x79, 0x49, 0x64, 0x22, 0x7e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x07,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa,
+	0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x9c, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04,
+	0x08, 0x06, 0x10, 0x07, 0x22, 0x70, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x6f, 0x61,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xdf, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
 	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
 	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
-	0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x1c, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x4a, 0x04, 0x08,
+	0x04, 0x10, 0x05, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74,
+	0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a,
+	0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x26, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
-	0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x54, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
-	0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
-	0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0c,
-	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04,
-	0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04,
-	0x6c, 0x6f, 0x61, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x6f,
-	0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa2,
-	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x45, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xfa,
+	0x42, 0x24, 0x72, 0x22, 0x52, 0x00, 0x52, 0x03, 0x4e, 0x45, 0x57, 0x52, 0x04, 0x47, 0x4f, 0x4f,
+	0x44, 0x52, 0x04, 0x46, 0x41, 0x49, 0x52, 0x52, 0x04, 0x50, 0x4f, 0x4f, 0x52, 0x52, 0x07, 0x44,
+	0x41, 0x4d, 0x41, 0x47, 0x45, 0x44, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x09,
+	0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x4f, 0x0a, 0x13, 0x4c, 0x6f, 0x61, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x6f, 0x72,
+	0x74, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x72,
+	0x19, 0x52, 0x00, 0x52, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x72, 0x0d, 0x52, 0x00, 0x52,
+	0x03, 0x61, 0x73, 0x63, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28,
+	0x00, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74,
+	0x6f, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb5, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28,
+	0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x28,
+	0x01, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x22, 0xaf,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xfa, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e,
+	0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0xfa, 0x42,
+	0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd4, 0x01,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x28, 0x01, 0x18,
+	0x64, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xfa, 0x42, 0x1a, 0x72, 0x18, 0x52, 0x00, 0x52, 0x06,
+	0x55, 0x4e, 0x50, 0x41, 0x49, 0x44, 0x52, 0x04, 0x50, 0x41, 0x49, 0x44, 0x52, 0x06, 0x57, 0x41,
+	0x49, 0x56, 0x45, 0x44, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06,
+	0x1a, 0x04, 0x18, 0x64, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x23,
-	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x7c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
-	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x32, 0xa3, 0x05, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
-	0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f,
-	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x61, 0x6e, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x10, 0x57, 0x61, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x66, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x46, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6e, 0x65, 0x22, 0x97, 0x02, 0x0a, 0x04, 0x48,
+	0x6f, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x79, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x11, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc,
+	0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xfa, 0x42, 0x33, 0x72, 0x31,
+	0x52, 0x00, 0x52, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x52, 0x05, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x52, 0x09, 0x46, 0x55, 0x4c, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x52, 0x09, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x52, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x01,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x28,
+	0x01, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a,
+	0x0c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x04, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x7d, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x05, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x2a, 0x67, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x4f, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x42, 0x4f, 0x52, 0x52, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f,
+	0x56, 0x45, 0x52, 0x44, 0x55, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x54,
+	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x32, 0xb0, 0x0b,
+	0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4c, 0x6f, 0x61, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x61, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f,
-	0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x6f, 0x61, 0x6e,
+	0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x55, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f,
+	0x70, 0x79, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x6f, 0x70, 0x79,
+	0x12, 0x20, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12,
+	0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x46,
+	0x69, 0x6e, 0x65, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x09, 0x57, 0x61, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x61, 0x69, 0x76, 0x65, 0x46,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x48,
+	0x6f, 0x6c, 0x64, 0x12, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -922,44 +2632,100 @@ func file_loan_service_proto_rawDescGZIP() []byte {
 	return file_loan_service_proto_rawDescData
 }
 
-var file_loan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_loan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_loan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_loan_service_proto_goTypes = []interface{}{
-	(*Loan)(nil),                        // 0: loan_service.Loan
-	(*CreateLoanRequest)(nil),           // 1: loan_service.CreateLoanRequest
-	(*ReturnLoanRequest)(nil),           // 2: loan_service.ReturnLoanRequest
-	(*GetLoanRequest)(nil),              // 3: loan_service.GetLoanRequest
-	(*UpdateLoanStatusRequest)(nil),     // 4: loan_service.UpdateLoanStatusRequest
-	(*ListUserLoansRequest)(nil),        // 5: loan_service.ListUserLoansRequest
-	(*ListLoansRequest)(nil),            // 6: loan_service.ListLoansRequest
-	(*LoanResponse)(nil),                // 7: loan_service.LoanResponse
-	(*ListLoansResponse)(nil),           // 8: loan_service.ListLoansResponse
-	(*GetUserLoansByStatusRequest)(nil), // 9: loan_service.GetUserLoansByStatusRequest
-	(*GetLoansByStatusRequest)(nil),     // 10: loan_service.GetLoansByStatusRequest
+	(LoanStatus)(0),                     // 0: loan_service.LoanStatus
+	(*Loan)(nil),                        // 1: loan_service.Loan
+	(*CreateLoanRequest)(nil),           // 2: loan_service.CreateLoanRequest
+	(*ReturnLoanRequest)(nil),           // 3: loan_service.ReturnLoanRequest
+	(*RenewLoanRequest)(nil),            // 4: loan_service.RenewLoanRequest
+	(*GetLoanRequest)(nil),              // 5: loan_service.GetLoanRequest
+	(*UpdateLoanStatusRequest)(nil),     // 6: loan_service.UpdateLoanStatusRequest
+	(*CheckOutCopyRequest)(nil),         // 7: loan_service.CheckOutCopyRequest
+	(*CheckInCopyRequest)(nil),          // 8: loan_service.CheckInCopyRequest
+	(*GetLoanHistoryRequest)(nil),       // 9: loan_service.GetLoanHistoryRequest
+	(*LoanStatusChange)(nil),            // 10: loan_service.LoanStatusChange
+	(*LoanHistoryResponse)(nil),         // 11: loan_service.LoanHistoryResponse
+	(*LoanListOptions)(nil),             // 12: loan_service.LoanListOptions
+	(*ListUserLoansRequest)(nil),        // 13: loan_service.ListUserLoansRequest
+	(*ListLoansRequest)(nil),            // 14: loan_service.ListLoansRequest
+	(*LoanResponse)(nil),                // 15: loan_service.LoanResponse
+	(*ListLoansResponse)(nil),           // 16: loan_service.ListLoansResponse
+	(*GetUserLoansByStatusRequest)(nil), // 17: loan_service.GetUserLoansByStatusRequest
+	(*GetLoansByStatusRequest)(nil),     // 18: loan_service.GetLoansByStatusRequest
+	(*Fine)(nil),                        // 19: loan_service.Fine
+	(*ListUserFinesRequest)(nil),        // 20: loan_service.ListUserFinesRequest
+	(*ListFinesResponse)(nil),           // 21: loan_service.ListFinesResponse
+	(*PayFineRequest)(nil),              // 22: loan_service.PayFineRequest
+	(*WaiveFineRequest)(nil),            // 23: loan_service.WaiveFineRequest
+	(*FineResponse)(nil),                // 24: loan_service.FineResponse
+	(*Hold)(nil),                        // 25: loan_service.Hold
+	(*PlaceHoldRequest)(nil),            // 26: loan_service.PlaceHoldRequest
+	(*CancelHoldRequest)(nil),           // 27: loan_service.CancelHoldRequest
+	(*ListUserHoldsRequest)(nil),        // 28: loan_service.ListUserHoldsRequest
+	(*HoldResponse)(nil),                // 29: loan_service.HoldResponse
+	(*ListHoldsResponse)(nil),           // 30: loan_service.ListHoldsResponse
 }
 var file_loan_service_proto_depIdxs = []int32{
-	0,  // 0: loan_service.LoanResponse.loan:type_name -> loan_service.Loan
-	0,  // 1: loan_service.ListLoansResponse.loans:type_name -> loan_service.Loan
-	1,  // 2: loan_service.LoanService.CreateLoan:input_type -> loan_service.CreateLoanRequest
-	2,  // 3: loan_service.LoanService.ReturnLoan:input_type -> loan_service.ReturnLoanRequest
-	3,  // 4: loan_service.LoanService.GetLoan:input_type -> loan_service.GetLoanRequest
-	4,  // 5: loan_service.LoanService.UpdateLoanStatus:input_type -> loan_service.UpdateLoanStatusRequest
-	5,  // 6: loan_service.LoanService.ListUserLoans:input_type -> loan_service.ListUserLoansRequest
-	6,  // 7: loan_service.LoanService.ListLoans:input_type -> loan_service.ListLoansRequest
-	9,  // 8: loan_service.LoanService.GetUserLoansByStatus:input_type -> loan_service.GetUserLoansByStatusRequest
-	10, // 9: loan_service.LoanService.GetLoansByStatus:input_type -> loan_service.GetLoansByStatusRequest
-	7,  // 10: loan_service.LoanService.CreateLoan:output_type -> loan_service.LoanResponse
-	7,  // 11: loan_service.LoanService.ReturnLoan:output_type -> loan_service.LoanResponse
-	7,  // 12: loan_service.LoanService.GetLoan:output_type -> loan_service.LoanResponse
-	7,  // 13: loan_service.LoanService.UpdateLoanStatus:output_type -> loan_service.LoanResponse
-	8,  // 14: loan_service.LoanService.ListUserLoans:output_type -> loan_service.ListLoansResponse
-	8,  // 15: loan_service.LoanService.ListLoans:output_type -> loan_service.ListLoansResponse
-	8,  // 16: loan_service.LoanService.GetUserLoansByStatus:output_type -> loan_service.ListLoansResponse
-	8,  // 17: loan_service.LoanService.GetLoansByStatus:output_type -> loan_service.ListLoansResponse
-	10, // [10:18] is the sub-list for method output_type
-	2,  // [2:10] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	0,  // 0: loan_service.Loan.status:type_name -> loan_service.LoanStatus
+	0,  // 1: loan_service.UpdateLoanStatusRequest.status:type_name -> loan_service.LoanStatus
+	0,  // 2: loan_service.LoanStatusChange.from_status:type_name -> loan_service.LoanStatus
+	0,  // 3: loan_service.LoanStatusChange.to_status:type_name -> loan_service.LoanStatus
+	10, // 4: loan_service.LoanHistoryResponse.history:type_name -> loan_service.LoanStatusChange
+	12, // 5: loan_service.ListUserLoansRequest.options:type_name -> loan_service.LoanListOptions
+	12, // 6: loan_service.ListLoansRequest.options:type_name -> loan_service.LoanListOptions
+	1,  // 7: loan_service.LoanResponse.loan:type_name -> loan_service.Loan
+	1,  // 8: loan_service.ListLoansResponse.loans:type_name -> loan_service.Loan
+	0,  // 9: loan_service.GetUserLoansByStatusRequest.status:type_name -> loan_service.LoanStatus
+	12, // 10: loan_service.GetUserLoansByStatusRequest.options:type_name -> loan_service.LoanListOptions
+	0,  // 11: loan_service.GetLoansByStatusRequest.status:type_name -> loan_service.LoanStatus
+	12, // 12: loan_service.GetLoansByStatusRequest.options:type_name -> loan_service.LoanListOptions
+	19, // 13: loan_service.ListFinesResponse.fines:type_name -> loan_service.Fine
+	19, // 14: loan_service.FineResponse.fine:type_name -> loan_service.Fine
+	25, // 15: loan_service.HoldResponse.hold:type_name -> loan_service.Hold
+	25, // 16: loan_service.ListHoldsResponse.holds:type_name -> loan_service.Hold
+	2,  // 17: loan_service.LoanService.CreateLoan:input_type -> loan_service.CreateLoanRequest
+	3,  // 18: loan_service.LoanService.ReturnLoan:input_type -> loan_service.ReturnLoanRequest
+	4,  // 19: loan_service.LoanService.RenewLoan:input_type -> loan_service.RenewLoanRequest
+	5,  // 20: loan_service.LoanService.GetLoan:input_type -> loan_service.GetLoanRequest
+	6,  // 21: loan_service.LoanService.UpdateLoanStatus:input_type -> loan_service.UpdateLoanStatusRequest
+	9,  // 22: loan_service.LoanService.GetLoanHistory:input_type -> loan_service.GetLoanHistoryRequest
+	7,  // 23: loan_service.LoanService.CheckOutCopy:input_type -> loan_service.CheckOutCopyRequest
+	8,  // 24: loan_service.LoanService.CheckInCopy:input_type -> loan_service.CheckInCopyRequest
+	13, // 25: loan_service.LoanService.ListUserLoans:input_type -> loan_service.ListUserLoansRequest
+	14, // 26: loan_service.LoanService.ListLoans:input_type -> loan_service.ListLoansRequest
+	17, // 27: loan_service.LoanService.GetUserLoansByStatus:input_type -> loan_service.GetUserLoansByStatusRequest
+	18, // 28: loan_service.LoanService.GetLoansByStatus:input_type -> loan_service.GetLoansByStatusRequest
+	20, // 29: loan_service.LoanService.ListUserFines:input_type -> loan_service.ListUserFinesRequest
+	22, // 30: loan_service.LoanService.PayFine:input_type -> loan_service.PayFineRequest
+	23, // 31: loan_service.LoanService.WaiveFine:input_type -> loan_service.WaiveFineRequest
+	26, // 32: loan_service.LoanService.PlaceHold:input_type -> loan_service.PlaceHoldRequest
+	27, // 33: loan_service.LoanService.CancelHold:input_type -> loan_service.CancelHoldRequest
+	28, // 34: loan_service.LoanService.ListUserHolds:input_type -> loan_service.ListUserHoldsRequest
+	15, // 35: loan_service.LoanService.CreateLoan:output_type -> loan_service.LoanResponse
+	15, // 36: loan_service.LoanService.ReturnLoan:output_type -> loan_service.LoanResponse
+	15, // 37: loan_service.LoanService.RenewLoan:output_type -> loan_service.LoanResponse
+	15, // 38: loan_service.LoanService.GetLoan:output_type -> loan_service.LoanResponse
+	15, // 39: loan_service.LoanService.UpdateLoanStatus:output_type -> loan_service.LoanResponse
+	11, // 40: loan_service.LoanService.GetLoanHistory:output_type -> loan_service.LoanHistoryResponse
+	15, // 41: loan_service.LoanService.CheckOutCopy:output_type -> loan_service.LoanResponse
+	15, // 42: loan_service.LoanService.CheckInCopy:output_type -> loan_service.LoanResponse
+	16, // 43: loan_service.LoanService.ListUserLoans:output_type -> loan_service.ListLoansResponse
+	16, // 44: loan_service.LoanService.ListLoans:output_type -> loan_service.ListLoansResponse
+	16, // 45: loan_service.LoanService.GetUserLoansByStatus:output_type -> loan_service.ListLoansResponse
+	16, // 46: loan_service.LoanService.GetLoansByStatus:output_type -> loan_service.ListLoansResponse
+	21, // 47: loan_service.LoanService.ListUserFines:output_type -> loan_service.ListFinesResponse
+	24, // 48: loan_service.LoanService.PayFine:output_type -> loan_service.FineResponse
+	24, // 49: loan_service.LoanService.WaiveFine:output_type -> loan_service.FineResponse
+	29, // 50: loan_service.LoanService.PlaceHold:output_type -> loan_service.HoldResponse
+	29, // 51: loan_service.LoanService.CancelHold:output_type -> loan_service.HoldResponse
+	30, // 52: loan_service.LoanService.ListUserHolds:output_type -> loan_service.ListHoldsResponse
+	35, // [35:53] is the sub-list for method output_type
+	17, // [17:35] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_loan_service_proto_init() }
@@ -1005,7 +2771,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoanRequest); i {
+			switch v := v.(*RenewLoanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1017,7 +2783,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLoanStatusRequest); i {
+			switch v := v.(*GetLoanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1029,7 +2795,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserLoansRequest); i {
+			switch v := v.(*UpdateLoanStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1041,7 +2807,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLoansRequest); i {
+			switch v := v.(*CheckOutCopyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1053,7 +2819,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoanResponse); i {
+			switch v := v.(*CheckInCopyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1065,7 +2831,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListLoansResponse); i {
+			switch v := v.(*GetLoanHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1077,7 +2843,7 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserLoansByStatusRequest); i {
+			switch v := v.(*LoanStatusChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1089,6 +2855,90 @@ func file_loan_service_proto_init() {
 			}
 		}
 		file_loan_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanListOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserLoansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoansRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoansResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserLoansByStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLoansByStatusRequest); i {
 			case 0:
 				return &v.state
@@ -1100,19 +2950,164 @@ func file_loan_service_proto_init() {
 				return nil
 			}
 		}
+		file_loan_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserFinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayFineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaiveFineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserHoldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loan_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHoldsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_loan_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_loan_service_proto_goTypes,
 		DependencyIndexes: file_loan_service_proto_depIdxs,
+		EnumInfos:         file_loan_service_proto_enumTypes,
 		MessageInfos:      file_loan_service_proto_msgTypes,
 	}.Build()
 	File_loan_service_proto = out.File
@@ -74,6 +74,12 @@ func (m *Loan) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for DueDate
+
+	// no validation rules for RenewalCount
+
+	// no validation rules for CopyId
+
 	if len(errors) > 0 {
 		return LoanMultiError(errors)
 	}
@@ -218,17 +224,6 @@ func (m *CreateLoanRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetBookVersion() < 1 {
-		err := CreateLoanRequestValidationError{
-			field:  "BookVersion",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
 		return CreateLoanRequestMultiError(errors)
 	}
@@ -415,17 +410,6 @@ func (m *ReturnLoanRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetReturnDate() < 0 {
-		err := ReturnLoanRequestValidationError{
-			field:  "ReturnDate",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if m.GetVersion() < 1 {
 		err := ReturnLoanRequestValidationError{
 			field:  "Version",
@@ -437,17 +421,6 @@ func (m *ReturnLoanRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetBookVersion() < 1 {
-		err := ReturnLoanRequestValidationError{
-			field:  "BookVersion",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
 		return ReturnLoanRequestMultiError(errors)
 	}
@@ -578,6 +551,139 @@ var _ interface {
 	ErrorName() string
 } = ReturnLoanRequestValidationError{}
 
+// Validate checks the field values on RenewLoanRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenewLoanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewLoanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewLoanRequestMultiError, or nil if none found.
+func (m *RenewLoanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewLoanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RenewLoanRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := RenewLoanRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := RenewLoanRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenewLoanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewLoanRequestMultiError is an error wrapping multiple validation errors
+// returned by RenewLoanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenewLoanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewLoanRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewLoanRequestMultiError) AllErrors() []error { return m }
+
+// RenewLoanRequestValidationError is the validation error returned by
+// RenewLoanRequest.Validate if the designated constraints aren't met.
+type RenewLoanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewLoanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewLoanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewLoanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewLoanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewLoanRequestValidationError) ErrorName() string { return "RenewLoanRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RenewLoanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewLoanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenewLoanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewLoanRequestValidationError{}
+
 // Validate checks the field values on GetLoanRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -722,10 +828,21 @@ func (m *UpdateLoanStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetStatus()) < 1 {
+	if _, ok := _UpdateLoanStatusRequest_Status_NotInLookup[m.GetStatus()]; ok {
 		err := UpdateLoanStatusRequestValidationError{
 			field:  "Status",
-			reason: "value length must be at least 1 runes",
+			reason: "value must not be in list [LOAN_STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LoanStatus_name[int32(m.GetStatus())]; !ok {
+		err := UpdateLoanStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -744,6 +861,28 @@ func (m *UpdateLoanStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetChangedBy()) < 1 {
+		err := UpdateLoanStatusRequestValidationError{
+			field:  "ChangedBy",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := UpdateLoanStatusRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UpdateLoanStatusRequestMultiError(errors)
 	}
@@ -824,22 +963,26 @@ var _ interface {
 	ErrorName() string
 } = UpdateLoanStatusRequestValidationError{}
 
-// Validate checks the field values on ListUserLoansRequest with the rules
+var _UpdateLoanStatusRequest_Status_NotInLookup = map[LoanStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CheckOutCopyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListUserLoansRequest) Validate() error {
+func (m *CheckOutCopyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUserLoansRequest with the rules
+// ValidateAll checks the field values on CheckOutCopyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListUserLoansRequestMultiError, or nil if none found.
-func (m *ListUserLoansRequest) ValidateAll() error {
+// CheckOutCopyRequestMultiError, or nil if none found.
+func (m *CheckOutCopyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUserLoansRequest) validate(all bool) error {
+func (m *CheckOutCopyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -847,7 +990,7 @@ func (m *ListUserLoansRequest) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := ListUserLoansRequestValidationError{
+		err := CheckOutCopyRequestValidationError{
 			field:  "UserId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -857,10 +1000,10 @@ func (m *ListUserLoansRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPage() < 1 {
-		err := ListUserLoansRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than or equal to 1",
+	if utf8.RuneCountInString(m.GetBarcode()) < 1 {
+		err := CheckOutCopyRequestValidationError{
+			field:  "Barcode",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -868,10 +1011,10 @@ func (m *ListUserLoansRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() < 1 {
-		err := ListUserLoansRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be greater than or equal to 1",
+	if utf8.RuneCountInString(m.GetChangedBy()) < 1 {
+		err := CheckOutCopyRequestValidationError{
+			field:  "ChangedBy",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -880,19 +1023,19 @@ func (m *ListUserLoansRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListUserLoansRequestMultiError(errors)
+		return CheckOutCopyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUserLoansRequestMultiError is an error wrapping multiple validation
-// errors returned by ListUserLoansRequest.ValidateAll() if the designated
+// CheckOutCopyRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckOutCopyRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListUserLoansRequestMultiError []error
+type CheckOutCopyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUserLoansRequestMultiError) Error() string {
+func (m CheckOutCopyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -901,11 +1044,11 @@ func (m ListUserLoansRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUserLoansRequestMultiError) AllErrors() []error { return m }
+func (m CheckOutCopyRequestMultiError) AllErrors() []error { return m }
 
-// ListUserLoansRequestValidationError is the validation error returned by
-// ListUserLoansRequest.Validate if the designated constraints aren't met.
-type ListUserLoansRequestValidationError struct {
+// CheckOutCopyRequestValidationError is the validation error returned by
+// CheckOutCopyRequest.Validate if the designated constraints aren't met.
+type CheckOutCopyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -913,24 +1056,24 @@ type ListUserLoansRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUserLoansRequestValidationError) Field() string { return e.field }
+func (e CheckOutCopyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUserLoansRequestValidationError) Reason() string { return e.reason }
+func (e CheckOutCopyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUserLoansRequestValidationError) Cause() error { return e.cause }
+func (e CheckOutCopyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUserLoansRequestValidationError) Key() bool { return e.key }
+func (e CheckOutCopyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUserLoansRequestValidationError) ErrorName() string {
-	return "ListUserLoansRequestValidationError"
+func (e CheckOutCopyRequestValidationError) ErrorName() string {
+	return "CheckOutCopyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListUserLoansRequestValidationError) Error() string {
+func (e CheckOutCopyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -942,14 +1085,14 @@ func (e ListUserLoansRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUserLoansRequest.%s: %s%s",
+		"invalid %sCheckOutCopyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUserLoansRequestValidationError{}
+var _ error = CheckOutCopyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -957,34 +1100,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUserLoansRequestValidationError{}
+} = CheckOutCopyRequestValidationError{}
 
-// Validate checks the field values on ListLoansRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListLoansRequest) Validate() error {
+// Validate checks the field values on CheckInCopyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckInCopyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLoansRequest with the rules
+// ValidateAll checks the field values on CheckInCopyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLoansRequestMultiError, or nil if none found.
-func (m *ListLoansRequest) ValidateAll() error {
+// CheckInCopyRequestMultiError, or nil if none found.
+func (m *CheckInCopyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLoansRequest) validate(all bool) error {
+func (m *CheckInCopyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPage() < 1 {
-		err := ListLoansRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than or equal to 1",
+	if utf8.RuneCountInString(m.GetBarcode()) < 1 {
+		err := CheckInCopyRequestValidationError{
+			field:  "Barcode",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -992,10 +1135,21 @@ func (m *ListLoansRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() < 1 {
-		err := ListLoansRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be greater than or equal to 1",
+	if utf8.RuneCountInString(m.GetChangedBy()) < 1 {
+		err := CheckInCopyRequestValidationError{
+			field:  "ChangedBy",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CheckInCopyRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := CheckInCopyRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [ NEW GOOD FAIR POOR DAMAGED]",
 		}
 		if !all {
 			return err
@@ -1004,19 +1158,19 @@ func (m *ListLoansRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListLoansRequestMultiError(errors)
+		return CheckInCopyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLoansRequestMultiError is an error wrapping multiple validation errors
-// returned by ListLoansRequest.ValidateAll() if the designated constraints
+// CheckInCopyRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckInCopyRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListLoansRequestMultiError []error
+type CheckInCopyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLoansRequestMultiError) Error() string {
+func (m CheckInCopyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1025,11 +1179,11 @@ func (m ListLoansRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLoansRequestMultiError) AllErrors() []error { return m }
+func (m CheckInCopyRequestMultiError) AllErrors() []error { return m }
 
-// ListLoansRequestValidationError is the validation error returned by
-// ListLoansRequest.Validate if the designated constraints aren't met.
-type ListLoansRequestValidationError struct {
+// CheckInCopyRequestValidationError is the validation error returned by
+// CheckInCopyRequest.Validate if the designated constraints aren't met.
+type CheckInCopyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1037,22 +1191,24 @@ type ListLoansRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLoansRequestValidationError) Field() string { return e.field }
+func (e CheckInCopyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLoansRequestValidationError) Reason() string { return e.reason }
+func (e CheckInCopyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLoansRequestValidationError) Cause() error { return e.cause }
+func (e CheckInCopyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLoansRequestValidationError) Key() bool { return e.key }
+func (e CheckInCopyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLoansRequestValidationError) ErrorName() string { return "ListLoansRequestValidationError" }
+func (e CheckInCopyRequestValidationError) ErrorName() string {
+	return "CheckInCopyRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListLoansRequestValidationError) Error() string {
+func (e CheckInCopyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1064,14 +1220,14 @@ func (e ListLoansRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLoansRequest.%s: %s%s",
+		"invalid %sCheckInCopyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLoansRequestValidationError{}
+var _ error = CheckInCopyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1079,72 +1235,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLoansRequestValidationError{}
+} = CheckInCopyRequestValidationError{}
+
+var _CheckInCopyRequest_Condition_InLookup = map[string]struct{}{
+	"":        {},
+	"NEW":     {},
+	"GOOD":    {},
+	"FAIR":    {},
+	"POOR":    {},
+	"DAMAGED": {},
+}
 
-// Validate checks the field values on LoanResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LoanResponse) Validate() error {
+// Validate checks the field values on GetLoanHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLoanHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoanResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LoanResponseMultiError, or
-// nil if none found.
-func (m *LoanResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetLoanHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLoanHistoryRequestMultiError, or nil if none found.
+func (m *GetLoanHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoanResponse) validate(all bool) error {
+func (m *GetLoanHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetLoan()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LoanResponseValidationError{
-					field:  "Loan",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, LoanResponseValidationError{
-					field:  "Loan",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetLoanHistoryRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetLoan()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return LoanResponseValidationError{
-				field:  "Loan",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return LoanResponseMultiError(errors)
+		return GetLoanHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoanResponseMultiError is an error wrapping multiple validation errors
-// returned by LoanResponse.ValidateAll() if the designated constraints aren't met.
-type LoanResponseMultiError []error
+// GetLoanHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLoanHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLoanHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoanResponseMultiError) Error() string {
+func (m GetLoanHistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1153,11 +1301,11 @@ func (m LoanResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoanResponseMultiError) AllErrors() []error { return m }
+func (m GetLoanHistoryRequestMultiError) AllErrors() []error { return m }
 
-// LoanResponseValidationError is the validation error returned by
-// LoanResponse.Validate if the designated constraints aren't met.
-type LoanResponseValidationError struct {
+// GetLoanHistoryRequestValidationError is the validation error returned by
+// GetLoanHistoryRequest.Validate if the designated constraints aren't met.
+type GetLoanHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1165,22 +1313,24 @@ type LoanResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoanResponseValidationError) Field() string { return e.field }
+func (e GetLoanHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoanResponseValidationError) Reason() string { return e.reason }
+func (e GetLoanHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoanResponseValidationError) Cause() error { return e.cause }
+func (e GetLoanHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoanResponseValidationError) Key() bool { return e.key }
+func (e GetLoanHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoanResponseValidationError) ErrorName() string { return "LoanResponseValidationError" }
+func (e GetLoanHistoryRequestValidationError) ErrorName() string {
+	return "GetLoanHistoryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LoanResponseValidationError) Error() string {
+func (e GetLoanHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1192,14 +1342,14 @@ func (e LoanResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoanResponse.%s: %s%s",
+		"invalid %sGetLoanHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoanResponseValidationError{}
+var _ error = GetLoanHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1207,58 +1357,161 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoanResponseValidationError{}
+} = GetLoanHistoryRequestValidationError{}
 
-// Validate checks the field values on ListLoansResponse with the rules defined
+// Validate checks the field values on LoanStatusChange with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListLoansResponse) Validate() error {
+func (m *LoanStatusChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLoansResponse with the rules
+// ValidateAll checks the field values on LoanStatusChange with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLoansResponseMultiError, or nil if none found.
-func (m *ListLoansResponse) ValidateAll() error {
+// LoanStatusChangeMultiError, or nil if none found.
+func (m *LoanStatusChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLoansResponse) validate(all bool) error {
+func (m *LoanStatusChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetLoans()) < 1 {
-		err := ListLoansResponseValidationError{
-			field:  "Loans",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	// no validation rules for Id
+
+	// no validation rules for LoanId
+
+	// no validation rules for FromStatus
+
+	// no validation rules for ToStatus
+
+	// no validation rules for ChangedBy
+
+	// no validation rules for Note
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LoanStatusChangeMultiError(errors)
 	}
 
-	for idx, item := range m.GetLoans() {
+	return nil
+}
+
+// LoanStatusChangeMultiError is an error wrapping multiple validation errors
+// returned by LoanStatusChange.ValidateAll() if the designated constraints
+// aren't met.
+type LoanStatusChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoanStatusChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoanStatusChangeMultiError) AllErrors() []error { return m }
+
+// LoanStatusChangeValidationError is the validation error returned by
+// LoanStatusChange.Validate if the designated constraints aren't met.
+type LoanStatusChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoanStatusChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoanStatusChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoanStatusChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoanStatusChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoanStatusChangeValidationError) ErrorName() string { return "LoanStatusChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoanStatusChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoanStatusChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoanStatusChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoanStatusChangeValidationError{}
+
+// Validate checks the field values on LoanHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LoanHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoanHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LoanHistoryResponseMultiError, or nil if none found.
+func (m *LoanHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoanHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHistory() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListLoansResponseValidationError{
-						field:  fmt.Sprintf("Loans[%v]", idx),
+					errors = append(errors, LoanHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListLoansResponseValidationError{
-						field:  fmt.Sprintf("Loans[%v]", idx),
+					errors = append(errors, LoanHistoryResponseValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1266,8 +1519,8 @@ func (m *ListLoansResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListLoansResponseValidationError{
-					field:  fmt.Sprintf("Loans[%v]", idx),
+				return LoanHistoryResponseValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1276,24 +1529,20 @@ func (m *ListLoansResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalItems
-
-	// no validation rules for TotalPages
-
 	if len(errors) > 0 {
-		return ListLoansResponseMultiError(errors)
+		return LoanHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLoansResponseMultiError is an error wrapping multiple validation errors
-// returned by ListLoansResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListLoansResponseMultiError []error
+// LoanHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by LoanHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type LoanHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLoansResponseMultiError) Error() string {
+func (m LoanHistoryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1302,11 +1551,11 @@ func (m ListLoansResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLoansResponseMultiError) AllErrors() []error { return m }
+func (m LoanHistoryResponseMultiError) AllErrors() []error { return m }
 
-// ListLoansResponseValidationError is the validation error returned by
-// ListLoansResponse.Validate if the designated constraints aren't met.
-type ListLoansResponseValidationError struct {
+// LoanHistoryResponseValidationError is the validation error returned by
+// LoanHistoryResponse.Validate if the designated constraints aren't met.
+type LoanHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1314,24 +1563,24 @@ type ListLoansResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLoansResponseValidationError) Field() string { return e.field }
+func (e LoanHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLoansResponseValidationError) Reason() string { return e.reason }
+func (e LoanHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLoansResponseValidationError) Cause() error { return e.cause }
+func (e LoanHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLoansResponseValidationError) Key() bool { return e.key }
+func (e LoanHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLoansResponseValidationError) ErrorName() string {
-	return "ListLoansResponseValidationError"
+func (e LoanHistoryResponseValidationError) ErrorName() string {
+	return "LoanHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLoansResponseValidationError) Error() string {
+func (e LoanHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1343,14 +1592,14 @@ func (e ListLoansResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLoansResponse.%s: %s%s",
+		"invalid %sLoanHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLoansResponseValidationError{}
+var _ error = LoanHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1358,34 +1607,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLoansResponseValidationError{}
+} = LoanHistoryResponseValidationError{}
 
-// Validate checks the field values on GetUserLoansByStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUserLoansByStatusRequest) Validate() error {
+// Validate checks the field values on LoanListOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LoanListOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserLoansByStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LoanListOptions with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserLoansByStatusRequestMultiError, or nil if none found.
-func (m *GetUserLoansByStatusRequest) ValidateAll() error {
+// LoanListOptionsMultiError, or nil if none found.
+func (m *LoanListOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserLoansByStatusRequest) validate(all bool) error {
+func (m *LoanListOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := GetUserLoansByStatusRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
+	if _, ok := _LoanListOptions_SortBy_InLookup[m.GetSortBy()]; !ok {
+		err := LoanListOptionsValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ loan_date updated_at]",
 		}
 		if !all {
 			return err
@@ -1393,10 +1642,10 @@ func (m *GetUserLoansByStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetStatus()) < 1 {
-		err := GetUserLoansByStatusRequestValidationError{
-			field:  "Status",
-			reason: "value length must be at least 1 runes",
+	if _, ok := _LoanListOptions_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		err := LoanListOptionsValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
 		}
 		if !all {
 			return err
@@ -1404,10 +1653,10 @@ func (m *GetUserLoansByStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPage() < 1 {
-		err := GetUserLoansByStatusRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than or equal to 1",
+	if m.GetFromDate() < 0 {
+		err := LoanListOptionsValidationError{
+			field:  "FromDate",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1415,10 +1664,10 @@ func (m *GetUserLoansByStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() < 1 {
-		err := GetUserLoansByStatusRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be greater than or equal to 1",
+	if m.GetToDate() < 0 {
+		err := LoanListOptionsValidationError{
+			field:  "ToDate",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1426,20 +1675,22 @@ func (m *GetUserLoansByStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return GetUserLoansByStatusRequestMultiError(errors)
+		return LoanListOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserLoansByStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetUserLoansByStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetUserLoansByStatusRequestMultiError []error
+// LoanListOptionsMultiError is an error wrapping multiple validation errors
+// returned by LoanListOptions.ValidateAll() if the designated constraints
+// aren't met.
+type LoanListOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserLoansByStatusRequestMultiError) Error() string {
+func (m LoanListOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1448,12 +1699,11 @@ func (m GetUserLoansByStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserLoansByStatusRequestMultiError) AllErrors() []error { return m }
+func (m LoanListOptionsMultiError) AllErrors() []error { return m }
 
-// GetUserLoansByStatusRequestValidationError is the validation error returned
-// by GetUserLoansByStatusRequest.Validate if the designated constraints
-// aren't met.
-type GetUserLoansByStatusRequestValidationError struct {
+// LoanListOptionsValidationError is the validation error returned by
+// LoanListOptions.Validate if the designated constraints aren't met.
+type LoanListOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1461,24 +1711,22 @@ type GetUserLoansByStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserLoansByStatusRequestValidationError) Field() string { return e.field }
+func (e LoanListOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserLoansByStatusRequestValidationError) Reason() string { return e.reason }
+func (e LoanListOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserLoansByStatusRequestValidationError) Cause() error { return e.cause }
+func (e LoanListOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserLoansByStatusRequestValidationError) Key() bool { return e.key }
+func (e LoanListOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserLoansByStatusRequestValidationError) ErrorName() string {
-	return "GetUserLoansByStatusRequestValidationError"
-}
+func (e LoanListOptionsValidationError) ErrorName() string { return "LoanListOptionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetUserLoansByStatusRequestValidationError) Error() string {
+func (e LoanListOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1490,14 +1738,14 @@ func (e GetUserLoansByStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserLoansByStatusRequest.%s: %s%s",
+		"invalid %sLoanListOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserLoansByStatusRequestValidationError{}
+var _ error = LoanListOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1505,33 +1753,2266 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserLoansByStatusRequestValidationError{}
+} = LoanListOptionsValidationError{}
 
-// Validate checks the field values on GetLoansByStatusRequest with the rules
+var _LoanListOptions_SortBy_InLookup = map[string]struct{}{
+	"":           {},
+	"loan_date":  {},
+	"updated_at": {},
+}
+
+var _LoanListOptions_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on ListUserLoansRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLoansByStatusRequest) Validate() error {
+func (m *ListUserLoansRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLoansByStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUserLoansRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLoansByStatusRequestMultiError, or nil if none found.
-func (m *GetLoansByStatusRequest) ValidateAll() error {
+// ListUserLoansRequestMultiError, or nil if none found.
+func (m *ListUserLoansRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLoansByStatusRequest) validate(all bool) error {
+func (m *ListUserLoansRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetStatus()) < 1 {
-		err := GetLoansByStatusRequestValidationError{
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ListUserLoansRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 1 {
+		err := ListUserLoansRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListUserLoansRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUserLoansRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUserLoansRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUserLoansRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListUserLoansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserLoansRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserLoansRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserLoansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserLoansRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserLoansRequestMultiError) AllErrors() []error { return m }
+
+// ListUserLoansRequestValidationError is the validation error returned by
+// ListUserLoansRequest.Validate if the designated constraints aren't met.
+type ListUserLoansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserLoansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserLoansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserLoansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserLoansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserLoansRequestValidationError) ErrorName() string {
+	return "ListUserLoansRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserLoansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserLoansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserLoansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserLoansRequestValidationError{}
+
+// Validate checks the field values on ListLoansRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLoansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLoansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLoansRequestMultiError, or nil if none found.
+func (m *ListLoansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLoansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 1 {
+		err := ListLoansRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListLoansRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLoansRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLoansRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLoansRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListLoansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLoansRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLoansRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLoansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLoansRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLoansRequestMultiError) AllErrors() []error { return m }
+
+// ListLoansRequestValidationError is the validation error returned by
+// ListLoansRequest.Validate if the designated constraints aren't met.
+type ListLoansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLoansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLoansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLoansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLoansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLoansRequestValidationError) ErrorName() string { return "ListLoansRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListLoansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLoansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLoansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLoansRequestValidationError{}
+
+// Validate checks the field values on LoanResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoanResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoanResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoanResponseMultiError, or
+// nil if none found.
+func (m *LoanResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoanResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLoan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanResponseValidationError{
+					field:  "Loan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanResponseValidationError{
+					field:  "Loan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanResponseValidationError{
+				field:  "Loan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LoanResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoanResponseMultiError is an error wrapping multiple validation errors
+// returned by LoanResponse.ValidateAll() if the designated constraints aren't met.
+type LoanResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoanResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoanResponseMultiError) AllErrors() []error { return m }
+
+// LoanResponseValidationError is the validation error returned by
+// LoanResponse.Validate if the designated constraints aren't met.
+type LoanResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoanResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoanResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoanResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoanResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoanResponseValidationError) ErrorName() string { return "LoanResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoanResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoanResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoanResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoanResponseValidationError{}
+
+// Validate checks the field values on ListLoansResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListLoansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLoansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLoansResponseMultiError, or nil if none found.
+func (m *ListLoansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLoansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetLoans()) < 1 {
+		err := ListLoansResponseValidationError{
+			field:  "Loans",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetLoans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLoansResponseValidationError{
+						field:  fmt.Sprintf("Loans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLoansResponseValidationError{
+						field:  fmt.Sprintf("Loans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLoansResponseValidationError{
+					field:  fmt.Sprintf("Loans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListLoansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLoansResponseMultiError is an error wrapping multiple validation errors
+// returned by ListLoansResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListLoansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLoansResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLoansResponseMultiError) AllErrors() []error { return m }
+
+// ListLoansResponseValidationError is the validation error returned by
+// ListLoansResponse.Validate if the designated constraints aren't met.
+type ListLoansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLoansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLoansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLoansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLoansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLoansResponseValidationError) ErrorName() string {
+	return "ListLoansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLoansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLoansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLoansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLoansResponseValidationError{}
+
+// Validate checks the field values on GetUserLoansByStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserLoansByStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserLoansByStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserLoansByStatusRequestMultiError, or nil if none found.
+func (m *GetUserLoansByStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserLoansByStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := GetUserLoansByStatusRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetUserLoansByStatusRequest_Status_NotInLookup[m.GetStatus()]; ok {
+		err := GetUserLoansByStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must not be in list [LOAN_STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LoanStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetUserLoansByStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 1 {
+		err := GetUserLoansByStatusRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := GetUserLoansByStatusRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserLoansByStatusRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserLoansByStatusRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserLoansByStatusRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserLoansByStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserLoansByStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserLoansByStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserLoansByStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserLoansByStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserLoansByStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetUserLoansByStatusRequestValidationError is the validation error returned
+// by GetUserLoansByStatusRequest.Validate if the designated constraints
+// aren't met.
+type GetUserLoansByStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserLoansByStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserLoansByStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserLoansByStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserLoansByStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserLoansByStatusRequestValidationError) ErrorName() string {
+	return "GetUserLoansByStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserLoansByStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserLoansByStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserLoansByStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserLoansByStatusRequestValidationError{}
+
+var _GetUserLoansByStatusRequest_Status_NotInLookup = map[LoanStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetLoansByStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLoansByStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLoansByStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLoansByStatusRequestMultiError, or nil if none found.
+func (m *GetLoansByStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLoansByStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GetLoansByStatusRequest_Status_NotInLookup[m.GetStatus()]; ok {
+		err := GetLoansByStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must not be in list [LOAN_STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LoanStatus_name[int32(m.GetStatus())]; !ok {
+		err := GetLoansByStatusRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 1 {
+		err := GetLoansByStatusRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := GetLoansByStatusRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetLoansByStatusRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetLoansByStatusRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetLoansByStatusRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetLoansByStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLoansByStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLoansByStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLoansByStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLoansByStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLoansByStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetLoansByStatusRequestValidationError is the validation error returned by
+// GetLoansByStatusRequest.Validate if the designated constraints aren't met.
+type GetLoansByStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLoansByStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLoansByStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLoansByStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLoansByStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLoansByStatusRequestValidationError) ErrorName() string {
+	return "GetLoansByStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLoansByStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLoansByStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLoansByStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLoansByStatusRequestValidationError{}
+
+var _GetLoansByStatusRequest_Status_NotInLookup = map[LoanStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Fine with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Fine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Fine with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FineMultiError, or nil if none found.
+func (m *Fine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Fine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for LoanId
+
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	// no validation rules for PaidAmount
+
+	// no validation rules for Status
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return FineMultiError(errors)
+	}
+
+	return nil
+}
+
+// FineMultiError is an error wrapping multiple validation errors returned by
+// Fine.ValidateAll() if the designated constraints aren't met.
+type FineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FineMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FineMultiError) AllErrors() []error { return m }
+
+// FineValidationError is the validation error returned by Fine.Validate if the
+// designated constraints aren't met.
+type FineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FineValidationError) ErrorName() string { return "FineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FineValidationError{}
+
+// Validate checks the field values on ListUserFinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserFinesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserFinesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserFinesRequestMultiError, or nil if none found.
+func (m *ListUserFinesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserFinesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ListUserFinesRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListUserFinesRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListUserFinesRequestValidationError{
 			field:  "Status",
+			reason: "value must be in list [ UNPAID PAID WAIVED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 1 {
+		err := ListUserFinesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListUserFinesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserFinesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserFinesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserFinesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserFinesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserFinesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserFinesRequestMultiError) AllErrors() []error { return m }
+
+// ListUserFinesRequestValidationError is the validation error returned by
+// ListUserFinesRequest.Validate if the designated constraints aren't met.
+type ListUserFinesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserFinesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserFinesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserFinesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserFinesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserFinesRequestValidationError) ErrorName() string {
+	return "ListUserFinesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserFinesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserFinesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserFinesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserFinesRequestValidationError{}
+
+var _ListUserFinesRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"UNPAID": {},
+	"PAID":   {},
+	"WAIVED": {},
+}
+
+// Validate checks the field values on ListFinesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFinesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFinesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFinesResponseMultiError, or nil if none found.
+func (m *ListFinesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFinesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFinesResponseValidationError{
+						field:  fmt.Sprintf("Fines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFinesResponseValidationError{
+						field:  fmt.Sprintf("Fines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFinesResponseValidationError{
+					field:  fmt.Sprintf("Fines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	// no validation rules for Outstanding
+
+	if len(errors) > 0 {
+		return ListFinesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFinesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListFinesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListFinesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFinesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFinesResponseMultiError) AllErrors() []error { return m }
+
+// ListFinesResponseValidationError is the validation error returned by
+// ListFinesResponse.Validate if the designated constraints aren't met.
+type ListFinesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFinesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFinesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFinesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFinesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFinesResponseValidationError) ErrorName() string {
+	return "ListFinesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFinesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFinesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFinesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFinesResponseValidationError{}
+
+// Validate checks the field values on PayFineRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PayFineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayFineRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PayFineRequestMultiError,
+// or nil if none found.
+func (m *PayFineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayFineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := PayFineRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := PayFineRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := PayFineRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := PayFineRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PayFineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayFineRequestMultiError is an error wrapping multiple validation errors
+// returned by PayFineRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PayFineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayFineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayFineRequestMultiError) AllErrors() []error { return m }
+
+// PayFineRequestValidationError is the validation error returned by
+// PayFineRequest.Validate if the designated constraints aren't met.
+type PayFineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayFineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayFineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayFineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayFineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayFineRequestValidationError) ErrorName() string { return "PayFineRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PayFineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayFineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayFineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayFineRequestValidationError{}
+
+// Validate checks the field values on WaiveFineRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WaiveFineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WaiveFineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WaiveFineRequestMultiError, or nil if none found.
+func (m *WaiveFineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WaiveFineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := WaiveFineRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := WaiveFineRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := WaiveFineRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WaiveFineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WaiveFineRequestMultiError is an error wrapping multiple validation errors
+// returned by WaiveFineRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WaiveFineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WaiveFineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WaiveFineRequestMultiError) AllErrors() []error { return m }
+
+// WaiveFineRequestValidationError is the validation error returned by
+// WaiveFineRequest.Validate if the designated constraints aren't met.
+type WaiveFineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WaiveFineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WaiveFineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WaiveFineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WaiveFineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WaiveFineRequestValidationError) ErrorName() string { return "WaiveFineRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WaiveFineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWaiveFineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WaiveFineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WaiveFineRequestValidationError{}
+
+// Validate checks the field values on FineResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FineResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FineResponseMultiError, or
+// nil if none found.
+func (m *FineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FineResponseValidationError{
+					field:  "Fine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FineResponseValidationError{
+					field:  "Fine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFine()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FineResponseValidationError{
+				field:  "Fine",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FineResponseMultiError is an error wrapping multiple validation errors
+// returned by FineResponse.ValidateAll() if the designated constraints aren't met.
+type FineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FineResponseMultiError) AllErrors() []error { return m }
+
+// FineResponseValidationError is the validation error returned by
+// FineResponse.Validate if the designated constraints aren't met.
+type FineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FineResponseValidationError) ErrorName() string { return "FineResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FineResponseValidationError{}
+
+// Validate checks the field values on Hold with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Hold) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Hold with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HoldMultiError, or nil if none found.
+func (m *Hold) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Hold) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for BookId
+
+	// no validation rules for Status
+
+	// no validation rules for QueuePosition
+
+	// no validation rules for ReadyAt
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return HoldMultiError(errors)
+	}
+
+	return nil
+}
+
+// HoldMultiError is an error wrapping multiple validation errors returned by
+// Hold.ValidateAll() if the designated constraints aren't met.
+type HoldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HoldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HoldMultiError) AllErrors() []error { return m }
+
+// HoldValidationError is the validation error returned by Hold.Validate if the
+// designated constraints aren't met.
+type HoldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HoldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HoldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HoldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HoldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HoldValidationError) ErrorName() string { return "HoldValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HoldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHold.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HoldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HoldValidationError{}
+
+// Validate checks the field values on PlaceHoldRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PlaceHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaceHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlaceHoldRequestMultiError, or nil if none found.
+func (m *PlaceHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaceHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := PlaceHoldRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = PlaceHoldRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBookId()) < 1 {
+		err := PlaceHoldRequestValidationError{
+			field:  "BookId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PlaceHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *PlaceHoldRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *PlaceHoldRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// PlaceHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by PlaceHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PlaceHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaceHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaceHoldRequestMultiError) AllErrors() []error { return m }
+
+// PlaceHoldRequestValidationError is the validation error returned by
+// PlaceHoldRequest.Validate if the designated constraints aren't met.
+type PlaceHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaceHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaceHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaceHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaceHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaceHoldRequestValidationError) ErrorName() string { return "PlaceHoldRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PlaceHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaceHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaceHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaceHoldRequestValidationError{}
+
+// Validate checks the field values on CancelHoldRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelHoldRequestMultiError, or nil if none found.
+func (m *CancelHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CancelHoldRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := CancelHoldRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := CancelHoldRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelHoldRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelHoldRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelHoldRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelHoldRequestMultiError) AllErrors() []error { return m }
+
+// CancelHoldRequestValidationError is the validation error returned by
+// CancelHoldRequest.Validate if the designated constraints aren't met.
+type CancelHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelHoldRequestValidationError) ErrorName() string {
+	return "CancelHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelHoldRequestValidationError{}
+
+// Validate checks the field values on ListUserHoldsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserHoldsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserHoldsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserHoldsRequestMultiError, or nil if none found.
+func (m *ListUserHoldsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserHoldsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ListUserHoldsRequestValidationError{
+			field:  "UserId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1540,8 +4021,19 @@ func (m *GetLoansByStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _ListUserHoldsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListUserHoldsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ WAITING READY FULFILLED CANCELLED EXPIRED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.GetPage() < 1 {
-		err := GetLoansByStatusRequestValidationError{
+		err := ListUserHoldsRequestValidationError{
 			field:  "Page",
 			reason: "value must be greater than or equal to 1",
 		}
@@ -1551,10 +4043,10 @@ func (m *GetLoansByStatusRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPageSize() < 1 {
-		err := GetLoansByStatusRequestValidationError{
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListUserHoldsRequestValidationError{
 			field:  "PageSize",
-			reason: "value must be greater than or equal to 1",
+			reason: "value must be inside range [1, 100]",
 		}
 		if !all {
 			return err
@@ -1563,19 +4055,19 @@ func (m *GetLoansByStatusRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetLoansByStatusRequestMultiError(errors)
+		return ListUserHoldsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetLoansByStatusRequestMultiError is an error wrapping multiple validation
-// errors returned by GetLoansByStatusRequest.ValidateAll() if the designated
+// ListUserHoldsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserHoldsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetLoansByStatusRequestMultiError []error
+type ListUserHoldsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetLoansByStatusRequestMultiError) Error() string {
+func (m ListUserHoldsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1584,11 +4076,11 @@ func (m GetLoansByStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetLoansByStatusRequestMultiError) AllErrors() []error { return m }
+func (m ListUserHoldsRequestMultiError) AllErrors() []error { return m }
 
-// GetLoansByStatusRequestValidationError is the validation error returned by
-// GetLoansByStatusRequest.Validate if the designated constraints aren't met.
-type GetLoansByStatusRequestValidationError struct {
+// ListUserHoldsRequestValidationError is the validation error returned by
+// ListUserHoldsRequest.Validate if the designated constraints aren't met.
+type ListUserHoldsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1596,24 +4088,24 @@ type GetLoansByStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetLoansByStatusRequestValidationError) Field() string { return e.field }
+func (e ListUserHoldsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetLoansByStatusRequestValidationError) Reason() string { return e.reason }
+func (e ListUserHoldsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetLoansByStatusRequestValidationError) Cause() error { return e.cause }
+func (e ListUserHoldsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetLoansByStatusRequestValidationError) Key() bool { return e.key }
+func (e ListUserHoldsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetLoansByStatusRequestValidationError) ErrorName() string {
-	return "GetLoansByStatusRequestValidationError"
+func (e ListUserHoldsRequestValidationError) ErrorName() string {
+	return "ListUserHoldsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetLoansByStatusRequestValidationError) Error() string {
+func (e ListUserHoldsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1625,14 +4117,14 @@ func (e GetLoansByStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetLoansByStatusRequest.%s: %s%s",
+		"invalid %sListUserHoldsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetLoansByStatusRequestValidationError{}
+var _ error = ListUserHoldsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1640,4 +4132,281 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetLoansByStatusRequestValidationError{}
+} = ListUserHoldsRequestValidationError{}
+
+var _ListUserHoldsRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"WAITING":   {},
+	"READY":     {},
+	"FULFILLED": {},
+	"CANCELLED": {},
+	"EXPIRED":   {},
+}
+
+// Validate checks the field values on HoldResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HoldResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HoldResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HoldResponseMultiError, or
+// nil if none found.
+func (m *HoldResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HoldResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetHold()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HoldResponseValidationError{
+					field:  "Hold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HoldResponseValidationError{
+					field:  "Hold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHold()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HoldResponseValidationError{
+				field:  "Hold",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HoldResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// HoldResponseMultiError is an error wrapping multiple validation errors
+// returned by HoldResponse.ValidateAll() if the designated constraints aren't met.
+type HoldResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HoldResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HoldResponseMultiError) AllErrors() []error { return m }
+
+// HoldResponseValidationError is the validation error returned by
+// HoldResponse.Validate if the designated constraints aren't met.
+type HoldResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HoldResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HoldResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HoldResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HoldResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HoldResponseValidationError) ErrorName() string { return "HoldResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HoldResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHoldResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HoldResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HoldResponseValidationError{}
+
+// Validate checks the field values on ListHoldsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListHoldsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHoldsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHoldsResponseMultiError, or nil if none found.
+func (m *ListHoldsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHoldsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHolds() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListHoldsResponseValidationError{
+						field:  fmt.Sprintf("Holds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListHoldsResponseValidationError{
+						field:  fmt.Sprintf("Holds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHoldsResponseValidationError{
+					field:  fmt.Sprintf("Holds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalItems
+
+	// no validation rules for TotalPages
+
+	if len(errors) > 0 {
+		return ListHoldsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHoldsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListHoldsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListHoldsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHoldsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHoldsResponseMultiError) AllErrors() []error { return m }
+
+// ListHoldsResponseValidationError is the validation error returned by
+// ListHoldsResponse.Validate if the designated constraints aren't met.
+type ListHoldsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHoldsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHoldsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHoldsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHoldsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHoldsResponseValidationError) ErrorName() string {
+	return "ListHoldsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHoldsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHoldsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHoldsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHoldsResponseValidationError{}
@@ -25,6 +25,11 @@ type UserServiceClient interface {
 	GetUserById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error)
 	GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...grpc.CallOption) (*GetUserByEmailResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	GetUserEntitlements(ctx context.Context, in *GetUserEntitlementsRequest, opts ...grpc.CallOption) (*GetUserEntitlementsResponse, error)
+	ListMembershipTiers(ctx context.Context, in *ListMembershipTiersRequest, opts ...grpc.CallOption) (*ListMembershipTiersResponse, error)
+	CreateMembershipTier(ctx context.Context, in *CreateMembershipTierRequest, opts ...grpc.CallOption) (*MembershipTierResponse, error)
+	UpdateMembershipTier(ctx context.Context, in *UpdateMembershipTierRequest, opts ...grpc.CallOption) (*MembershipTierResponse, error)
+	AssignMembershipTier(ctx context.Context, in *AssignMembershipTierRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error)
 }
 
 type userServiceClient struct {
@@ -62,6 +67,51 @@ func (c *userServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserEntitlements(ctx context.Context, in *GetUserEntitlementsRequest, opts ...grpc.CallOption) (*GetUserEntitlementsResponse, error) {
+	out := new(GetUserEntitlementsResponse)
+	err := c.cc.Invoke(ctx, "/user_service.UserService/GetUserEntitlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListMembershipTiers(ctx context.Context, in *ListMembershipTiersRequest, opts ...grpc.CallOption) (*ListMembershipTiersResponse, error) {
+	out := new(ListMembershipTiersResponse)
+	err := c.cc.Invoke(ctx, "/user_service.UserService/ListMembershipTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateMembershipTier(ctx context.Context, in *CreateMembershipTierRequest, opts ...grpc.CallOption) (*MembershipTierResponse, error) {
+	out := new(MembershipTierResponse)
+	err := c.cc.Invoke(ctx, "/user_service.UserService/CreateMembershipTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateMembershipTier(ctx context.Context, in *UpdateMembershipTierRequest, opts ...grpc.CallOption) (*MembershipTierResponse, error) {
+	out := new(MembershipTierResponse)
+	err := c.cc.Invoke(ctx, "/user_service.UserService/UpdateMembershipTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AssignMembershipTier(ctx context.Context, in *AssignMembershipTierRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error) {
+	out := new(GetUserByIdResponse)
+	err := c.cc.Invoke(ctx, "/user_service.UserService/AssignMembershipTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -69,6 +119,11 @@ type UserServiceServer interface {
 	GetUserById(context.Context, *GetUserByIdRequest) (*GetUserByIdResponse, error)
 	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*GetUserByEmailResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	GetUserEntitlements(context.Context, *GetUserEntitlementsRequest) (*GetUserEntitlementsResponse, error)
+	ListMembershipTiers(context.Context, *ListMembershipTiersRequest) (*ListMembershipTiersResponse, error)
+	CreateMembershipTier(context.Context, *CreateMembershipTierRequest) (*MembershipTierResponse, error)
+	UpdateMembershipTier(context.Context, *UpdateMembershipTierRequest) (*MembershipTierResponse, error)
+	AssignMembershipTier(context.Context, *AssignMembershipTierRequest) (*GetUserByIdResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -85,6 +140,21 @@ func (UnimplementedUserServiceServer) GetUserByEmail(context.Context, *GetUserBy
 func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserEntitlements(context.Context, *GetUserEntitlementsRequest) (*GetUserEntitlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserEntitlements not implemented")
+}
+func (UnimplementedUserServiceServer) ListMembershipTiers(context.Context, *ListMembershipTiersRequest) (*ListMembershipTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembershipTiers not implemented")
+}
+func (UnimplementedUserServiceServer) CreateMembershipTier(context.Context, *CreateMembershipTierRequest) (*MembershipTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMembershipTier not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateMembershipTier(context.Context, *UpdateMembershipTierRequest) (*MembershipTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembershipTier not implemented")
+}
+func (UnimplementedUserServiceServer) AssignMembershipTier(context.Context, *AssignMembershipTierRequest) (*GetUserByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignMembershipTier not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +222,96 @@ func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserEntitlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserEntitlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.UserService/GetUserEntitlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserEntitlements(ctx, req.(*GetUserEntitlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListMembershipTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembershipTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListMembershipTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.UserService/ListMembershipTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListMembershipTiers(ctx, req.(*ListMembershipTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateMembershipTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMembershipTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateMembershipTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.UserService/CreateMembershipTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateMembershipTier(ctx, req.(*CreateMembershipTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateMembershipTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMembershipTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateMembershipTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.UserService/UpdateMembershipTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateMembershipTier(ctx, req.(*UpdateMembershipTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AssignMembershipTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignMembershipTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AssignMembershipTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user_service.UserService/AssignMembershipTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AssignMembershipTier(ctx, req.(*AssignMembershipTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +331,26 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _UserService_ListUsers_Handler,
 		},
+		{
+			MethodName: "GetUserEntitlements",
+			Handler:    _UserService_GetUserEntitlements_Handler,
+		},
+		{
+			MethodName: "ListMembershipTiers",
+			Handler:    _UserService_ListMembershipTiers_Handler,
+		},
+		{
+			MethodName: "CreateMembershipTier",
+			Handler:    _UserService_CreateMembershipTier_Handler,
+		},
+		{
+			MethodName: "UpdateMembershipTier",
+			Handler:    _UserService_UpdateMembershipTier_Handler,
+		},
+		{
+			MethodName: "AssignMembershipTier",
+			Handler:    _UserService_AssignMembershipTier_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user_service.proto",
@@ -6,9 +6,26 @@ CREATE TABLE IF NOT EXISTS authors (
     biography VARCHAR(255) NOT NULL,
     version INT NOT NULL DEFAULT 1,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE -- set while the author is in the trash
 );
 
+-- Backs the case-insensitive lookup by name used by catalogue imports
+CREATE INDEX idx_author_name_lower ON authors (LOWER(name));
+
+-- Backs the trash listing and the purge of authors deleted before the retention period
+CREATE INDEX idx_author_deleted_at ON authors (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- IDs of the authors merged into another, GetAuthor follows them to the survivor.
+-- Purging the survivor drops its redirects, a merged author is never restored.
+CREATE TABLE IF NOT EXISTS author_redirects (
+    old_id UUID PRIMARY KEY,
+    survivor_id UUID NOT NULL REFERENCES authors (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_author_redirect_survivor_id ON author_redirects (survivor_id);
+
 -- Membuat fungsi trigger untuk memperbarui kolom updated_at dan menaikkan version
 CREATE OR REPLACE FUNCTION update_updated_at_and_version_authors()
 RETURNS TRIGGER AS $$
@@ -1,16 +1,40 @@
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
 
 CREATE TABLE IF NOT EXISTS books (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     title VARCHAR(255) NOT NULL,
-    author_id UUID NOT NULL,
     category_id UUID NOT NULL,
-    stock INT NOT NULL CHECK (stock >= 0),
+    publication_year SMALLINT CHECK (publication_year BETWEEN 1 AND 9999),
+    -- ISBN-10 numbers are stored converted to ISBN-13 so both forms of the same book collide
+    isbn VARCHAR(13) CHECK (isbn ~ '^97[89][0-9]{10}$'),
+    publisher VARCHAR(255) NOT NULL DEFAULT '',
+    language VARCHAR(8) NOT NULL DEFAULT '',
+    page_count INT CHECK (page_count > 0),
+    edition VARCHAR(50) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    -- The 'simple' configuration does not stem, so titles in any language are indexed the same way.
+    -- Title matches rank above description matches.
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', description), 'B')
+    ) STORED,
     version INT NOT NULL DEFAULT 1,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE -- set while the book is in the trash
 );
 
+-- Only books outside the trash must have distinct ISBNs, a deleted book cannot be restored while its ISBN is taken
+CREATE UNIQUE INDEX books_isbn_key ON books (isbn) WHERE deleted_at IS NULL;
+
+-- Backs the trash listing and the purge of books deleted before the retention period
+CREATE INDEX idx_book_deleted_at ON books (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Full-text matches on the title and description, the trigram index backs the typo-tolerant fallback
+CREATE INDEX idx_book_search_vector ON books USING GIN (search_vector);
+CREATE INDEX idx_book_title_trgm ON books USING GIN (title gin_trgm_ops);
+CREATE INDEX idx_book_publication_year ON books (publication_year);
+
 -- Membuat fungsi trigger untuk memperbarui kolom updated_at dan menaikkan version
 CREATE OR REPLACE FUNCTION update_updated_at_and_version_books()
 RETURNS TRIGGER AS $$
@@ -25,4 +49,59 @@ $$ LANGUAGE plpgsql;
 CREATE TRIGGER set_updated_at_and_version_books
 BEFORE UPDATE ON books
 FOR EACH ROW
-EXECUTE FUNCTION update_updated_at_and_version_books();
\ No newline at end of file
+EXECUTE FUNCTION update_updated_at_and_version_books();
+-- Authors credited on a book, position is the 1-based credit order
+CREATE TABLE IF NOT EXISTS book_authors (
+    book_id UUID NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'author' CHECK (role IN ('author', 'editor', 'translator')),
+    position SMALLINT NOT NULL CHECK (position >= 1),
+    PRIMARY KEY (book_id, author_id, role),
+    CONSTRAINT book_authors_position_key UNIQUE (book_id, position)
+);
+
+-- Backs the lookup of the books of an author
+CREATE INDEX idx_book_authors_author_id ON book_authors (author_id);
+
+-- Physical copies of a book, the book stock is the number of AVAILABLE copies
+CREATE TABLE IF NOT EXISTS book_copies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    book_id UUID NOT NULL REFERENCES books (id) ON DELETE CASCADE,
+    barcode VARCHAR(64) NOT NULL UNIQUE,
+    condition VARCHAR(20) NOT NULL DEFAULT 'GOOD' CHECK (condition IN ('NEW', 'GOOD', 'FAIR', 'POOR', 'DAMAGED')),
+    shelf_location VARCHAR(100) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'AVAILABLE' CHECK (status IN ('AVAILABLE', 'ON_LOAN', 'RETIRED')),
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Backs the stock count and the lookup of an available copy to check out
+CREATE INDEX idx_book_copy_book_id_status ON book_copies (book_id, status);
+
+CREATE TRIGGER set_updated_at_and_version_book_copies
+BEFORE UPDATE ON book_copies
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_and_version_books();
+
+-- Catalogue imports run in the background, the uploaded file is kept until the job ends
+CREATE TABLE IF NOT EXISTS book_import_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    format VARCHAR(10) NOT NULL CHECK (format IN ('csv', 'marc21')),
+    file_name VARCHAR(255) NOT NULL DEFAULT '',
+    payload BYTEA,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'RUNNING', 'COMPLETED', 'FAILED')),
+    total_rows INT NOT NULL DEFAULT 0,
+    imported_rows INT NOT NULL DEFAULT 0,
+    failed_rows INT NOT NULL DEFAULT 0,
+    row_errors JSONB NOT NULL DEFAULT '[]',
+    error TEXT NOT NULL DEFAULT '',
+    requested_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Backs claiming the oldest pending job and spotting running jobs that stopped making progress
+CREATE INDEX idx_book_import_job_status ON book_import_jobs (status, created_at);
@@ -1,12 +1,35 @@
-INSERT INTO books (id, title, author_id, category_id, stock, created_at, updated_at)
-VALUES
-    (uuid_generate_v4(), 'Clean Code', 'a99926f2-100d-41a9-83f5-e35a186ef838', 'a556f178-600d-46f2-87f6-b88f621f7843', 10, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'The Pragmatic Programmer', 'b111b734-200e-42b8-93c2-f44b287cf839', 'a556f178-600d-46f2-87f6-b88f621f7843', 8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Design Patterns', 'c223c845-300f-43c9-84d3-b55c398d6840', 'b667e289-700e-47f3-98f7-b99f732f7844', 5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Refactoring', 'd334d956-400a-44d0-95e4-b66d409e6841', 'b667e289-700e-47f3-98f7-b99f732f7844', 12, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'You Don’t Know JS', 'e445e067-500b-45e1-86f5-b77e510f6842', 'a556f178-600d-46f2-87f6-b88f621f7843', 20, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Introduction to Algorithms', 'd334d956-400a-44d0-95e4-b66d409e6841', 'c778f390-800f-48f4-89f8-b00f843f7845', 7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Grokking Algorithms', 'c223c845-300f-43c9-84d3-b55c398d6840', 'c778f390-800f-48f4-89f8-b00f843f7845', 6, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Head First Design Patterns', 'c223c845-300f-43c9-84d3-b55c398d6840', 'b667e289-700e-47f3-98f7-b99f732f7844', 15, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Cracking the Coding Interview', 'b111b734-200e-42b8-93c2-f44b287cf839', 'c778f390-800f-48f4-89f8-b00f843f7845', 9, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
-    (uuid_generate_v4(), 'Fluent Python', 'e445e067-500b-45e1-86f5-b77e510f6842', 'a556f178-600d-46f2-87f6-b88f621f7843', 14, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+WITH seeded_books (title, author_id, category_id, publication_year, isbn, publisher, page_count, copies) AS (
+    VALUES
+        ('Clean Code', 'a99926f2-100d-41a9-83f5-e35a186ef838', 'a556f178-600d-46f2-87f6-b88f621f7843', 2008, '9780132350884', 'Prentice Hall', 464, 10),
+        ('The Pragmatic Programmer', 'b111b734-200e-42b8-93c2-f44b287cf839', 'a556f178-600d-46f2-87f6-b88f621f7843', 1999, '9780201616224', 'Addison-Wesley', 352, 8),
+        ('Design Patterns', 'c223c845-300f-43c9-84d3-b55c398d6840', 'b667e289-700e-47f3-98f7-b99f732f7844', 1994, '9780201633610', 'Addison-Wesley', 395, 5),
+        ('Refactoring', 'd334d956-400a-44d0-95e4-b66d409e6841', 'b667e289-700e-47f3-98f7-b99f732f7844', 1999, '9780201485677', 'Addison-Wesley', 431, 12),
+        ('You Don’t Know JS', 'e445e067-500b-45e1-86f5-b77e510f6842', 'a556f178-600d-46f2-87f6-b88f621f7843', 2014, '9781491924464', 'O''Reilly Media', 88, 20),
+        ('Introduction to Algorithms', 'd334d956-400a-44d0-95e4-b66d409e6841', 'c778f390-800f-48f4-89f8-b00f843f7845', 1990, '9780262031417', 'MIT Press', 1028, 7),
+        ('Grokking Algorithms', 'c223c845-300f-43c9-84d3-b55c398d6840', 'c778f390-800f-48f4-89f8-b00f843f7845', 2016, '9781617292231', 'Manning', 256, 6),
+        ('Head First Design Patterns', 'c223c845-300f-43c9-84d3-b55c398d6840', 'b667e289-700e-47f3-98f7-b99f732f7844', 2004, '9780596007126', 'O''Reilly Media', 694, 15),
+        ('Cracking the Coding Interview', 'b111b734-200e-42b8-93c2-f44b287cf839', 'c778f390-800f-48f4-89f8-b00f843f7845', 2008, NULL, 'CareerCup', NULL, 9),
+        ('Fluent Python', 'e445e067-500b-45e1-86f5-b77e510f6842', 'a556f178-600d-46f2-87f6-b88f621f7843', 2015, '9781491946008', 'O''Reilly Media', 792, 14)
+),
+inserted_books AS (
+    INSERT INTO books (id, title, category_id, publication_year, isbn, publisher, language, page_count, created_at, updated_at)
+    SELECT uuid_generate_v4(), title, category_id::UUID, publication_year, isbn, publisher, 'en', page_count, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+    FROM seeded_books
+    RETURNING id, title
+),
+inserted_authors AS (
+    INSERT INTO book_authors (book_id, author_id, role, position)
+    SELECT b.id, s.author_id::UUID, 'author', 1
+    FROM inserted_books b
+    JOIN seeded_books s ON s.title = b.title
+)
+-- One barcoded copy per unit of the former stock
+INSERT INTO book_copies (book_id, barcode, condition, shelf_location)
+SELECT
+    b.id,
+    'BC-' || UPPER(SUBSTRING(REPLACE(b.id::TEXT, '-', '') FROM 1 FOR 8)) || '-' || LPAD(n::TEXT, 3, '0'),
+    'GOOD',
+    'A-' || LPAD(DENSE_RANK() OVER (ORDER BY b.title)::TEXT, 2, '0')
+FROM inserted_books b
+JOIN seeded_books s ON s.title = b.title
+CROSS JOIN LATERAL generate_series(1, s.copies) AS n;
@@ -3,11 +3,33 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 CREATE TABLE IF NOT EXISTS categories (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     name VARCHAR(50) NOT NULL,
+    parent_id UUID REFERENCES categories(id) ON DELETE RESTRICT, -- NULL for a top-level category
     version INT NOT NULL DEFAULT 1,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE, -- set while the category is in the trash
+    CONSTRAINT categories_parent_not_self CHECK (parent_id <> id)
 );
 
+-- Backs the lookup of the subcategories of a category
+CREATE INDEX idx_category_parent_id ON categories (parent_id);
+
+-- Backs the case-insensitive lookup by name used by catalogue imports
+CREATE INDEX idx_category_name_lower ON categories (LOWER(name));
+
+-- Backs the trash listing and the purge of categories deleted before the retention period
+CREATE INDEX idx_category_deleted_at ON categories (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- IDs of the categories merged into another, GetCategory follows them to the survivor.
+-- Purging the survivor drops its redirects, a merged category is never restored.
+CREATE TABLE IF NOT EXISTS category_redirects (
+    old_id UUID PRIMARY KEY,
+    survivor_id UUID NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_category_redirect_survivor_id ON category_redirects (survivor_id);
+
 -- Membuat fungsi trigger untuk memperbarui kolom updated_at dan menaikkan version
 CREATE OR REPLACE FUNCTION update_updated_at_and_version_categories()
 RETURNS TRIGGER AS $$
@@ -4,9 +4,12 @@ CREATE TABLE IF NOT EXISTS loans (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     user_id UUID NOT NULL,
     book_id UUID NOT NULL,
+    copy_id UUID NOT NULL, -- Physical copy lent, see book_copies in the book service
     loan_date TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    due_date TIMESTAMP WITH TIME ZONE NOT NULL,
     return_date TIMESTAMP WITH TIME ZONE,
-    status VARCHAR(50) NOT NULL DEFAULT 'BORROWED', -- Status of the loan (e.g., BORROWED, RETURNED, LOST)
+    renewal_count INT NOT NULL DEFAULT 0 CHECK (renewal_count >= 0),
+    status VARCHAR(50) NOT NULL DEFAULT 'BORROWED' CHECK (status IN ('BORROWED', 'RETURNED', 'OVERDUE', 'LOST', 'FOUND')), -- Status of the loan
     version INT NOT NULL DEFAULT 1,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
@@ -14,8 +17,96 @@ CREATE TABLE IF NOT EXISTS loans (
 
 CREATE INDEX idx_loan_user_id ON loans (user_id);
 CREATE INDEX idx_loan_book_id ON loans (book_id);
+CREATE INDEX idx_loan_copy_id ON loans (copy_id);
 CREATE INDEX idx_loan_status ON loans (status);
 
+-- Composite indexes backing sorted listings and keyset (cursor) pagination
+CREATE INDEX idx_loan_loan_date_id ON loans (loan_date, id);
+CREATE INDEX idx_loan_updated_at_id ON loans (updated_at, id);
+CREATE INDEX idx_loan_user_id_loan_date_id ON loans (user_id, loan_date, id);
+CREATE INDEX idx_loan_status_loan_date_id ON loans (status, loan_date, id);
+
+-- Backs the overdue sweeper lookup of borrowed loans past their due date
+CREATE INDEX idx_loan_status_due_date ON loans (status, due_date);
+
+-- Every status transition of a loan, from_status is NULL for the initial BORROWED entry
+CREATE TABLE IF NOT EXISTS loan_status_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    loan_id UUID NOT NULL REFERENCES loans (id),
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    changed_by VARCHAR(255) NOT NULL, -- User ID of the actor, or 'system' for the sweepers
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_loan_status_history_loan_id_created_at ON loan_status_history (loan_id, created_at);
+
+-- Fines charged to a patron for a loan, amounts are in rupiah
+CREATE TABLE IF NOT EXISTS fines (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    loan_id UUID NOT NULL REFERENCES loans (id),
+    type VARCHAR(50) NOT NULL, -- Reason of the fine (e.g., OVERDUE, LOST)
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    paid_amount BIGINT NOT NULL DEFAULT 0 CHECK (paid_amount >= 0 AND paid_amount <= amount),
+    status VARCHAR(50) NOT NULL DEFAULT 'UNPAID', -- Status of the fine (e.g., UNPAID, PAID, WAIVED)
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (loan_id, type)
+);
+
+CREATE INDEX idx_fine_user_id_created_at ON fines (user_id, created_at);
+CREATE INDEX idx_fine_user_id_status ON fines (user_id, status);
+
+-- Append-only ledger of every charge, payment and waiver applied to a fine
+CREATE TABLE IF NOT EXISTS fine_ledger (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    fine_id UUID NOT NULL REFERENCES fines (id),
+    user_id UUID NOT NULL,
+    entry_type VARCHAR(50) NOT NULL, -- Type of the entry (e.g., CHARGE, PAYMENT, WAIVER)
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_fine_ledger_fine_id ON fine_ledger (fine_id);
+
+-- Holds form a FIFO queue per book, the head of the queue becomes READY when a copy is returned
+CREATE TABLE IF NOT EXISTS holds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    book_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL, -- Where the pickup notification is sent
+    status VARCHAR(50) NOT NULL DEFAULT 'WAITING', -- Status of the hold (e.g., WAITING, READY, FULFILLED, CANCELLED, EXPIRED)
+    ready_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE, -- End of the pickup window once READY
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A user can only have one active hold per book
+CREATE UNIQUE INDEX idx_hold_active_user_id_book_id ON holds (user_id, book_id) WHERE status IN ('WAITING', 'READY');
+CREATE INDEX idx_hold_book_id_status_created_at ON holds (book_id, status, created_at, id);
+CREATE INDEX idx_hold_user_id_created_at ON holds (user_id, created_at);
+CREATE INDEX idx_hold_status_expires_at ON holds (status, expires_at);
+
+-- Transactional outbox, messages are written with the loan change and relayed to RabbitMQ after commit
+CREATE TABLE IF NOT EXISTS outbox (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    exchange VARCHAR(255) NOT NULL,
+    routing_key VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Backs the relay lookup of pending messages in insertion order
+CREATE INDEX idx_outbox_pending_created_at ON outbox (created_at, id) WHERE published_at IS NULL;
+
 -- Membuat fungsi trigger untuk memperbarui kolom updated_at dan menaikkan version
 CREATE OR REPLACE FUNCTION update_updated_at_and_version_loans()
 RETURNS TRIGGER AS $$
@@ -31,3 +122,15 @@ CREATE TRIGGER set_updated_at_and_version_loans
 BEFORE UPDATE ON loans
 FOR EACH ROW
 EXECUTE FUNCTION update_updated_at_and_version_loans();
+
+-- Membuat trigger yang sama untuk tabel fines
+CREATE TRIGGER set_updated_at_and_version_fines
+BEFORE UPDATE ON fines
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_and_version_loans();
+
+-- Membuat trigger yang sama untuk tabel holds
+CREATE TRIGGER set_updated_at_and_version_holds
+BEFORE UPDATE ON holds
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_and_version_loans();
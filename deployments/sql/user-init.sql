@@ -1,18 +1,129 @@
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
+-- Membership tiers define how much their members may borrow
+CREATE TABLE IF NOT EXISTS membership_tiers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    max_concurrent_loans INT NOT NULL CHECK (max_concurrent_loans > 0),
+    loan_duration_days INT NOT NULL CHECK (loan_duration_days > 0),
+    max_renewals INT NOT NULL CHECK (max_renewals >= 0),
+    is_default BOOLEAN NOT NULL DEFAULT false, -- Applies to every user without an assigned tier
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- At most one tier can be the default
+CREATE UNIQUE INDEX idx_membership_tier_default ON membership_tiers (is_default) WHERE is_default;
+
+INSERT INTO membership_tiers (name, max_concurrent_loans, loan_duration_days, max_renewals, is_default)
+VALUES ('standard', 5, 7, 2, true)
+ON CONFLICT (name) DO NOTHING;
+
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     email VARCHAR(255) NOT NULL UNIQUE,
     username VARCHAR(100) NOT NULL UNIQUE,
     password TEXT NOT NULL,
     verified BOOLEAN NOT NULL DEFAULT false,
-    role VARCHAR(50) NOT NULL DEFAULT 'user',
-    refresh_token TEXT,
+    tier_id UUID REFERENCES membership_tiers (id), -- NULL means the default tier
+    mfa_enabled BOOLEAN NOT NULL DEFAULT false,
+    mfa_secret TEXT NOT NULL DEFAULT '', -- Base32 TOTP secret, set at enrollment and in use once mfa_enabled
+    mfa_last_step BIGINT NOT NULL DEFAULT 0, -- Last accepted TOTP time step, a code is never accepted twice
     last_login_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Roles grant named permissions such as books:write, a user holds any number of roles.
+-- Built-in roles cannot be deleted and the admin role always grants every permission.
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(50) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    built_in BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(50) NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+    permission VARCHAR(100) NOT NULL,
+    PRIMARY KEY (role, permission)
+);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL REFERENCES roles (name) ON DELETE CASCADE,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role)
+);
+
+CREATE INDEX idx_user_role_role ON user_roles (role);
+
+-- A librarian runs the catalogue and the circulation desk, between a member and an admin
+INSERT INTO roles (name, description, built_in)
+VALUES
+    ('user', 'Library member, borrows and holds books', true),
+    ('librarian', 'Runs the catalogue and the circulation desk', true),
+    ('admin', 'Manages the whole system', true)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+VALUES
+    ('librarian', 'books:write'),
+    ('librarian', 'copies:manage'),
+    ('librarian', 'authors:write'),
+    ('librarian', 'categories:write'),
+    ('librarian', 'loans:manage'),
+    ('librarian', 'users:read'),
+    ('admin', 'books:write'),
+    ('admin', 'copies:manage'),
+    ('admin', 'authors:write'),
+    ('admin', 'categories:write'),
+    ('admin', 'catalogue:admin'),
+    ('admin', 'loans:manage'),
+    ('admin', 'users:read'),
+    ('admin', 'users:manage'),
+    ('admin', 'roles:manage')
+ON CONFLICT DO NOTHING;
+
+-- One row per signed-in device. A refresh token carries the family ID of its session and only the
+-- latest one is valid, presenting an older one means it leaked and the session is revoked.
+CREATE TABLE IF NOT EXISTS sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    family_id UUID NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    refresh_token_hash TEXT NOT NULL, -- SHA-256 of the latest refresh token of the family
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL -- Pushed back on every refresh, like the refresh token
+);
+
+CREATE INDEX idx_session_user_id ON sessions (user_id);
+
+-- Single-use 2FA backup codes, stored as SHA-256 hashes
+CREATE TABLE IF NOT EXISTS user_mfa_backup_codes (
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (user_id, code_hash)
+);
+
+-- IDs of the 2FA challenge tokens that completed their login, a challenge is only good once.
+-- A row only has to outlive its token, rows past expires_at are deleted as new ones come in.
+CREATE TABLE IF NOT EXISTS used_mfa_tokens (
+    token_id TEXT PRIMARY KEY, -- jti of the challenge token
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Roles whose members have to use 2FA, a role without a row does not require it
+CREATE TABLE IF NOT EXISTS role_mfa_requirements (
+    role VARCHAR(50) PRIMARY KEY REFERENCES roles (name) ON DELETE CASCADE,
+    required BOOLEAN NOT NULL DEFAULT false,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
 CREATE OR REPLACE FUNCTION update_updated_at_column_users()
 RETURNS TRIGGER AS $$
 BEGIN
@@ -25,3 +136,13 @@ CREATE TRIGGER set_updated_at_users
 BEFORE UPDATE ON users
 FOR EACH ROW
 EXECUTE FUNCTION update_updated_at_column_users();
+
+CREATE TRIGGER set_updated_at_membership_tiers
+BEFORE UPDATE ON membership_tiers
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column_users();
+
+CREATE TRIGGER set_updated_at_roles
+BEFORE UPDATE ON roles
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column_users();
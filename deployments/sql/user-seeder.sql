@@ -1,10 +1,19 @@
-INSERT INTO users (id, email, username, password, verified, role, created_at, updated_at)
+INSERT INTO membership_tiers (name, max_concurrent_loans, loan_duration_days, max_renewals)
+VALUES
+    ('premium', 10, 14, 3);
+
+INSERT INTO users (id, email, username, password, verified, created_at, updated_at)
 VALUES
     -- Admin User
-    (uuid_generate_v4(), 'najibfikri13@gmail.com', 'admin', '$2a$10$8/ltlfsE55OXuMsweGkHU.DseW9aiONTkSNCwmk6lW4Ihih4LgZbK', true, 'admin', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    (uuid_generate_v4(), 'najibfikri13@gmail.com', 'admin', '$2a$10$8/ltlfsE55OXuMsweGkHU.DseW9aiONTkSNCwmk6lW4Ihih4LgZbK', true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
     
     -- Regular User
-    (uuid_generate_v4(), 'najibfikri26@gmail.com', 'regularuser', '$2a$10$8/ltlfsE55OXuMsweGkHU.DseW9aiONTkSNCwmk6lW4Ihih4LgZbK', true, 'user', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+    (uuid_generate_v4(), 'najibfikri26@gmail.com', 'regularuser', '$2a$10$8/ltlfsE55OXuMsweGkHU.DseW9aiONTkSNCwmk6lW4Ihih4LgZbK', true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+INSERT INTO user_roles (user_id, role)
+SELECT id, 'admin' FROM users WHERE email = 'najibfikri13@gmail.com'
+UNION ALL
+SELECT id, 'user' FROM users WHERE email = 'najibfikri26@gmail.com';
 
 
 -- default password for each user: 1234
\ No newline at end of file
@@ -5,12 +5,16 @@ import (
 	"auth_service/internal/constants"
 	"auth_service/internal/grpc_server"
 	"auth_service/internal/repository"
+	"auth_service/internal/scheduler"
 	"auth_service/internal/service"
 	"auth_service/pkg/jwt"
+	"auth_service/pkg/lockout"
 	loggerPackage "auth_service/pkg/logger"
 	"auth_service/pkg/mailer"
+	"auth_service/pkg/password"
 	"auth_service/pkg/rabbitmq"
 	"auth_service/pkg/redis"
+	"auth_service/pkg/revocation"
 	"context"
 	"database/sql"
 	"fmt"
@@ -69,6 +73,11 @@ func main() {
 		log.Fatalf("Failed to declare exchange: %v", err)
 	}
 
+	err = rabbitMQPublisher.DeclareExchange(constants.RevocationExchange, constants.ExchangeTypeFanout)
+	if err != nil {
+		log.Fatalf("Failed to declare exchange: %v", err)
+	}
+
 	// Logger
 	var logger *loggerPackage.Logger
 	if configs.AppConfig.LoggerWorkerType == constants.LoggerWorkerTypeSingle {
@@ -103,15 +112,51 @@ func main() {
 	// Redis cache
 	redisCache := redis.NewRedisCache(fmt.Sprintf("%s:%s", configs.AppConfig.RedisHost, configs.AppConfig.RedisPort), configs.AppConfig.RedisDB, configs.AppConfig.RedisPassword, configs.AppConfig.RedisDefaultExp)
 
+	// Signing keys, a replaced key keeps verifying until the refresh tokens it signed expired
+	keyRing, err := jwt.NewKeyRing(configs.AppConfig.JwtKeysDir, configs.AppConfig.JwtSigningAlgorithm, configs.AppConfig.JwtKeyRotation, configs.AppConfig.JwtExpRefreshToken)
+	if err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
+
 	// JWT Service
-	jwtService := jwt.NewJWTService(configs.AppConfig.JwtSecret, configs.AppConfig.JwtIssuer, configs.AppConfig.JwtExpAccessToken, configs.AppConfig.JwtExpRefreshToken)
+	jwtService := jwt.NewJWTService(keyRing, configs.AppConfig.JwtIssuer, configs.AppConfig.JwtExpAccessToken, configs.AppConfig.JwtExpRefreshToken, configs.AppConfig.MFAChallengeExp)
 
 	// Mailer Service
 	mailerService := mailer.NewOTPMailer(string(emailSenderBytes), string(emailPasswordBytes))
 
+	// Password policy
+	passwordPolicy, err := password.NewPolicy(configs.AppConfig.PasswordMinLength, configs.AppConfig.PasswordRequiredClasses, configs.AppConfig.PasswordDenylistFile)
+	if err != nil {
+		log.Fatalf("Failed to load password policy: %v", err)
+	}
+
+	// Lockouts after repeated failures, the IP allows more since many users can share one
+	loginLockout := lockout.Policy{
+		MaxAttempts: configs.AppConfig.LoginMaxAttempts,
+		Window:      configs.AppConfig.LoginAttemptWindow,
+		BaseLockout: configs.AppConfig.LockoutBase,
+		MaxLockout:  configs.AppConfig.LockoutMax,
+	}
+	ipLockout := loginLockout
+	ipLockout.MaxAttempts = configs.AppConfig.LoginIPMaxAttempts
+	limiters := service.Limiters{
+		Email: lockout.NewLimiter(redisCache, logger, "login_email", loginLockout),
+		IP:    lockout.NewLimiter(redisCache, logger, "login_ip", ipLockout),
+		MFA:   lockout.NewLimiter(redisCache, logger, "mfa_user", loginLockout),
+	}
+
+	// Access tokens revoked before they expire
+	revocations := revocation.NewList(redisCache, rabbitMQPublisher, logger, configs.AppConfig.JwtExpAccessToken)
+
 	// Repository and Service Layer
 	authRepo := repository.NewAuthRepository(db)
-	authService := service.NewAuthService(authRepo, jwtService, mailerService, rabbitMQPublisher)
+	authService := service.NewAuthService(authRepo, jwtService, mailerService, rabbitMQPublisher, passwordPolicy, configs.AppConfig.MFAIssuer, limiters, revocations)
+
+	// Background workers share one context so they stop together
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	keyRotator := scheduler.NewKeyRotator(keyRing, logger, configs.AppConfig.JwtKeyCheckInterval)
+	go keyRotator.Start(workerCtx)
 
 	// gRPC Server
 	address := fmt.Sprintf(":%s", configs.AppConfig.GrpcPort)
@@ -121,7 +166,7 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	authServer := grpc_server.NewAuthServer(authService, redisCache, logger)
+	authServer := grpc_server.NewAuthServer(authService, redisCache, configs.AppConfig.PasswordResetExp, configs.AppConfig.OTPMaxAttempts, configs.AppConfig.OTPResendCooldown, logger)
 	protoAuth.RegisterAuthServiceServer(grpcServer, authServer)
 	healthCheckServer := grpc_server.NewHealthGRPCServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthCheckServer)
@@ -158,6 +203,9 @@ func main() {
 	grpcServer.GracefulStop()
 	log.Println("gRPC server stopped")
 
+	// Stop the background workers
+	stopWorkers()
+
 	// Perform additional cleanup tasks ???
 	// ...
 
@@ -3,6 +3,7 @@ package configs
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -17,9 +18,25 @@ type Config struct {
 	RedisDB                    int
 	RedisPort                  string
 	JwtIssuer                  string
-	JwtSecret                  string
+	JwtSigningAlgorithm        string        // RS256 or EdDSA
+	JwtKeysDir                 string        // shared by every instance, holds the signing keys
 	JwtExpAccessToken          time.Duration // minute unit
 	JwtExpRefreshToken         time.Duration // minute unit
+	JwtKeyRotation             time.Duration // minute unit, age at which the signing key is replaced
+	JwtKeyCheckInterval        time.Duration // minute unit
+	MFAIssuer                  string        // shown by authenticator apps next to the account
+	MFAChallengeExp            time.Duration // minute unit
+	PasswordResetExp           time.Duration // minute unit
+	LoginMaxAttempts           int           // failed logins per email before a lockout
+	LoginIPMaxAttempts         int           // failed logins per client IP before a lockout
+	LoginAttemptWindow         time.Duration // minute unit
+	LockoutBase                time.Duration // minute unit, doubled for each further lockout
+	LockoutMax                 time.Duration // minute unit
+	OTPMaxAttempts             int           // verification attempts per OTP code
+	OTPResendCooldown          time.Duration // second unit
+	PasswordMinLength          int
+	PasswordRequiredClasses    []string // any of lower, upper, number and special
+	PasswordDenylistFile       string   // empty skips the breached password check
 	EmailSenderContainerFile   string
 	EmailPasswordContainerFile string
 	RabbitMQURL                string
@@ -62,7 +79,9 @@ func InitializeAppConfig() error {
 		"REDIS_PASSWORD":                &AppConfig.RedisPassword,
 		"REDIS_PORT":                    &AppConfig.RedisPort,
 		"JWT_ISSUER":                    &AppConfig.JwtIssuer,
-		"JWT_SECRET":                    &AppConfig.JwtSecret,
+		"JWT_SIGNING_ALGORITHM":         &AppConfig.JwtSigningAlgorithm,
+		"JWT_KEYS_DIR":                  &AppConfig.JwtKeysDir,
+		"MFA_ISSUER":                    &AppConfig.MFAIssuer,
 		"EMAIL_SENDER_CONTAINER_FILE":   &AppConfig.EmailSenderContainerFile,
 		"EMAIL_PASSWORD_CONTAINER_FILE": &AppConfig.EmailPasswordContainerFile,
 		"RABBITMQ_URL":                  &AppConfig.RabbitMQURL,
@@ -100,6 +119,72 @@ func InitializeAppConfig() error {
 		return err
 	}
 
+	AppConfig.JwtKeyRotation, err = getDurationEnv("JWT_KEY_ROTATION", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.JwtKeyCheckInterval, err = getDurationEnv("JWT_KEY_CHECK_INTERVAL", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.MFAChallengeExp, err = getDurationEnv("MFA_CHALLENGE_EXP", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.PasswordResetExp, err = getDurationEnv("PASSWORD_RESET_EXP", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.LoginMaxAttempts, err = getIntEnv("LOGIN_MAX_ATTEMPTS")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.LoginIPMaxAttempts, err = getIntEnv("LOGIN_IP_MAX_ATTEMPTS")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.LoginAttemptWindow, err = getDurationEnv("LOGIN_ATTEMPT_WINDOW", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.LockoutBase, err = getDurationEnv("LOCKOUT_BASE", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.LockoutMax, err = getDurationEnv("LOCKOUT_MAX", time.Minute)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.OTPMaxAttempts, err = getIntEnv("OTP_MAX_ATTEMPTS")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.OTPResendCooldown, err = getDurationEnv("OTP_RESEND_COOLDOWN", time.Second)
+	if err != nil {
+		return err
+	}
+
+	AppConfig.PasswordMinLength, err = getIntEnv("PASSWORD_MIN_LENGTH")
+	if err != nil {
+		return err
+	}
+
+	// Optional password policy values
+	if classes := viper.GetString("PASSWORD_REQUIRED_CLASSES"); classes != "" {
+		AppConfig.PasswordRequiredClasses = strings.Split(classes, ",")
+	}
+	AppConfig.PasswordDenylistFile = viper.GetString("PASSWORD_DENYLIST_FILE")
+
 	AppConfig.LoggerWorkerNum, err = getIntEnv("LOGGER_WORKER_NUM")
 	if err != nil {
 		return err
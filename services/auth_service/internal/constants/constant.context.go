@@ -6,4 +6,6 @@ const (
 	ContextRequestIDKey contextKey = "requestID"
 
 	ContextProtoRequestIDKey = "request-id"
+	ContextProtoClientIPKey  = "client-ip"         // IP of the client the gateway serves, for per-IP lockouts
+	ContextProtoUserAgentKey = "client-user-agent" // User agent of the client the gateway serves, shown in its sessions
 )
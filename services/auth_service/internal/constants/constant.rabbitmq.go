@@ -2,12 +2,16 @@ package constants
 
 const (
 	ExchangeTypeDirect = "direct"
+	ExchangeTypeFanout = "fanout"
 
-	EmailExchange = "email_exchange"
-	LogExchange   = "log_exchange"
+	EmailExchange      = "email_exchange"
+	LogExchange        = "log_exchange"
+	RevocationExchange = "revocation_exchange" // fanout, every gateway instance evicts the revoked tokens it cached
 
-	OTPQueue = "otp_code"
-	LogQueue = "log_queue"
+	OTPQueue           = "otp_code"
+	PasswordResetQueue = "password_reset"
+	EmailChangeQueue   = "email_change_otp"
+	LogQueue           = "log_queue"
 
 	LogServiceAuth = "auth-service"
 
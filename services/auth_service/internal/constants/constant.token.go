@@ -3,4 +3,7 @@ package constants
 const (
 	TokenAccess  = "access"
 	TokenRefresh = "refresh"
+
+	// TokenMFARequired is the challenge Login returns instead of tokens when a second factor is due
+	TokenMFARequired = "mfa_required"
 )
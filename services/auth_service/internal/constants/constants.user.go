@@ -1,6 +1,20 @@
 package constants
 
 const (
-	RoleAdmin = "admin"
-	RoleUser  = "user"
+	RoleAdmin     = "admin"
+	RoleLibrarian = "librarian"
+	RoleUser      = "user"
+)
+
+// Permissions the gateway checks, a role grants any number of them
+const (
+	PermissionBooksWrite      = "books:write"
+	PermissionCopiesManage    = "copies:manage"
+	PermissionAuthorsWrite    = "authors:write"
+	PermissionCategoriesWrite = "categories:write"
+	PermissionCatalogueAdmin  = "catalogue:admin"
+	PermissionLoansManage     = "loans:manage"
+	PermissionUsersRead       = "users:read"
+	PermissionUsersManage     = "users:manage"
+	PermissionRolesManage     = "roles:manage"
 )
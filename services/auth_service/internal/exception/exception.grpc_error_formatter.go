@@ -45,6 +45,102 @@ func GRPCErrorFormatter(err error) error {
 		return status.Error(codes.Internal, err.Error())
 	case errors.Is(err, service.ErrUpdateLastLogin):
 		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrGenerateResetToken):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrInvalidResetToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrUpdatePassword):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrWeakPassword):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrGetUserById):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrEmailUnchanged):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUpdateEmail):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrGenerateMFAToken):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrInvalidMFAToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrInvalidMFACode):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrCheckMFACode):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrMFAAlreadyEnabled):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrMFANotEnrolled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrMFANotEnabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrEnrollMFA):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrGetMFARequirement):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrSetMFARequirement):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrListMFARequirements):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrTooManyAttempts):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrCheckLockout):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrUnlockAccount):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrTooManyOTPAttempts):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrTooManyResetAttempts):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrResendCooldown):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrRefreshTokenReused):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrCreateSession):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrGetSession):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrSessionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrListSessions):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrRevokeSession):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrTokenRevoked):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrCheckRevocation):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrForceLogout):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrGetUserAccess):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrListRoles):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrGetRole):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrRoleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrRoleAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrUnknownPermission):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrBuiltInRole):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrAdminRoleFixed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrCreateRole):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrUpdateRole):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrDeleteRole):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrAssignRole):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrUnassignRole):
+		return status.Error(codes.Internal, err.Error())
+	case errors.Is(err, service.ErrRoleNotAssigned):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrLastAdmin):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		// Fallback for unknown errors
 		return status.Error(codes.Unknown, err.Error())
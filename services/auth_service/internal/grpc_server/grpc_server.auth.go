@@ -11,23 +11,30 @@ import (
 	protoAuth "auth_service/proto/auth_service"
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type authServer struct {
-	authService service.AuthService
-	redisCache  redis.RedisCache
-	logger      *logger.Logger
+	authService       service.AuthService
+	redisCache        redis.RedisCache
+	passwordResetExp  time.Duration
+	otpMaxAttempts    int
+	otpResendCooldown time.Duration
+	logger            *logger.Logger
 	protoAuth.UnimplementedAuthServiceServer
 }
 
-func NewAuthServer(authService service.AuthService, redisCache redis.RedisCache, logger *logger.Logger) protoAuth.AuthServiceServer {
+func NewAuthServer(authService service.AuthService, redisCache redis.RedisCache, passwordResetExp time.Duration, otpMaxAttempts int, otpResendCooldown time.Duration, logger *logger.Logger) protoAuth.AuthServiceServer {
 	return &authServer{
-		authService: authService,
-		redisCache:  redisCache,
-		logger:      logger,
+		authService:       authService,
+		redisCache:        redisCache,
+		passwordResetExp:  passwordResetExp,
+		otpMaxAttempts:    otpMaxAttempts,
+		otpResendCooldown: otpResendCooldown,
+		logger:            logger,
 	}
 }
 
@@ -57,7 +64,7 @@ func (s *authServer) Register(ctx context.Context, req *protoAuth.RegisterReques
 			Id:        user.ID,
 			Email:     user.Email,
 			Username:  user.Username,
-			Role:      user.Role,
+			Roles:     user.Roles,
 			Verified:  user.Verified,
 			CreatedAt: user.CreatedAt.Unix(),
 			UpdatedAt: user.UpdatedAt.Unix(),
@@ -74,6 +81,12 @@ func (s *authServer) SendOTP(ctx context.Context, req *protoAuth.SendOTPRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
+	otpKey := fmt.Sprintf("user_otp:%s", req.Email)
+	if err := s.startResendCooldown(otpKey); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelWarn, "OTP resend refused", map[string]interface{}{"email": req.Email}, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
 	otpCode, err := s.authService.SendOTP(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.Email)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to send OTP", nil, err)
@@ -81,12 +94,16 @@ func (s *authServer) SendOTP(ctx context.Context, req *protoAuth.SendOTPRequest)
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "OTP generated successfully", nil, nil)
-	otpKey := fmt.Sprintf("user_otp:%s", req.Email)
 	err = s.redisCache.Set(otpKey, otpCode)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to cache OTP", nil, err)
 		return nil, exception.GRPCErrorFormatter(err)
 	}
+	// A new code gets the full number of attempts
+	if err := s.redisCache.Del(otpAttemptsKey(otpKey)); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to reset OTP attempts", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "OTP cached successfully", nil, nil)
 	return &protoAuth.SendOTPResponse{
@@ -109,6 +126,11 @@ func (s *authServer) VerifyEmail(ctx context.Context, req *protoAuth.VerifyEmail
 	}
 
 	otpKey := fmt.Sprintf("user_otp:%s", verifyEmailRequest.Email)
+	if err := s.countOTPAttempt(otpKey); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelWarn, "OTP verification refused", map[string]interface{}{"email": req.Email}, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
 	redisOtp, err := s.redisCache.Get(otpKey)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get OTP from cache", nil, err)
@@ -122,7 +144,7 @@ func (s *authServer) VerifyEmail(ctx context.Context, req *protoAuth.VerifyEmail
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Email verification succeeded", nil, nil)
-	err = s.redisCache.Del(otpKey)
+	err = s.redisCache.Del(otpKey, otpAttemptsKey(otpKey))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to delete OTP from cache", nil, err)
 		return nil, exception.GRPCErrorFormatter(err)
@@ -142,20 +164,28 @@ func (s *authServer) Login(ctx context.Context, req *protoAuth.LoginRequest) (*p
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	result, err := s.authService.Login(ctx, &models.LoginRequest{
+	result, err := s.authService.Login(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), &models.LoginRequest{
 		Email:    req.Email,
 		Password: req.Password,
+		Client:   clientInfo(ctx),
 	})
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Login service failed", nil, err)
 		return nil, exception.GRPCErrorFormatter(err)
 	}
 
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Login success, access token & refresh token generated", nil, nil)
+	if result.MFARequired {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password accepted, second factor required", map[string]interface{}{"enrollment_required": result.MFAEnrollmentRequired}, nil)
+	} else {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Login success, access token & refresh token generated", nil, nil)
+	}
 	return &protoAuth.LoginResponse{
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
-		Message:      result.Message,
+		AccessToken:           result.AccessToken,
+		RefreshToken:          result.RefreshToken,
+		Message:               result.Message,
+		MfaRequired:           result.MFARequired,
+		MfaToken:              result.MFAToken,
+		MfaEnrollmentRequired: result.MFAEnrollmentRequired,
 	}, nil
 }
 
@@ -176,10 +206,38 @@ func (s *authServer) ValidateToken(ctx context.Context, req *protoAuth.ValidateT
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Token validation succeeded", nil, nil)
 	return &protoAuth.ValidateTokenResponse{
-		Valid:  result.Valid,
-		UserId: result.UserID,
-		Role:   result.Role,
-		Email:  result.Email,
+		Valid:       result.Valid,
+		UserId:      result.UserID,
+		Roles:       result.Roles,
+		Permissions: result.Permissions,
+		Email:       result.Email,
+		SessionId:   result.SessionID,
+		TokenId:     result.TokenID,
+	}, nil
+}
+
+func (s *authServer) GetJWKS(ctx context.Context, req *protoAuth.GetJWKSRequest) (*protoAuth.GetJWKSResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetJWKS request", nil, nil)
+
+	keys := s.authService.GetJWKS(ctx)
+	protoKeys := make([]*protoAuth.JWK, 0, len(keys))
+	for _, key := range keys {
+		protoKeys = append(protoKeys, &protoAuth.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "JWKS returned", map[string]interface{}{"count": len(protoKeys)}, nil)
+	return &protoAuth.GetJWKSResponse{
+		Keys: protoKeys,
 	}, nil
 }
 
@@ -187,7 +245,7 @@ func (s *authServer) RefreshToken(ctx context.Context, req *protoAuth.RefreshTok
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RefreshTokens request", map[string]interface{}{"user_id": req.UserId}, nil)
 
-	result, err := s.authService.RefreshToken(ctx, req.UserId, req.RefreshToken)
+	result, err := s.authService.RefreshToken(ctx, req.UserId, req.RefreshToken, clientInfo(ctx))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RefreshTokens service failed", nil, err)
 		return nil, exception.GRPCErrorFormatter(err)
@@ -203,9 +261,9 @@ func (s *authServer) RefreshToken(ctx context.Context, req *protoAuth.RefreshTok
 
 func (s *authServer) Logout(ctx context.Context, req *protoAuth.LogoutRequest) (*protoAuth.LogoutResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received Logout request", map[string]interface{}{"user_id": req.UserId}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received Logout request", map[string]interface{}{"user_id": req.UserId, "session_id": req.SessionId}, nil)
 
-	err := s.authService.Logout(ctx, req.UserId)
+	err := s.authService.Logout(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.UserId, req.SessionId)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Logout service failed", nil, err)
 		return nil, status.Errorf(codes.Internal, "Logout failed: %v", err)
@@ -0,0 +1,109 @@
+package grpc_server
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/exception"
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	protoAuth "auth_service/proto/auth_service"
+	"context"
+	"errors"
+	"fmt"
+
+	goredis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func emailChangeKey(userID, newEmail string) string {
+	return fmt.Sprintf("email_change:%s:%s", userID, newEmail)
+}
+
+func (s *authServer) ChangePassword(ctx context.Context, req *protoAuth.ChangePasswordRequest) (*protoAuth.ChangePasswordResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ChangePassword request", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ChangePassword request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	err := s.authService.ChangePassword(ctx, &models.ChangePasswordRequest{
+		UserID:          req.UserId,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ChangePassword service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password changed successfully", nil, nil)
+	return &protoAuth.ChangePasswordResponse{
+		Message: "Password changed successfully, please log in again",
+	}, nil
+}
+
+func (s *authServer) ChangeEmail(ctx context.Context, req *protoAuth.ChangeEmailRequest) (*protoAuth.ChangeEmailResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ChangeEmail request", map[string]interface{}{"user_id": req.UserId, "new_email": req.NewEmail}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ChangeEmail request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.startResendCooldown(fmt.Sprintf("email_change:%s", req.UserId)); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelWarn, "Email change OTP resend refused", map[string]interface{}{"user_id": req.UserId}, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	otpCode, err := s.authService.ChangeEmail(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.UserId, req.NewEmail)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to send email change OTP", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	err = s.redisCache.Set(emailChangeKey(req.UserId, req.NewEmail), otpCode)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to cache email change OTP", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Email change OTP cached successfully", nil, nil)
+	return &protoAuth.ChangeEmailResponse{
+		Message: fmt.Sprintf("OTP sent to %s, confirm it to change the email", req.NewEmail),
+	}, nil
+}
+
+func (s *authServer) ConfirmEmailChange(ctx context.Context, req *protoAuth.ConfirmEmailChangeRequest) (*protoAuth.ConfirmEmailChangeResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ConfirmEmailChange request", map[string]interface{}{"user_id": req.UserId, "new_email": req.NewEmail}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ConfirmEmailChange request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	// The OTP is taken out of the cache before it is checked, so a wrong guess needs a new OTP
+	redisOtp, err := s.redisCache.GetDel(emailChangeKey(req.UserId, req.NewEmail))
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to get email change OTP from cache", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	err = s.authService.ConfirmEmailChange(ctx, &models.ConfirmEmailChangeRequest{
+		UserID:   req.UserId,
+		NewEmail: req.NewEmail,
+		OTP:      req.Otp,
+	}, redisOtp)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ConfirmEmailChange service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Email changed successfully", nil, nil)
+	return &protoAuth.ConfirmEmailChangeResponse{
+		Message: "Email changed successfully, please log in again",
+	}, nil
+}
@@ -0,0 +1,88 @@
+package grpc_server
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/exception"
+	"auth_service/internal/service"
+	"auth_service/pkg/utils"
+	protoAuth "auth_service/proto/auth_service"
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func otpAttemptsKey(otpKey string) string {
+	return otpKey + ":attempts"
+}
+
+// countOTPAttempt counts a verification attempt against the OTP cached under otpKey. Once the attempts run out the
+// OTP is deleted, so it cannot be guessed any further and a new one has to be sent.
+func (s *authServer) countOTPAttempt(otpKey string) error {
+	return s.countAttempt(otpKey, service.ErrTooManyOTPAttempts)
+}
+
+// countAttempt counts an attempt against the single-use code cached under key and deletes the code once the
+// attempts run out, returning exhausted
+func (s *authServer) countAttempt(key string, exhausted error) error {
+	remaining, err := s.redisCache.TTL(key)
+	if err != nil {
+		return err
+	}
+	if remaining <= 0 {
+		// No OTP to guess, the lookup reports it
+		return nil
+	}
+
+	attempts, err := s.redisCache.Incr(otpAttemptsKey(key), remaining)
+	if err != nil {
+		return err
+	}
+	if attempts > int64(s.otpMaxAttempts) {
+		if err := s.redisCache.Del(key, otpAttemptsKey(key)); err != nil {
+			return err
+		}
+		return exhausted
+	}
+	return nil
+}
+
+// startResendCooldown lets one email go out per cooldown for the key, it returns ErrResendCooldown with the time left otherwise
+func (s *authServer) startResendCooldown(key string) error {
+	cooldownKey := key + ":cooldown"
+	started, err := s.redisCache.SetNX(cooldownKey, true, s.otpResendCooldown)
+	if err != nil {
+		return err
+	}
+	if started {
+		return nil
+	}
+
+	remaining, err := s.redisCache.TTL(cooldownKey)
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w, try again in %s", service.ErrResendCooldown, remaining.Round(time.Second))
+}
+
+func (s *authServer) UnlockAccount(ctx context.Context, req *protoAuth.UnlockAccountRequest) (*protoAuth.UnlockAccountResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UnlockAccount request", map[string]interface{}{"email": req.Email}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UnlockAccount request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.authService.UnlockAccount(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.Email); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UnlockAccount service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Account unlocked", map[string]interface{}{"email": req.Email}, nil)
+	return &protoAuth.UnlockAccountResponse{
+		Message: fmt.Sprintf("Account %s unlocked", req.Email),
+	}, nil
+}
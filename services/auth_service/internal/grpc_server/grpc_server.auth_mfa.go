@@ -0,0 +1,145 @@
+package grpc_server
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/exception"
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	protoAuth "auth_service/proto/auth_service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *authServer) EnrollMFA(ctx context.Context, req *protoAuth.EnrollMFARequest) (*protoAuth.EnrollMFAResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received EnrollMFA request", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid EnrollMFA request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	result, err := s.authService.EnrollMFA(ctx, &models.EnrollMFARequest{
+		UserID:   req.UserId,
+		MFAToken: req.MfaToken,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "EnrollMFA service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA enrollment started", nil, nil)
+	return &protoAuth.EnrollMFAResponse{
+		Secret:     result.Secret,
+		OtpauthUri: result.OtpauthURI,
+	}, nil
+}
+
+func (s *authServer) ConfirmMFA(ctx context.Context, req *protoAuth.ConfirmMFARequest) (*protoAuth.ConfirmMFAResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ConfirmMFA request", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ConfirmMFA request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	result, err := s.authService.ConfirmMFA(ctx, &models.ConfirmMFARequest{
+		UserID:   req.UserId,
+		MFAToken: req.MfaToken,
+		Code:     req.Code,
+		Client:   clientInfo(ctx),
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ConfirmMFA service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	resp := &protoAuth.ConfirmMFAResponse{
+		BackupCodes: result.BackupCodes,
+		Message:     "Two-factor authentication enabled, keep the backup codes somewhere safe",
+	}
+	if result.Tokens != nil {
+		resp.AccessToken = result.Tokens.AccessToken
+		resp.RefreshToken = result.Tokens.RefreshToken
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA enabled", nil, nil)
+	return resp, nil
+}
+
+func (s *authServer) VerifyMFA(ctx context.Context, req *protoAuth.VerifyMFARequest) (*protoAuth.VerifyMFAResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received VerifyMFA request", nil, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid VerifyMFA request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	result, err := s.authService.VerifyMFA(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), &models.VerifyMFARequest{
+		MFAToken: req.MfaToken,
+		Code:     req.Code,
+		Client:   clientInfo(ctx),
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "VerifyMFA service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Second factor verified, access token & refresh token generated", nil, nil)
+	return &protoAuth.VerifyMFAResponse{
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		Message:      result.Message,
+	}, nil
+}
+
+func (s *authServer) SetRoleMFARequirement(ctx context.Context, req *protoAuth.SetRoleMFARequirementRequest) (*protoAuth.SetRoleMFARequirementResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received SetRoleMFARequirement request", map[string]interface{}{"role": req.Role, "required": req.Required}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid SetRoleMFARequirement request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	requirement, err := s.authService.SetRoleMFARequirement(ctx, req.Role, req.Required)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "SetRoleMFARequirement service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA requirement updated", nil, nil)
+	return &protoAuth.SetRoleMFARequirementResponse{
+		Requirement: toProtoRoleMFARequirement(*requirement),
+	}, nil
+}
+
+func (s *authServer) ListRoleMFARequirements(ctx context.Context, req *protoAuth.ListRoleMFARequirementsRequest) (*protoAuth.ListRoleMFARequirementsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListRoleMFARequirements request", nil, nil)
+
+	requirements, err := s.authService.ListRoleMFARequirements(ctx)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListRoleMFARequirements service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	resp := &protoAuth.ListRoleMFARequirementsResponse{}
+	for _, requirement := range requirements {
+		resp.Requirements = append(resp.Requirements, toProtoRoleMFARequirement(requirement))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "MFA requirements listed", nil, nil)
+	return resp, nil
+}
+
+func toProtoRoleMFARequirement(requirement models.RoleMFARequirement) *protoAuth.RoleMFARequirement {
+	return &protoAuth.RoleMFARequirement{
+		Role:     requirement.Role,
+		Required: requirement.Required,
+	}
+}
@@ -0,0 +1,116 @@
+package grpc_server
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/exception"
+	"auth_service/internal/models"
+	"auth_service/internal/service"
+	"auth_service/pkg/utils"
+	protoAuth "auth_service/proto/auth_service"
+	"context"
+	"errors"
+	"fmt"
+
+	goredis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// passwordResetMessage is returned whether or not the email is registered, so the endpoint cannot be used to find accounts
+const passwordResetMessage = "If the email is registered, a password reset token has been sent"
+
+func passwordResetKey(email string) string {
+	return fmt.Sprintf("password_reset:%s", email)
+}
+
+func (s *authServer) RequestPasswordReset(ctx context.Context, req *protoAuth.RequestPasswordResetRequest) (*protoAuth.RequestPasswordResetResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RequestPasswordReset request", map[string]interface{}{"email": req.Email}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RequestPasswordReset request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	// The cooldown applies to unknown emails as well, so it tells nothing about which are registered
+	if err := s.startResendCooldown(passwordResetKey(req.Email)); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelWarn, "Password reset resend refused", map[string]interface{}{"email": req.Email}, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	token, err := s.authService.RequestPasswordReset(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.Email)
+	if errors.Is(err, service.ErrGetUserByEmail) {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelWarn, "Password reset requested for an unknown email", map[string]interface{}{"email": req.Email}, nil)
+		return &protoAuth.RequestPasswordResetResponse{
+			Message: passwordResetMessage,
+		}, nil
+	}
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to send password reset token", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	// A new token replaces the previous one, only the latest email works
+	err = s.redisCache.SetWithExpiration(passwordResetKey(req.Email), token, s.passwordResetExp)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to cache password reset token", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+	if err := s.redisCache.Del(otpAttemptsKey(passwordResetKey(req.Email))); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to reset password reset attempts", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password reset token cached successfully", nil, nil)
+	return &protoAuth.RequestPasswordResetResponse{
+		Message: passwordResetMessage,
+	}, nil
+}
+
+func (s *authServer) ResetPassword(ctx context.Context, req *protoAuth.ResetPasswordRequest) (*protoAuth.ResetPasswordResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ResetPassword request", map[string]interface{}{"email": req.Email}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ResetPassword request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	resetKey := passwordResetKey(req.Email)
+	// Every guess counts against the token, and it is only deleted once it matches
+	cachedToken := func() (string, error) {
+		if err := s.countAttempt(resetKey, service.ErrTooManyResetAttempts); err != nil {
+			return "", err
+		}
+		return cachedResetToken(s.redisCache.Get(resetKey))
+	}
+	takeToken := func() (string, error) {
+		if err := s.redisCache.Del(otpAttemptsKey(resetKey)); err != nil {
+			return "", err
+		}
+		return cachedResetToken(s.redisCache.GetDel(resetKey))
+	}
+
+	err := s.authService.ResetPassword(ctx, &models.ResetPasswordRequest{
+		Email:       req.Email,
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}, cachedToken, takeToken)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ResetPassword service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Password reset succeeded", nil, nil)
+	return &protoAuth.ResetPasswordResponse{
+		Message: "Password reset successfully, please log in again",
+	}, nil
+}
+
+// cachedResetToken treats a missing token as an empty one, which matches nothing
+func cachedResetToken(token string, err error) (string, error) {
+	if errors.Is(err, goredis.Nil) {
+		return "", nil
+	}
+	return token, err
+}
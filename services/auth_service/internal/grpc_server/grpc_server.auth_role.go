@@ -0,0 +1,172 @@
+package grpc_server
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/exception"
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	protoAuth "auth_service/proto/auth_service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *authServer) ListPermissions(ctx context.Context, req *protoAuth.ListPermissionsRequest) (*protoAuth.ListPermissionsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListPermissions request", nil, nil)
+
+	permissions := s.authService.ListPermissions(ctx)
+	protoPermissions := make([]*protoAuth.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		protoPermissions = append(protoPermissions, &protoAuth.Permission{
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Permissions listed", map[string]interface{}{"count": len(protoPermissions)}, nil)
+	return &protoAuth.ListPermissionsResponse{
+		Permissions: protoPermissions,
+	}, nil
+}
+
+func (s *authServer) ListRoles(ctx context.Context, req *protoAuth.ListRolesRequest) (*protoAuth.ListRolesResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListRoles request", nil, nil)
+
+	roles, err := s.authService.ListRoles(ctx)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListRoles service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	protoRoles := make([]*protoAuth.Role, 0, len(roles))
+	for _, role := range roles {
+		protoRoles = append(protoRoles, toProtoRole(role))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Roles listed", map[string]interface{}{"count": len(protoRoles)}, nil)
+	return &protoAuth.ListRolesResponse{
+		Roles: protoRoles,
+	}, nil
+}
+
+func (s *authServer) CreateRole(ctx context.Context, req *protoAuth.CreateRoleRequest) (*protoAuth.RoleResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CreateRole request", map[string]interface{}{"name": req.Name, "permissions": req.Permissions}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CreateRole request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	role, err := s.authService.CreateRole(ctx, &models.RoleRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "CreateRole service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role created", map[string]interface{}{"name": role.Name}, nil)
+	return &protoAuth.RoleResponse{
+		Role: toProtoRole(*role),
+	}, nil
+}
+
+func (s *authServer) UpdateRole(ctx context.Context, req *protoAuth.UpdateRoleRequest) (*protoAuth.RoleResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateRole request", map[string]interface{}{"name": req.Name, "permissions": req.Permissions}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UpdateRole request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	role, err := s.authService.UpdateRole(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), &models.RoleRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UpdateRole service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role updated", map[string]interface{}{"name": role.Name}, nil)
+	return &protoAuth.RoleResponse{
+		Role: toProtoRole(*role),
+	}, nil
+}
+
+func (s *authServer) DeleteRole(ctx context.Context, req *protoAuth.DeleteRoleRequest) (*protoAuth.DeleteRoleResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteRole request", map[string]interface{}{"name": req.Name}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid DeleteRole request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.authService.DeleteRole(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.Name); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "DeleteRole service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role deleted", map[string]interface{}{"name": req.Name}, nil)
+	return &protoAuth.DeleteRoleResponse{
+		Message: "Role deleted",
+	}, nil
+}
+
+func (s *authServer) AssignRole(ctx context.Context, req *protoAuth.AssignRoleRequest) (*protoAuth.AssignRoleResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received AssignRole request", map[string]interface{}{"user_id": req.UserId, "role": req.Role}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid AssignRole request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.authService.AssignRole(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.UserId, req.Role); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "AssignRole service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role assigned", map[string]interface{}{"user_id": req.UserId, "role": req.Role}, nil)
+	return &protoAuth.AssignRoleResponse{
+		Message: "Role assigned",
+	}, nil
+}
+
+func (s *authServer) UnassignRole(ctx context.Context, req *protoAuth.UnassignRoleRequest) (*protoAuth.UnassignRoleResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UnassignRole request", map[string]interface{}{"user_id": req.UserId, "role": req.Role}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UnassignRole request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.authService.UnassignRole(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.UserId, req.Role); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "UnassignRole service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Role unassigned", map[string]interface{}{"user_id": req.UserId, "role": req.Role}, nil)
+	return &protoAuth.UnassignRoleResponse{
+		Message: "Role unassigned",
+	}, nil
+}
+
+func toProtoRole(role models.RoleRecord) *protoAuth.Role {
+	return &protoAuth.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		BuiltIn:     role.BuiltIn,
+		Permissions: role.Permissions,
+	}
+}
@@ -0,0 +1,97 @@
+package grpc_server
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/exception"
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	protoAuth "auth_service/proto/auth_service"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *authServer) ListSessions(ctx context.Context, req *protoAuth.ListSessionsRequest) (*protoAuth.ListSessionsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListSessions request", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListSessions request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	sessions, err := s.authService.ListSessions(ctx, req.UserId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ListSessions service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	protoSessions := make([]*protoAuth.Session, 0, len(sessions))
+	for _, session := range sessions {
+		protoSessions = append(protoSessions, toProtoSession(session))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Sessions listed", map[string]interface{}{"count": len(protoSessions)}, nil)
+	return &protoAuth.ListSessionsResponse{
+		Sessions: protoSessions,
+	}, nil
+}
+
+func (s *authServer) RevokeSession(ctx context.Context, req *protoAuth.RevokeSessionRequest) (*protoAuth.RevokeSessionResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RevokeSession request", map[string]interface{}{"user_id": req.UserId, "session_id": req.SessionId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RevokeSession request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.authService.RevokeSession(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.UserId, req.SessionId); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "RevokeSession service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Session revoked", nil, nil)
+	return &protoAuth.RevokeSessionResponse{
+		Message: "Session revoked",
+	}, nil
+}
+
+func (s *authServer) ForceLogout(ctx context.Context, req *protoAuth.ForceLogoutRequest) (*protoAuth.ForceLogoutResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ForceLogout request", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ForceLogout request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	if err := s.authService.ForceLogout(context.WithValue(ctx, constants.ContextRequestIDKey, requestID), req.UserId); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ForceLogout service failed", nil, err)
+		return nil, exception.GRPCErrorFormatter(err)
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User logged out everywhere", map[string]interface{}{"user_id": req.UserId}, nil)
+	return &protoAuth.ForceLogoutResponse{
+		Message: "User logged out everywhere",
+	}, nil
+}
+
+// clientInfo reads the device the gateway forwarded the request for
+func clientInfo(ctx context.Context) models.ClientInfo {
+	return models.ClientInfo{
+		IP:        utils.GetClientIPFromMetadataContext(ctx),
+		UserAgent: utils.GetUserAgentFromMetadataContext(ctx),
+	}
+}
+
+func toProtoSession(session models.SessionRecord) *protoAuth.Session {
+	return &protoAuth.Session{
+		Id:         session.ID,
+		UserAgent:  session.UserAgent,
+		IpAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt.Unix(),
+		LastUsedAt: session.LastUsedAt.Unix(),
+	}
+}
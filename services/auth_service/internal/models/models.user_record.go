@@ -3,14 +3,50 @@ package models
 import "time"
 
 type UserRecord struct {
-	ID           string    `db:"id" json:"id"`
-	Email        string    `db:"email" json:"email"`
-	Username     string    `db:"username" json:"username"`
-	Password     string    `db:"password" json:"-"`
-	Verified     bool      `db:"verified" json:"verified"`
-	Role         string    `db:"role" json:"role"`
-	RefreshToken string    `db:"refresh_token" json:"-"`
-	LastLoginAt  time.Time `db:"last_login_at" json:"last_login_at"`
-	CreatedAt    time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+	ID          string    `db:"id" json:"id"`
+	Email       string    `db:"email" json:"email"`
+	Username    string    `db:"username" json:"username"`
+	Password    string    `db:"password" json:"-"`
+	Verified    bool      `db:"verified" json:"verified"`
+	Roles       []string  `db:"roles" json:"roles"`
+	MFAEnabled  bool      `db:"mfa_enabled" json:"mfa_enabled"`
+	MFASecret   string    `db:"mfa_secret" json:"-"`
+	MFALastStep int64     `db:"mfa_last_step" json:"-"`
+	LastLoginAt time.Time `db:"last_login_at" json:"last_login_at"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// RoleRecord is a named set of permissions, built-in roles ship with the schema and cannot be deleted
+type RoleRecord struct {
+	Name        string    `db:"name" json:"name"`
+	Description string    `db:"description" json:"description"`
+	BuiltIn     bool      `db:"built_in" json:"built_in"`
+	Permissions []string  `db:"permissions" json:"permissions"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// Permission is a right a role can grant, the gateway checks them by name
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type RoleMFARequirement struct {
+	Role     string `db:"role" json:"role"`
+	Required bool   `db:"required" json:"required"`
+}
+
+// SessionRecord is a signed-in device, its refresh tokens rotate within the token family
+type SessionRecord struct {
+	ID               string    `db:"id" json:"id"`
+	UserID           string    `db:"user_id" json:"user_id"`
+	FamilyID         string    `db:"family_id" json:"-"`
+	RefreshTokenHash string    `db:"refresh_token_hash" json:"-"`
+	UserAgent        string    `db:"user_agent" json:"user_agent"`
+	IPAddress        string    `db:"ip_address" json:"ip_address"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	LastUsedAt       time.Time `db:"last_used_at" json:"last_used_at"`
+	ExpiresAt        time.Time `db:"expires_at" json:"expires_at"`
 }
@@ -6,9 +6,16 @@ type RegisterRequest struct {
 	Password string
 }
 
+// ClientInfo describes the device a request comes from, the fields are empty when the caller did not pass them
+type ClientInfo struct {
+	IP        string // the per-IP lockout is skipped without it
+	UserAgent string
+}
+
 type LoginRequest struct {
 	Email    string
 	Password string
+	Client   ClientInfo
 }
 
 type VerifyEmailRequest struct {
@@ -19,3 +26,47 @@ type VerifyEmailRequest struct {
 type ValidateTokenRequest struct {
 	Token string
 }
+
+type ResetPasswordRequest struct {
+	Email       string
+	Token       string
+	NewPassword string
+}
+
+type ChangePasswordRequest struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+}
+
+// EnrollMFARequest names the user by ID when signed in, or by the challenge token of a login that requires enrollment
+type EnrollMFARequest struct {
+	UserID   string
+	MFAToken string
+}
+
+type ConfirmMFARequest struct {
+	UserID   string
+	MFAToken string
+	Code     string
+	Client   ClientInfo // caps failed codes per IP and signs the device in when confirming with a challenge token
+}
+
+type VerifyMFARequest struct {
+	MFAToken string
+	Code     string
+	Client   ClientInfo
+}
+
+type ConfirmEmailChangeRequest struct {
+	UserID   string
+	NewEmail string
+	OTP      string
+}
+
+// RoleRequest creates a role or replaces the description and permissions of one
+type RoleRequest struct {
+	Name        string
+	Description string
+	Permissions []string
+}
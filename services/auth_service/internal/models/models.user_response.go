@@ -5,9 +5,22 @@ type RegisterResponse struct {
 }
 
 type LoginResponse struct {
-	AccessToken  string
-	RefreshToken string
-	Message      string
+	AccessToken           string
+	RefreshToken          string
+	Message               string
+	MFARequired           bool
+	MFAToken              string
+	MFAEnrollmentRequired bool
+}
+
+type EnrollMFAResponse struct {
+	Secret     string
+	OtpauthURI string
+}
+
+type ConfirmMFAResponse struct {
+	BackupCodes []string
+	Tokens      *LoginResponse // only when confirmed with a challenge token
 }
 
 type VerifyEmailResponse struct {
@@ -15,8 +28,11 @@ type VerifyEmailResponse struct {
 }
 
 type ValidateTokenResponse struct {
-	Valid  bool
-	UserID string
-	Role   string
-	Email  string
+	Valid       bool
+	UserID      string
+	Roles       []string
+	Permissions []string
+	Email       string
+	SessionID   string
+	TokenID     string
 }
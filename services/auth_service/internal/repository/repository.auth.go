@@ -15,9 +15,32 @@ type AuthRepository interface {
 	GetUserById(ctx context.Context, id string) (*models.UserRecord, error)
 	GetUserByEmail(ctx context.Context, email string) (*models.UserRecord, error)
 	UpdateUserVerification(ctx context.Context, email string, verified bool) error
-	UpdateRefreshToken(ctx context.Context, userID string, refreshToken string) error
 	UpdateLastLogin(ctx context.Context, userID string) error
-	DeleteRefreshToken(ctx context.Context, userID string) error
+	UpdatePassword(ctx context.Context, userID string, hashedPassword string) error
+	UpdateEmail(ctx context.Context, userID string, email string) error
+	SetMFASecret(ctx context.Context, userID string, secret string) error
+	EnableMFA(ctx context.Context, userID string, step int64, backupCodeHashes []string) error
+	UpdateMFALastStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseMFABackupCode(ctx context.Context, userID string, codeHash string) (bool, error)
+	UseMFAToken(ctx context.Context, tokenID string, expiresAt time.Time) (bool, error)
+	IsMFARequiredForUser(ctx context.Context, userID string) (bool, error)
+	SetRoleMFARequirement(ctx context.Context, role string, required bool) (*models.RoleMFARequirement, error)
+	ListRoleMFARequirements(ctx context.Context) ([]models.RoleMFARequirement, error)
+	GetUserAccess(ctx context.Context, userID string) (roles []string, permissions []string, err error)
+	ListRoles(ctx context.Context) ([]models.RoleRecord, error)
+	GetRole(ctx context.Context, name string) (*models.RoleRecord, error)
+	CreateRole(ctx context.Context, role *models.RoleRecord) (*models.RoleRecord, error)
+	UpdateRole(ctx context.Context, role *models.RoleRecord) (*models.RoleRecord, error)
+	DeleteRole(ctx context.Context, name string) error
+	ListUserIdsByRole(ctx context.Context, role string) ([]string, error)
+	AssignRole(ctx context.Context, userID string, role string) error
+	UnassignRole(ctx context.Context, userID string, role string, keepLast bool) error
+	CreateSession(ctx context.Context, session *models.SessionRecord) error
+	GetSessionByFamilyId(ctx context.Context, familyID string) (*models.SessionRecord, error)
+	RotateSession(ctx context.Context, sessionID string, oldTokenHash string, session *models.SessionRecord) (bool, error)
+	ListSessionsByUserId(ctx context.Context, userID string) ([]models.SessionRecord, error)
+	DeleteSession(ctx context.Context, userID string, sessionID string) (bool, error)
+	DeleteSessionsByUserId(ctx context.Context, userID string) error
 
 	Close()
 }
@@ -34,26 +57,31 @@ func (r *authRepository) Close() {
 	r.db.Close()
 }
 
+// CreateUser inserts the user along with its roles in one transaction
 func (r *authRepository) CreateUser(ctx context.Context, user *models.UserRecord) (*models.UserRecord, error) {
-	query := `INSERT INTO users (id, email, username, password, verified, role)
-	          VALUES (uuid_generate_v4(), $1, $2, $3, $4, $5) 
-	          RETURNING id, email, username, password, verified, role, created_at, updated_at`
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO users (id, email, username, password, verified)
+	          VALUES (uuid_generate_v4(), $1, $2, $3, $4) 
+	          RETURNING id, email, username, password, verified, created_at, updated_at`
 
 	var newUser models.UserRecord
 
-	err := r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		user.Email,
 		user.Username,
 		user.Password,
 		user.Verified,
-		user.Role,
 	).Scan(
 		&newUser.ID,
 		&newUser.Email,
 		&newUser.Username,
 		&newUser.Password,
 		&newUser.Verified,
-		&newUser.Role,
 		&newUser.CreatedAt,
 		&newUser.UpdatedAt,
 	)
@@ -62,25 +90,40 @@ func (r *authRepository) CreateUser(ctx context.Context, user *models.UserRecord
 		return nil, err
 	}
 
+	for _, role := range user.Roles {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO user_roles (user_id, role) VALUES ($1, $2)`, newUser.ID, role); err != nil {
+			log.Printf("[%s] Failed to assign role %s to user %s: %v\n", utils.GetLocation(), role, user.Email, err)
+			return nil, err
+		}
+	}
+	newUser.Roles = user.Roles
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	log.Printf("[%s] User %s successfully created\n", utils.GetLocation(), newUser.Email)
 	return &newUser, nil
 }
 
 func (r *authRepository) GetUserById(ctx context.Context, id string) (*models.UserRecord, error) {
-	query := `SELECT id, email, username, password, role, verified, last_login_at, refresh_token, created_at, updated_at 
+	query := `SELECT id, email, username, password, verified, last_login_at, mfa_enabled, mfa_secret, mfa_last_step, created_at, updated_at 
 	          FROM users WHERE id = $1`
 
 	var user models.UserRecord
+	// NULL until the first login
+	var lastLoginAt sql.NullTime
 
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
 		&user.Email,
 		&user.Username,
 		&user.Password,
-		&user.Role,
 		&user.Verified,
-		&user.LastLoginAt,
-		&user.RefreshToken,
+		&lastLoginAt,
+		&user.MFAEnabled,
+		&user.MFASecret,
+		&user.MFALastStep,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -92,12 +135,14 @@ func (r *authRepository) GetUserById(ctx context.Context, id string) (*models.Us
 		return nil, err
 	}
 
+	user.LastLoginAt = lastLoginAt.Time
+
 	log.Printf("[%s] User %s retrieved successfully\n", utils.GetLocation(), id)
 	return &user, nil
 }
 
 func (r *authRepository) GetUserByEmail(ctx context.Context, email string) (*models.UserRecord, error) {
-	query := `SELECT id, email, username, password, role, verified, created_at, updated_at FROM users WHERE email = $1`
+	query := `SELECT id, email, username, password, verified, mfa_enabled, created_at, updated_at FROM users WHERE email = $1`
 
 	var user models.UserRecord
 
@@ -108,8 +153,8 @@ func (r *authRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 		&user.Email,
 		&user.Username,
 		&user.Password,
-		&user.Role,
 		&user.Verified,
+		&user.MFAEnabled,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -137,17 +182,6 @@ func (r *authRepository) UpdateUserVerification(ctx context.Context, email strin
 	return nil
 }
 
-func (r *authRepository) UpdateRefreshToken(ctx context.Context, userID string, refreshToken string) error {
-	query := `UPDATE users SET refresh_token = $1  WHERE id = $2`
-	_, err := r.db.ExecContext(ctx, query, refreshToken, userID)
-	if err != nil {
-		log.Printf("[%s] Failed to update refresh token for user ID %s: %v\n", utils.GetLocation(), userID, err)
-		return err
-	}
-	log.Printf("[%s] Refresh token updated for user ID %s\n", utils.GetLocation(), userID)
-	return nil
-}
-
 func (r *authRepository) UpdateLastLogin(ctx context.Context, userID string) error {
 	query := `UPDATE users SET last_login_at = $1 WHERE id = $2`
 	_, err := r.db.ExecContext(ctx, query, time.Now(), userID)
@@ -159,13 +193,38 @@ func (r *authRepository) UpdateLastLogin(ctx context.Context, userID string) err
 	return nil
 }
 
-func (r *authRepository) DeleteRefreshToken(ctx context.Context, userID string) error {
-	query := `UPDATE users SET refresh_token = NULL WHERE id = $1`
-	_, err := r.db.ExecContext(ctx, query, userID)
+// UpdatePassword replaces the password and ends every session, so every device has to log in again
+func (r *authRepository) UpdatePassword(ctx context.Context, userID string, hashedPassword string) error {
+	return r.updateUserAndEndSessions(ctx, userID, `UPDATE users SET password = $1 WHERE id = $2`, hashedPassword, "password")
+}
+
+// UpdateEmail replaces the email and ends every session, so every device has to log in again
+func (r *authRepository) UpdateEmail(ctx context.Context, userID string, email string) error {
+	return r.updateUserAndEndSessions(ctx, userID, `UPDATE users SET email = $1 WHERE id = $2`, email, "email")
+}
+
+// updateUserAndEndSessions sets a credential of the user with query, which takes the value as $1 and the ID as $2,
+// and deletes the sessions in the same transaction
+func (r *authRepository) updateUserAndEndSessions(ctx context.Context, userID string, query string, value string, field string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		log.Printf("[%s] Failed to delete refresh token for user ID %s: %v\n", utils.GetLocation(), userID, err)
 		return err
 	}
-	log.Printf("[%s] Successfully deleted refresh token for user ID %s\n", utils.GetLocation(), userID)
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, query, value, userID); err != nil {
+		log.Printf("[%s] Failed to update %s for user ID %s: %v\n", utils.GetLocation(), field, userID, err)
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM sessions WHERE user_id = $1`, userID); err != nil {
+		log.Printf("[%s] Failed to delete sessions for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Printf("[%s] Updated %s and ended sessions for user ID %s\n", utils.GetLocation(), field, userID)
 	return nil
 }
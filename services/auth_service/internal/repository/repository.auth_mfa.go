@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+var ErrMFAAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+
+// SetMFASecret stores the secret of a pending enrollment, it replaces an earlier pending secret but never the secret in use
+func (r *authRepository) SetMFASecret(ctx context.Context, userID string, secret string) error {
+	query := `UPDATE users SET mfa_secret = $1 WHERE id = $2 AND NOT mfa_enabled`
+	result, err := r.db.ExecContext(ctx, query, secret, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to set MFA secret for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrMFAAlreadyEnabled
+	}
+
+	log.Printf("[%s] MFA enrollment started for user ID %s\n", utils.GetLocation(), userID)
+	return nil
+}
+
+// EnableMFA turns 2FA on with the pending secret, records the step of the confirming code and replaces the backup codes
+func (r *authRepository) EnableMFA(ctx context.Context, userID string, step int64, backupCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE users SET mfa_enabled = true, mfa_last_step = $1 WHERE id = $2 AND NOT mfa_enabled`, step, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to enable MFA for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrMFAAlreadyEnabled
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_mfa_backup_codes WHERE user_id = $1`, userID); err != nil {
+		log.Printf("[%s] Failed to delete backup codes for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return err
+	}
+	for _, codeHash := range backupCodeHashes {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO user_mfa_backup_codes (user_id, code_hash) VALUES ($1, $2)`, userID, codeHash); err != nil {
+			log.Printf("[%s] Failed to insert backup code for user ID %s: %v\n", utils.GetLocation(), userID, err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Printf("[%s] MFA enabled for user ID %s\n", utils.GetLocation(), userID)
+	return nil
+}
+
+// UpdateMFALastStep records an accepted TOTP step, it returns false when the step or a later one was already used
+func (r *authRepository) UpdateMFALastStep(ctx context.Context, userID string, step int64) (bool, error) {
+	query := `UPDATE users SET mfa_last_step = $1 WHERE id = $2 AND mfa_last_step < $1`
+	result, err := r.db.ExecContext(ctx, query, step, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to update MFA step for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// UseMFABackupCode marks an unused backup code as used, it returns false when there is no such unused code
+func (r *authRepository) UseMFABackupCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	query := `UPDATE user_mfa_backup_codes SET used_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+	result, err := r.db.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		log.Printf("[%s] Failed to use backup code for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 1 {
+		log.Printf("[%s] Backup code used for user ID %s\n", utils.GetLocation(), userID)
+	}
+	return affected == 1, nil
+}
+
+// UseMFAToken records a challenge token as used, it returns false when it was used before
+func (r *authRepository) UseMFAToken(ctx context.Context, tokenID string, expiresAt time.Time) (bool, error) {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM used_mfa_tokens WHERE expires_at < CURRENT_TIMESTAMP`); err != nil {
+		log.Printf("[%s] Failed to delete expired MFA tokens: %v\n", utils.GetLocation(), err)
+		return false, err
+	}
+
+	query := `INSERT INTO used_mfa_tokens (token_id, expires_at) VALUES ($1, $2) ON CONFLICT (token_id) DO NOTHING`
+	result, err := r.db.ExecContext(ctx, query, tokenID, expiresAt)
+	if err != nil {
+		log.Printf("[%s] Failed to use MFA token ID %s: %v\n", utils.GetLocation(), tokenID, err)
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// IsMFARequiredForUser reports whether any role of the user requires a second factor
+func (r *authRepository) IsMFARequiredForUser(ctx context.Context, userID string) (bool, error) {
+	query := `SELECT EXISTS (
+	              SELECT 1 FROM user_roles ur
+	              JOIN role_mfa_requirements m ON m.role = ur.role
+	              WHERE ur.user_id = $1 AND m.required
+	          )`
+
+	var required bool
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&required); err != nil {
+		log.Printf("[%s] Failed to get MFA requirement for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return false, err
+	}
+	return required, nil
+}
+
+func (r *authRepository) SetRoleMFARequirement(ctx context.Context, role string, required bool) (*models.RoleMFARequirement, error) {
+	query := `INSERT INTO role_mfa_requirements (role, required) VALUES ($1, $2)
+	          ON CONFLICT (role) DO UPDATE SET required = EXCLUDED.required, updated_at = CURRENT_TIMESTAMP
+	          RETURNING role, required`
+
+	var requirement models.RoleMFARequirement
+	if err := r.db.QueryRowContext(ctx, query, role, required).Scan(&requirement.Role, &requirement.Required); err != nil {
+		log.Printf("[%s] Failed to set MFA requirement for role %s: %v\n", utils.GetLocation(), role, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] MFA requirement for role %s set to %v\n", utils.GetLocation(), role, required)
+	return &requirement, nil
+}
+
+// ListRoleMFARequirements lists every role, a role without a requirement does not require a second factor
+func (r *authRepository) ListRoleMFARequirements(ctx context.Context) ([]models.RoleMFARequirement, error) {
+	query := `SELECT r.name, COALESCE(m.required, false)
+	          FROM roles r LEFT JOIN role_mfa_requirements m ON m.role = r.name
+	          ORDER BY r.name`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		log.Printf("[%s] Failed to list MFA requirements: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requirements []models.RoleMFARequirement
+	for rows.Next() {
+		var requirement models.RoleMFARequirement
+		if err := rows.Scan(&requirement.Role, &requirement.Required); err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, rows.Err()
+}
@@ -0,0 +1,293 @@
+package repository
+
+import (
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+)
+
+var (
+	ErrRoleNotFound    = errors.New("role not found")
+	ErrRoleExists      = errors.New("role already exists")
+	ErrRoleNotAssigned = errors.New("role not assigned to the user")
+	ErrLastRoleHolder  = errors.New("user is the last holder of the role")
+)
+
+// GetUserAccess returns the roles of a user and the union of their permissions, both sorted
+func (r *authRepository) GetUserAccess(ctx context.Context, userID string) ([]string, []string, error) {
+	roles, err := r.queryStrings(ctx, `SELECT role FROM user_roles WHERE user_id = $1 ORDER BY role`, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to get roles for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return nil, nil, err
+	}
+
+	query := `SELECT DISTINCT rp.permission
+	          FROM user_roles ur JOIN role_permissions rp ON rp.role = ur.role
+	          WHERE ur.user_id = $1
+	          ORDER BY rp.permission`
+	permissions, err := r.queryStrings(ctx, query, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to get permissions for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return nil, nil, err
+	}
+	return roles, permissions, nil
+}
+
+func (r *authRepository) ListRoles(ctx context.Context) ([]models.RoleRecord, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT name, description, built_in, created_at, updated_at FROM roles ORDER BY name`)
+	if err != nil {
+		log.Printf("[%s] Failed to list roles: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []models.RoleRecord{}
+	index := make(map[string]int)
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			log.Printf("[%s] Failed to scan role: %v\n", utils.GetLocation(), err)
+			return nil, err
+		}
+		index[role.Name] = len(roles)
+		roles = append(roles, *role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	permissionRows, err := r.db.QueryContext(ctx, `SELECT role, permission FROM role_permissions ORDER BY role, permission`)
+	if err != nil {
+		log.Printf("[%s] Failed to list role permissions: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+	defer permissionRows.Close()
+
+	for permissionRows.Next() {
+		var role, permission string
+		if err := permissionRows.Scan(&role, &permission); err != nil {
+			return nil, err
+		}
+		if i, ok := index[role]; ok {
+			roles[i].Permissions = append(roles[i].Permissions, permission)
+		}
+	}
+	return roles, permissionRows.Err()
+}
+
+func (r *authRepository) GetRole(ctx context.Context, name string) (*models.RoleRecord, error) {
+	query := `SELECT name, description, built_in, created_at, updated_at FROM roles WHERE name = $1`
+
+	role, err := scanRole(r.db.QueryRowContext(ctx, query, name))
+	if err == sql.ErrNoRows {
+		log.Printf("[%s] Role %s not found\n", utils.GetLocation(), name)
+		return nil, ErrRoleNotFound
+	} else if err != nil {
+		log.Printf("[%s] Error fetching role %s: %v\n", utils.GetLocation(), name, err)
+		return nil, err
+	}
+
+	role.Permissions, err = r.queryStrings(ctx, `SELECT permission FROM role_permissions WHERE role = $1 ORDER BY permission`, name)
+	if err != nil {
+		log.Printf("[%s] Error fetching permissions of role %s: %v\n", utils.GetLocation(), name, err)
+		return nil, err
+	}
+	return role, nil
+}
+
+// CreateRole inserts a custom role with its permissions, it returns ErrRoleExists when the name is taken
+func (r *authRepository) CreateRole(ctx context.Context, role *models.RoleRecord) (*models.RoleRecord, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO roles (name, description) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING`, role.Name, role.Description)
+	if err != nil {
+		log.Printf("[%s] Failed to insert role %s: %v\n", utils.GetLocation(), role.Name, err)
+		return nil, err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if affected == 0 {
+		return nil, ErrRoleExists
+	}
+
+	if err := insertRolePermissions(ctx, tx, role.Name, role.Permissions); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[%s] Role %s created\n", utils.GetLocation(), role.Name)
+	return r.GetRole(ctx, role.Name)
+}
+
+// UpdateRole replaces the description and the permissions of a role
+func (r *authRepository) UpdateRole(ctx context.Context, role *models.RoleRecord) (*models.RoleRecord, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE roles SET description = $1 WHERE name = $2`, role.Description, role.Name)
+	if err != nil {
+		log.Printf("[%s] Failed to update role %s: %v\n", utils.GetLocation(), role.Name, err)
+		return nil, err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if affected == 0 {
+		return nil, ErrRoleNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_permissions WHERE role = $1`, role.Name); err != nil {
+		log.Printf("[%s] Failed to delete permissions of role %s: %v\n", utils.GetLocation(), role.Name, err)
+		return nil, err
+	}
+	if err := insertRolePermissions(ctx, tx, role.Name, role.Permissions); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[%s] Role %s updated\n", utils.GetLocation(), role.Name)
+	return r.GetRole(ctx, role.Name)
+}
+
+// DeleteRole deletes a custom role, its assignments and MFA requirement go with it. Built-in roles are kept.
+func (r *authRepository) DeleteRole(ctx context.Context, name string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM roles WHERE name = $1 AND NOT built_in`, name)
+	if err != nil {
+		log.Printf("[%s] Failed to delete role %s: %v\n", utils.GetLocation(), name, err)
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrRoleNotFound
+	}
+
+	log.Printf("[%s] Role %s deleted\n", utils.GetLocation(), name)
+	return nil
+}
+
+func (r *authRepository) ListUserIdsByRole(ctx context.Context, role string) ([]string, error) {
+	userIDs, err := r.queryStrings(ctx, `SELECT user_id FROM user_roles WHERE role = $1`, role)
+	if err != nil {
+		log.Printf("[%s] Failed to list users of role %s: %v\n", utils.GetLocation(), role, err)
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// AssignRole gives the user a role, assigning a role the user already holds is a no-op
+func (r *authRepository) AssignRole(ctx context.Context, userID string, role string) error {
+	query := `INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT (user_id, role) DO NOTHING`
+	if _, err := r.db.ExecContext(ctx, query, userID, role); err != nil {
+		log.Printf("[%s] Failed to assign role %s to user ID %s: %v\n", utils.GetLocation(), role, userID, err)
+		return err
+	}
+
+	log.Printf("[%s] Role %s assigned to user ID %s\n", utils.GetLocation(), role, userID)
+	return nil
+}
+
+// UnassignRole takes a role from the user. With keepLast it returns ErrLastRoleHolder instead when no one else
+// holds the role, the holders stay locked until the delete commits so two calls cannot both pass the check.
+func (r *authRepository) UnassignRole(ctx context.Context, userID string, role string, keepLast bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT user_id FROM user_roles WHERE role = $1 FOR UPDATE`, role)
+	if err != nil {
+		log.Printf("[%s] Failed to lock holders of role %s: %v\n", utils.GetLocation(), role, err)
+		return err
+	}
+	holders, assigned := 0, false
+	for rows.Next() {
+		var holder string
+		if err := rows.Scan(&holder); err != nil {
+			rows.Close()
+			return err
+		}
+		holders++
+		assigned = assigned || holder == userID
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if !assigned {
+		return ErrRoleNotAssigned
+	}
+	if keepLast && holders == 1 {
+		return ErrLastRoleHolder
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_roles WHERE user_id = $1 AND role = $2`, userID, role); err != nil {
+		log.Printf("[%s] Failed to unassign role %s from user ID %s: %v\n", utils.GetLocation(), role, userID, err)
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	log.Printf("[%s] Role %s unassigned from user ID %s\n", utils.GetLocation(), role, userID)
+	return nil
+}
+
+func insertRolePermissions(ctx context.Context, tx *sql.Tx, role string, permissions []string) error {
+	for _, permission := range permissions {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO role_permissions (role, permission) VALUES ($1, $2)`, role, permission); err != nil {
+			log.Printf("[%s] Failed to grant %s to role %s: %v\n", utils.GetLocation(), permission, role, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// queryStrings runs a query of a single text column
+func (r *authRepository) queryStrings(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []string{}
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, rows.Err()
+}
+
+func scanRole(row rowScanner) (*models.RoleRecord, error) {
+	var role models.RoleRecord
+	err := row.Scan(
+		&role.Name,
+		&role.Description,
+		&role.BuiltIn,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+const sessionColumns = `id, user_id, family_id, refresh_token_hash, user_agent, ip_address, created_at, last_used_at, expires_at`
+
+// CreateSession stores a new session, the expired sessions of the user are cleared on the way
+func (r *authRepository) CreateSession(ctx context.Context, session *models.SessionRecord) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM sessions WHERE user_id = $1 AND expires_at <= CURRENT_TIMESTAMP`, session.UserID); err != nil {
+		log.Printf("[%s] Failed to delete expired sessions for user ID %s: %v\n", utils.GetLocation(), session.UserID, err)
+		return err
+	}
+
+	query := `INSERT INTO sessions (id, user_id, family_id, refresh_token_hash, user_agent, ip_address, expires_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7)
+	          RETURNING created_at, last_used_at`
+	err := r.db.QueryRowContext(ctx, query,
+		session.ID,
+		session.UserID,
+		session.FamilyID,
+		session.RefreshTokenHash,
+		session.UserAgent,
+		session.IPAddress,
+		session.ExpiresAt,
+	).Scan(&session.CreatedAt, &session.LastUsedAt)
+	if err != nil {
+		log.Printf("[%s] Failed to create session for user ID %s: %v\n", utils.GetLocation(), session.UserID, err)
+		return err
+	}
+
+	log.Printf("[%s] Session %s created for user ID %s\n", utils.GetLocation(), session.ID, session.UserID)
+	return nil
+}
+
+// GetSessionByFamilyId returns the live session of a token family, an expired one counts as not found
+func (r *authRepository) GetSessionByFamilyId(ctx context.Context, familyID string) (*models.SessionRecord, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE family_id = $1 AND expires_at > CURRENT_TIMESTAMP`
+
+	session, err := scanSession(r.db.QueryRowContext(ctx, query, familyID))
+	if err == sql.ErrNoRows {
+		log.Printf("[%s] Session of token family %s not found\n", utils.GetLocation(), familyID)
+		return nil, ErrSessionNotFound
+	} else if err != nil {
+		log.Printf("[%s] Error fetching session of token family %s: %v\n", utils.GetLocation(), familyID, err)
+		return nil, err
+	}
+	return session, nil
+}
+
+// RotateSession stores the next refresh token of a session along with the device that used it. It returns false
+// when the session no longer holds oldTokenHash, that is when another refresh got there first or the session ended.
+func (r *authRepository) RotateSession(ctx context.Context, sessionID string, oldTokenHash string, session *models.SessionRecord) (bool, error) {
+	query := `UPDATE sessions
+	          SET refresh_token_hash = $1, user_agent = $2, ip_address = $3, expires_at = $4, last_used_at = CURRENT_TIMESTAMP
+	          WHERE id = $5 AND refresh_token_hash = $6`
+	result, err := r.db.ExecContext(ctx, query,
+		session.RefreshTokenHash,
+		session.UserAgent,
+		session.IPAddress,
+		session.ExpiresAt,
+		sessionID,
+		oldTokenHash,
+	)
+	if err != nil {
+		log.Printf("[%s] Failed to rotate session %s: %v\n", utils.GetLocation(), sessionID, err)
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[%s] Session %s rotated: %v\n", utils.GetLocation(), sessionID, affected > 0)
+	return affected > 0, nil
+}
+
+// ListSessionsByUserId returns the live sessions of a user, the most recently used first
+func (r *authRepository) ListSessionsByUserId(ctx context.Context, userID string) ([]models.SessionRecord, error) {
+	query := `SELECT ` + sessionColumns + ` FROM sessions WHERE user_id = $1 AND expires_at > CURRENT_TIMESTAMP ORDER BY last_used_at DESC`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to list sessions for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []models.SessionRecord{}
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			log.Printf("[%s] Failed to scan session for user ID %s: %v\n", utils.GetLocation(), userID, err)
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// DeleteSession ends a session of the user, it returns false when the user has no such session
+func (r *authRepository) DeleteSession(ctx context.Context, userID string, sessionID string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM sessions WHERE id = $1 AND user_id = $2`, sessionID, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to delete session %s for user ID %s: %v\n", utils.GetLocation(), sessionID, userID, err)
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[%s] Session %s deleted for user ID %s: %v\n", utils.GetLocation(), sessionID, userID, affected > 0)
+	return affected > 0, nil
+}
+
+// DeleteSessionsByUserId ends every session of the user
+func (r *authRepository) DeleteSessionsByUserId(ctx context.Context, userID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM sessions WHERE user_id = $1`, userID); err != nil {
+		log.Printf("[%s] Failed to delete sessions for user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return err
+	}
+
+	log.Printf("[%s] Sessions deleted for user ID %s\n", utils.GetLocation(), userID)
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSession(row rowScanner) (*models.SessionRecord, error) {
+	var session models.SessionRecord
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.FamilyID,
+		&session.RefreshTokenHash,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/pkg/jwt"
+	"auth_service/pkg/logger"
+	"auth_service/pkg/utils"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+type KeyRotator struct {
+	keys     *jwt.KeyRing
+	logger   *logger.Logger
+	interval time.Duration
+}
+
+func NewKeyRotator(keys *jwt.KeyRing, logger *logger.Logger, interval time.Duration) *KeyRotator {
+	return &KeyRotator{
+		keys:     keys,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Start checks the signing keys once immediately and then on every interval until ctx is cancelled
+func (r *KeyRotator) Start(ctx context.Context) {
+	log.Printf("[%s] Key rotator started with interval %s\n", utils.GetLocation(), r.interval)
+	runEvery(ctx, r.interval, r.run)
+	log.Printf("[%s] Key rotator stopped\n", utils.GetLocation())
+}
+
+func (r *KeyRotator) run(ctx context.Context) {
+	requestID := fmt.Sprintf("key-rotation-%d", time.Now().Unix())
+
+	result, err := r.keys.Rotate()
+	if result.Generated != "" {
+		r.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Signing key rotated", map[string]interface{}{"kid": result.Generated}, nil)
+	}
+	if len(result.Removed) > 0 {
+		r.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Retired signing keys removed", map[string]interface{}{"kids": result.Removed}, nil)
+	}
+	if err != nil {
+		r.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to rotate signing keys", nil, err)
+	}
+}
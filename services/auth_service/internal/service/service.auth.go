@@ -5,11 +5,16 @@ import (
 	"auth_service/internal/models"
 	"auth_service/internal/repository"
 	"auth_service/pkg/jwt"
+	"auth_service/pkg/lockout"
 	"auth_service/pkg/mailer"
+	"auth_service/pkg/password"
 	"auth_service/pkg/rabbitmq"
+	"auth_service/pkg/revocation"
 	"auth_service/pkg/utils"
 	"context"
+	"errors"
 	"log"
+	"time"
 )
 
 type AuthService interface {
@@ -18,23 +23,60 @@ type AuthService interface {
 	VerifyEmail(ctx context.Context, req *models.VerifyEmailRequest, redisOtp string) (*models.VerifyEmailResponse, error)
 	Login(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error)
 	ValidateToken(ctx context.Context, req *models.ValidateTokenRequest) (*models.ValidateTokenResponse, error)
-	RefreshToken(ctx context.Context, userID string, oldRefreshToken string) (*models.LoginResponse, error)
-	Logout(ctx context.Context, userID string) error
+	GetJWKS(ctx context.Context) []jwt.JWK
+	RefreshToken(ctx context.Context, userID string, oldRefreshToken string, client models.ClientInfo) (*models.LoginResponse, error)
+	Logout(ctx context.Context, userID string, sessionID string) error
+	RequestPasswordReset(ctx context.Context, email string) (*string, error)
+	ResetPassword(ctx context.Context, req *models.ResetPasswordRequest, cachedToken, takeToken func() (string, error)) error
+	ChangePassword(ctx context.Context, req *models.ChangePasswordRequest) error
+	ChangeEmail(ctx context.Context, userID string, newEmail string) (*string, error)
+	ConfirmEmailChange(ctx context.Context, req *models.ConfirmEmailChangeRequest, redisOtp string) error
+	EnrollMFA(ctx context.Context, req *models.EnrollMFARequest) (*models.EnrollMFAResponse, error)
+	ConfirmMFA(ctx context.Context, req *models.ConfirmMFARequest) (*models.ConfirmMFAResponse, error)
+	VerifyMFA(ctx context.Context, req *models.VerifyMFARequest) (*models.LoginResponse, error)
+	SetRoleMFARequirement(ctx context.Context, role string, required bool) (*models.RoleMFARequirement, error)
+	ListRoleMFARequirements(ctx context.Context) ([]models.RoleMFARequirement, error)
+	UnlockAccount(ctx context.Context, email string) error
+	ListSessions(ctx context.Context, userID string) ([]models.SessionRecord, error)
+	RevokeSession(ctx context.Context, userID string, sessionID string) error
+	ForceLogout(ctx context.Context, userID string) error
+	ListPermissions(ctx context.Context) []models.Permission
+	ListRoles(ctx context.Context) ([]models.RoleRecord, error)
+	CreateRole(ctx context.Context, req *models.RoleRequest) (*models.RoleRecord, error)
+	UpdateRole(ctx context.Context, req *models.RoleRequest) (*models.RoleRecord, error)
+	DeleteRole(ctx context.Context, name string) error
+	AssignRole(ctx context.Context, userID string, role string) error
+	UnassignRole(ctx context.Context, userID string, role string) error
+}
+
+// Limiters count the failed attempts that lock a subject out
+type Limiters struct {
+	Email lockout.Limiter // failed logins per email
+	IP    lockout.Limiter // failed logins and second factors per client IP
+	MFA   lockout.Limiter // failed second factors per user ID
 }
 
 type authService struct {
-	repo       repository.AuthRepository
-	jwtService jwt.JWTService
-	mailer     mailer.OTPMailer
-	publisher  *rabbitmq.Publisher
+	repo           repository.AuthRepository
+	jwtService     jwt.JWTService
+	mailer         mailer.OTPMailer
+	publisher      *rabbitmq.Publisher
+	passwordPolicy password.Policy
+	mfaIssuer      string
+	limiters       Limiters
+	revocations    revocation.List
 }
 
-func NewAuthService(repo repository.AuthRepository, jwtService jwt.JWTService, mailer mailer.OTPMailer, publisher *rabbitmq.Publisher) AuthService {
+func NewAuthService(repo repository.AuthRepository, jwtService jwt.JWTService, mailer mailer.OTPMailer, publisher *rabbitmq.Publisher, passwordPolicy password.Policy, mfaIssuer string, limiters Limiters, revocations revocation.List) AuthService {
 	return &authService{
-		repo:       repo,
-		jwtService: jwtService,
-		mailer:     mailer,
-		publisher:  publisher,
+		repo:           repo,
+		jwtService:     jwtService,
+		mailer:         mailer,
+		publisher:      publisher,
+		passwordPolicy: passwordPolicy,
+		mfaIssuer:      mfaIssuer,
+		limiters:       limiters,
+		revocations:    revocations,
 	}
 }
 
@@ -45,6 +87,10 @@ func (s *authService) Register(ctx context.Context, req *models.RegisterRequest)
 		return nil, ErrEmailAlreadyRegistered
 	}
 
+	if err := s.checkPasswordPolicy(req.Password); err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := utils.HashPassword(req.Password)
 	if err != nil {
 		log.Printf("[%s] Failed to hash password: %v\n", utils.GetLocation(), err)
@@ -56,7 +102,7 @@ func (s *authService) Register(ctx context.Context, req *models.RegisterRequest)
 		Username: req.Username,
 		Password: hashedPassword,
 		Verified: false,
-		Role:     "user", // Default role
+		Roles:    []string{constants.RoleUser}, // Default role
 	}
 	// Create user
 	createdUser, err := s.repo.CreateUser(ctx, &user)
@@ -135,9 +181,20 @@ func (s *authService) VerifyEmail(ctx context.Context, req *models.VerifyEmailRe
 }
 
 func (s *authService) Login(ctx context.Context, req *models.LoginRequest) (*models.LoginResponse, error) {
+	if err := checkLocked(ctx, s.limiters.Email, req.Email); err != nil {
+		return nil, err
+	}
+	if err := checkLocked(ctx, s.limiters.IP, req.Client.IP); err != nil {
+		return nil, err
+	}
+
 	user, err := s.repo.GetUserByEmail(ctx, req.Email)
 	if err != nil {
 		log.Printf("[%s] Failed to get user by email %s: %v\n", utils.GetLocation(), req.Email, err)
+		// Unknown emails count too, guessing them costs the IP as much as guessing passwords
+		if err := s.recordLoginFailure(ctx, req.Email, req.Client.IP); err != nil {
+			return nil, err
+		}
 		return nil, ErrGetUserByEmail
 	}
 
@@ -149,26 +206,72 @@ func (s *authService) Login(ctx context.Context, req *models.LoginRequest) (*mod
 	// Check password
 	if err := utils.CheckPassword(user.Password, req.Password); err != nil {
 		log.Printf("[%s] Invalid password for email %s\n", utils.GetLocation(), req.Email)
+		if err := s.recordLoginFailure(ctx, req.Email, req.Client.IP); err != nil {
+			return nil, err
+		}
 		return nil, ErrInvalidPassword
 	}
 
+	// The IP keeps its failures, one good password should not clear a run of guesses at other accounts
+	if err := s.limiters.Email.Reset(ctx, req.Email); err != nil {
+		log.Printf("[%s] Failed to reset login failures for email %s: %v\n", utils.GetLocation(), req.Email, err)
+	}
+
+	// A second factor is due when the user turned it on or one of the roles requires it, the tokens wait for VerifyMFA
+	mfaRequired, err := s.repo.IsMFARequiredForUser(ctx, user.ID)
+	if err != nil {
+		return nil, ErrGetMFARequirement
+	}
+	if user.MFAEnabled || mfaRequired {
+		mfaToken, err := s.jwtService.GenerateMFAToken(user.ID, user.Email)
+		if err != nil {
+			log.Printf("[%s] Failed to generate MFA token for email %s: %v\n", utils.GetLocation(), req.Email, err)
+			return nil, ErrGenerateMFAToken
+		}
+
+		log.Printf("[%s] Second factor required for email %s\n", utils.GetLocation(), req.Email)
+		return &models.LoginResponse{
+			MFARequired:           true,
+			MFAToken:              mfaToken,
+			MFAEnrollmentRequired: !user.MFAEnabled,
+			Message:               "Two-factor authentication required",
+		}, nil
+	}
+
+	return s.completeLogin(ctx, user, req.Client)
+}
+
+// completeLogin opens a session for the device of a user who passed every factor and issues its first tokens
+func (s *authService) completeLogin(ctx context.Context, user *models.UserRecord, client models.ClientInfo) (*models.LoginResponse, error) {
+	session, err := newSession(user.ID, client)
+	if err != nil {
+		log.Printf("[%s] Failed to generate session IDs for email %s: %v\n", utils.GetLocation(), user.Email, err)
+		return nil, ErrCreateSession
+	}
+
+	roles, permissions, err := s.repo.GetUserAccess(ctx, user.ID)
+	if err != nil {
+		return nil, ErrGetUserAccess
+	}
+
 	// Generate Access Token
-	accessToken, err := s.jwtService.GenerateToken(user.ID, user.Role, user.Email)
+	accessToken, err := s.jwtService.GenerateToken(user.ID, roles, permissions, user.Email, session.ID)
 	if err != nil {
-		log.Printf("[%s] Failed to generate access token for email %s: %v\n", utils.GetLocation(), req.Email, err)
+		log.Printf("[%s] Failed to generate access token for email %s: %v\n", utils.GetLocation(), user.Email, err)
 		return nil, ErrGenerateAccessToken
 	}
 
 	// Generate Refresh Token
-	refreshToken, err := s.jwtService.GenerateRefreshToken(user.ID, user.Role, user.Email)
+	refreshToken, err := s.jwtService.GenerateRefreshToken(user.ID, user.Email, session.FamilyID)
 	if err != nil {
-		log.Printf("[%s] Failed to generate refresh token for email %s: %v\n", utils.GetLocation(), req.Email, err)
+		log.Printf("[%s] Failed to generate refresh token for email %s: %v\n", utils.GetLocation(), user.Email, err)
 		return nil, ErrGenerateRefreshToken
 	}
 
-	// Update refresh token in database
-	if err := s.repo.UpdateRefreshToken(ctx, user.ID, refreshToken); err != nil {
-		return nil, ErrUpdateRefreshToken
+	session.RefreshTokenHash = hashRefreshToken(refreshToken)
+	session.ExpiresAt = time.Now().Add(s.jwtService.RefreshTokenExpiry())
+	if err := s.repo.CreateSession(ctx, session); err != nil {
+		return nil, ErrCreateSession
 	}
 
 	// Update last login
@@ -176,7 +279,7 @@ func (s *authService) Login(ctx context.Context, req *models.LoginRequest) (*mod
 		return nil, ErrUpdateLastLogin
 	}
 
-	log.Printf("[%s] Login successful for email %s\n", utils.GetLocation(), req.Email)
+	log.Printf("[%s] Login successful for email %s\n", utils.GetLocation(), user.Email)
 	return &models.LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -191,38 +294,98 @@ func (s *authService) ValidateToken(ctx context.Context, req *models.ValidateTok
 		return nil, ErrParseToken
 	}
 
+	revoked, err := s.revocations.IsRevoked(ctx, revocation.Token{
+		ID:        claims.ID,
+		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
+		IssuedAt:  claims.IssuedAtTime(),
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to check revocation of token for user ID %s: %v\n", utils.GetLocation(), claims.UserID, err)
+		return nil, ErrCheckRevocation
+	}
+	if revoked {
+		log.Printf("[%s] Revoked token presented for user ID %s\n", utils.GetLocation(), claims.UserID)
+		return nil, ErrTokenRevoked
+	}
+
 	log.Printf("[%s] Token validation successful for user ID %s\n", utils.GetLocation(), claims.UserID)
 	return &models.ValidateTokenResponse{
-		Valid:  true,
-		UserID: claims.UserID,
-		Role:   claims.Role,
-		Email:  claims.Email,
+		Valid:       true,
+		UserID:      claims.UserID,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+		Email:       claims.Email,
+		SessionID:   claims.SessionID,
+		TokenID:     claims.ID,
 	}, nil
 }
 
-func (s *authService) RefreshToken(ctx context.Context, userID string, oldRefreshToken string) (*models.LoginResponse, error) {
-	user, err := s.repo.GetUserById(ctx, userID)
-	if err != nil || user.RefreshToken != oldRefreshToken {
+// GetJWKS returns the public keys of the signing keys still in use, verifiers pick one by the kid of a token
+func (s *authService) GetJWKS(ctx context.Context) []jwt.JWK {
+	return s.jwtService.JWKS()
+}
+
+// RefreshToken rotates the refresh token of a session. Only the latest token of the family is accepted,
+// an older one was either stolen or replayed so the whole session is revoked.
+func (s *authService) RefreshToken(ctx context.Context, userID string, oldRefreshToken string, client models.ClientInfo) (*models.LoginResponse, error) {
+	claims, err := s.jwtService.ParseToken(oldRefreshToken, constants.TokenRefresh)
+	if err != nil || claims.UserID != userID || claims.FamilyID == "" {
 		log.Printf("[%s] Invalid or expired refresh token for user ID %s\n", utils.GetLocation(), userID)
 		return nil, ErrInvalidRefreshToken
 	}
 
+	session, err := s.repo.GetSessionByFamilyId(ctx, claims.FamilyID)
+	if errors.Is(err, repository.ErrSessionNotFound) {
+		return nil, ErrInvalidRefreshToken
+	} else if err != nil {
+		return nil, ErrGetSession
+	}
+
+	oldTokenHash := hashRefreshToken(oldRefreshToken)
+	if session.RefreshTokenHash != oldTokenHash {
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+
+	user, err := s.repo.GetUserById(ctx, userID)
+	if err != nil {
+		return nil, ErrGetUserById
+	}
+
+	// The roles are loaded again, a change since the last refresh shows up in the new access token
+	roles, permissions, err := s.repo.GetUserAccess(ctx, user.ID)
+	if err != nil {
+		return nil, ErrGetUserAccess
+	}
+
 	// Generate new tokens
-	accessToken, err := s.jwtService.GenerateToken(user.ID, user.Role, user.Email)
+	accessToken, err := s.jwtService.GenerateToken(user.ID, roles, permissions, user.Email, session.ID)
 	if err != nil {
 		return nil, ErrGenerateAccessToken
 	}
 
 	// Generate Refresh Token
-	refreshToken, err := s.jwtService.GenerateRefreshToken(user.ID, user.Role, user.Email)
+	refreshToken, err := s.jwtService.GenerateRefreshToken(user.ID, user.Email, session.FamilyID)
 	if err != nil {
 		return nil, ErrGenerateRefreshToken
 	}
 
-	// Update refresh token in database
-	if err := s.repo.UpdateRefreshToken(ctx, user.ID, refreshToken); err != nil {
+	session.RefreshTokenHash = hashRefreshToken(refreshToken)
+	session.ExpiresAt = time.Now().Add(s.jwtService.RefreshTokenExpiry())
+	if client.IP != "" {
+		session.IPAddress = client.IP
+	}
+	if client.UserAgent != "" {
+		session.UserAgent = client.UserAgent
+	}
+	rotated, err := s.repo.RotateSession(ctx, session.ID, oldTokenHash, session)
+	if err != nil {
 		return nil, ErrUpdateRefreshToken
 	}
+	if !rotated {
+		// The same token was presented twice at once, only one of the callers can be the device
+		return nil, s.revokeReusedSession(ctx, session)
+	}
 
 	return &models.LoginResponse{
 		AccessToken:  accessToken,
@@ -231,8 +394,21 @@ func (s *authService) RefreshToken(ctx context.Context, userID string, oldRefres
 	}, nil
 }
 
-func (s *authService) Logout(ctx context.Context, userID string) error {
-	err := s.repo.DeleteRefreshToken(ctx, userID)
+// Logout ends the session the access token was issued for and revokes every token issued for it,
+// or ends every session of the user and revokes all their tokens without one
+func (s *authService) Logout(ctx context.Context, userID string, sessionID string) error {
+	var err error
+	if sessionID == "" {
+		err = s.repo.DeleteSessionsByUserId(ctx, userID)
+		if err == nil {
+			err = s.revocations.RevokeUser(ctx, userID)
+		}
+	} else {
+		_, err = s.repo.DeleteSession(ctx, userID, sessionID)
+		if err == nil {
+			err = s.revocations.RevokeSession(ctx, sessionID)
+		}
+	}
 	if err != nil {
 		log.Printf("[%s] Failed to log out user ID %s: %v\n", utils.GetLocation(), userID, err)
 		return ErrLogoutFailed
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	"context"
+	"log"
+	"strings"
+)
+
+// ChangeEmail emails an OTP to the new address, the caller caches it for ConfirmEmailChange. The email
+// of the user stays the same until the OTP is confirmed.
+func (s *authService) ChangeEmail(ctx context.Context, userID string, newEmail string) (*string, error) {
+	// Get requestID from context
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	user, err := s.repo.GetUserById(ctx, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to get user by ID %s: %v\n", utils.GetLocation(), userID, err)
+		return nil, ErrGetUserById
+	}
+	if strings.EqualFold(user.Email, newEmail) {
+		log.Printf("[%s] User ID %s already uses email %s\n", utils.GetLocation(), userID, newEmail)
+		return nil, ErrEmailUnchanged
+	}
+
+	if userFromDB, _ := s.repo.GetUserByEmail(ctx, newEmail); userFromDB != nil {
+		log.Printf("[%s] User with email %s already exists\n", utils.GetLocation(), newEmail)
+		return nil, ErrEmailAlreadyRegistered
+	}
+
+	otp, err := utils.GenerateOTPCode(6)
+	if err != nil {
+		log.Printf("[%s] Failed when generate OTP code: %v\n", utils.GetLocation(), err)
+		return nil, ErrGenerateOTPCode
+	}
+
+	// Publish to RabbitMQ
+	err = s.publisher.Publish(constants.EmailExchange, constants.EmailChangeQueue, map[string]string{
+		"X-Correlation-ID": requestID,
+		"email":            newEmail,
+		"otp":              otp,
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to publish to RabbitMQ: %v\n", utils.GetLocation(), err)
+		return nil, ErrPublishToQueue
+	}
+
+	log.Printf("[%s] Email change OTP for user ID %s sent to %s\n", utils.GetLocation(), userID, newEmail)
+	return &otp, nil
+}
+
+// ConfirmEmailChange swaps the email when the OTP matches the cached one and signs the user out everywhere
+func (s *authService) ConfirmEmailChange(ctx context.Context, req *models.ConfirmEmailChangeRequest, redisOtp string) error {
+	if redisOtp == "" || req.OTP != redisOtp {
+		log.Printf("[%s] Email change OTP mismatch for user ID %s\n", utils.GetLocation(), req.UserID)
+		return ErrMismatchOTPCode
+	}
+
+	// The address may have been registered since the OTP was sent
+	if userFromDB, _ := s.repo.GetUserByEmail(ctx, req.NewEmail); userFromDB != nil {
+		log.Printf("[%s] User with email %s already exists\n", utils.GetLocation(), req.NewEmail)
+		return ErrEmailAlreadyRegistered
+	}
+
+	if err := s.repo.UpdateEmail(ctx, req.UserID, req.NewEmail); err != nil {
+		log.Printf("[%s] Failed to update email for user ID %s: %v\n", utils.GetLocation(), req.UserID, err)
+		return ErrUpdateEmail
+	}
+	s.revokeUserTokens(ctx, req.UserID)
+
+	log.Printf("[%s] Email of user ID %s changed to %s\n", utils.GetLocation(), req.UserID, req.NewEmail)
+	return nil
+}
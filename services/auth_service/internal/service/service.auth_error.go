@@ -23,4 +23,52 @@ var (
 	ErrUpdateRefreshToken     = errors.New("error update refresh token")
 	ErrUpdateLastLogin        = errors.New("error update last login")
 	ErrLogoutFailed           = errors.New("error logout")
+	ErrGenerateResetToken     = errors.New("error generate password reset token")
+	ErrInvalidResetToken      = errors.New("invalid or expired password reset token")
+	ErrUpdatePassword         = errors.New("error update password")
+	ErrWeakPassword           = errors.New("password does not meet the password policy")
+	ErrGetUserById            = errors.New("error get user by id")
+	ErrEmailUnchanged         = errors.New("new email is the same as the current email")
+	ErrUpdateEmail            = errors.New("error update email")
+	ErrGenerateMFAToken       = errors.New("error generating mfa token")
+	ErrInvalidMFAToken        = errors.New("invalid or expired mfa token")
+	ErrInvalidMFACode         = errors.New("invalid two-factor authentication code")
+	ErrCheckMFACode           = errors.New("error check two-factor authentication code")
+	ErrMFAAlreadyEnabled      = errors.New("two-factor authentication is already enabled")
+	ErrMFANotEnrolled         = errors.New("two-factor authentication enrollment has not been started")
+	ErrMFANotEnabled          = errors.New("two-factor authentication is not enabled, enroll first")
+	ErrEnrollMFA              = errors.New("error enroll two-factor authentication")
+	ErrGetMFARequirement      = errors.New("error get two-factor authentication requirement")
+	ErrSetMFARequirement      = errors.New("error set two-factor authentication requirement")
+	ErrListMFARequirements    = errors.New("error list two-factor authentication requirements")
+	ErrTooManyAttempts        = errors.New("too many failed attempts")
+	ErrCheckLockout           = errors.New("error check lockout")
+	ErrUnlockAccount          = errors.New("error unlock account")
+	ErrTooManyOTPAttempts     = errors.New("too many attempts, request a new otp code")
+	ErrTooManyResetAttempts   = errors.New("too many attempts, request a new password reset token")
+	ErrResendCooldown         = errors.New("an email was sent recently")
+	ErrRefreshTokenReused     = errors.New("refresh token was already used, the session has been revoked")
+	ErrCreateSession          = errors.New("error create session")
+	ErrGetSession             = errors.New("error get session")
+	ErrSessionNotFound        = errors.New("session not found")
+	ErrListSessions           = errors.New("error list sessions")
+	ErrRevokeSession          = errors.New("error revoke session")
+	ErrTokenRevoked           = errors.New("token has been revoked")
+	ErrCheckRevocation        = errors.New("error check token revocation")
+	ErrForceLogout            = errors.New("error force logout")
+	ErrGetUserAccess          = errors.New("error get user roles and permissions")
+	ErrListRoles              = errors.New("error list roles")
+	ErrGetRole                = errors.New("error get role")
+	ErrRoleNotFound           = errors.New("role not found")
+	ErrRoleAlreadyExists      = errors.New("role already exists")
+	ErrUnknownPermission      = errors.New("unknown permission")
+	ErrBuiltInRole            = errors.New("built-in role cannot be deleted")
+	ErrAdminRoleFixed         = errors.New("the admin role always holds every permission")
+	ErrCreateRole             = errors.New("error create role")
+	ErrUpdateRole             = errors.New("error update role")
+	ErrDeleteRole             = errors.New("error delete role")
+	ErrAssignRole             = errors.New("error assign role")
+	ErrUnassignRole           = errors.New("error unassign role")
+	ErrRoleNotAssigned        = errors.New("role not assigned to the user")
+	ErrLastAdmin              = errors.New("cannot remove the last admin")
 )
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"auth_service/pkg/lockout"
+	"auth_service/pkg/utils"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// UnlockAccount lifts the login and second factor lockouts of an account, the lockouts of client IPs stay
+func (s *authService) UnlockAccount(ctx context.Context, email string) error {
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		log.Printf("[%s] Failed to get user by email %s: %v\n", utils.GetLocation(), email, err)
+		return ErrGetUserByEmail
+	}
+
+	if err := s.limiters.Email.Unlock(ctx, user.Email); err != nil {
+		log.Printf("[%s] Failed to unlock email %s: %v\n", utils.GetLocation(), email, err)
+		return ErrUnlockAccount
+	}
+	if err := s.limiters.MFA.Unlock(ctx, user.ID); err != nil {
+		log.Printf("[%s] Failed to unlock MFA of user ID %s: %v\n", utils.GetLocation(), user.ID, err)
+		return ErrUnlockAccount
+	}
+
+	log.Printf("[%s] Account %s unlocked\n", utils.GetLocation(), email)
+	return nil
+}
+
+// recordLoginFailure counts a failed login against the email and the client IP, it returns ErrTooManyAttempts
+// when the failure started a lockout of either
+func (s *authService) recordLoginFailure(ctx context.Context, email string, clientIP string) error {
+	emailErr := recordFailure(ctx, s.limiters.Email, email)
+	ipErr := recordFailure(ctx, s.limiters.IP, clientIP)
+	if emailErr != nil {
+		return emailErr
+	}
+	return ipErr
+}
+
+// checkLocked returns ErrTooManyAttempts with the time left while the subject is locked out, an empty subject
+// is never locked. A lockout that cannot be checked refuses the attempt.
+func checkLocked(ctx context.Context, limiter lockout.Limiter, subject string) error {
+	if subject == "" {
+		return nil
+	}
+
+	remaining, err := limiter.Locked(ctx, subject)
+	if err != nil {
+		log.Printf("[%s] Failed to check lockout of %s: %v\n", utils.GetLocation(), subject, err)
+		return ErrCheckLockout
+	}
+	if remaining > 0 {
+		log.Printf("[%s] %s is locked out for %s\n", utils.GetLocation(), subject, remaining)
+		return lockedError(remaining)
+	}
+	return nil
+}
+
+// recordFailure counts a failed attempt and returns ErrTooManyAttempts when it started a lockout, a failure that
+// cannot be counted is only logged so the caller still gets the error of the attempt itself
+func recordFailure(ctx context.Context, limiter lockout.Limiter, subject string) error {
+	if subject == "" {
+		return nil
+	}
+
+	duration, err := limiter.Fail(ctx, subject)
+	if err != nil {
+		log.Printf("[%s] Failed to record failed attempt of %s: %v\n", utils.GetLocation(), subject, err)
+		return nil
+	}
+	if duration > 0 {
+		return lockedError(duration)
+	}
+	return nil
+}
+
+func lockedError(remaining time.Duration) error {
+	return fmt.Errorf("%w, try again in %s", ErrTooManyAttempts, remaining.Round(time.Second))
+}
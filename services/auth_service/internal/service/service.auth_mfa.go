@@ -0,0 +1,283 @@
+package service
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/models"
+	"auth_service/internal/repository"
+	"auth_service/pkg/jwt"
+	"auth_service/pkg/totp"
+	"auth_service/pkg/utils"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	mfaBackupCodeCount = 10
+	mfaBackupCodeBytes = 5 // shown as two groups of five hex characters
+	mfaSkew            = 1 // steps accepted either side of the current one for clock drift
+)
+
+// EnrollMFA starts an enrollment with a new secret, 2FA stays off until ConfirmMFA proves the authenticator has it
+func (s *authService) EnrollMFA(ctx context.Context, req *models.EnrollMFARequest) (*models.EnrollMFAResponse, error) {
+	user, _, err := s.mfaUser(ctx, req.UserID, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled {
+		log.Printf("[%s] MFA already enabled for user ID %s\n", utils.GetLocation(), user.ID)
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Printf("[%s] Failed to generate MFA secret: %v\n", utils.GetLocation(), err)
+		return nil, ErrEnrollMFA
+	}
+
+	if err := s.repo.SetMFASecret(ctx, user.ID, secret); err != nil {
+		if errors.Is(err, repository.ErrMFAAlreadyEnabled) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, ErrEnrollMFA
+	}
+
+	log.Printf("[%s] MFA enrollment started for user ID %s\n", utils.GetLocation(), user.ID)
+	return &models.EnrollMFAResponse{
+		Secret:     secret,
+		OtpauthURI: totp.URI(s.mfaIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmMFA turns 2FA on once the code matches the pending secret and returns the backup codes, which are
+// only ever shown here. Confirming with a challenge token also completes the login that asked for the enrollment.
+func (s *authService) ConfirmMFA(ctx context.Context, req *models.ConfirmMFARequest) (*models.ConfirmMFAResponse, error) {
+	user, challenge, err := s.mfaUser(ctx, req.UserID, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled {
+		log.Printf("[%s] MFA already enabled for user ID %s\n", utils.GetLocation(), user.ID)
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if user.MFASecret == "" {
+		log.Printf("[%s] No MFA enrollment for user ID %s\n", utils.GetLocation(), user.ID)
+		return nil, ErrMFANotEnrolled
+	}
+
+	// A challenge token alone reaches this, so guessing the code is capped as in VerifyMFA
+	if err := checkLocked(ctx, s.limiters.MFA, user.ID); err != nil {
+		return nil, err
+	}
+	if err := checkLocked(ctx, s.limiters.IP, req.Client.IP); err != nil {
+		return nil, err
+	}
+
+	step, ok := totp.Validate(user.MFASecret, req.Code, time.Now(), mfaSkew)
+	if !ok {
+		log.Printf("[%s] MFA code mismatch for user ID %s\n", utils.GetLocation(), user.ID)
+		if err := recordFailure(ctx, s.limiters.MFA, user.ID); err != nil {
+			return nil, err
+		}
+		if err := recordFailure(ctx, s.limiters.IP, req.Client.IP); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidMFACode
+	}
+	if err := s.useMFAToken(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	if err := s.limiters.MFA.Reset(ctx, user.ID); err != nil {
+		log.Printf("[%s] Failed to reset MFA failures for user ID %s: %v\n", utils.GetLocation(), user.ID, err)
+	}
+
+	backupCodes := make([]string, 0, mfaBackupCodeCount)
+	backupCodeHashes := make([]string, 0, mfaBackupCodeCount)
+	for i := 0; i < mfaBackupCodeCount; i++ {
+		code, err := utils.GenerateToken(mfaBackupCodeBytes)
+		if err != nil {
+			log.Printf("[%s] Failed to generate backup code: %v\n", utils.GetLocation(), err)
+			return nil, ErrEnrollMFA
+		}
+		backupCodes = append(backupCodes, code[:5]+"-"+code[5:])
+		backupCodeHashes = append(backupCodeHashes, hashBackupCode(code))
+	}
+
+	if err := s.repo.EnableMFA(ctx, user.ID, step, backupCodeHashes); err != nil {
+		if errors.Is(err, repository.ErrMFAAlreadyEnabled) {
+			return nil, ErrMFAAlreadyEnabled
+		}
+		return nil, ErrEnrollMFA
+	}
+
+	resp := &models.ConfirmMFAResponse{BackupCodes: backupCodes}
+	if req.MFAToken != "" {
+		resp.Tokens, err = s.completeLogin(ctx, user, req.Client)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[%s] MFA enabled for user ID %s\n", utils.GetLocation(), user.ID)
+	return resp, nil
+}
+
+// VerifyMFA exchanges the challenge of a login and a second factor for the access and refresh tokens
+func (s *authService) VerifyMFA(ctx context.Context, req *models.VerifyMFARequest) (*models.LoginResponse, error) {
+	user, challenge, err := s.mfaUser(ctx, "", req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.MFAEnabled {
+		log.Printf("[%s] MFA not enabled for user ID %s\n", utils.GetLocation(), user.ID)
+		return nil, ErrMFANotEnabled
+	}
+
+	if err := checkLocked(ctx, s.limiters.MFA, user.ID); err != nil {
+		return nil, err
+	}
+	if err := checkLocked(ctx, s.limiters.IP, req.Client.IP); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkMFACode(ctx, user, req.Code); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			if err := recordFailure(ctx, s.limiters.MFA, user.ID); err != nil {
+				return nil, err
+			}
+			if err := recordFailure(ctx, s.limiters.IP, req.Client.IP); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	if err := s.useMFAToken(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	if err := s.limiters.MFA.Reset(ctx, user.ID); err != nil {
+		log.Printf("[%s] Failed to reset MFA failures for user ID %s: %v\n", utils.GetLocation(), user.ID, err)
+	}
+
+	return s.completeLogin(ctx, user, req.Client)
+}
+
+func (s *authService) SetRoleMFARequirement(ctx context.Context, role string, required bool) (*models.RoleMFARequirement, error) {
+	if _, err := s.getRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	requirement, err := s.repo.SetRoleMFARequirement(ctx, role, required)
+	if err != nil {
+		return nil, ErrSetMFARequirement
+	}
+
+	log.Printf("[%s] MFA requirement for role %s set to %v\n", utils.GetLocation(), role, required)
+	return requirement, nil
+}
+
+// ListRoleMFARequirements lists every role with its requirement, even when it was never set
+func (s *authService) ListRoleMFARequirements(ctx context.Context) ([]models.RoleMFARequirement, error) {
+	requirements, err := s.repo.ListRoleMFARequirements(ctx)
+	if err != nil {
+		return nil, ErrListMFARequirements
+	}
+	return requirements, nil
+}
+
+// mfaUser returns the user named by a challenge token along with its claims, or the user with the ID of a signed-in
+// user when there is no token
+func (s *authService) mfaUser(ctx context.Context, userID string, mfaToken string) (*models.UserRecord, *jwt.JwtCustomClaim, error) {
+	var challenge *jwt.JwtCustomClaim
+	if mfaToken != "" {
+		claims, err := s.jwtService.ParseToken(mfaToken, constants.TokenMFARequired)
+		if err != nil {
+			log.Printf("[%s] Failed to parse MFA token: %v\n", utils.GetLocation(), err)
+			return nil, nil, ErrInvalidMFAToken
+		}
+		// Challenges issued without an ID cannot be used up, so they are not accepted
+		if claims.ID == "" || claims.ExpiresAt == nil {
+			log.Printf("[%s] MFA token without an ID for user ID %s\n", utils.GetLocation(), claims.UserID)
+			return nil, nil, ErrInvalidMFAToken
+		}
+		userID = claims.UserID
+		challenge = &claims
+	}
+	if userID == "" {
+		return nil, nil, ErrInvalidMFAToken
+	}
+
+	user, err := s.repo.GetUserById(ctx, userID)
+	if err != nil {
+		log.Printf("[%s] Failed to get user by ID %s: %v\n", utils.GetLocation(), userID, err)
+		return nil, nil, ErrGetUserById
+	}
+	return user, challenge, nil
+}
+
+// useMFAToken uses up the challenge once its second factor was accepted, so it cannot complete another login.
+// There is nothing to use up when a signed-in user confirms without a challenge.
+func (s *authService) useMFAToken(ctx context.Context, challenge *jwt.JwtCustomClaim) error {
+	if challenge == nil {
+		return nil
+	}
+
+	used, err := s.repo.UseMFAToken(ctx, challenge.ID, challenge.ExpiresAt.Time)
+	if err != nil {
+		return ErrCheckMFACode
+	}
+	if !used {
+		log.Printf("[%s] MFA token replayed for user ID %s\n", utils.GetLocation(), challenge.UserID)
+		return ErrInvalidMFAToken
+	}
+	return nil
+}
+
+// checkMFACode accepts an authenticator code whose step was not used before, or an unused backup code
+func (s *authService) checkMFACode(ctx context.Context, user *models.UserRecord, code string) error {
+	code = strings.TrimSpace(code)
+
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(user.MFASecret, code, time.Now(), mfaSkew)
+		if !ok {
+			log.Printf("[%s] MFA code mismatch for user ID %s\n", utils.GetLocation(), user.ID)
+			return ErrInvalidMFACode
+		}
+
+		accepted, err := s.repo.UpdateMFALastStep(ctx, user.ID, step)
+		if err != nil {
+			return ErrCheckMFACode
+		}
+		if !accepted {
+			log.Printf("[%s] MFA code replayed for user ID %s\n", utils.GetLocation(), user.ID)
+			return ErrInvalidMFACode
+		}
+		return nil
+	}
+
+	used, err := s.repo.UseMFABackupCode(ctx, user.ID, hashBackupCode(code))
+	if err != nil {
+		return ErrCheckMFACode
+	}
+	if !used {
+		log.Printf("[%s] Invalid backup code for user ID %s\n", utils.GetLocation(), user.ID)
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+// hashBackupCode ignores case and the dash between the groups, backup codes are random so a plain hash is enough
+func hashBackupCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"auth_service/internal/models"
+	"auth_service/internal/repository"
+	"auth_service/pkg/jwt"
+	"auth_service/pkg/totp"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+// fakeMFARepository keeps the 2FA state of one user, any call it does not implement panics
+type fakeMFARepository struct {
+	repository.AuthRepository
+	lastStep    int64
+	backupCodes map[string]bool // hash to used
+	usedTokens  map[string]bool
+}
+
+func (r *fakeMFARepository) UpdateMFALastStep(ctx context.Context, userID string, step int64) (bool, error) {
+	if step <= r.lastStep {
+		return false, nil
+	}
+	r.lastStep = step
+	return true, nil
+}
+
+func (r *fakeMFARepository) UseMFABackupCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	used, found := r.backupCodes[codeHash]
+	if !found || used {
+		return false, nil
+	}
+	r.backupCodes[codeHash] = true
+	return true, nil
+}
+
+func (r *fakeMFARepository) UseMFAToken(ctx context.Context, tokenID string, expiresAt time.Time) (bool, error) {
+	if r.usedTokens[tokenID] {
+		return false, nil
+	}
+	r.usedTokens[tokenID] = true
+	return true, nil
+}
+
+func newTestMFAService() (*authService, *fakeMFARepository) {
+	repo := &fakeMFARepository{
+		backupCodes: map[string]bool{hashBackupCode("abcde12345"): false},
+		usedTokens:  make(map[string]bool),
+	}
+	return &authService{repo: repo}, repo
+}
+
+func testMFAUser() *models.UserRecord {
+	return &models.UserRecord{ID: "user-1", MFAEnabled: true, MFASecret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}
+}
+
+func TestCheckMFACodeBackupCodeIsSingleUse(t *testing.T) {
+	svc, _ := newTestMFAService()
+	ctx := context.Background()
+
+	// Shown as two dashed groups, typed in any case
+	if err := svc.checkMFACode(ctx, testMFAUser(), " ABCDE-12345 "); err != nil {
+		t.Fatalf("checkMFACode() first use error = %v", err)
+	}
+	if err := svc.checkMFACode(ctx, testMFAUser(), "abcde12345"); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("checkMFACode() second use error = %v, want ErrInvalidMFACode", err)
+	}
+	if err := svc.checkMFACode(ctx, testMFAUser(), "fffff-00000"); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("checkMFACode() unknown code error = %v, want ErrInvalidMFACode", err)
+	}
+}
+
+func TestCheckMFACodeRejectsReplayedStep(t *testing.T) {
+	svc, _ := newTestMFAService()
+	ctx := context.Background()
+	user := testMFAUser()
+
+	code, err := totp.Code(user.MFASecret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.checkMFACode(ctx, user, code); err != nil {
+		t.Fatalf("checkMFACode() error = %v", err)
+	}
+	if err := svc.checkMFACode(ctx, user, code); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("checkMFACode() replay error = %v, want ErrInvalidMFACode", err)
+	}
+}
+
+func TestUseMFATokenIsSingleUse(t *testing.T) {
+	svc, _ := newTestMFAService()
+	ctx := context.Background()
+	challenge := &jwt.JwtCustomClaim{
+		UserID: "user-1",
+		RegisteredClaims: golangJWT.RegisteredClaims{
+			ID:        "challenge-1",
+			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+
+	if err := svc.useMFAToken(ctx, challenge); err != nil {
+		t.Fatalf("useMFAToken() first use error = %v", err)
+	}
+	if err := svc.useMFAToken(ctx, challenge); !errors.Is(err, ErrInvalidMFAToken) {
+		t.Fatalf("useMFAToken() second use error = %v, want ErrInvalidMFAToken", err)
+	}
+	if err := svc.useMFAToken(ctx, nil); err != nil {
+		t.Fatalf("useMFAToken() without a challenge error = %v", err)
+	}
+}
+
+// fakeLimiter locks a subject out after maxAttempts failures
+type fakeLimiter struct {
+	maxAttempts int
+	failures    map[string]int
+}
+
+func newFakeLimiter(maxAttempts int) *fakeLimiter {
+	return &fakeLimiter{maxAttempts: maxAttempts, failures: make(map[string]int)}
+}
+
+func (l *fakeLimiter) Locked(ctx context.Context, subject string) (time.Duration, error) {
+	if l.failures[subject] >= l.maxAttempts {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (l *fakeLimiter) Fail(ctx context.Context, subject string) (time.Duration, error) {
+	l.failures[subject]++
+	if l.failures[subject] == l.maxAttempts {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (l *fakeLimiter) Reset(ctx context.Context, subject string) error {
+	delete(l.failures, subject)
+	return nil
+}
+
+func (l *fakeLimiter) Unlock(ctx context.Context, subject string) error {
+	delete(l.failures, subject)
+	return nil
+}
+
+func (r *fakeMFARepository) GetUserById(ctx context.Context, id string) (*models.UserRecord, error) {
+	user := testMFAUser()
+	user.MFAEnabled = false
+	return user, nil
+}
+
+func TestConfirmMFACapsFailedCodes(t *testing.T) {
+	svc, _ := newTestMFAService()
+	mfaLimiter, ipLimiter := newFakeLimiter(2), newFakeLimiter(5)
+	svc.limiters = Limiters{MFA: mfaLimiter, IP: ipLimiter}
+	ctx := context.Background()
+	req := &models.ConfirmMFARequest{UserID: "user-1", Code: "000000", Client: models.ClientInfo{IP: "10.0.0.1"}}
+
+	if _, err := svc.ConfirmMFA(ctx, req); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("ConfirmMFA() first wrong code error = %v, want ErrInvalidMFACode", err)
+	}
+	if mfaLimiter.failures["user-1"] != 1 || ipLimiter.failures["10.0.0.1"] != 1 {
+		t.Fatalf("failures = %v and %v, want one for the user and one for the IP", mfaLimiter.failures, ipLimiter.failures)
+	}
+	if _, err := svc.ConfirmMFA(ctx, req); !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("ConfirmMFA() wrong code reaching the limit error = %v, want ErrTooManyAttempts", err)
+	}
+
+	// Even the right code is refused while locked out
+	code, err := totp.Code(testMFAUser().MFASecret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Code = code
+	if _, err := svc.ConfirmMFA(ctx, req); !errors.Is(err, ErrTooManyAttempts) {
+		t.Fatalf("ConfirmMFA() while locked out error = %v, want ErrTooManyAttempts", err)
+	}
+}
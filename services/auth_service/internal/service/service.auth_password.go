@@ -0,0 +1,145 @@
+package service
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+)
+
+// passwordResetTokenLength is the number of random bytes in a password reset token
+const passwordResetTokenLength = 32
+
+// RequestPasswordReset generates a reset token and emails it to the user, the caller caches it for ResetPassword
+func (s *authService) RequestPasswordReset(ctx context.Context, email string) (*string, error) {
+	// Get requestID from context
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	if _, err := s.repo.GetUserByEmail(ctx, email); err != nil {
+		log.Printf("[%s] Failed when get email %s: %v\n", utils.GetLocation(), email, err)
+		return nil, ErrGetUserByEmail
+	}
+
+	token, err := utils.GenerateToken(passwordResetTokenLength)
+	if err != nil {
+		log.Printf("[%s] Failed when generate password reset token: %v\n", utils.GetLocation(), err)
+		return nil, ErrGenerateResetToken
+	}
+
+	// Publish to RabbitMQ
+	err = s.publisher.Publish(constants.EmailExchange, constants.PasswordResetQueue, map[string]string{
+		"X-Correlation-ID": requestID,
+		"email":            email,
+		"token":            token,
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to publish to RabbitMQ: %v\n", utils.GetLocation(), err)
+		return nil, ErrPublishToQueue
+	}
+
+	log.Printf("[%s] Password reset token sent to %s\n", utils.GetLocation(), email)
+	return &token, nil
+}
+
+// ResetPassword sets the new password when the token matches the cached one and signs the user out everywhere.
+// cachedToken returns the cached token, counting the attempt, and is only called once the new password passes the
+// policy so a weak password does not use up an attempt. takeToken deletes the cached token and returns it, it is
+// called once the token matches so a wrong guess leaves the token in place and a right one is used only once.
+func (s *authService) ResetPassword(ctx context.Context, req *models.ResetPasswordRequest, cachedToken, takeToken func() (string, error)) error {
+	if err := s.checkPasswordPolicy(req.NewPassword); err != nil {
+		return err
+	}
+
+	redisToken, err := cachedToken()
+	if err != nil {
+		log.Printf("[%s] Failed to get password reset token for email %s: %v\n", utils.GetLocation(), req.Email, err)
+		return err
+	}
+	if !matchResetToken(req.Token, redisToken) {
+		log.Printf("[%s] Password reset token mismatch for email %s\n", utils.GetLocation(), req.Email)
+		return ErrInvalidResetToken
+	}
+
+	// A concurrent reset may have taken the token in between
+	redisToken, err = takeToken()
+	if err != nil {
+		log.Printf("[%s] Failed to take password reset token for email %s: %v\n", utils.GetLocation(), req.Email, err)
+		return err
+	}
+	if !matchResetToken(req.Token, redisToken) {
+		log.Printf("[%s] Password reset token for email %s was already used\n", utils.GetLocation(), req.Email)
+		return ErrInvalidResetToken
+	}
+
+	user, err := s.repo.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		log.Printf("[%s] Failed to get user by email %s: %v\n", utils.GetLocation(), req.Email, err)
+		return ErrGetUserByEmail
+	}
+
+	hashedPassword, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		log.Printf("[%s] Failed to hash password: %v\n", utils.GetLocation(), err)
+		return ErrFailedHashPassword
+	}
+
+	// Updating the password ends every session of the user
+	if err := s.repo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		log.Printf("[%s] Failed to update password for email %s: %v\n", utils.GetLocation(), req.Email, err)
+		return ErrUpdatePassword
+	}
+	s.revokeUserTokens(ctx, user.ID)
+
+	log.Printf("[%s] Password reset for email %s\n", utils.GetLocation(), req.Email)
+	return nil
+}
+
+// ChangePassword sets the new password when the current one matches and signs the user out everywhere
+func (s *authService) ChangePassword(ctx context.Context, req *models.ChangePasswordRequest) error {
+	user, err := s.repo.GetUserById(ctx, req.UserID)
+	if err != nil {
+		log.Printf("[%s] Failed to get user by ID %s: %v\n", utils.GetLocation(), req.UserID, err)
+		return ErrGetUserById
+	}
+
+	if err := utils.CheckPassword(user.Password, req.CurrentPassword); err != nil {
+		log.Printf("[%s] Invalid current password for user ID %s\n", utils.GetLocation(), req.UserID)
+		return ErrInvalidPassword
+	}
+
+	if err := s.checkPasswordPolicy(req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		log.Printf("[%s] Failed to hash password: %v\n", utils.GetLocation(), err)
+		return ErrFailedHashPassword
+	}
+
+	if err := s.repo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		log.Printf("[%s] Failed to update password for user ID %s: %v\n", utils.GetLocation(), req.UserID, err)
+		return ErrUpdatePassword
+	}
+	s.revokeUserTokens(ctx, user.ID)
+
+	log.Printf("[%s] Password changed for user ID %s\n", utils.GetLocation(), req.UserID)
+	return nil
+}
+
+// matchResetToken compares in constant time, no cached token matches nothing
+func matchResetToken(token, redisToken string) bool {
+	return redisToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(redisToken)) == 1
+}
+
+// checkPasswordPolicy wraps the policy violation in ErrWeakPassword so the caller learns what to fix
+func (s *authService) checkPasswordPolicy(newPassword string) error {
+	if err := s.passwordPolicy.Check(newPassword); err != nil {
+		log.Printf("[%s] Password rejected by the password policy: %v\n", utils.GetLocation(), err)
+		return fmt.Errorf("%w: %v", ErrWeakPassword, err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/models"
+	"auth_service/internal/repository"
+	"auth_service/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// permissionCatalogue is every permission a role can grant, the admin role holds all of them
+var permissionCatalogue = []models.Permission{
+	{Name: constants.PermissionBooksWrite, Description: "Create, update, delete, import and export books"},
+	{Name: constants.PermissionCopiesManage, Description: "Add, update and retire the physical copies of books"},
+	{Name: constants.PermissionAuthorsWrite, Description: "Create, update and delete authors"},
+	{Name: constants.PermissionCategoriesWrite, Description: "Create, update and delete categories"},
+	{Name: constants.PermissionCatalogueAdmin, Description: "Browse the trash, restore deleted books, authors and categories and merge duplicates"},
+	{Name: constants.PermissionLoansManage, Description: "Check books out and in, manage every loan and waive fines"},
+	{Name: constants.PermissionUsersRead, Description: "List users and membership tiers"},
+	{Name: constants.PermissionUsersManage, Description: "Manage membership tiers, unlock accounts and log users out"},
+	{Name: constants.PermissionRolesManage, Description: "Manage roles, role assignments and 2FA requirements"},
+}
+
+func (s *authService) ListPermissions(ctx context.Context) []models.Permission {
+	return permissionCatalogue
+}
+
+func (s *authService) ListRoles(ctx context.Context) ([]models.RoleRecord, error) {
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, ErrListRoles
+	}
+	return roles, nil
+}
+
+func (s *authService) CreateRole(ctx context.Context, req *models.RoleRequest) (*models.RoleRecord, error) {
+	if err := checkPermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+
+	role, err := s.repo.CreateRole(ctx, &models.RoleRecord{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if errors.Is(err, repository.ErrRoleExists) {
+		return nil, ErrRoleAlreadyExists
+	} else if err != nil {
+		return nil, ErrCreateRole
+	}
+
+	log.Printf("[%s] Role %s created with permissions %v\n", utils.GetLocation(), role.Name, role.Permissions)
+	return role, nil
+}
+
+// UpdateRole replaces the description and permissions of a role. The tokens of its members are revoked,
+// so their next refresh carries the new permissions. The admin role always holds every permission.
+func (s *authService) UpdateRole(ctx context.Context, req *models.RoleRequest) (*models.RoleRecord, error) {
+	if req.Name == constants.RoleAdmin {
+		return nil, ErrAdminRoleFixed
+	}
+	if err := checkPermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+
+	role, err := s.repo.UpdateRole(ctx, &models.RoleRecord{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if errors.Is(err, repository.ErrRoleNotFound) {
+		return nil, ErrRoleNotFound
+	} else if err != nil {
+		return nil, ErrUpdateRole
+	}
+	s.revokeRoleMembers(ctx, role.Name)
+
+	log.Printf("[%s] Role %s updated with permissions %v\n", utils.GetLocation(), role.Name, role.Permissions)
+	return role, nil
+}
+
+// DeleteRole deletes a custom role and revokes the tokens of those who held it
+func (s *authService) DeleteRole(ctx context.Context, name string) error {
+	role, err := s.getRole(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role.BuiltIn {
+		return ErrBuiltInRole
+	}
+
+	// The assignments go with the role, the members are looked up first
+	userIDs, err := s.repo.ListUserIdsByRole(ctx, name)
+	if err != nil {
+		return ErrDeleteRole
+	}
+	if err := s.repo.DeleteRole(ctx, name); errors.Is(err, repository.ErrRoleNotFound) {
+		return ErrRoleNotFound
+	} else if err != nil {
+		return ErrDeleteRole
+	}
+	for _, userID := range userIDs {
+		s.revokeUserTokens(ctx, userID)
+	}
+
+	log.Printf("[%s] Role %s deleted, %d user(s) lost it\n", utils.GetLocation(), name, len(userIDs))
+	return nil
+}
+
+// AssignRole gives a user a role, the tokens of the user are revoked so the next refresh picks it up
+func (s *authService) AssignRole(ctx context.Context, userID string, role string) error {
+	if _, err := s.getRole(ctx, role); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetUserById(ctx, userID); err != nil {
+		return ErrGetUserById
+	}
+
+	if err := s.repo.AssignRole(ctx, userID, role); err != nil {
+		return ErrAssignRole
+	}
+	s.revokeUserTokens(ctx, userID)
+
+	log.Printf("[%s] Role %s assigned to user ID %s\n", utils.GetLocation(), role, userID)
+	return nil
+}
+
+// UnassignRole takes a role from a user, the last admin keeps theirs so the system cannot be locked out
+func (s *authService) UnassignRole(ctx context.Context, userID string, role string) error {
+	err := s.repo.UnassignRole(ctx, userID, role, role == constants.RoleAdmin)
+	switch {
+	case errors.Is(err, repository.ErrRoleNotAssigned):
+		return ErrRoleNotAssigned
+	case errors.Is(err, repository.ErrLastRoleHolder):
+		return ErrLastAdmin
+	case err != nil:
+		return ErrUnassignRole
+	}
+	s.revokeUserTokens(ctx, userID)
+
+	log.Printf("[%s] Role %s unassigned from user ID %s\n", utils.GetLocation(), role, userID)
+	return nil
+}
+
+func (s *authService) getRole(ctx context.Context, name string) (*models.RoleRecord, error) {
+	role, err := s.repo.GetRole(ctx, name)
+	if errors.Is(err, repository.ErrRoleNotFound) {
+		return nil, ErrRoleNotFound
+	} else if err != nil {
+		return nil, ErrGetRole
+	}
+	return role, nil
+}
+
+// revokeRoleMembers revokes the access tokens of everyone holding the role, a failure is only logged
+func (s *authService) revokeRoleMembers(ctx context.Context, role string) {
+	userIDs, err := s.repo.ListUserIdsByRole(ctx, role)
+	if err != nil {
+		log.Printf("[%s] Failed to list members of role %s, their tokens run out on their own: %v\n", utils.GetLocation(), role, err)
+		return
+	}
+	for _, userID := range userIDs {
+		s.revokeUserTokens(ctx, userID)
+	}
+}
+
+// checkPermissions rejects a permission missing from the catalogue, a typo would otherwise grant nothing silently
+func checkPermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if !isKnownPermission(permission) {
+			return fmt.Errorf("%w: %s", ErrUnknownPermission, permission)
+		}
+	}
+	return nil
+}
+
+func isKnownPermission(name string) bool {
+	for _, permission := range permissionCatalogue {
+		if permission.Name == name {
+			return true
+		}
+	}
+	return false
+}
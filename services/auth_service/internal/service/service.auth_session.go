@@ -0,0 +1,99 @@
+package service
+
+import (
+	"auth_service/internal/models"
+	"auth_service/pkg/utils"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+)
+
+func (s *authService) ListSessions(ctx context.Context, userID string) ([]models.SessionRecord, error) {
+	sessions, err := s.repo.ListSessionsByUserId(ctx, userID)
+	if err != nil {
+		return nil, ErrListSessions
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs one device of the user out, its refresh token stops working at once
+func (s *authService) RevokeSession(ctx context.Context, userID string, sessionID string) error {
+	deleted, err := s.repo.DeleteSession(ctx, userID, sessionID)
+	if err != nil {
+		return ErrRevokeSession
+	}
+	if !deleted {
+		log.Printf("[%s] Session %s not found for user ID %s\n", utils.GetLocation(), sessionID, userID)
+		return ErrSessionNotFound
+	}
+	if err := s.revocations.RevokeSession(ctx, sessionID); err != nil {
+		log.Printf("[%s] Failed to revoke access tokens of session %s: %v\n", utils.GetLocation(), sessionID, err)
+		return ErrRevokeSession
+	}
+
+	log.Printf("[%s] Session %s revoked for user ID %s\n", utils.GetLocation(), sessionID, userID)
+	return nil
+}
+
+// ForceLogout ends every session of the user and revokes the access tokens already issued
+func (s *authService) ForceLogout(ctx context.Context, userID string) error {
+	if err := s.repo.DeleteSessionsByUserId(ctx, userID); err != nil {
+		return ErrForceLogout
+	}
+	if err := s.revocations.RevokeUser(ctx, userID); err != nil {
+		log.Printf("[%s] Failed to revoke access tokens of user ID %s: %v\n", utils.GetLocation(), userID, err)
+		return ErrForceLogout
+	}
+
+	log.Printf("[%s] User ID %s logged out everywhere\n", utils.GetLocation(), userID)
+	return nil
+}
+
+// revokeUserTokens revokes the access tokens of a user whose sessions a credential change ended. The change
+// is already stored, so a failure is only logged and the tokens run out on their own.
+func (s *authService) revokeUserTokens(ctx context.Context, userID string) {
+	if err := s.revocations.RevokeUser(ctx, userID); err != nil {
+		log.Printf("[%s] Failed to revoke access tokens of user ID %s: %v\n", utils.GetLocation(), userID, err)
+	}
+}
+
+// revokeReusedSession ends a session whose rotated refresh token came back, the thief and the device
+// both have to log in again and only the device can
+func (s *authService) revokeReusedSession(ctx context.Context, session *models.SessionRecord) error {
+	log.Printf("[%s] Reuse of a rotated refresh token detected, revoking session %s of user ID %s\n", utils.GetLocation(), session.ID, session.UserID)
+	if _, err := s.repo.DeleteSession(ctx, session.UserID, session.ID); err != nil {
+		return ErrRevokeSession
+	}
+	if err := s.revocations.RevokeSession(ctx, session.ID); err != nil {
+		log.Printf("[%s] Failed to revoke access tokens of session %s: %v\n", utils.GetLocation(), session.ID, err)
+		return ErrRevokeSession
+	}
+	return ErrRefreshTokenReused
+}
+
+// newSession returns a session of the device with new session and token family IDs
+func newSession(userID string, client models.ClientInfo) (*models.SessionRecord, error) {
+	id, err := utils.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+	familyID, err := utils.GenerateUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SessionRecord{
+		ID:        id,
+		UserID:    userID,
+		FamilyID:  familyID,
+		UserAgent: client.UserAgent,
+		IPAddress: client.IP,
+	}, nil
+}
+
+// hashRefreshToken is what a session keeps of its refresh token, the token is signed and random so a plain hash is enough
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
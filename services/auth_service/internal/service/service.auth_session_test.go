@@ -0,0 +1,200 @@
+package service
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/internal/models"
+	"auth_service/internal/repository"
+	"auth_service/pkg/jwt"
+	"auth_service/pkg/revocation"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeJWTService issues numbered tokens, a refresh token names its user and family as "refresh:<user>:<family>:<n>"
+type fakeJWTService struct {
+	jwt.JWTService
+	issued int
+}
+
+func (j *fakeJWTService) GenerateToken(userID string, roles []string, permissions []string, email string, sessionID string) (string, error) {
+	j.issued++
+	return fmt.Sprintf("access:%s:%s:%d", userID, sessionID, j.issued), nil
+}
+
+func (j *fakeJWTService) GenerateRefreshToken(userID string, email string, familyID string) (string, error) {
+	j.issued++
+	return fmt.Sprintf("refresh:%s:%s:%d", userID, familyID, j.issued), nil
+}
+
+func (j *fakeJWTService) RefreshTokenExpiry() time.Duration {
+	return time.Hour
+}
+
+func (j *fakeJWTService) ParseToken(tokenString string, expectedType string) (jwt.JwtCustomClaim, error) {
+	parts := strings.Split(tokenString, ":")
+	if len(parts) != 4 || parts[0] != "refresh" || expectedType != constants.TokenRefresh {
+		return jwt.JwtCustomClaim{}, errors.New("token is not valid")
+	}
+	return jwt.JwtCustomClaim{UserID: parts[1], FamilyID: parts[2], TokenType: constants.TokenRefresh}, nil
+}
+
+// fakeSessionRepository keeps sessions by ID, any call it does not implement panics
+type fakeSessionRepository struct {
+	repository.AuthRepository
+	sessions map[string]models.SessionRecord
+	// beforeRotate runs before a rotation, to let another refresh get there first
+	beforeRotate func()
+}
+
+func (r *fakeSessionRepository) GetSessionByFamilyId(ctx context.Context, familyID string) (*models.SessionRecord, error) {
+	for _, session := range r.sessions {
+		if session.FamilyID == familyID {
+			return &session, nil
+		}
+	}
+	return nil, repository.ErrSessionNotFound
+}
+
+func (r *fakeSessionRepository) RotateSession(ctx context.Context, sessionID string, oldTokenHash string, session *models.SessionRecord) (bool, error) {
+	if r.beforeRotate != nil {
+		r.beforeRotate()
+	}
+	stored, found := r.sessions[sessionID]
+	if !found || stored.RefreshTokenHash != oldTokenHash {
+		return false, nil
+	}
+	r.sessions[sessionID] = *session
+	return true, nil
+}
+
+func (r *fakeSessionRepository) DeleteSession(ctx context.Context, userID string, sessionID string) (bool, error) {
+	_, found := r.sessions[sessionID]
+	delete(r.sessions, sessionID)
+	return found, nil
+}
+
+func (r *fakeSessionRepository) GetUserById(ctx context.Context, id string) (*models.UserRecord, error) {
+	return &models.UserRecord{ID: id, Email: id + "@mail.com"}, nil
+}
+
+func (r *fakeSessionRepository) GetUserAccess(ctx context.Context, userID string) ([]string, []string, error) {
+	return []string{"member"}, nil, nil
+}
+
+// fakeRevocationList records what was revoked
+type fakeRevocationList struct {
+	revocation.List
+	tokens   []string
+	sessions []string
+}
+
+func (l *fakeRevocationList) RevokeToken(ctx context.Context, tokenID string) error {
+	l.tokens = append(l.tokens, tokenID)
+	return nil
+}
+
+func (l *fakeRevocationList) RevokeSession(ctx context.Context, sessionID string) error {
+	l.sessions = append(l.sessions, sessionID)
+	return nil
+}
+
+const testRefreshToken = "refresh:user-1:family-1:0"
+
+func newTestSessionService() (*authService, *fakeSessionRepository, *fakeRevocationList) {
+	repo := &fakeSessionRepository{sessions: map[string]models.SessionRecord{
+		"session-1": {ID: "session-1", UserID: "user-1", FamilyID: "family-1", RefreshTokenHash: hashRefreshToken(testRefreshToken)},
+	}}
+	revocations := &fakeRevocationList{}
+	return &authService{repo: repo, jwtService: &fakeJWTService{}, revocations: revocations}, repo, revocations
+}
+
+func TestRefreshTokenRotates(t *testing.T) {
+	svc, repo, _ := newTestSessionService()
+	ctx := context.Background()
+
+	first, err := svc.RefreshToken(ctx, "user-1", testRefreshToken, models.ClientInfo{IP: "10.0.0.1"})
+	if err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	second, err := svc.RefreshToken(ctx, "user-1", first.RefreshToken, models.ClientInfo{})
+	if err != nil {
+		t.Fatalf("RefreshToken() with the rotated token error = %v", err)
+	}
+
+	session := repo.sessions["session-1"]
+	if session.RefreshTokenHash != hashRefreshToken(second.RefreshToken) || session.IPAddress != "10.0.0.1" {
+		t.Fatalf("session = %+v, want the latest token and the last known IP", session)
+	}
+}
+
+func TestRefreshTokenReuseRevokesSession(t *testing.T) {
+	svc, repo, revocations := newTestSessionService()
+	ctx := context.Background()
+
+	if _, err := svc.RefreshToken(ctx, "user-1", testRefreshToken, models.ClientInfo{}); err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	if _, err := svc.RefreshToken(ctx, "user-1", testRefreshToken, models.ClientInfo{}); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("RefreshToken() with a rotated token error = %v, want ErrRefreshTokenReused", err)
+	}
+
+	if _, found := repo.sessions["session-1"]; found {
+		t.Fatal("session survived the reuse of a rotated refresh token")
+	}
+	if len(revocations.sessions) != 1 || revocations.sessions[0] != "session-1" {
+		t.Fatalf("revoked sessions = %v, want session-1", revocations.sessions)
+	}
+	if _, err := svc.RefreshToken(ctx, "user-1", testRefreshToken, models.ClientInfo{}); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("RefreshToken() after the revocation error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestRefreshTokenConcurrentUseRevokesSession(t *testing.T) {
+	svc, repo, revocations := newTestSessionService()
+	ctx := context.Background()
+
+	// Another refresh with the same token rotates the session between the check and the rotation
+	repo.beforeRotate = func() {
+		session := repo.sessions["session-1"]
+		session.RefreshTokenHash = hashRefreshToken("refresh:user-1:family-1:99")
+		repo.sessions["session-1"] = session
+		repo.beforeRotate = nil
+	}
+
+	if _, err := svc.RefreshToken(ctx, "user-1", testRefreshToken, models.ClientInfo{}); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("RefreshToken() error = %v, want ErrRefreshTokenReused", err)
+	}
+	if _, found := repo.sessions["session-1"]; found || len(revocations.sessions) != 1 {
+		t.Fatalf("sessions = %v, revoked = %v, want the session revoked", repo.sessions, revocations.sessions)
+	}
+}
+
+func TestRefreshTokenRejectsTokenOfAnotherUser(t *testing.T) {
+	svc, repo, _ := newTestSessionService()
+
+	if _, err := svc.RefreshToken(context.Background(), "user-2", testRefreshToken, models.ClientInfo{}); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Fatalf("RefreshToken() error = %v, want ErrInvalidRefreshToken", err)
+	}
+	if _, found := repo.sessions["session-1"]; !found {
+		t.Fatal("session of user-1 was ended by a request of user-2")
+	}
+}
+
+func TestLogoutRevokesTheSession(t *testing.T) {
+	svc, repo, revocations := newTestSessionService()
+
+	if err := svc.Logout(context.Background(), "user-1", "session-1"); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+	if _, found := repo.sessions["session-1"]; found {
+		t.Fatal("session survived the logout")
+	}
+	// Every access token of the session stops working, not only the one that logged out
+	if len(revocations.sessions) != 1 || revocations.sessions[0] != "session-1" || len(revocations.tokens) != 0 {
+		t.Fatalf("revoked sessions = %v, tokens = %v, want session-1 only", revocations.sessions, revocations.tokens)
+	}
+}
@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"auth_service/internal/constants"
+	"auth_service/pkg/utils"
 	"errors"
 	"time"
 
@@ -9,75 +10,155 @@ import (
 )
 
 type JWTService interface {
-	GenerateToken(userId string, Role string, email string) (t string, err error)
-	GenerateRefreshToken(userID string, Role string, email string) (t string, err error)
+	GenerateToken(userId string, roles []string, permissions []string, email string, sessionID string) (t string, err error)
+	GenerateRefreshToken(userID string, email string, familyID string) (t string, err error)
+	RefreshTokenExpiry() time.Duration
+	JWKS() []JWK
+	GenerateMFAToken(userID string, email string) (t string, err error)
 	ParseToken(tokenString string, expectedType string) (claims JwtCustomClaim, err error)
 }
 
 type JwtCustomClaim struct {
-	UserID    string
-	Role      string
-	Email     string
-	TokenType string
+	UserID      string
+	Roles       []string // access tokens only, refreshing reloads them
+	Permissions []string // access tokens only, the union of the permissions of the roles
+	Email       string
+	TokenType   string
+	SessionID   string // session an access token was issued for
+	FamilyID    string // token family a refresh token belongs to
+	// IssuedAtMilli is iat in unix milliseconds. The registered iat only keeps whole seconds, which would let the
+	// revocation of a user also take the tokens issued right after it within the same second.
+	IssuedAtMilli int64
 	golangJWT.RegisteredClaims
 }
 
+// IssuedAtTime returns when the token was issued to the millisecond, tokens without IssuedAtMilli fall back to iat
+func (c JwtCustomClaim) IssuedAtTime() time.Time {
+	if c.IssuedAtMilli != 0 {
+		return time.UnixMilli(c.IssuedAtMilli)
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
 type jwtService struct {
-	secretKey           string
+	keys                *KeyRing
 	issuer              string
 	expiredAccessToken  time.Duration
 	expiredRefreshToken time.Duration
+	expiredMFAToken     time.Duration
 }
 
-func NewJWTService(secretKey, issuer string, expiredAccessToken, expiredRefreshToken time.Duration) JWTService {
+func NewJWTService(keys *KeyRing, issuer string, expiredAccessToken, expiredRefreshToken, expiredMFAToken time.Duration) JWTService {
 	return &jwtService{
 		issuer:              issuer,
-		secretKey:           secretKey,
+		keys:                keys,
 		expiredAccessToken:  expiredAccessToken,
 		expiredRefreshToken: expiredRefreshToken,
+		expiredMFAToken:     expiredMFAToken,
 	}
 }
 
-// GenerateToken creates a new JWT token for authentication with short expiry (e.g., 15 minutes)
-func (j *jwtService) GenerateToken(userID string, Role string, email string) (t string, err error) {
+// GenerateToken creates a new JWT token for authentication with short expiry (e.g., 15 minutes),
+// its random ID (jti) is what a revocation of the single token refers to
+func (j *jwtService) GenerateToken(userID string, roles []string, permissions []string, email string, sessionID string) (t string, err error) {
+	tokenID, err := utils.GenerateToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
 	claims := &JwtCustomClaim{
-		UserID:    userID,
-		Role:      Role,
-		Email:     email,
-		TokenType: constants.TokenAccess,
+		UserID:        userID,
+		Roles:         roles,
+		Permissions:   permissions,
+		Email:         email,
+		TokenType:     constants.TokenAccess,
+		SessionID:     sessionID,
+		IssuedAtMilli: now.UnixMilli(),
 		RegisteredClaims: golangJWT.RegisteredClaims{
-			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(j.expiredAccessToken)),
+			ID:        tokenID,
+			ExpiresAt: golangJWT.NewNumericDate(now.Add(j.expiredAccessToken)),
 			Issuer:    j.issuer,
-			IssuedAt:  golangJWT.NewNumericDate(time.Now()),
+			IssuedAt:  golangJWT.NewNumericDate(now),
 		},
 	}
-	token := golangJWT.NewWithClaims(golangJWT.SigningMethodHS256, claims)
-	t, err = token.SignedString([]byte(j.secretKey))
-	return
+	return j.sign(claims)
 }
 
-func (j *jwtService) GenerateRefreshToken(userID string, Role string, email string) (t string, err error) {
+// GenerateRefreshToken creates a refresh token of the token family, the random ID keeps two tokens
+// issued within the same second apart so rotation always yields a new token
+func (j *jwtService) GenerateRefreshToken(userID string, email string, familyID string) (t string, err error) {
+	tokenID, err := utils.GenerateToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
 	claims := &JwtCustomClaim{
-		UserID:    userID,
-		Role:      Role,
-		Email:     email,
-		TokenType: constants.TokenRefresh,
+		UserID:        userID,
+		Email:         email,
+		TokenType:     constants.TokenRefresh,
+		FamilyID:      familyID,
+		IssuedAtMilli: now.UnixMilli(),
 		RegisteredClaims: golangJWT.RegisteredClaims{
-			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(j.expiredRefreshToken)),
+			ID:        tokenID,
+			ExpiresAt: golangJWT.NewNumericDate(now.Add(j.expiredRefreshToken)),
 			Issuer:    j.issuer,
-			IssuedAt:  golangJWT.NewNumericDate(time.Now()),
+			IssuedAt:  golangJWT.NewNumericDate(now),
 		},
 	}
-	token := golangJWT.NewWithClaims(golangJWT.SigningMethodHS256, claims)
-	t, err = token.SignedString([]byte(j.secretKey))
-	return
+	return j.sign(claims)
+}
+
+// RefreshTokenExpiry is how long a refresh token, and so an idle session, lasts
+func (j *jwtService) RefreshTokenExpiry() time.Duration {
+	return j.expiredRefreshToken
+}
+
+// GenerateMFAToken creates the short-lived challenge that stands between the password and the second factor,
+// its random ID (jti) lets the challenge be used up once the second factor is accepted
+func (j *jwtService) GenerateMFAToken(userID string, email string) (t string, err error) {
+	tokenID, err := utils.GenerateToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := &JwtCustomClaim{
+		UserID:        userID,
+		Email:         email,
+		TokenType:     constants.TokenMFARequired,
+		IssuedAtMilli: now.UnixMilli(),
+		RegisteredClaims: golangJWT.RegisteredClaims{
+			ID:        tokenID,
+			ExpiresAt: golangJWT.NewNumericDate(now.Add(j.expiredMFAToken)),
+			Issuer:    j.issuer,
+			IssuedAt:  golangJWT.NewNumericDate(now),
+		},
+	}
+	return j.sign(claims)
+}
+
+// JWKS returns the public keys verifiers check the signatures with
+func (j *jwtService) JWKS() []JWK {
+	return j.keys.JWKS()
+}
+
+// sign signs with the current key of the key ring and names it in the kid header
+func (j *jwtService) sign(claims *JwtCustomClaim) (string, error) {
+	key := j.keys.signingKey()
+	token := golangJWT.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.id
+	return token.SignedString(key.private)
 }
 
 // ParseToken parses and validates the JWT token, extracting the claims.
 func (j *jwtService) ParseToken(tokenString string, expectedType string) (claims JwtCustomClaim, err error) {
-	token, err := golangJWT.ParseWithClaims(tokenString, &claims, func(token *golangJWT.Token) (interface{}, error) {
-		return []byte(j.secretKey), nil
-	})
+	token, err := golangJWT.ParseWithClaims(tokenString, &claims, j.keys.verificationKey,
+		golangJWT.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA}))
 	if err != nil || !token.Valid {
 		return JwtCustomClaim{}, errors.New("token is not valid")
 	}
@@ -0,0 +1,322 @@
+package jwt
+
+import (
+	"auth_service/pkg/utils"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+// Algorithms tokens can be signed with
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	keyFileExtension = ".pem"
+	rsaKeyBits       = 2048
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// JWK is a public key as published in a JSON Web Key Set (RFC 7517), RSA keys fill n and e, Ed25519 keys crv and x
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type signingKey struct {
+	id        string // kid, the name of the key file
+	method    golangJWT.SigningMethod
+	private   crypto.Signer
+	createdAt time.Time
+}
+
+// RotationResult tells what a rotation changed
+type RotationResult struct {
+	Generated string   // kid of the new signing key, empty when the current one was kept
+	Removed   []string // kids of the retired keys deleted
+}
+
+// KeyRing holds the keys in a directory, one PKCS #8 PEM file per key named after its kid. The newest key of the
+// configured algorithm signs, every key verifies, so tokens signed before a rotation or an algorithm switch stay valid.
+type KeyRing struct {
+	dir       string
+	algorithm string
+	rotation  time.Duration // age at which the signing key is replaced
+	retention time.Duration // time a replaced key is kept to verify the tokens it signed, at least their lifetime
+
+	mu      sync.RWMutex
+	keys    map[string]*signingKey
+	current *signingKey
+}
+
+// NewKeyRing loads the keys of dir and generates the first one when there is none of the algorithm yet
+func NewKeyRing(dir string, algorithm string, rotation time.Duration, retention time.Duration) (*KeyRing, error) {
+	if algorithm != AlgorithmRS256 && algorithm != AlgorithmEdDSA {
+		return nil, fmt.Errorf("unsupported signing algorithm %q, use %s or %s", algorithm, AlgorithmRS256, AlgorithmEdDSA)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	k := &KeyRing{
+		dir:       dir,
+		algorithm: algorithm,
+		rotation:  rotation,
+		retention: retention,
+	}
+	if err := k.load(); err != nil {
+		return nil, err
+	}
+	if k.current == nil {
+		if _, err := k.generate(); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// Rotate reloads the directory, which picks up the keys other instances generated, replaces the signing key
+// once it is older than the rotation period and deletes the keys replaced longer than the retention period ago
+func (k *KeyRing) Rotate() (RotationResult, error) {
+	var result RotationResult
+	if err := k.load(); err != nil {
+		return result, err
+	}
+
+	k.mu.RLock()
+	due := k.current == nil || time.Since(k.current.createdAt) >= k.rotation
+	k.mu.RUnlock()
+	if due {
+		kid, err := k.generate()
+		if err != nil {
+			return result, err
+		}
+		result.Generated = kid
+	}
+
+	removed, err := k.removeRetired()
+	result.Removed = removed
+	return result, err
+}
+
+// JWKS returns the public keys, newest first
+func (k *KeyRing) JWKS() []JWK {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := k.sortedKeys()
+	jwks := make([]JWK, 0, len(keys))
+	for _, key := range keys {
+		jwks = append(jwks, toJWK(key))
+	}
+	return jwks
+}
+
+func (k *KeyRing) signingKey() *signingKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.current
+}
+
+// verificationKey returns the public key of the kid, as long as the token claims the algorithm of the key
+func (k *KeyRing) verificationKey(token *golangJWT.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	k.mu.RLock()
+	key, ok := k.keys[kid]
+	k.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("key %s does not sign with %s", kid, token.Method.Alg())
+	}
+	return key.private.Public(), nil
+}
+
+// load replaces the keys with the ones in the directory
+func (k *KeyRing) load() error {
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*signingKey, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != keyFileExtension {
+			continue
+		}
+		key, err := readKey(filepath.Join(k.dir, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to load key %s: %w", entry.Name(), err)
+		}
+		keys[key.id] = key
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.current = k.newestKey()
+	return nil
+}
+
+// generate writes a new key of the algorithm and makes it the signing key
+func (k *KeyRing) generate() (string, error) {
+	var private crypto.Signer
+	var err error
+	if k.algorithm == AlgorithmRS256 {
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	} else {
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return "", err
+	}
+	suffix, err := utils.GenerateToken(4)
+	if err != nil {
+		return "", err
+	}
+	kid := time.Now().UTC().Format("20060102T150405Z") + "-" + suffix
+
+	path := filepath.Join(k.dir, kid+keyFileExtension)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		return "", err
+	}
+	key, err := readKey(path)
+	if err != nil {
+		return "", err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys[kid] = key
+	k.current = k.newestKey()
+	return kid, nil
+}
+
+// removeRetired deletes the files of the keys whose successor took over longer than the retention period ago
+func (k *KeyRing) removeRetired() ([]string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	var removed []string
+	keys := k.sortedKeys() // newest first, so keys[i-1] replaced keys[i]
+	for i := 1; i < len(keys); i++ {
+		if keys[i] == k.current || time.Since(keys[i-1].createdAt) < k.retention {
+			continue
+		}
+		if err := os.Remove(filepath.Join(k.dir, keys[i].id+keyFileExtension)); err != nil && !os.IsNotExist(err) {
+			return removed, err
+		}
+		delete(k.keys, keys[i].id)
+		removed = append(removed, keys[i].id)
+	}
+	return removed, nil
+}
+
+// newestKey returns the newest key of the algorithm, the caller holds the lock
+func (k *KeyRing) newestKey() *signingKey {
+	for _, key := range k.sortedKeys() {
+		if key.method.Alg() == k.algorithm {
+			return key
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys newest first, the caller holds the lock
+func (k *KeyRing) sortedKeys() []*signingKey {
+	keys := make([]*signingKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].createdAt.Equal(keys[j].createdAt) {
+			return keys[i].createdAt.After(keys[j].createdAt)
+		}
+		return keys[i].id > keys[j].id
+	})
+	return keys
+}
+
+// readKey parses a PKCS #8 private key, the modification time of the file counts as the creation time of the key
+func readKey(path string) (*signingKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &signingKey{
+		id:        strings.TrimSuffix(filepath.Base(path), keyFileExtension),
+		createdAt: info.ModTime(),
+	}
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.private = golangJWT.SigningMethodRS256, private
+	case ed25519.PrivateKey:
+		key.method, key.private = golangJWT.SigningMethodEdDSA, private
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+	return key, nil
+}
+
+func toJWK(key *signingKey) JWK {
+	jwk := JWK{
+		Kid: key.id,
+		Use: "sig",
+		Alg: key.method.Alg(),
+	}
+	switch public := key.private.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
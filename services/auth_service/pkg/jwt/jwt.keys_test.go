@@ -0,0 +1,179 @@
+package jwt
+
+import (
+	"auth_service/internal/constants"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+func newTestKeyRing(t *testing.T, dir string, algorithm string) *KeyRing {
+	t.Helper()
+	keys, err := NewKeyRing(dir, algorithm, 24*time.Hour, time.Hour)
+	if err != nil {
+		t.Fatalf("NewKeyRing(%s) error = %v", algorithm, err)
+	}
+	return keys
+}
+
+func newTestJWTService(keys *KeyRing) JWTService {
+	return NewJWTService(keys, "test", time.Minute, time.Hour, time.Minute)
+}
+
+// age moves the creation time of a key back, the modification time of its file stands for it
+func age(t *testing.T, keys *KeyRing, kid string, by time.Duration) {
+	t.Helper()
+	at := time.Now().Add(-by)
+	if err := os.Chtimes(filepath.Join(keys.dir, kid+keyFileExtension), at, at); err != nil {
+		t.Fatal(err)
+	}
+	if err := keys.load(); err != nil {
+		t.Fatalf("load() error = %v", err)
+	}
+}
+
+func TestNewKeyRingRejectsUnknownAlgorithm(t *testing.T) {
+	if _, err := NewKeyRing(t.TempDir(), "HS256", time.Hour, time.Hour); err == nil {
+		t.Fatal("NewKeyRing(HS256) succeeded")
+	}
+}
+
+func TestKeyRingSignsAndVerifies(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmEdDSA, AlgorithmRS256} {
+		t.Run(algorithm, func(t *testing.T) {
+			keys := newTestKeyRing(t, t.TempDir(), algorithm)
+			svc := newTestJWTService(keys)
+
+			token, err := svc.GenerateToken("user-1", []string{"member"}, nil, "a@mail.com", "session-1")
+			if err != nil {
+				t.Fatalf("GenerateToken() error = %v", err)
+			}
+			claims, err := svc.ParseToken(token, constants.TokenAccess)
+			if err != nil || claims.UserID != "user-1" || claims.SessionID != "session-1" || claims.ID == "" {
+				t.Fatalf("ParseToken() = %+v, %v, want the claims of user-1", claims, err)
+			}
+			if _, err := svc.ParseToken(token, constants.TokenRefresh); err == nil {
+				t.Fatal("ParseToken() accepted an access token as a refresh token")
+			}
+
+			jwks := svc.JWKS()
+			if len(jwks) != 1 || jwks[0].Kid != keys.signingKey().id || jwks[0].Alg != algorithm || jwks[0].Use != "sig" {
+				t.Fatalf("JWKS() = %+v, want the signing key", jwks)
+			}
+		})
+	}
+}
+
+func TestJWKSPublishesPublicKeys(t *testing.T) {
+	rsaKeys := newTestKeyRing(t, t.TempDir(), AlgorithmRS256)
+	if jwk := rsaKeys.JWKS()[0]; jwk.Kty != "RSA" || jwk.E != "AQAB" || jwk.N == "" || jwk.X != "" {
+		t.Fatalf("RSA JWK = %+v", jwk)
+	}
+
+	edKeys := newTestKeyRing(t, t.TempDir(), AlgorithmEdDSA)
+	if jwk := edKeys.JWKS()[0]; jwk.Kty != "OKP" || jwk.Crv != "Ed25519" || len(jwk.X) != 43 || jwk.N != "" {
+		t.Fatalf("Ed25519 JWK = %+v", jwk)
+	}
+}
+
+func TestKeyRingAlgorithmSwitchKeepsOldTokensValid(t *testing.T) {
+	dir := t.TempDir()
+	oldKeys := newTestKeyRing(t, dir, AlgorithmEdDSA)
+	oldKid := oldKeys.signingKey().id
+	oldToken, err := newTestJWTService(oldKeys).GenerateToken("user-1", nil, nil, "a@mail.com", "session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	age(t, oldKeys, oldKid, time.Minute)
+
+	keys := newTestKeyRing(t, dir, AlgorithmRS256)
+	svc := newTestJWTService(keys)
+
+	if current := keys.signingKey(); current.id == oldKid || current.method.Alg() != AlgorithmRS256 {
+		t.Fatalf("signing key = %s %s, want a new RS256 key", current.id, current.method.Alg())
+	}
+	if _, err := svc.ParseToken(oldToken, constants.TokenAccess); err != nil {
+		t.Fatalf("ParseToken() of a token signed before the switch error = %v", err)
+	}
+	if jwks := svc.JWKS(); len(jwks) != 2 || jwks[0].Alg != AlgorithmRS256 || jwks[1].Kid != oldKid {
+		t.Fatalf("JWKS() = %+v, want the new key first and the old one kept", jwks)
+	}
+}
+
+func TestParseTokenRejectsForeignKeys(t *testing.T) {
+	keys := newTestKeyRing(t, t.TempDir(), AlgorithmEdDSA)
+	other := newTestKeyRing(t, t.TempDir(), AlgorithmRS256)
+	svc := newTestJWTService(keys)
+
+	claims := &JwtCustomClaim{
+		UserID:    "user-1",
+		TokenType: constants.TokenAccess,
+		RegisteredClaims: golangJWT.RegisteredClaims{
+			ExpiresAt: golangJWT.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+	signWith := func(key *signingKey, kid string) string {
+		token := golangJWT.NewWithClaims(key.method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key.private)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "unknown kid", token: signWith(other.signingKey(), other.signingKey().id)},
+		{name: "no kid", token: signWith(keys.signingKey(), "")},
+		// An RS256 token naming the Ed25519 key must not be checked against it
+		{name: "algorithm of another key", token: signWith(other.signingKey(), keys.signingKey().id)},
+		{name: "unsigned", token: func() string {
+			token := golangJWT.NewWithClaims(golangJWT.SigningMethodNone, claims)
+			token.Header["kid"] = keys.signingKey().id
+			signed, _ := token.SignedString(golangJWT.UnsafeAllowNoneSignatureType)
+			return signed
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.ParseToken(tt.token, constants.TokenAccess); err == nil {
+				t.Fatal("ParseToken() succeeded")
+			}
+		})
+	}
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	keys := newTestKeyRing(t, t.TempDir(), AlgorithmEdDSA)
+	first := keys.signingKey().id
+
+	if result, err := keys.Rotate(); err != nil || result.Generated != "" || len(result.Removed) != 0 {
+		t.Fatalf("Rotate() of a fresh key = %+v, %v, want nothing changed", result, err)
+	}
+
+	age(t, keys, first, 48*time.Hour)
+	result, err := keys.Rotate()
+	if err != nil || result.Generated == "" || keys.signingKey().id != result.Generated {
+		t.Fatalf("Rotate() of an old key = %+v, %v, want a new signing key", result, err)
+	}
+	// The replaced key still verifies the tokens it signed until the retention is over
+	if len(result.Removed) != 0 || len(keys.JWKS()) != 2 {
+		t.Fatalf("Rotate() removed %v right away, want %s kept", result.Removed, first)
+	}
+
+	age(t, keys, result.Generated, 2*time.Hour)
+	result, err = keys.Rotate()
+	if err != nil || result.Generated != "" || len(result.Removed) != 1 || result.Removed[0] != first {
+		t.Fatalf("Rotate() past the retention = %+v, %v, want %s removed", result, err, first)
+	}
+	if _, err := os.Stat(filepath.Join(keys.dir, first+keyFileExtension)); !os.IsNotExist(err) {
+		t.Fatalf("key file of %s still exists: %v", first, err)
+	}
+}
@@ -0,0 +1,43 @@
+package jwt
+
+import (
+	"auth_service/internal/constants"
+	"testing"
+	"time"
+
+	golangJWT "github.com/golang-jwt/jwt/v5"
+)
+
+func TestTokensKeepIssuedAtInMilliseconds(t *testing.T) {
+	svc := newTestJWTService(newTestKeyRing(t, t.TempDir(), AlgorithmEdDSA))
+
+	before := time.Now().Truncate(time.Millisecond)
+	token, err := svc.GenerateToken("user-1", nil, nil, "a@mail.com", "session-1")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	after := time.Now()
+
+	claims, err := svc.ParseToken(token, constants.TokenAccess)
+	if err != nil {
+		t.Fatalf("ParseToken() error = %v", err)
+	}
+	if issuedAt := claims.IssuedAtTime(); issuedAt.Before(before) || issuedAt.After(after) {
+		t.Fatalf("IssuedAtTime() = %v, want between %v and %v", issuedAt, before, after)
+	}
+	// The registered iat stays in whole seconds, nothing changes the precision of the library for the process
+	if golangJWT.TimePrecision != time.Second || claims.IssuedAt.Time.Nanosecond() != 0 {
+		t.Fatalf("iat = %v with precision %v, want whole seconds", claims.IssuedAt.Time, golangJWT.TimePrecision)
+	}
+}
+
+func TestIssuedAtTimeFallsBackToIssuedAt(t *testing.T) {
+	issuedAt := time.Unix(1700000000, 0)
+	claims := JwtCustomClaim{RegisteredClaims: golangJWT.RegisteredClaims{IssuedAt: golangJWT.NewNumericDate(issuedAt)}}
+	if got := claims.IssuedAtTime(); !got.Equal(issuedAt) {
+		t.Fatalf("IssuedAtTime() = %v, want %v", got, issuedAt)
+	}
+	if got := (JwtCustomClaim{}).IssuedAtTime(); !got.IsZero() {
+		t.Fatalf("IssuedAtTime() without iat = %v, want zero", got)
+	}
+}
@@ -0,0 +1,124 @@
+package lockout
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/pkg/logger"
+	"auth_service/pkg/redis"
+	"auth_service/pkg/utils"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Policy sets when and for how long a subject is locked out
+type Policy struct {
+	MaxAttempts int           // failures that lead to a lockout
+	Window      time.Duration // failures are forgotten this long after the last one
+	BaseLockout time.Duration // length of the first lockout, each further one doubles it
+	MaxLockout  time.Duration // longest lockout, earlier lockouts are forgotten this long after the last one
+}
+
+type Limiter interface {
+	// Locked returns how long the subject stays locked out, 0 when it is not
+	Locked(ctx context.Context, subject string) (time.Duration, error)
+	// Fail records a failed attempt and returns the length of the lockout it started, 0 when it started none
+	Fail(ctx context.Context, subject string) (time.Duration, error)
+	// Reset forgets the failures after a success, earlier lockouts still make the next one longer
+	Reset(ctx context.Context, subject string) error
+	// Unlock lifts the lockout of the subject and forgets its failures and earlier lockouts
+	Unlock(ctx context.Context, subject string) error
+}
+
+type limiter struct {
+	cache  redis.RedisCache
+	logger *logger.Logger
+	name   string
+	policy Policy
+}
+
+// NewLimiter counts failures in Redis under keys prefixed with the name, so limiters with different names
+// (e.g. per email and per IP) do not share counters. Lockouts are logged through the logger.
+func NewLimiter(cache redis.RedisCache, logger *logger.Logger, name string, policy Policy) Limiter {
+	return &limiter{
+		cache:  cache,
+		logger: logger,
+		name:   name,
+		policy: policy,
+	}
+}
+
+func (l *limiter) key(kind, subject string) string {
+	return fmt.Sprintf("lockout:%s:%s:%s", l.name, kind, subject)
+}
+
+func (l *limiter) Locked(ctx context.Context, subject string) (time.Duration, error) {
+	remaining, err := l.cache.TTL(l.key("lock", subject))
+	if err != nil {
+		return 0, err
+	}
+	if remaining < 0 {
+		return 0, nil
+	}
+	return remaining, nil
+}
+
+func (l *limiter) Fail(ctx context.Context, subject string) (time.Duration, error) {
+	failures, err := l.cache.Incr(l.key("failures", subject), l.policy.Window)
+	if err != nil {
+		return 0, err
+	}
+	if failures < int64(l.policy.MaxAttempts) {
+		return 0, nil
+	}
+
+	lockouts, err := l.cache.Incr(l.key("lockouts", subject), l.policy.MaxLockout)
+	if err != nil {
+		return 0, err
+	}
+	duration := l.lockoutDuration(lockouts)
+
+	if err := l.cache.SetWithExpiration(l.key("lock", subject), true, duration); err != nil {
+		return 0, err
+	}
+	// The next lockout takes another MaxAttempts failures once this one is over
+	if err := l.cache.Del(l.key("failures", subject)); err != nil {
+		return 0, err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), utils.GetRequestIDFromContext(ctx), constants.LogLevelWarn, "Lockout started", map[string]interface{}{
+		"limiter":  l.name,
+		"subject":  subject,
+		"failures": failures,
+		"lockout":  lockouts,
+		"duration": duration.String(),
+	}, nil)
+	return duration, nil
+}
+
+// lockoutDuration doubles the base lockout for each earlier lockout, up to the maximum
+func (l *limiter) lockoutDuration(lockouts int64) time.Duration {
+	duration := l.policy.BaseLockout
+	for i := int64(1); i < lockouts && duration < l.policy.MaxLockout; i++ {
+		duration *= 2
+	}
+	if duration > l.policy.MaxLockout {
+		duration = l.policy.MaxLockout
+	}
+	return duration
+}
+
+func (l *limiter) Reset(ctx context.Context, subject string) error {
+	return l.cache.Del(l.key("failures", subject))
+}
+
+func (l *limiter) Unlock(ctx context.Context, subject string) error {
+	if err := l.cache.Del(l.key("lock", subject), l.key("failures", subject), l.key("lockouts", subject)); err != nil {
+		return err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), utils.GetRequestIDFromContext(ctx), constants.LogLevelInfo, "Lockout lifted", map[string]interface{}{
+		"limiter": l.name,
+		"subject": subject,
+	}, nil)
+	return nil
+}
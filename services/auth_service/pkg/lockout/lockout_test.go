@@ -0,0 +1,149 @@
+package lockout
+
+import (
+	"auth_service/pkg/redis"
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeCache keeps the counters and the expiration of every key, time does not pass in it
+type fakeCache struct {
+	redis.RedisCache
+	counters map[string]int64
+	ttls     map[string]time.Duration
+}
+
+func newFakeCache() *fakeCache {
+	return &fakeCache{counters: make(map[string]int64), ttls: make(map[string]time.Duration)}
+}
+
+func (c *fakeCache) Incr(key string, expiration time.Duration) (int64, error) {
+	c.counters[key]++
+	c.ttls[key] = expiration
+	return c.counters[key], nil
+}
+
+func (c *fakeCache) SetWithExpiration(key string, value interface{}, expiration time.Duration) error {
+	c.ttls[key] = expiration
+	return nil
+}
+
+func (c *fakeCache) Del(keys ...string) error {
+	for _, key := range keys {
+		delete(c.counters, key)
+		delete(c.ttls, key)
+	}
+	return nil
+}
+
+// TTL returns -2 for a missing key, like Redis
+func (c *fakeCache) TTL(key string) (time.Duration, error) {
+	if ttl, found := c.ttls[key]; found {
+		return ttl, nil
+	}
+	return -2, nil
+}
+
+var testPolicy = Policy{
+	MaxAttempts: 3,
+	Window:      15 * time.Minute,
+	BaseLockout: time.Minute,
+	MaxLockout:  10 * time.Minute,
+}
+
+func TestLockoutDuration(t *testing.T) {
+	l := &limiter{policy: testPolicy}
+
+	tests := []struct {
+		lockouts int64
+		want     time.Duration
+	}{
+		{lockouts: 1, want: time.Minute},
+		{lockouts: 2, want: 2 * time.Minute},
+		{lockouts: 3, want: 4 * time.Minute},
+		{lockouts: 4, want: 8 * time.Minute},
+		{lockouts: 5, want: 10 * time.Minute},
+		{lockouts: 1000, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := l.lockoutDuration(tt.lockouts); got != tt.want {
+			t.Fatalf("lockoutDuration(%d) = %s, want %s", tt.lockouts, got, tt.want)
+		}
+	}
+}
+
+func TestLimiterBacksOff(t *testing.T) {
+	cache := newFakeCache()
+	l := NewLimiter(cache, nil, "email", testPolicy)
+	ctx := context.Background()
+
+	fail := func(times int) time.Duration {
+		t.Helper()
+		var duration time.Duration
+		for i := 0; i < times; i++ {
+			var err error
+			if duration, err = l.Fail(ctx, "a@mail.com"); err != nil {
+				t.Fatalf("Fail() error = %v", err)
+			}
+		}
+		return duration
+	}
+
+	if got := fail(2); got != 0 {
+		t.Fatalf("Fail() below the limit = %s, want no lockout", got)
+	}
+	if got := fail(1); got != time.Minute {
+		t.Fatalf("Fail() at the limit = %s, want the base lockout", got)
+	}
+	if got, _ := l.Locked(ctx, "a@mail.com"); got != time.Minute {
+		t.Fatalf("Locked() = %s, want the base lockout", got)
+	}
+	if got, _ := l.Locked(ctx, "b@mail.com"); got != 0 {
+		t.Fatalf("Locked() of another subject = %s, want 0", got)
+	}
+
+	// The failures start over after a lockout, the next lockout is twice as long
+	if got := fail(3); got != 2*time.Minute {
+		t.Fatalf("second lockout = %s, want twice the base", got)
+	}
+
+	// A success forgets the failures but not the earlier lockouts
+	fail(2)
+	if err := l.Reset(ctx, "a@mail.com"); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if got := fail(2); got != 0 {
+		t.Fatalf("Fail() after Reset() = %s, want no lockout", got)
+	}
+	if got := fail(1); got != 4*time.Minute {
+		t.Fatalf("third lockout = %s, want four times the base", got)
+	}
+
+	// Unlocking forgets everything
+	if err := l.Unlock(ctx, "a@mail.com"); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+	if got, _ := l.Locked(ctx, "a@mail.com"); got != 0 {
+		t.Fatalf("Locked() after Unlock() = %s, want 0", got)
+	}
+	if got := fail(3); got != time.Minute {
+		t.Fatalf("lockout after Unlock() = %s, want the base lockout", got)
+	}
+}
+
+func TestLimitersDoNotShareCounters(t *testing.T) {
+	cache := newFakeCache()
+	byEmail := NewLimiter(cache, nil, "email", testPolicy)
+	byIP := NewLimiter(cache, nil, "ip", testPolicy)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		byEmail.Fail(ctx, "subject")
+		byIP.Fail(ctx, "subject")
+	}
+	if got, _ := byEmail.Locked(ctx, "subject"); got != 0 {
+		t.Fatalf("Locked() = %s, want the counters kept apart", got)
+	}
+}
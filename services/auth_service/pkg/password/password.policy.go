@@ -0,0 +1,115 @@
+package password
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Character classes a policy can require
+const (
+	ClassLower   = "lower"
+	ClassUpper   = "upper"
+	ClassNumber  = "number"
+	ClassSpecial = "special"
+)
+
+var (
+	ErrTooShort     = errors.New("password is too short")
+	ErrMissingClass = errors.New("password is missing a required character class")
+	ErrBreached     = errors.New("password appears in a list of breached passwords")
+)
+
+type Policy interface {
+	// Check returns nil when the password is acceptable, otherwise an error wrapping one of the Err values
+	Check(password string) error
+}
+
+type policy struct {
+	minLength       int
+	requiredClasses []string
+	denylist        map[string]struct{}
+}
+
+// NewPolicy builds a password policy, denylistFile holds one breached password per line and may be empty to skip
+// the check. The denylist is matched ignoring case, blank lines and lines starting with # are skipped.
+func NewPolicy(minLength int, requiredClasses []string, denylistFile string) (Policy, error) {
+	p := &policy{
+		minLength: minLength,
+		denylist:  make(map[string]struct{}),
+	}
+
+	for _, class := range requiredClasses {
+		class = strings.ToLower(strings.TrimSpace(class))
+		switch class {
+		case "":
+			continue
+		case ClassLower, ClassUpper, ClassNumber, ClassSpecial:
+			p.requiredClasses = append(p.requiredClasses, class)
+		default:
+			return nil, fmt.Errorf("unknown password character class %q", class)
+		}
+	}
+
+	if denylistFile != "" {
+		file, err := os.Open(denylistFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open password denylist: %w", err)
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			p.denylist[strings.ToLower(line)] = struct{}{}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read password denylist: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+func (p *policy) Check(password string) error {
+	if len([]rune(password)) < p.minLength {
+		return fmt.Errorf("%w, it needs at least %d characters", ErrTooShort, p.minLength)
+	}
+
+	for _, class := range p.requiredClasses {
+		if !hasClass(password, class) {
+			return fmt.Errorf("%w, it needs a %s character", ErrMissingClass, classNames[class])
+		}
+	}
+
+	if _, found := p.denylist[strings.ToLower(password)]; found {
+		return ErrBreached
+	}
+	return nil
+}
+
+var classNames = map[string]string{
+	ClassLower:   "lowercase",
+	ClassUpper:   "uppercase",
+	ClassNumber:  "numeric",
+	ClassSpecial: "special",
+}
+
+func hasClass(password, class string) bool {
+	for _, r := range password {
+		switch {
+		case class == ClassLower && unicode.IsLower(r),
+			class == ClassUpper && unicode.IsUpper(r),
+			class == ClassNumber && unicode.IsDigit(r),
+			class == ClassSpecial && !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package password
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	denylist := filepath.Join(t.TempDir(), "denylist.txt")
+	if err := os.WriteFile(denylist, []byte("# breached\n\n  Password1!  \nletmein\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := NewPolicy(8, []string{" Lower", "UPPER", "number", "special", ""}, denylist)
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		want     error
+	}{
+		{name: "acceptable", password: "Corr3ct-horse"},
+		{name: "too short", password: "Ab1!", want: ErrTooShort},
+		{name: "length counts runes", password: "Äö1!ÄÖüß"},
+		{name: "multi-byte but too short", password: "Äö1!Äö", want: ErrTooShort},
+		{name: "no lowercase", password: "CORR3CT-HORSE", want: ErrMissingClass},
+		{name: "no uppercase", password: "corr3ct-horse", want: ErrMissingClass},
+		{name: "no number", password: "Correct-horse", want: ErrMissingClass},
+		{name: "no special", password: "Corr3cthorse", want: ErrMissingClass},
+		{name: "space is not special", password: "Corr3ct horse", want: ErrMissingClass},
+		{name: "breached ignoring case", password: "pASSWORD1!", want: ErrBreached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := policy.Check(tt.password); !errors.Is(err, tt.want) {
+				t.Fatalf("Check(%q) error = %v, want %v", tt.password, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyWithoutRequirements(t *testing.T) {
+	policy, err := NewPolicy(0, nil, "")
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+	if err := policy.Check("a"); err != nil {
+		t.Fatalf("Check() error = %v, want nil", err)
+	}
+}
+
+func TestNewPolicyRejectsBadConfiguration(t *testing.T) {
+	if _, err := NewPolicy(8, []string{"emoji"}, ""); err == nil {
+		t.Fatal("NewPolicy() with an unknown class succeeded")
+	}
+	if _, err := NewPolicy(8, nil, filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Fatal("NewPolicy() with a missing denylist succeeded")
+	}
+}
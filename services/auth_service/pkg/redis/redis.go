@@ -12,7 +12,11 @@ type RedisCache interface {
 	Set(key string, value interface{}) error
 	SetWithExpiration(key string, value interface{}, expiration time.Duration) error
 	Get(key string) (string, error)
-	Del(key string) error
+	GetDel(key string) (string, error)
+	Del(keys ...string) error
+	Incr(key string, expiration time.Duration) (int64, error)
+	SetNX(key string, value interface{}, expiration time.Duration) (bool, error)
+	TTL(key string) (time.Duration, error)
 
 	Ping() error
 }
@@ -65,6 +69,46 @@ func (cache *redisCache) Get(key string) (email string, err error) {
 	return email, err
 }
 
-func (cache *redisCache) Del(key string) error {
-	return cache.client.Del(cache.client.Context(), key).Err()
+func (cache *redisCache) Del(keys ...string) error {
+	return cache.client.Del(cache.client.Context(), keys...).Err()
+}
+
+// GetDel reads the value and deletes the key in one step, so only one caller gets a single-use value
+func (cache *redisCache) GetDel(key string) (string, error) {
+	val, err := cache.client.GetDel(cache.client.Context(), key).Result()
+	if err != nil {
+		return "", err
+	}
+
+	var value string
+	err = json.Unmarshal([]byte(val), &value)
+	return value, err
+}
+
+// Incr increments a counter and moves its expiration to now plus expiration, counters hold plain integers
+// instead of the JSON of Set so they are not meant to be read with Get
+func (cache *redisCache) Incr(key string, expiration time.Duration) (int64, error) {
+	ctx := cache.client.Context()
+	pipe := cache.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, expiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// SetNX sets the value only when the key does not exist yet and reports whether it did
+func (cache *redisCache) SetNX(key string, value interface{}, expiration time.Duration) (bool, error) {
+	jsonData, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	return cache.client.SetNX(cache.client.Context(), key, jsonData, expiration).Result()
+}
+
+// TTL returns the time left before the key expires, a negative duration when the key does not exist or never expires
+func (cache *redisCache) TTL(key string) (time.Duration, error) {
+	return cache.client.TTL(cache.client.Context(), key).Result()
 }
@@ -0,0 +1,165 @@
+package revocation
+
+import (
+	"auth_service/internal/constants"
+	"auth_service/pkg/logger"
+	"auth_service/pkg/rabbitmq"
+	"auth_service/pkg/redis"
+	"auth_service/pkg/utils"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// Token is what the list needs to know of an access token to tell whether it was revoked
+type Token struct {
+	ID        string // jti
+	UserID    string
+	SessionID string
+	IssuedAt  time.Time
+}
+
+// Kinds of revocation an Event announces
+const (
+	EventToken   = "token"
+	EventSession = "session"
+	EventUser    = "user"
+)
+
+// Event announces a revocation on the revocation exchange, so that the services verifying tokens
+// on their own stop trusting the ones they already checked
+type Event struct {
+	RequestID  string `json:"X-Correlation-ID"` // for logging purpose
+	Type       string `json:"type"`
+	TokenID    string `json:"token_id,omitempty"`
+	SessionID  string `json:"session_id,omitempty"`
+	UserID     string `json:"user_id,omitempty"`
+	ValidAfter int64  `json:"valid_after,omitempty"` // unix milliseconds, tokens of the user issued before then are revoked
+}
+
+// List revokes access tokens before they expire. An entry only has to outlive the tokens it revokes,
+// so every key expires after the lifetime of an access token.
+type List interface {
+	// RevokeToken revokes the access token with the ID
+	RevokeToken(ctx context.Context, tokenID string) error
+	// RevokeSession revokes the access tokens issued for the session
+	RevokeSession(ctx context.Context, sessionID string) error
+	// RevokeUser revokes the access tokens issued to the user until now, by moving the time tokens are valid after
+	RevokeUser(ctx context.Context, userID string) error
+	// IsRevoked tells whether the token was revoked by any of the above
+	IsRevoked(ctx context.Context, token Token) (bool, error)
+}
+
+type list struct {
+	cache         redis.RedisCache
+	publisher     *rabbitmq.Publisher
+	logger        *logger.Logger
+	tokenLifetime time.Duration
+}
+
+// NewList keeps the revocations in Redis and announces them on the revocation exchange,
+// tokenLifetime is the lifetime of an access token
+func NewList(cache redis.RedisCache, publisher *rabbitmq.Publisher, logger *logger.Logger, tokenLifetime time.Duration) List {
+	return &list{
+		cache:         cache,
+		publisher:     publisher,
+		logger:        logger,
+		tokenLifetime: tokenLifetime,
+	}
+}
+
+func tokenKey(tokenID string) string {
+	return "revoked_token:" + tokenID
+}
+
+func sessionKey(sessionID string) string {
+	return "revoked_session:" + sessionID
+}
+
+func userKey(userID string) string {
+	return "tokens_valid_after:" + userID
+}
+
+func (l *list) RevokeToken(ctx context.Context, tokenID string) error {
+	if tokenID == "" {
+		return nil
+	}
+	if err := l.cache.SetWithExpiration(tokenKey(tokenID), true, l.tokenLifetime); err != nil {
+		return err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), utils.GetRequestIDFromContext(ctx), constants.LogLevelInfo, "Access token revoked", map[string]interface{}{"token_id": tokenID}, nil)
+	l.announce(ctx, Event{Type: EventToken, TokenID: tokenID})
+	return nil
+}
+
+func (l *list) RevokeSession(ctx context.Context, sessionID string) error {
+	if err := l.cache.SetWithExpiration(sessionKey(sessionID), true, l.tokenLifetime); err != nil {
+		return err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), utils.GetRequestIDFromContext(ctx), constants.LogLevelInfo, "Access tokens of session revoked", map[string]interface{}{"session_id": sessionID}, nil)
+	l.announce(ctx, Event{Type: EventSession, SessionID: sessionID})
+	return nil
+}
+
+func (l *list) RevokeUser(ctx context.Context, userID string) error {
+	validAfter := time.Now().UnixMilli()
+	if err := l.cache.SetWithExpiration(userKey(userID), strconv.FormatInt(validAfter, 10), l.tokenLifetime); err != nil {
+		return err
+	}
+
+	l.logger.LogMessage(utils.GetLocation(), utils.GetRequestIDFromContext(ctx), constants.LogLevelInfo, "Access tokens of user revoked", map[string]interface{}{
+		"user_id":     userID,
+		"valid_after": validAfter,
+	}, nil)
+	l.announce(ctx, Event{Type: EventUser, UserID: userID, ValidAfter: validAfter})
+	return nil
+}
+
+// IsRevoked compares in milliseconds like the iat claim, so a token issued right after RevokeUser stays valid
+func (l *list) IsRevoked(ctx context.Context, token Token) (bool, error) {
+	if token.ID != "" {
+		if revoked, err := l.exists(tokenKey(token.ID)); err != nil || revoked {
+			return revoked, err
+		}
+	}
+	if token.SessionID != "" {
+		if revoked, err := l.exists(sessionKey(token.SessionID)); err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	value, err := l.cache.Get(userKey(token.UserID))
+	if errors.Is(err, goredis.Nil) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	validAfter, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	return token.IssuedAt.UnixMilli() < validAfter, nil
+}
+
+// announce publishes the event of a stored revocation. A failure is only logged, the revocation holds anyway
+// and the verifiers keep a checked token for a short while only.
+func (l *list) announce(ctx context.Context, event Event) {
+	event.RequestID = utils.GetRequestIDFromContext(ctx)
+	if err := l.publisher.Publish(constants.RevocationExchange, "", event); err != nil {
+		l.logger.LogMessage(utils.GetLocation(), event.RequestID, constants.LogLevelWarn, "Failed to announce revocation", map[string]interface{}{"type": event.Type}, err)
+	}
+}
+
+// exists reads the TTL, which is negative only when the key does not exist since every key expires
+func (l *list) exists(key string) (bool, error) {
+	remaining, err := l.cache.TTL(key)
+	if err != nil {
+		return false, err
+	}
+	return remaining >= 0, nil
+}
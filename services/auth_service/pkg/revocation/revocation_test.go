@@ -0,0 +1,64 @@
+package revocation
+
+import (
+	"auth_service/pkg/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+)
+
+// fakeCache holds values without expiring them, a missing key reads as goredis.Nil like Redis
+type fakeCache struct {
+	redis.RedisCache
+	values map[string]string
+}
+
+func (c *fakeCache) Get(key string) (string, error) {
+	value, found := c.values[key]
+	if !found {
+		return "", goredis.Nil
+	}
+	return value, nil
+}
+
+func (c *fakeCache) TTL(key string) (time.Duration, error) {
+	if _, found := c.values[key]; found {
+		return time.Minute, nil
+	}
+	return -2, nil
+}
+
+func TestIsRevoked(t *testing.T) {
+	revokedAt := time.UnixMilli(1700000000500)
+	l := &list{cache: &fakeCache{values: map[string]string{
+		tokenKey("token-1"):     "true",
+		sessionKey("session-1"): "true",
+		userKey("user-1"):       strconv.FormatInt(revokedAt.UnixMilli(), 10),
+	}}}
+
+	tests := []struct {
+		name  string
+		token Token
+		want  bool
+	}{
+		{name: "revoked token", token: Token{ID: "token-1", UserID: "user-2", IssuedAt: revokedAt}, want: true},
+		{name: "revoked session", token: Token{ID: "token-2", UserID: "user-2", SessionID: "session-1", IssuedAt: revokedAt}, want: true},
+		{name: "untouched token", token: Token{ID: "token-2", UserID: "user-2", SessionID: "session-2", IssuedAt: revokedAt}},
+		{name: "issued before the user was revoked", token: Token{UserID: "user-1", IssuedAt: revokedAt.Add(-time.Millisecond)}, want: true},
+		{name: "issued earlier in the same second", token: Token{UserID: "user-1", IssuedAt: revokedAt.Add(-400 * time.Millisecond)}, want: true},
+		{name: "issued right as the user was revoked", token: Token{UserID: "user-1", IssuedAt: revokedAt}},
+		{name: "issued later in the same second", token: Token{UserID: "user-1", IssuedAt: revokedAt.Add(300 * time.Millisecond)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.IsRevoked(context.Background(), tt.token)
+			if err != nil || got != tt.want {
+				t.Fatalf("IsRevoked(%+v) = %v, %v, want %v", tt.token, got, err, tt.want)
+			}
+		})
+	}
+}
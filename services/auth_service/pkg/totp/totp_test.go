@@ -0,0 +1,88 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 seed of the RFC 6238 appendix B test vectors, "12345678901234567890" base32 encoded
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// The RFC lists eight digit codes, the six digit codes are their last six digits
+var rfcVectors = []struct {
+	unix int64
+	code string
+}{
+	{unix: 59, code: "287082"},
+	{unix: 1111111109, code: "081804"},
+	{unix: 1111111111, code: "050471"},
+	{unix: 1234567890, code: "005924"},
+	{unix: 2000000000, code: "279037"},
+	{unix: 20000000000, code: "353130"},
+}
+
+func TestCodeMatchesRFC6238(t *testing.T) {
+	for _, tt := range rfcVectors {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil || got != tt.code {
+			t.Fatalf("Code() at %d = %q, %v, want %q", tt.unix, got, err, tt.code)
+		}
+	}
+}
+
+func TestCodeAcceptsLowerCaseSecret(t *testing.T) {
+	got, err := Code(strings.ToLower(rfcSecret), 1)
+	if err != nil || got != "287082" {
+		t.Fatalf("Code() = %q, %v, want 287082", got, err)
+	}
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Fatal("Code() with an invalid secret succeeded")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	previous, _ := Code(rfcSecret, current-1)
+	next, _ := Code(rfcSecret, current+1)
+	tooOld, _ := Code(rfcSecret, current-2)
+
+	tests := []struct {
+		name     string
+		code     string
+		skew     int
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "current step", code: "050471", skew: 1, wantStep: current, wantOK: true},
+		{name: "previous step within skew", code: previous, skew: 1, wantStep: current - 1, wantOK: true},
+		{name: "next step within skew", code: next, skew: 1, wantStep: current + 1, wantOK: true},
+		{name: "outside the skew", code: tooOld, skew: 1},
+		{name: "previous step without skew", code: previous, skew: 0},
+		{name: "wrong code", code: "000000", skew: 1},
+		{name: "empty code", code: "", skew: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, tt.code, now, tt.skew)
+			if ok != tt.wantOK || (ok && step != tt.wantStep) {
+				t.Fatalf("Validate(%q) = %d, %v, want %d, %v", tt.code, step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if _, err := Code(secret, 0); err != nil {
+		t.Fatalf("Code() with a generated secret error = %v", err)
+	}
+	if other, _ := GenerateSecret(); other == secret {
+		t.Fatal("GenerateSecret() returned the same secret twice")
+	}
+}
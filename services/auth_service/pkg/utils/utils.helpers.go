@@ -43,6 +43,18 @@ func GetRequestIDFromMetadataContext(ctx context.Context) string {
 	return requestId
 }
 
+// GetClientIPFromMetadataContext retrieves the client IP from gRPC metadata, empty when the caller did not pass it
+func GetClientIPFromMetadataContext(ctx context.Context) string {
+	clientIP, _ := GetMetadataValue(ctx, constants.ContextProtoClientIPKey)
+	return clientIP
+}
+
+// GetUserAgentFromMetadataContext retrieves the client user agent from gRPC metadata, empty when the caller did not pass it
+func GetUserAgentFromMetadataContext(ctx context.Context) string {
+	userAgent, _ := GetMetadataValue(ctx, constants.ContextProtoUserAgentKey)
+	return userAgent
+}
+
 // GetRequestIDFromContext retrieves the request ID from context
 func GetRequestIDFromContext(ctx context.Context) string {
 	requestID, ok := ctx.Value(constants.ContextRequestIDKey).(string)
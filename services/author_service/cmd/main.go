@@ -2,9 +2,11 @@ package main
 
 import (
 	"author_service/configs"
+	"author_service/internal/clients"
 	"author_service/internal/constants"
 	"author_service/internal/grpc_server"
 	"author_service/internal/repository"
+	"author_service/internal/scheduler"
 	"author_service/internal/service"
 	loggerPackage "author_service/pkg/logger"
 	"author_service/pkg/rabbitmq"
@@ -77,9 +79,21 @@ func main() {
 	}
 	defer logger.Close()
 
+	// Clients
+	bookClient, err := clients.NewBookClient()
+	if err != nil {
+		log.Fatalf("Failed to establish book client connection %v", err)
+	}
+
 	// Repository and Service Layer
 	authorRepo := repository.NewAuthorRepository(db)
-	authorService := service.NewAuthorService(authorRepo)
+	authorService := service.NewAuthorService(authorRepo, bookClient)
+
+	// Background workers share one context so they stop together
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	trashPurger := scheduler.NewTrashPurger(authorService, logger, time.Duration(configs.AppConfig.TrashPurgeInterval)*time.Minute, time.Duration(configs.AppConfig.TrashRetentionDays)*24*time.Hour)
+	go trashPurger.Start(workerCtx)
 
 	// gRPC Server
 	address := fmt.Sprintf(":%s", configs.AppConfig.GrpcPort)
@@ -126,6 +140,9 @@ func main() {
 	grpcServer.GracefulStop()
 	log.Println("gRPC server stopped")
 
+	// Stop the background workers
+	stopWorkers()
+
 	// Perform additional cleanup tasks ???
 	// ...
 
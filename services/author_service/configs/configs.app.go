@@ -12,8 +12,11 @@ type Config struct {
 	DSN                    string
 	RabbitMQURL            string
 	LoggerWorkerType       string
+	BookServiceURL         string
 	LoggerWorkerNum        int
 	LoggerWorkerBufferSize int
+	TrashRetentionDays     int
+	TrashPurgeInterval     int
 }
 
 var AppConfig Config
@@ -40,6 +43,7 @@ func InitializeAppConfig() error {
 		"DSN":                &AppConfig.DSN,
 		"RABBITMQ_URL":       &AppConfig.RabbitMQURL,
 		"LOGGER_WORKER_TYPE": &AppConfig.LoggerWorkerType,
+		"BOOK_SERVICE_URL":   &AppConfig.BookServiceURL,
 	}
 
 	// Assign string values
@@ -62,5 +66,15 @@ func InitializeAppConfig() error {
 		return err
 	}
 
+	AppConfig.TrashRetentionDays, err = getIntEnv("TRASH_RETENTION_DAYS")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.TrashPurgeInterval, err = getIntEnv("TRASH_PURGE_INTERVAL")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
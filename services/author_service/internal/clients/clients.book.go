@@ -0,0 +1,73 @@
+package clients
+
+import (
+	"author_service/configs"
+	protoBook "author_service/proto/book_service"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// BookClient reaches the books referencing an author, the book service owns them
+type BookClient interface {
+	CountBooksByAuthor(ctx context.Context, authorId string) (int, error)
+	ReassignBooksByAuthor(ctx context.Context, authorId string, newAuthorId string) (int, error)
+	DeleteBooksByAuthor(ctx context.Context, authorId string) (int, error)
+}
+
+type bookClient struct {
+	client protoBook.BookServiceClient
+}
+
+func NewBookClient() (BookClient, error) {
+	conn, err := grpc.NewClient(configs.AppConfig.BookServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	client := protoBook.NewBookServiceClient(conn)
+	return &bookClient{
+		client: client,
+	}, nil
+}
+
+func (b *bookClient) CountBooksByAuthor(ctx context.Context, authorId string) (int, error) {
+	reqProto := protoBook.CountBooksByAuthorRequest{
+		AuthorId: authorId,
+	}
+
+	resp, err := b.client.CountBooksByAuthor(ctx, &reqProto)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.TotalBooks), nil
+}
+
+func (b *bookClient) ReassignBooksByAuthor(ctx context.Context, authorId string, newAuthorId string) (int, error) {
+	reqProto := protoBook.ReassignBooksByAuthorRequest{
+		AuthorId:    authorId,
+		NewAuthorId: newAuthorId,
+	}
+
+	resp, err := b.client.ReassignBooksByAuthor(ctx, &reqProto)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.AffectedBooks), nil
+}
+
+func (b *bookClient) DeleteBooksByAuthor(ctx context.Context, authorId string) (int, error) {
+	reqProto := protoBook.DeleteBooksByAuthorRequest{
+		AuthorId: authorId,
+	}
+
+	resp, err := b.client.DeleteBooksByAuthor(ctx, &reqProto)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.AffectedBooks), nil
+}
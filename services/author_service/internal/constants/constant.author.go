@@ -0,0 +1,8 @@
+package constants
+
+// What happens to the books referencing an author when it is deleted
+const (
+	OnBooksReject   = "reject"
+	OnBooksReassign = "reassign"
+	OnBooksCascade  = "cascade"
+)
@@ -2,10 +2,12 @@ package grpc_server
 
 import (
 	"author_service/internal/models"
+	"author_service/internal/repository"
 	"author_service/internal/service"
 	"author_service/pkg/logger"
 	protoAuthor "author_service/proto/author_service"
 	"context"
+	"errors"
 	"fmt"
 
 	"author_service/internal/constants"
@@ -164,7 +166,7 @@ func (s *authorGRPCServer) UpdateAuthor(ctx context.Context, req *protoAuthor.Up
 
 func (s *authorGRPCServer) DeleteAuthor(ctx context.Context, req *protoAuthor.DeleteAuthorRequest) (*protoAuthor.DeleteAuthorResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteAuthor request", map[string]interface{}{"author_id": req.Id}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteAuthor request", map[string]interface{}{"author_id": req.Id, "on_books": req.OnBooks}, nil)
 
 	// Validate request from client
 	if err := req.Validate(); err != nil {
@@ -172,13 +174,72 @@ func (s *authorGRPCServer) DeleteAuthor(ctx context.Context, req *protoAuthor.De
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	err := s.authorService.DeleteAuthor(ctx, req.Id, int(req.Version))
+	affectedBooks, err := s.authorService.DeleteAuthor(ctx, req.Id, &models.AuthorDeleteRequest{
+		Version:    int(req.Version),
+		OnBooks:    req.OnBooks,
+		ReassignTo: req.ReassignTo,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to delete author with id '%s'", req.Id), map[string]interface{}{"on_books": req.OnBooks, "reassign_to": req.ReassignTo}, err)
+		return nil, authorError(err, fmt.Sprintf("failed to delete author with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Author deleted successfully", map[string]interface{}{"author_id": req.Id, "on_books": req.OnBooks, "affected_books": affectedBooks}, nil)
+
+	return &protoAuthor.DeleteAuthorResponse{
+		Message:       fmt.Sprintf("success delete author with id %s", req.Id),
+		AffectedBooks: int32(affectedBooks),
+	}, nil
+}
+
+func (s *authorGRPCServer) ResolveAuthor(ctx context.Context, req *protoAuthor.ResolveAuthorRequest) (*protoAuthor.ResolveAuthorResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ResolveAuthor request", map[string]interface{}{"name": req.Name}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ResolveAuthor request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	author, created, err := s.authorService.ResolveAuthor(ctx, req.Name)
 	if err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to delete author with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete author with id '%s'", req.Id))
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to resolve author with name '%s'", req.Name), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to resolve author with name '%s'", req.Name))
 	}
 
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Author deleted successfully", map[string]interface{}{"author_id": req.Id}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Author resolved successfully", map[string]interface{}{"author_id": author.Id, "created": created}, nil)
 
-	return &protoAuthor.DeleteAuthorResponse{Message: fmt.Sprintf("success delete author with id %s", req.Id)}, nil
+	return &protoAuthor.ResolveAuthorResponse{
+		Author: &protoAuthor.Author{
+			Id:        author.Id,
+			Name:      author.Name,
+			Biography: author.Biography,
+			Version:   int32(author.Version),
+			CreatedAt: author.CreatedAt.Unix(),
+			UpdatedAt: author.UpdatedAt.Unix(),
+		},
+		Created: created,
+	}, nil
+}
+
+// authorError maps the errors of the author service to gRPC codes, errors returned by the book service keep their code
+func authorError(err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrAuthorNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrAuthorVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrAuthorHasBooks):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrReassignTargetRequired), errors.Is(err, service.ErrReassignTargetNotFound), errors.Is(err, service.ErrReassignToSelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrMergeIntoSelf), errors.Is(err, service.ErrMergeDuplicateRepeated):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+	return status.Error(codes.Internal, message)
 }
@@ -0,0 +1,48 @@
+package grpc_server
+
+import (
+	"author_service/internal/constants"
+	"author_service/internal/models"
+	"author_service/pkg/utils"
+	protoAuthor "author_service/proto/author_service"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *authorGRPCServer) MergeAuthors(ctx context.Context, req *protoAuthor.MergeAuthorsRequest) (*protoAuthor.MergeAuthorsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received MergeAuthors request", map[string]interface{}{"survivor_id": req.SurvivorId, "duplicates": len(req.Duplicates)}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid MergeAuthors request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	duplicates := make([]models.MergeDuplicate, 0, len(req.Duplicates))
+	for _, duplicate := range req.Duplicates {
+		duplicates = append(duplicates, models.MergeDuplicate{
+			Id:      duplicate.Id,
+			Version: int(duplicate.Version),
+		})
+	}
+
+	survivor, affectedBooks, err := s.authorService.MergeAuthors(ctx, req.SurvivorId, &models.AuthorMergeRequest{
+		SurvivorVersion: int(req.SurvivorVersion),
+		Duplicates:      duplicates,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to merge authors into author with id '%s'", req.SurvivorId), nil, err)
+		return nil, authorError(err, fmt.Sprintf("failed to merge authors into author with id '%s'", req.SurvivorId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Authors merged successfully", map[string]interface{}{"survivor_id": survivor.Id, "duplicates": len(duplicates), "affected_books": affectedBooks}, nil)
+
+	return &protoAuthor.MergeAuthorsResponse{
+		Survivor:      toProtoDeletedAuthor(survivor),
+		AffectedBooks: int32(affectedBooks),
+	}, nil
+}
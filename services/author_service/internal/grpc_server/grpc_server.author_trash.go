@@ -0,0 +1,82 @@
+package grpc_server
+
+import (
+	"author_service/internal/constants"
+	"author_service/internal/models"
+	"author_service/pkg/utils"
+	protoAuthor "author_service/proto/author_service"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *authorGRPCServer) ListDeletedAuthors(ctx context.Context, req *protoAuthor.ListAuthorsRequest) (*protoAuthor.ListAuthorsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListDeletedAuthors request", nil, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListDeletedAuthors request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	authors, totalItems, err := s.authorService.ListDeletedAuthors(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve deleted authors list", nil, err)
+		return nil, status.Error(codes.Internal, "failed to retrieve deleted author list")
+	}
+
+	var protoAuthors []*protoAuthor.Author
+	for _, author := range authors {
+		protoAuthors = append(protoAuthors, toProtoDeletedAuthor(author))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Deleted authors list retrieved successfully", nil, nil)
+
+	return &protoAuthor.ListAuthorsResponse{
+		Authors:    protoAuthors,
+		TotalItems: int32(totalItems),
+		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+	}, nil
+}
+
+func (s *authorGRPCServer) RestoreAuthor(ctx context.Context, req *protoAuthor.RestoreAuthorRequest) (*protoAuthor.GetAuthorResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RestoreAuthor request", map[string]interface{}{"author_id": req.Id}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RestoreAuthor request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	author, err := s.authorService.RestoreAuthor(ctx, req.Id)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to restore author with id '%s'", req.Id), nil, err)
+		return nil, authorError(err, fmt.Sprintf("failed to restore author with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Author restored successfully", map[string]interface{}{"author_id": author.Id}, nil)
+
+	return &protoAuthor.GetAuthorResponse{
+		Author: toProtoDeletedAuthor(author),
+	}, nil
+}
+
+// toProtoDeletedAuthor converts an author that may be in the trash, DeletedAt stays 0 for a live author
+func toProtoDeletedAuthor(author *models.AuthorRecord) *protoAuthor.Author {
+	record := &protoAuthor.Author{
+		Id:        author.Id,
+		Name:      author.Name,
+		Biography: author.Biography,
+		Version:   int32(author.Version),
+		CreatedAt: author.CreatedAt.Unix(),
+		UpdatedAt: author.UpdatedAt.Unix(),
+	}
+	if author.DeletedAt != nil {
+		record.DeletedAt = author.DeletedAt.Unix()
+	}
+	return record
+}
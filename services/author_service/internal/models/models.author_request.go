@@ -10,3 +10,20 @@ type AuthorUpdateRequest struct {
 	Biography string
 	Version   int
 }
+
+type AuthorDeleteRequest struct {
+	Version    int
+	OnBooks    string // one of the constants.OnBooks values, empty rejects
+	ReassignTo string // the author receiving the books with constants.OnBooksReassign
+}
+
+// AuthorMergeRequest folds the duplicates into the survivor, each version has to be the current one
+type AuthorMergeRequest struct {
+	SurvivorVersion int
+	Duplicates      []MergeDuplicate
+}
+
+type MergeDuplicate struct {
+	Id      string
+	Version int
+}
@@ -12,6 +12,11 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+var (
+	ErrAuthorNotFound        = errors.New("author not found")
+	ErrAuthorVersionConflict = errors.New("delete failed due to wrong id or concurrent modification")
+)
+
 // AuthorRepository defines the methods that our repository will implement.
 type AuthorRepository interface {
 	CreateAuthor(ctx context.Context, req *models.AuthorRecord) (*models.AuthorRecord, error)
@@ -19,7 +24,14 @@ type AuthorRepository interface {
 	ListAuthors(ctx context.Context, page int, pageSize int) ([]*models.AuthorRecord, error)
 	UpdateAuthor(ctx context.Context, req *models.AuthorRecord) (*models.AuthorRecord, error)
 	DeleteAuthor(ctx context.Context, id string, version int) error
+	ResolveAuthorByName(ctx context.Context, name string) (author *models.AuthorRecord, created bool, err error)
 	CountAuthors(ctx context.Context) (int, error)
+	ListDeletedAuthors(ctx context.Context, page int, pageSize int) ([]*models.AuthorRecord, error)
+	CountDeletedAuthors(ctx context.Context) (int, error)
+	RestoreAuthor(ctx context.Context, id string) (*models.AuthorRecord, error)
+	PurgeDeletedAuthors(ctx context.Context, deletedBefore time.Time) (int, error)
+	GetAuthorRedirect(ctx context.Context, oldId string) (survivorId string, err error)
+	MergeAuthors(ctx context.Context, survivorId string, survivorVersion int, duplicates []models.MergeDuplicate, moveBooks func(duplicateId string) error) (*models.AuthorRecord, error)
 }
 
 // authorRepository implements the AuthorRepository interface
@@ -72,7 +84,7 @@ func (r *authorRepository) GetAuthor(ctx context.Context, id string) (*models.Au
 			  FROM 
 			  	authors 
 			  WHERE 
-			  	id = $1`
+			  	id = $1 AND deleted_at IS NULL`
 
 	author := &models.AuthorRecord{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
@@ -86,7 +98,7 @@ func (r *authorRepository) GetAuthor(ctx context.Context, id string) (*models.Au
 	if err != nil {
 		if err == sql.ErrNoRows {
 			log.Printf("Author with ID %s not found.\n", id)
-			return nil, errors.New("author not found")
+			return nil, ErrAuthorNotFound
 		}
 		log.Printf("Error fetching author by ID %s: %v\n", id, err)
 		return nil, err
@@ -105,6 +117,8 @@ func (r *authorRepository) ListAuthors(ctx context.Context, page int, pageSize i
 				id, name, biography, version, created_at, updated_at 
 			  FROM 
 			  	authors 
+			  WHERE 
+			  	deleted_at IS NULL 
 			  LIMIT 
 			  	$1 OFFSET $2`
 	rows, err := r.db.QueryContext(ctx, query, pageSize, offset)
@@ -156,7 +170,7 @@ func (r *authorRepository) UpdateAuthor(ctx context.Context, req *models.AuthorR
 					  SET 
 					  	name = $1, biography = $2
                       WHERE 
-					  	id = $3 AND version = $4 
+					  	id = $3 AND version = $4 AND deleted_at IS NULL 
                       RETURNING 
 					  	id, name, biography, created_at, updated_at, version`
 
@@ -211,9 +225,61 @@ func (r *authorRepository) UpdateAuthor(ctx context.Context, req *models.AuthorR
 	}
 }
 
-// DeleteAuthor deletes an author from the database by their ID.
+// ResolveAuthorByName returns the oldest author with the name ignoring case, or creates one with an empty biography.
+// The advisory lock on the name keeps concurrent imports from creating the same author twice.
+func (r *authorRepository) ResolveAuthorByName(ctx context.Context, name string) (*models.AuthorRecord, bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting ResolveAuthorByName transaction: %v\n", err)
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('authors:' || LOWER($1)))`, name); err != nil {
+		log.Printf("Error locking author name %s: %v\n", name, err)
+		return nil, false, err
+	}
+
+	query := `SELECT 
+				id, name, biography, version, created_at, updated_at 
+			  FROM 
+			  	authors 
+			  WHERE 
+			  	LOWER(name) = LOWER($1) AND deleted_at IS NULL 
+			  ORDER BY 
+			  	created_at 
+			  LIMIT 1`
+
+	author := &models.AuthorRecord{}
+	created := false
+	err = tx.GetContext(ctx, author, query, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		query = `INSERT INTO 
+					authors (name, biography) 
+				 VALUES 
+				 	($1, '') 
+				 RETURNING 
+				 	id, name, biography, version, created_at, updated_at`
+		err = tx.GetContext(ctx, author, query, name)
+		created = true
+	}
+	if err != nil {
+		log.Printf("Error resolving author by name %s: %v\n", name, err)
+		return nil, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing ResolveAuthorByName transaction: %v\n", err)
+		return nil, false, err
+	}
+
+	log.Printf("Resolved author %s to ID: %s (created: %t)\n", name, author.Id, created)
+	return author, created, nil
+}
+
+// DeleteAuthor moves an author to the trash, PurgeDeletedAuthors removes it for good once the retention period is over.
 func (r *authorRepository) DeleteAuthor(ctx context.Context, id string, version int) error {
-	query := `DELETE FROM authors WHERE id = $1 AND version = $2`
+	query := `UPDATE authors SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND version = $2 AND deleted_at IS NULL`
 
 	result, err := r.db.ExecContext(ctx, query, id, version)
 	if err != nil {
@@ -229,7 +295,7 @@ func (r *authorRepository) DeleteAuthor(ctx context.Context, id string, version
 
 	if rowsAffected == 0 {
 		log.Printf("Optimistic locking failed, no rows deleted for author ID %s with version %d\n", id, version)
-		return errors.New("delete failed due to wrong id or concurrent modification")
+		return ErrAuthorVersionConflict
 	}
 
 	log.Printf("Deleted author with ID: %s and version: %d\n", id, version)
@@ -239,7 +305,7 @@ func (r *authorRepository) DeleteAuthor(ctx context.Context, id string, version
 // CountAuthors counts the total number of authors in the database.
 func (r *authorRepository) CountAuthors(ctx context.Context) (int, error) {
 	log.Printf("Counting total authors")
-	query := `SELECT COUNT(*) FROM authors`
+	query := `SELECT COUNT(*) FROM authors WHERE deleted_at IS NULL`
 	var totalItems int
 	err := r.db.QueryRowContext(ctx, query).Scan(&totalItems)
 	if err != nil {
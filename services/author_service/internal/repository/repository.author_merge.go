@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"author_service/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+)
+
+// GetAuthorRedirect returns the author an old author ID was merged into, or ErrAuthorNotFound.
+func (r *authorRepository) GetAuthorRedirect(ctx context.Context, oldId string) (string, error) {
+	query := `SELECT survivor_id FROM author_redirects WHERE old_id = $1`
+
+	var survivorId string
+	if err := r.db.QueryRowContext(ctx, query, oldId).Scan(&survivorId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrAuthorNotFound
+		}
+		log.Printf("Error fetching redirect of author ID %s: %v\n", oldId, err)
+		return "", err
+	}
+
+	log.Printf("Author ID %s redirects to ID: %s\n", oldId, survivorId)
+	return survivorId, nil
+}
+
+// MergeAuthors deletes the duplicates and redirects their IDs to the survivor, the IDs already redirected to a
+// duplicate follow it. The survivor's version is bumped, and nothing changes unless every version matches.
+// moveBooks is called for every duplicate once the authors are locked and merged, right before the commit, so the
+// books only move when the merge holds. When it fails the duplicates stay, and the merge can be retried as is.
+func (r *authorRepository) MergeAuthors(ctx context.Context, survivorId string, survivorVersion int, duplicates []models.MergeDuplicate, moveBooks func(duplicateId string) error) (*models.AuthorRecord, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting MergeAuthors transaction: %v\n", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE 
+				authors 
+			  SET 
+			  	updated_at = CURRENT_TIMESTAMP 
+			  WHERE 
+			  	id = $1 AND version = $2 AND deleted_at IS NULL 
+			  RETURNING 
+			  	id, name, biography, version, created_at, updated_at`
+	survivor := &models.AuthorRecord{}
+	if err := tx.GetContext(ctx, survivor, query, survivorId, survivorVersion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Optimistic locking failed for survivor author ID %s with version %d\n", survivorId, survivorVersion)
+			return nil, ErrAuthorVersionConflict
+		}
+		log.Printf("Error locking survivor author ID %s: %v\n", survivorId, err)
+		return nil, err
+	}
+
+	for _, duplicate := range duplicates {
+		// Moved before the delete, which would cascade to the redirects pointing at the duplicate
+		if _, err := tx.ExecContext(ctx, `UPDATE author_redirects SET survivor_id = $2 WHERE survivor_id = $1`, duplicate.Id, survivorId); err != nil {
+			log.Printf("Error moving redirects of author ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+
+		result, err := tx.ExecContext(ctx, `DELETE FROM authors WHERE id = $1 AND version = $2 AND deleted_at IS NULL`, duplicate.Id, duplicate.Version)
+		if err != nil {
+			log.Printf("Error deleting duplicate author ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			log.Printf("Error fetching rows affected for merge operation: %v\n", err)
+			return nil, err
+		}
+		if rowsAffected == 0 {
+			log.Printf("Optimistic locking failed for duplicate author ID %s with version %d\n", duplicate.Id, duplicate.Version)
+			return nil, ErrAuthorVersionConflict
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO author_redirects (old_id, survivor_id) VALUES ($1, $2)`, duplicate.Id, survivorId); err != nil {
+			log.Printf("Error redirecting author ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+	}
+
+	// The deleted duplicates stay locked while their books move, so no concurrent edit can undo the merge
+	for _, duplicate := range duplicates {
+		if err := moveBooks(duplicate.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing MergeAuthors transaction: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Merged %d authors into author ID: %s\n", len(duplicates), survivorId)
+	return survivor, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"author_service/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+)
+
+// ListDeletedAuthors retrieves the authors in the trash, the most recently deleted first.
+func (r *authorRepository) ListDeletedAuthors(ctx context.Context, page int, pageSize int) ([]*models.AuthorRecord, error) {
+	log.Printf("Listing deleted authors with pagination (Page: %d, PageSize: %d)", page, pageSize)
+
+	offset := (page - 1) * pageSize
+	query := `SELECT 
+				id, name, biography, version, created_at, updated_at, deleted_at 
+			  FROM 
+			  	authors 
+			  WHERE 
+			  	deleted_at IS NOT NULL 
+			  ORDER BY 
+			  	deleted_at DESC, id 
+			  LIMIT 
+			  	$1 OFFSET $2`
+
+	var authors []*models.AuthorRecord
+	if err := r.db.SelectContext(ctx, &authors, query, pageSize, offset); err != nil {
+		log.Printf("Error listing deleted authors: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Fetched %d deleted authors\n", len(authors))
+	return authors, nil
+}
+
+// CountDeletedAuthors counts the authors in the trash.
+func (r *authorRepository) CountDeletedAuthors(ctx context.Context) (int, error) {
+	log.Printf("Counting deleted authors")
+	query := `SELECT COUNT(*) FROM authors WHERE deleted_at IS NOT NULL`
+	var totalItems int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&totalItems); err != nil {
+		log.Printf("Error counting deleted authors: %v\n", err)
+		return 0, err
+	}
+	return totalItems, nil
+}
+
+// RestoreAuthor takes an author out of the trash and returns it.
+func (r *authorRepository) RestoreAuthor(ctx context.Context, id string) (*models.AuthorRecord, error) {
+	query := `UPDATE 
+				authors 
+			  SET 
+			  	deleted_at = NULL 
+			  WHERE 
+			  	id = $1 AND deleted_at IS NOT NULL 
+			  RETURNING 
+			  	id, name, biography, version, created_at, updated_at, deleted_at`
+
+	author := &models.AuthorRecord{}
+	if err := r.db.GetContext(ctx, author, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Deleted author with ID %s not found.\n", id)
+			return nil, ErrAuthorNotFound
+		}
+		log.Printf("Error restoring author with ID %s: %v\n", id, err)
+		return nil, err
+	}
+
+	log.Printf("Restored author with ID: %s\n", id)
+	return author, nil
+}
+
+// PurgeDeletedAuthors removes for good the authors deleted before the given time and returns how many were removed.
+func (r *authorRepository) PurgeDeletedAuthors(ctx context.Context, deletedBefore time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM authors WHERE deleted_at < $1`, deletedBefore)
+	if err != nil {
+		log.Printf("Error purging deleted authors: %v\n", err)
+		return 0, err
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("Error fetching rows affected for purge operation: %v\n", err)
+		return 0, err
+	}
+
+	log.Printf("Purged %d deleted authors\n", purged)
+	return int(purged), nil
+}
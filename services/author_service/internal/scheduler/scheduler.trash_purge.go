@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"author_service/internal/constants"
+	"author_service/internal/service"
+	"author_service/pkg/logger"
+	"author_service/pkg/utils"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+type TrashPurger struct {
+	authorService service.AuthorService
+	logger        *logger.Logger
+	interval      time.Duration
+	retention     time.Duration
+}
+
+func NewTrashPurger(authorService service.AuthorService, logger *logger.Logger, interval time.Duration, retention time.Duration) *TrashPurger {
+	return &TrashPurger{
+		authorService: authorService,
+		logger:        logger,
+		interval:      interval,
+		retention:     retention,
+	}
+}
+
+// Start purges the authors kept in the trash longer than the retention period once immediately
+// and then on every interval until ctx is cancelled
+func (p *TrashPurger) Start(ctx context.Context) {
+	log.Printf("[%s] Trash purger started with interval %s and retention %s\n", utils.GetLocation(), p.interval, p.retention)
+	runEvery(ctx, p.interval, p.run)
+	log.Printf("[%s] Trash purger stopped\n", utils.GetLocation())
+}
+
+func (p *TrashPurger) run(ctx context.Context) {
+	requestID := fmt.Sprintf("trash-purge-%d", time.Now().Unix())
+	ctx = context.WithValue(ctx, constants.ContextRequestIDKey, requestID)
+
+	purged, err := p.authorService.PurgeDeletedAuthors(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		p.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to purge deleted authors", nil, err)
+		return
+	}
+	if purged > 0 {
+		p.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Deleted authors purged", map[string]interface{}{"purged": purged}, nil)
+	}
+}
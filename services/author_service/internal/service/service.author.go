@@ -1,11 +1,26 @@
 package service
 
 import (
+	"author_service/internal/clients"
+	"author_service/internal/constants"
 	"author_service/internal/models"
 	"author_service/internal/repository"
 	"author_service/pkg/utils"
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAuthorHasBooks         = errors.New("author still has books")
+	ErrReassignTargetRequired = errors.New("reassign_to is required to reassign the books")
+	ErrReassignTargetNotFound = errors.New("author to reassign the books to not found")
+	ErrReassignToSelf         = errors.New("books cannot be reassigned to the author being deleted")
+	ErrMergeIntoSelf          = errors.New("an author cannot be merged into itself")
+	ErrMergeDuplicateRepeated = errors.New("an author is listed more than once to merge")
 )
 
 type AuthorService interface {
@@ -13,16 +28,23 @@ type AuthorService interface {
 	GetAuthor(ctx context.Context, id string) (*models.AuthorRecord, error)
 	ListAuthors(ctx context.Context, page int, pageSize int) (authors []*models.AuthorRecord, totalItems int, err error)
 	UpdateAuthor(ctx context.Context, id string, req *models.AuthorUpdateRequest) (*models.AuthorRecord, error)
-	DeleteAuthor(ctx context.Context, id string, version int) error
+	DeleteAuthor(ctx context.Context, id string, req *models.AuthorDeleteRequest) (affectedBooks int, err error)
+	ResolveAuthor(ctx context.Context, name string) (author *models.AuthorRecord, created bool, err error)
+	ListDeletedAuthors(ctx context.Context, page int, pageSize int) (authors []*models.AuthorRecord, totalItems int, err error)
+	RestoreAuthor(ctx context.Context, id string) (*models.AuthorRecord, error)
+	PurgeDeletedAuthors(ctx context.Context, deletedBefore time.Time) (int, error)
+	MergeAuthors(ctx context.Context, survivorId string, req *models.AuthorMergeRequest) (survivor *models.AuthorRecord, affectedBooks int, err error)
 }
 
 type authorService struct {
-	repo repository.AuthorRepository
+	repo       repository.AuthorRepository
+	bookClient clients.BookClient
 }
 
-func NewAuthorService(repo repository.AuthorRepository) AuthorService {
+func NewAuthorService(repo repository.AuthorRepository, bookClient clients.BookClient) AuthorService {
 	return &authorService{
-		repo: repo,
+		repo:       repo,
+		bookClient: bookClient,
 	}
 }
 
@@ -44,11 +66,16 @@ func (s *authorService) CreateAuthor(ctx context.Context, req *models.AuthorCrea
 	return createdAuthor, nil
 }
 
-// GetAuthor fetches an author by ID and returns the author.
+// GetAuthor fetches an author by ID and returns the author, the ID of an author merged into another returns the survivor.
 func (s *authorService) GetAuthor(ctx context.Context, id string) (*models.AuthorRecord, error) {
 	log.Printf("[%s] Fetching author with ID: %s\n", utils.GetLocation(), id)
 
 	author, err := s.repo.GetAuthor(ctx, id)
+	if errors.Is(err, repository.ErrAuthorNotFound) {
+		if survivorId, redirectErr := s.repo.GetAuthorRedirect(ctx, id); redirectErr == nil {
+			author, err = s.repo.GetAuthor(ctx, survivorId)
+		}
+	}
 	if err != nil {
 		log.Printf("[%s] Failed to get author with ID %s: %v\n", utils.GetLocation(), id, err)
 		return nil, err
@@ -99,16 +126,82 @@ func (s *authorService) UpdateAuthor(ctx context.Context, id string, req *models
 	return updatedAuthor, nil
 }
 
-// DeleteAuthor deletes an author by ID.
-func (s *authorService) DeleteAuthor(ctx context.Context, id string, version int) error {
-	log.Printf("[%s] Deleting author with ID: %s\n", utils.GetLocation(), id)
+// DeleteAuthor deletes an author by ID, what happens to the books referencing it is chosen by req.OnBooks.
+// It returns the number of books reassigned or deleted along with the author.
+func (s *authorService) DeleteAuthor(ctx context.Context, id string, req *models.AuthorDeleteRequest) (int, error) {
+	log.Printf("[%s] Deleting author with ID: %s (on books: %s)\n", utils.GetLocation(), id, req.OnBooks)
 
-	err := s.repo.DeleteAuthor(ctx, id, version)
+	// Check the version first so the books are not touched when the delete would fail anyway
+	author, err := s.repo.GetAuthor(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get author with ID %s: %v\n", utils.GetLocation(), id, err)
+		return 0, err
+	}
+	if author.Version != req.Version {
+		log.Printf("[%s] Author with ID %s is at version %d, not %d\n", utils.GetLocation(), id, author.Version, req.Version)
+		return 0, repository.ErrAuthorVersionConflict
+	}
+
+	affectedBooks, err := s.releaseBooks(ctx, id, req)
+	if err != nil {
+		log.Printf("[%s] Failed to release books of author with ID %s: %v\n", utils.GetLocation(), id, err)
+		return 0, err
+	}
+
+	err = s.repo.DeleteAuthor(ctx, id, req.Version)
 	if err != nil {
 		log.Printf("[%s] Failed to delete author with ID %s: %v\n", utils.GetLocation(), id, err)
-		return err
+		return 0, err
+	}
+
+	log.Printf("[%s] Author with ID %s deleted successfully, %d books affected\n", utils.GetLocation(), id, affectedBooks)
+	return affectedBooks, nil
+}
+
+// releaseBooks makes sure no book references the author anymore, or fails with ErrAuthorHasBooks when req rejects it
+func (s *authorService) releaseBooks(ctx context.Context, id string, req *models.AuthorDeleteRequest) (int, error) {
+	switch req.OnBooks {
+	case constants.OnBooksReassign:
+		if req.ReassignTo == "" {
+			return 0, ErrReassignTargetRequired
+		}
+		if req.ReassignTo == id {
+			return 0, ErrReassignToSelf
+		}
+		if _, err := s.repo.GetAuthor(ctx, req.ReassignTo); err != nil {
+			if errors.Is(err, repository.ErrAuthorNotFound) {
+				return 0, ErrReassignTargetNotFound
+			}
+			return 0, err
+		}
+		return s.bookClient.ReassignBooksByAuthor(ctx, id, req.ReassignTo)
+
+	case constants.OnBooksCascade:
+		return s.bookClient.DeleteBooksByAuthor(ctx, id)
+
+	default:
+		totalBooks, err := s.bookClient.CountBooksByAuthor(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if totalBooks > 0 {
+			return 0, fmt.Errorf("%w: %d books reference the author", ErrAuthorHasBooks, totalBooks)
+		}
+		return 0, nil
+	}
+}
+
+// ResolveAuthor finds an author by name ignoring case and surrounding spaces, creating it when there is none.
+func (s *authorService) ResolveAuthor(ctx context.Context, name string) (*models.AuthorRecord, bool, error) {
+	name = strings.TrimSpace(name)
+	log.Printf("[%s] Resolving author with name: %s\n", utils.GetLocation(), name)
+
+	author, created, err := s.repo.ResolveAuthorByName(ctx, name)
+	if err != nil {
+		log.Printf("[%s] Failed to resolve author with name %s: %v\n", utils.GetLocation(), name, err)
+		return nil, false, err
 	}
 
-	log.Printf("[%s] Author with ID %s deleted successfully\n", utils.GetLocation(), id)
-	return nil
+	log.Printf("[%s] Author %s resolved to ID %s\n", utils.GetLocation(), name, author.Id)
+	return author, created, nil
 }
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"author_service/internal/models"
+	"author_service/internal/repository"
+	"author_service/pkg/utils"
+	"context"
+	"log"
+)
+
+// MergeAuthors moves the books of the duplicates to the survivor and deletes the duplicates, their IDs keep
+// resolving to the survivor through GetAuthor. It returns the survivor and the number of books moved.
+func (s *authorService) MergeAuthors(ctx context.Context, survivorId string, req *models.AuthorMergeRequest) (*models.AuthorRecord, int, error) {
+	log.Printf("[%s] Merging %d authors into author with ID: %s\n", utils.GetLocation(), len(req.Duplicates), survivorId)
+
+	// Check every version first so a stale merge is rejected before the transaction, which checks them again
+	if err := s.checkMergeVersion(ctx, survivorId, req.SurvivorVersion); err != nil {
+		return nil, 0, err
+	}
+	seen := make(map[string]bool, len(req.Duplicates))
+	for _, duplicate := range req.Duplicates {
+		if duplicate.Id == survivorId {
+			return nil, 0, ErrMergeIntoSelf
+		}
+		if seen[duplicate.Id] {
+			return nil, 0, ErrMergeDuplicateRepeated
+		}
+		seen[duplicate.Id] = true
+
+		if err := s.checkMergeVersion(ctx, duplicate.Id, duplicate.Version); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// The books move inside the merge transaction, after the authors are locked and merged
+	affectedBooks := 0
+	survivor, err := s.repo.MergeAuthors(ctx, survivorId, req.SurvivorVersion, req.Duplicates, func(duplicateId string) error {
+		affected, err := s.bookClient.ReassignBooksByAuthor(ctx, duplicateId, survivorId)
+		if err != nil {
+			log.Printf("[%s] Failed to move books of author with ID %s: %v\n", utils.GetLocation(), duplicateId, err)
+			return err
+		}
+		affectedBooks += affected
+		return nil
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to merge authors into author with ID %s: %v\n", utils.GetLocation(), survivorId, err)
+		return nil, 0, err
+	}
+
+	log.Printf("[%s] Merged %d authors into author with ID %s, %d books moved\n", utils.GetLocation(), len(req.Duplicates), survivorId, affectedBooks)
+	return survivor, affectedBooks, nil
+}
+
+// checkMergeVersion makes sure the author exists outside the trash at the given version
+func (s *authorService) checkMergeVersion(ctx context.Context, id string, version int) error {
+	author, err := s.repo.GetAuthor(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get author with ID %s: %v\n", utils.GetLocation(), id, err)
+		return err
+	}
+	if author.Version != version {
+		log.Printf("[%s] Author with ID %s is at version %d, not %d\n", utils.GetLocation(), id, author.Version, version)
+		return repository.ErrAuthorVersionConflict
+	}
+	return nil
+}
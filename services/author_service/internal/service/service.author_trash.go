@@ -0,0 +1,56 @@
+package service
+
+import (
+	"author_service/internal/models"
+	"author_service/pkg/utils"
+	"context"
+	"log"
+	"time"
+)
+
+// ListDeletedAuthors fetches the authors in the trash with pagination, the most recently deleted first.
+func (s *authorService) ListDeletedAuthors(ctx context.Context, page int, pageSize int) (authors []*models.AuthorRecord, totalItems int, err error) {
+	log.Printf("[%s] Fetching list of deleted authors with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), page, pageSize)
+
+	authors, err = s.repo.ListDeletedAuthors(ctx, page, pageSize)
+	if err != nil {
+		log.Printf("[%s] Failed to list deleted authors: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	totalItems, err = s.repo.CountDeletedAuthors(ctx)
+	if err != nil {
+		log.Printf("[%s] Failed to count deleted authors: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	log.Printf("[%s] Successfully fetched %d deleted authors\n", utils.GetLocation(), len(authors))
+	return authors, totalItems, nil
+}
+
+// RestoreAuthor takes an author out of the trash. The books deleted along with it stay in the trash, each is restored on its own.
+func (s *authorService) RestoreAuthor(ctx context.Context, id string) (*models.AuthorRecord, error) {
+	log.Printf("[%s] Restoring author with ID: %s\n", utils.GetLocation(), id)
+
+	author, err := s.repo.RestoreAuthor(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to restore author with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Author with ID %s restored successfully\n", utils.GetLocation(), id)
+	return author, nil
+}
+
+// PurgeDeletedAuthors removes for good the authors that have been in the trash since before deletedBefore.
+func (s *authorService) PurgeDeletedAuthors(ctx context.Context, deletedBefore time.Time) (int, error) {
+	log.Printf("[%s] Purging authors deleted before %s\n", utils.GetLocation(), deletedBefore.Format(time.RFC3339))
+
+	purged, err := s.repo.PurgeDeletedAuthors(ctx, deletedBefore)
+	if err != nil {
+		log.Printf("[%s] Failed to purge deleted authors: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -68,6 +68,8 @@ func (m *Author) validate(all bool) error {
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for DeletedAt
+
 	if len(errors) > 0 {
 		return AuthorMultiError(errors)
 	}
@@ -1227,6 +1229,19 @@ func (m *DeleteAuthorRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _DeleteAuthorRequest_OnBooks_InLookup[m.GetOnBooks()]; !ok {
+		err := DeleteAuthorRequestValidationError{
+			field:  "OnBooks",
+			reason: "value must be in list [ reject reassign cascade]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ReassignTo
+
 	if len(errors) > 0 {
 		return DeleteAuthorRequestMultiError(errors)
 	}
@@ -1307,6 +1322,13 @@ var _ interface {
 	ErrorName() string
 } = DeleteAuthorRequestValidationError{}
 
+var _DeleteAuthorRequest_OnBooks_InLookup = map[string]struct{}{
+	"":         {},
+	"reject":   {},
+	"reassign": {},
+	"cascade":  {},
+}
+
 // Validate checks the field values on DeleteAuthorResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1331,6 +1353,8 @@ func (m *DeleteAuthorResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for AffectedBooks
+
 	if len(errors) > 0 {
 		return DeleteAuthorResponseMultiError(errors)
 	}
@@ -1410,3 +1434,786 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteAuthorResponseValidationError{}
+
+// Validate checks the field values on RestoreAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreAuthorRequestMultiError, or nil if none found.
+func (m *RestoreAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RestoreAuthorRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreAuthorRequestMultiError) AllErrors() []error { return m }
+
+// RestoreAuthorRequestValidationError is the validation error returned by
+// RestoreAuthorRequest.Validate if the designated constraints aren't met.
+type RestoreAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreAuthorRequestValidationError) ErrorName() string {
+	return "RestoreAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreAuthorRequestValidationError{}
+
+// Validate checks the field values on MergeAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeAuthorsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeAuthorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeAuthorsRequestMultiError, or nil if none found.
+func (m *MergeAuthorsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeAuthorsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSurvivorId()) < 1 {
+		err := MergeAuthorsRequestValidationError{
+			field:  "SurvivorId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSurvivorVersion() < 1 {
+		err := MergeAuthorsRequestValidationError{
+			field:  "SurvivorVersion",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetDuplicates()) < 1 {
+		err := MergeAuthorsRequestValidationError{
+			field:  "Duplicates",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDuplicates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MergeAuthorsRequestValidationError{
+						field:  fmt.Sprintf("Duplicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MergeAuthorsRequestValidationError{
+						field:  fmt.Sprintf("Duplicates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MergeAuthorsRequestValidationError{
+					field:  fmt.Sprintf("Duplicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MergeAuthorsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeAuthorsRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeAuthorsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MergeAuthorsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeAuthorsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeAuthorsRequestMultiError) AllErrors() []error { return m }
+
+// MergeAuthorsRequestValidationError is the validation error returned by
+// MergeAuthorsRequest.Validate if the designated constraints aren't met.
+type MergeAuthorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeAuthorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeAuthorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeAuthorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeAuthorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeAuthorsRequestValidationError) ErrorName() string {
+	return "MergeAuthorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeAuthorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeAuthorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeAuthorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeAuthorsRequestValidationError{}
+
+// Validate checks the field values on MergeDuplicate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MergeDuplicate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeDuplicate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MergeDuplicateMultiError,
+// or nil if none found.
+func (m *MergeDuplicate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeDuplicate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := MergeDuplicateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := MergeDuplicateValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeDuplicateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeDuplicateMultiError is an error wrapping multiple validation errors
+// returned by MergeDuplicate.ValidateAll() if the designated constraints
+// aren't met.
+type MergeDuplicateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeDuplicateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeDuplicateMultiError) AllErrors() []error { return m }
+
+// MergeDuplicateValidationError is the validation error returned by
+// MergeDuplicate.Validate if the designated constraints aren't met.
+type MergeDuplicateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeDuplicateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeDuplicateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeDuplicateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeDuplicateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeDuplicateValidationError) ErrorName() string { return "MergeDuplicateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeDuplicateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeDuplicate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeDuplicateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeDuplicateValidationError{}
+
+// Validate checks the field values on MergeAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeAuthorsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeAuthorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeAuthorsResponseMultiError, or nil if none found.
+func (m *MergeAuthorsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeAuthorsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSurvivor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MergeAuthorsResponseValidationError{
+					field:  "Survivor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MergeAuthorsResponseValidationError{
+					field:  "Survivor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSurvivor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MergeAuthorsResponseValidationError{
+				field:  "Survivor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AffectedBooks
+
+	if len(errors) > 0 {
+		return MergeAuthorsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeAuthorsResponseMultiError is an error wrapping multiple validation
+// errors returned by MergeAuthorsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MergeAuthorsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeAuthorsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeAuthorsResponseMultiError) AllErrors() []error { return m }
+
+// MergeAuthorsResponseValidationError is the validation error returned by
+// MergeAuthorsResponse.Validate if the designated constraints aren't met.
+type MergeAuthorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeAuthorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeAuthorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeAuthorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeAuthorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeAuthorsResponseValidationError) ErrorName() string {
+	return "MergeAuthorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeAuthorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeAuthorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeAuthorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeAuthorsResponseValidationError{}
+
+// Validate checks the field values on ResolveAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveAuthorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveAuthorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveAuthorRequestMultiError, or nil if none found.
+func (m *ResolveAuthorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveAuthorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 50 {
+		err := ResolveAuthorRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResolveAuthorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveAuthorRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveAuthorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveAuthorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveAuthorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveAuthorRequestMultiError) AllErrors() []error { return m }
+
+// ResolveAuthorRequestValidationError is the validation error returned by
+// ResolveAuthorRequest.Validate if the designated constraints aren't met.
+type ResolveAuthorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveAuthorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveAuthorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveAuthorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveAuthorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveAuthorRequestValidationError) ErrorName() string {
+	return "ResolveAuthorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveAuthorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveAuthorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveAuthorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveAuthorRequestValidationError{}
+
+// Validate checks the field values on ResolveAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveAuthorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveAuthorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveAuthorResponseMultiError, or nil if none found.
+func (m *ResolveAuthorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveAuthorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveAuthorResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveAuthorResponseValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveAuthorResponseValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return ResolveAuthorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveAuthorResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveAuthorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveAuthorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveAuthorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveAuthorResponseMultiError) AllErrors() []error { return m }
+
+// ResolveAuthorResponseValidationError is the validation error returned by
+// ResolveAuthorResponse.Validate if the designated constraints aren't met.
+type ResolveAuthorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveAuthorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveAuthorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveAuthorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveAuthorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveAuthorResponseValidationError) ErrorName() string {
+	return "ResolveAuthorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveAuthorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveAuthorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveAuthorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveAuthorResponseValidationError{}
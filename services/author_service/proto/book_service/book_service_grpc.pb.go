@@ -0,0 +1,1111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: book_service.proto
+
+package book_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BookServiceClient is the client API for BookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookServiceClient interface {
+	CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpc.CallOption) (*CreateBookResponse, error)
+	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
+	GetBookByISBN(ctx context.Context, in *GetBookByISBNRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
+	GetBooksByAuthor(ctx context.Context, in *GetBooksByAuthorRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	GetBooksByCategory(ctx context.Context, in *GetBooksByCategoryRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error)
+	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error)
+	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error)
+	// Deleted books stay in the trash until restored or purged after the retention period
+	ListDeletedBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error)
+	RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
+	// Physical copies, the book stock is the number of available copies
+	AddBookCopy(ctx context.Context, in *AddBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error)
+	UpdateBookCopy(ctx context.Context, in *UpdateBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error)
+	RetireBookCopy(ctx context.Context, in *RetireBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error)
+	GetBookCopyByBarcode(ctx context.Context, in *GetBookCopyByBarcodeRequest, opts ...grpc.CallOption) (*BookCopyResponse, error)
+	ListBookCopies(ctx context.Context, in *ListBookCopiesRequest, opts ...grpc.CallOption) (*ListBookCopiesResponse, error)
+	CheckOutBookCopy(ctx context.Context, in *CheckOutBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error)
+	CheckInBookCopy(ctx context.Context, in *CheckInBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error)
+	// Catalogue import and export in CSV or MARC21 (ISO 2709), imports run as background jobs
+	ImportBooks(ctx context.Context, opts ...grpc.CallOption) (BookService_ImportBooksClient, error)
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error)
+	ExportBooks(ctx context.Context, in *ExportBooksRequest, opts ...grpc.CallOption) (BookService_ExportBooksClient, error)
+	// Books referencing an author or category, checked by those services before they delete one
+	CountBooksByAuthor(ctx context.Context, in *CountBooksByAuthorRequest, opts ...grpc.CallOption) (*CountBooksResponse, error)
+	CountBooksByCategory(ctx context.Context, in *CountBooksByCategoryRequest, opts ...grpc.CallOption) (*CountBooksResponse, error)
+	ReassignBooksByAuthor(ctx context.Context, in *ReassignBooksByAuthorRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error)
+	ReassignBooksByCategory(ctx context.Context, in *ReassignBooksByCategoryRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error)
+	DeleteBooksByAuthor(ctx context.Context, in *DeleteBooksByAuthorRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error)
+	DeleteBooksByCategory(ctx context.Context, in *DeleteBooksByCategoryRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error)
+}
+
+type bookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookServiceClient(cc grpc.ClientConnInterface) BookServiceClient {
+	return &bookServiceClient{cc}
+}
+
+func (c *bookServiceClient) CreateBook(ctx context.Context, in *CreateBookRequest, opts ...grpc.CallOption) (*CreateBookResponse, error) {
+	out := new(CreateBookResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/CreateBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error) {
+	out := new(GetBookResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/GetBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetBookByISBN(ctx context.Context, in *GetBookByISBNRequest, opts ...grpc.CallOption) (*GetBookResponse, error) {
+	out := new(GetBookResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/GetBookByISBN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetBooksByAuthor(ctx context.Context, in *GetBooksByAuthorRequest, opts ...grpc.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/GetBooksByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetBooksByCategory(ctx context.Context, in *GetBooksByCategoryRequest, opts ...grpc.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/GetBooksByCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/ListBooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error) {
+	out := new(SearchBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/SearchBooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*UpdateBookResponse, error) {
+	out := new(UpdateBookResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/UpdateBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*DeleteBookResponse, error) {
+	out := new(DeleteBookResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/DeleteBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListDeletedBooks(ctx context.Context, in *ListBooksRequest, opts ...grpc.CallOption) (*ListBooksResponse, error) {
+	out := new(ListBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/ListDeletedBooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RestoreBook(ctx context.Context, in *RestoreBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error) {
+	out := new(GetBookResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/RestoreBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) AddBookCopy(ctx context.Context, in *AddBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error) {
+	out := new(BookCopyResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/AddBookCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateBookCopy(ctx context.Context, in *UpdateBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error) {
+	out := new(BookCopyResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/UpdateBookCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RetireBookCopy(ctx context.Context, in *RetireBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error) {
+	out := new(BookCopyResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/RetireBookCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetBookCopyByBarcode(ctx context.Context, in *GetBookCopyByBarcodeRequest, opts ...grpc.CallOption) (*BookCopyResponse, error) {
+	out := new(BookCopyResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/GetBookCopyByBarcode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ListBookCopies(ctx context.Context, in *ListBookCopiesRequest, opts ...grpc.CallOption) (*ListBookCopiesResponse, error) {
+	out := new(ListBookCopiesResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/ListBookCopies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CheckOutBookCopy(ctx context.Context, in *CheckOutBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error) {
+	out := new(BookCopyResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/CheckOutBookCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CheckInBookCopy(ctx context.Context, in *CheckInBookCopyRequest, opts ...grpc.CallOption) (*BookCopyResponse, error) {
+	out := new(BookCopyResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/CheckInBookCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ImportBooks(ctx context.Context, opts ...grpc.CallOption) (BookService_ImportBooksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BookService_ServiceDesc.Streams[0], "/book_service.BookService/ImportBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceImportBooksClient{stream}
+	return x, nil
+}
+
+type BookService_ImportBooksClient interface {
+	Send(*ImportBooksRequest) error
+	CloseAndRecv() (*ImportJobResponse, error)
+	grpc.ClientStream
+}
+
+type bookServiceImportBooksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceImportBooksClient) Send(m *ImportBooksRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bookServiceImportBooksClient) CloseAndRecv() (*ImportJobResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportJobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJobResponse, error) {
+	out := new(ImportJobResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/GetImportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ExportBooks(ctx context.Context, in *ExportBooksRequest, opts ...grpc.CallOption) (BookService_ExportBooksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BookService_ServiceDesc.Streams[1], "/book_service.BookService/ExportBooks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bookServiceExportBooksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BookService_ExportBooksClient interface {
+	Recv() (*ExportBooksChunk, error)
+	grpc.ClientStream
+}
+
+type bookServiceExportBooksClient struct {
+	grpc.ClientStream
+}
+
+func (x *bookServiceExportBooksClient) Recv() (*ExportBooksChunk, error) {
+	m := new(ExportBooksChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bookServiceClient) CountBooksByAuthor(ctx context.Context, in *CountBooksByAuthorRequest, opts ...grpc.CallOption) (*CountBooksResponse, error) {
+	out := new(CountBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/CountBooksByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) CountBooksByCategory(ctx context.Context, in *CountBooksByCategoryRequest, opts ...grpc.CallOption) (*CountBooksResponse, error) {
+	out := new(CountBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/CountBooksByCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ReassignBooksByAuthor(ctx context.Context, in *ReassignBooksByAuthorRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error) {
+	out := new(BulkBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/ReassignBooksByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ReassignBooksByCategory(ctx context.Context, in *ReassignBooksByCategoryRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error) {
+	out := new(BulkBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/ReassignBooksByCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteBooksByAuthor(ctx context.Context, in *DeleteBooksByAuthorRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error) {
+	out := new(BulkBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/DeleteBooksByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) DeleteBooksByCategory(ctx context.Context, in *DeleteBooksByCategoryRequest, opts ...grpc.CallOption) (*BulkBooksResponse, error) {
+	out := new(BulkBooksResponse)
+	err := c.cc.Invoke(ctx, "/book_service.BookService/DeleteBooksByCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookServiceServer is the server API for BookService service.
+// All implementations must embed UnimplementedBookServiceServer
+// for forward compatibility
+type BookServiceServer interface {
+	CreateBook(context.Context, *CreateBookRequest) (*CreateBookResponse, error)
+	GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error)
+	GetBookByISBN(context.Context, *GetBookByISBNRequest) (*GetBookResponse, error)
+	GetBooksByAuthor(context.Context, *GetBooksByAuthorRequest) (*ListBooksResponse, error)
+	GetBooksByCategory(context.Context, *GetBooksByCategoryRequest) (*ListBooksResponse, error)
+	ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error)
+	UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error)
+	DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error)
+	// Deleted books stay in the trash until restored or purged after the retention period
+	ListDeletedBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error)
+	RestoreBook(context.Context, *RestoreBookRequest) (*GetBookResponse, error)
+	// Physical copies, the book stock is the number of available copies
+	AddBookCopy(context.Context, *AddBookCopyRequest) (*BookCopyResponse, error)
+	UpdateBookCopy(context.Context, *UpdateBookCopyRequest) (*BookCopyResponse, error)
+	RetireBookCopy(context.Context, *RetireBookCopyRequest) (*BookCopyResponse, error)
+	GetBookCopyByBarcode(context.Context, *GetBookCopyByBarcodeRequest) (*BookCopyResponse, error)
+	ListBookCopies(context.Context, *ListBookCopiesRequest) (*ListBookCopiesResponse, error)
+	CheckOutBookCopy(context.Context, *CheckOutBookCopyRequest) (*BookCopyResponse, error)
+	CheckInBookCopy(context.Context, *CheckInBookCopyRequest) (*BookCopyResponse, error)
+	// Catalogue import and export in CSV or MARC21 (ISO 2709), imports run as background jobs
+	ImportBooks(BookService_ImportBooksServer) error
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJobResponse, error)
+	ExportBooks(*ExportBooksRequest, BookService_ExportBooksServer) error
+	// Books referencing an author or category, checked by those services before they delete one
+	CountBooksByAuthor(context.Context, *CountBooksByAuthorRequest) (*CountBooksResponse, error)
+	CountBooksByCategory(context.Context, *CountBooksByCategoryRequest) (*CountBooksResponse, error)
+	ReassignBooksByAuthor(context.Context, *ReassignBooksByAuthorRequest) (*BulkBooksResponse, error)
+	ReassignBooksByCategory(context.Context, *ReassignBooksByCategoryRequest) (*BulkBooksResponse, error)
+	DeleteBooksByAuthor(context.Context, *DeleteBooksByAuthorRequest) (*BulkBooksResponse, error)
+	DeleteBooksByCategory(context.Context, *DeleteBooksByCategoryRequest) (*BulkBooksResponse, error)
+	mustEmbedUnimplementedBookServiceServer()
+}
+
+// UnimplementedBookServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBookServiceServer struct {
+}
+
+func (UnimplementedBookServiceServer) CreateBook(context.Context, *CreateBookRequest) (*CreateBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBook not implemented")
+}
+func (UnimplementedBookServiceServer) GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBook not implemented")
+}
+func (UnimplementedBookServiceServer) GetBookByISBN(context.Context, *GetBookByISBNRequest) (*GetBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookByISBN not implemented")
+}
+func (UnimplementedBookServiceServer) GetBooksByAuthor(context.Context, *GetBooksByAuthorRequest) (*ListBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooksByAuthor not implemented")
+}
+func (UnimplementedBookServiceServer) GetBooksByCategory(context.Context, *GetBooksByCategoryRequest) (*ListBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooksByCategory not implemented")
+}
+func (UnimplementedBookServiceServer) ListBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBooks not implemented")
+}
+func (UnimplementedBookServiceServer) SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBooks not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateBook(context.Context, *UpdateBookRequest) (*UpdateBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*DeleteBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
+}
+func (UnimplementedBookServiceServer) ListDeletedBooks(context.Context, *ListBooksRequest) (*ListBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedBooks not implemented")
+}
+func (UnimplementedBookServiceServer) RestoreBook(context.Context, *RestoreBookRequest) (*GetBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBook not implemented")
+}
+func (UnimplementedBookServiceServer) AddBookCopy(context.Context, *AddBookCopyRequest) (*BookCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookCopy not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateBookCopy(context.Context, *UpdateBookCopyRequest) (*BookCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBookCopy not implemented")
+}
+func (UnimplementedBookServiceServer) RetireBookCopy(context.Context, *RetireBookCopyRequest) (*BookCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireBookCopy not implemented")
+}
+func (UnimplementedBookServiceServer) GetBookCopyByBarcode(context.Context, *GetBookCopyByBarcodeRequest) (*BookCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookCopyByBarcode not implemented")
+}
+func (UnimplementedBookServiceServer) ListBookCopies(context.Context, *ListBookCopiesRequest) (*ListBookCopiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookCopies not implemented")
+}
+func (UnimplementedBookServiceServer) CheckOutBookCopy(context.Context, *CheckOutBookCopyRequest) (*BookCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOutBookCopy not implemented")
+}
+func (UnimplementedBookServiceServer) CheckInBookCopy(context.Context, *CheckInBookCopyRequest) (*BookCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInBookCopy not implemented")
+}
+func (UnimplementedBookServiceServer) ImportBooks(BookService_ImportBooksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBooks not implemented")
+}
+func (UnimplementedBookServiceServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedBookServiceServer) ExportBooks(*ExportBooksRequest, BookService_ExportBooksServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBooks not implemented")
+}
+func (UnimplementedBookServiceServer) CountBooksByAuthor(context.Context, *CountBooksByAuthorRequest) (*CountBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountBooksByAuthor not implemented")
+}
+func (UnimplementedBookServiceServer) CountBooksByCategory(context.Context, *CountBooksByCategoryRequest) (*CountBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountBooksByCategory not implemented")
+}
+func (UnimplementedBookServiceServer) ReassignBooksByAuthor(context.Context, *ReassignBooksByAuthorRequest) (*BulkBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignBooksByAuthor not implemented")
+}
+func (UnimplementedBookServiceServer) ReassignBooksByCategory(context.Context, *ReassignBooksByCategoryRequest) (*BulkBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignBooksByCategory not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteBooksByAuthor(context.Context, *DeleteBooksByAuthorRequest) (*BulkBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBooksByAuthor not implemented")
+}
+func (UnimplementedBookServiceServer) DeleteBooksByCategory(context.Context, *DeleteBooksByCategoryRequest) (*BulkBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBooksByCategory not implemented")
+}
+func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
+
+// UnsafeBookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookServiceServer will
+// result in compilation errors.
+type UnsafeBookServiceServer interface {
+	mustEmbedUnimplementedBookServiceServer()
+}
+
+func RegisterBookServiceServer(s grpc.ServiceRegistrar, srv BookServiceServer) {
+	s.RegisterService(&BookService_ServiceDesc, srv)
+}
+
+func _BookService_CreateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CreateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/CreateBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CreateBook(ctx, req.(*CreateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/GetBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBook(ctx, req.(*GetBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBookByISBN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookByISBNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBookByISBN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/GetBookByISBN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBookByISBN(ctx, req.(*GetBookByISBNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBooksByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBooksByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBooksByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/GetBooksByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBooksByAuthor(ctx, req.(*GetBooksByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBooksByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBooksByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBooksByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/GetBooksByCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBooksByCategory(ctx, req.(*GetBooksByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/ListBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListBooks(ctx, req.(*ListBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_SearchBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).SearchBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/SearchBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).SearchBooks(ctx, req.(*SearchBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/UpdateBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateBook(ctx, req.(*UpdateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/DeleteBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteBook(ctx, req.(*DeleteBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListDeletedBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListDeletedBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/ListDeletedBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListDeletedBooks(ctx, req.(*ListBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RestoreBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RestoreBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/RestoreBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RestoreBook(ctx, req.(*RestoreBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_AddBookCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).AddBookCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/AddBookCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).AddBookCopy(ctx, req.(*AddBookCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateBookCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateBookCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/UpdateBookCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateBookCopy(ctx, req.(*UpdateBookCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RetireBookCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireBookCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RetireBookCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/RetireBookCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RetireBookCopy(ctx, req.(*RetireBookCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetBookCopyByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookCopyByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetBookCopyByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/GetBookCopyByBarcode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetBookCopyByBarcode(ctx, req.(*GetBookCopyByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ListBookCopies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookCopiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ListBookCopies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/ListBookCopies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ListBookCopies(ctx, req.(*ListBookCopiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CheckOutBookCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutBookCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CheckOutBookCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/CheckOutBookCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CheckOutBookCopy(ctx, req.(*CheckOutBookCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CheckInBookCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInBookCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CheckInBookCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/CheckInBookCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CheckInBookCopy(ctx, req.(*CheckInBookCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ImportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BookServiceServer).ImportBooks(&bookServiceImportBooksServer{stream})
+}
+
+type BookService_ImportBooksServer interface {
+	SendAndClose(*ImportJobResponse) error
+	Recv() (*ImportBooksRequest, error)
+	grpc.ServerStream
+}
+
+type bookServiceImportBooksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceImportBooksServer) SendAndClose(m *ImportJobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bookServiceImportBooksServer) Recv() (*ImportBooksRequest, error) {
+	m := new(ImportBooksRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BookService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/GetImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ExportBooks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBooksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookServiceServer).ExportBooks(m, &bookServiceExportBooksServer{stream})
+}
+
+type BookService_ExportBooksServer interface {
+	Send(*ExportBooksChunk) error
+	grpc.ServerStream
+}
+
+type bookServiceExportBooksServer struct {
+	grpc.ServerStream
+}
+
+func (x *bookServiceExportBooksServer) Send(m *ExportBooksChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BookService_CountBooksByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountBooksByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CountBooksByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/CountBooksByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CountBooksByAuthor(ctx, req.(*CountBooksByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_CountBooksByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountBooksByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).CountBooksByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/CountBooksByCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).CountBooksByCategory(ctx, req.(*CountBooksByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ReassignBooksByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignBooksByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ReassignBooksByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/ReassignBooksByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ReassignBooksByAuthor(ctx, req.(*ReassignBooksByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ReassignBooksByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignBooksByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ReassignBooksByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/ReassignBooksByCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ReassignBooksByCategory(ctx, req.(*ReassignBooksByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteBooksByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBooksByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteBooksByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/DeleteBooksByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteBooksByAuthor(ctx, req.(*DeleteBooksByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_DeleteBooksByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBooksByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).DeleteBooksByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/book_service.BookService/DeleteBooksByCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).DeleteBooksByCategory(ctx, req.(*DeleteBooksByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookService_ServiceDesc is the grpc.ServiceDesc for BookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "book_service.BookService",
+	HandlerType: (*BookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBook",
+			Handler:    _BookService_CreateBook_Handler,
+		},
+		{
+			MethodName: "GetBook",
+			Handler:    _BookService_GetBook_Handler,
+		},
+		{
+			MethodName: "GetBookByISBN",
+			Handler:    _BookService_GetBookByISBN_Handler,
+		},
+		{
+			MethodName: "GetBooksByAuthor",
+			Handler:    _BookService_GetBooksByAuthor_Handler,
+		},
+		{
+			MethodName: "GetBooksByCategory",
+			Handler:    _BookService_GetBooksByCategory_Handler,
+		},
+		{
+			MethodName: "ListBooks",
+			Handler:    _BookService_ListBooks_Handler,
+		},
+		{
+			MethodName: "SearchBooks",
+			Handler:    _BookService_SearchBooks_Handler,
+		},
+		{
+			MethodName: "UpdateBook",
+			Handler:    _BookService_UpdateBook_Handler,
+		},
+		{
+			MethodName: "DeleteBook",
+			Handler:    _BookService_DeleteBook_Handler,
+		},
+		{
+			MethodName: "ListDeletedBooks",
+			Handler:    _BookService_ListDeletedBooks_Handler,
+		},
+		{
+			MethodName: "RestoreBook",
+			Handler:    _BookService_RestoreBook_Handler,
+		},
+		{
+			MethodName: "AddBookCopy",
+			Handler:    _BookService_AddBookCopy_Handler,
+		},
+		{
+			MethodName: "UpdateBookCopy",
+			Handler:    _BookService_UpdateBookCopy_Handler,
+		},
+		{
+			MethodName: "RetireBookCopy",
+			Handler:    _BookService_RetireBookCopy_Handler,
+		},
+		{
+			MethodName: "GetBookCopyByBarcode",
+			Handler:    _BookService_GetBookCopyByBarcode_Handler,
+		},
+		{
+			MethodName: "ListBookCopies",
+			Handler:    _BookService_ListBookCopies_Handler,
+		},
+		{
+			MethodName: "CheckOutBookCopy",
+			Handler:    _BookService_CheckOutBookCopy_Handler,
+		},
+		{
+			MethodName: "CheckInBookCopy",
+			Handler:    _BookService_CheckInBookCopy_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _BookService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "CountBooksByAuthor",
+			Handler:    _BookService_CountBooksByAuthor_Handler,
+		},
+		{
+			MethodName: "CountBooksByCategory",
+			Handler:    _BookService_CountBooksByCategory_Handler,
+		},
+		{
+			MethodName: "ReassignBooksByAuthor",
+			Handler:    _BookService_ReassignBooksByAuthor_Handler,
+		},
+		{
+			MethodName: "ReassignBooksByCategory",
+			Handler:    _BookService_ReassignBooksByCategory_Handler,
+		},
+		{
+			MethodName: "DeleteBooksByAuthor",
+			Handler:    _BookService_DeleteBooksByAuthor_Handler,
+		},
+		{
+			MethodName: "DeleteBooksByCategory",
+			Handler:    _BookService_DeleteBooksByCategory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportBooks",
+			Handler:       _BookService_ImportBooks_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportBooks",
+			Handler:       _BookService_ExportBooks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "book_service.proto",
+}
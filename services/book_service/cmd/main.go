@@ -6,6 +6,7 @@ import (
 	"book_service/internal/constants"
 	"book_service/internal/grpc_server"
 	"book_service/internal/repository"
+	"book_service/internal/scheduler"
 	"book_service/internal/service"
 	loggerPackage "book_service/pkg/logger"
 	"book_service/pkg/rabbitmq"
@@ -91,7 +92,19 @@ func main() {
 
 	// Repository and Service Layer
 	bookRepo := repository.NewBookRepository(db)
+	bookCopyRepo := repository.NewBookCopyRepository(db)
+	bookImportJobRepo := repository.NewBookImportJobRepository(db)
 	bookService := service.NewBookService(bookRepo, authorClient, categoryClient)
+	bookCopyService := service.NewBookCopyService(bookCopyRepo, bookRepo)
+	bookCatalogueService := service.NewBookCatalogueService(bookImportJobRepo, bookRepo, bookService, authorClient, categoryClient)
+
+	// Background workers share one context so they stop together
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	bookImportWorker := scheduler.NewBookImportWorker(bookCatalogueService, logger, time.Duration(configs.AppConfig.BookImportInterval)*time.Second)
+	go bookImportWorker.Start(workerCtx)
+	trashPurger := scheduler.NewTrashPurger(bookService, logger, time.Duration(configs.AppConfig.TrashPurgeInterval)*time.Minute, time.Duration(configs.AppConfig.TrashRetentionDays)*24*time.Hour)
+	go trashPurger.Start(workerCtx)
 
 	// gRPC Server
 	address := fmt.Sprintf(":%s", configs.AppConfig.GrpcPort)
@@ -101,7 +114,7 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	bookServer := grpc_server.NewBookGRPCServer(bookService, logger)
+	bookServer := grpc_server.NewBookGRPCServer(bookService, bookCopyService, bookCatalogueService, logger)
 	protoBook.RegisterBookServiceServer(grpcServer, bookServer)
 	healthCheckServer := grpc_server.NewHealthGRPCServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthCheckServer)
@@ -138,6 +151,9 @@ func main() {
 	grpcServer.GracefulStop()
 	log.Println("gRPC server stopped")
 
+	// Stop the background workers, a running import is marked as interrupted
+	stopWorkers()
+
 	// Perform additional cleanup tasks ???
 	// ...
 
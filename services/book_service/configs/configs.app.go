@@ -16,6 +16,9 @@ type Config struct {
 	CategoryServiceURL     string
 	LoggerWorkerNum        int
 	LoggerWorkerBufferSize int
+	BookImportInterval     int
+	TrashRetentionDays     int
+	TrashPurgeInterval     int
 }
 
 var AppConfig Config
@@ -66,5 +69,20 @@ func InitializeAppConfig() error {
 		return err
 	}
 
+	AppConfig.BookImportInterval, err = getIntEnv("BOOK_IMPORT_INTERVAL")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.TrashRetentionDays, err = getIntEnv("TRASH_RETENTION_DAYS")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.TrashPurgeInterval, err = getIntEnv("TRASH_PURGE_INTERVAL")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 
 type AuthorClient interface {
 	GetAuthor(ctx context.Context, id string) (*AuthorResponse, error)
+	ResolveAuthor(ctx context.Context, name string) (*AuthorResponse, error)
 }
 
 type authorClient struct {
@@ -40,10 +41,29 @@ func (a *authorClient) GetAuthor(ctx context.Context, id string) (*AuthorRespons
 	}
 
 	return &AuthorResponse{
-		Id: resp.Author.Id,
+		Id:   resp.Author.Id,
+		Name: resp.Author.Name,
+	}, nil
+}
+
+// ResolveAuthor finds the author with the name, the author service creates it when there is none
+func (a *authorClient) ResolveAuthor(ctx context.Context, name string) (*AuthorResponse, error) {
+	reqProto := protoAuthor.ResolveAuthorRequest{
+		Name: name,
+	}
+
+	resp, err := a.client.ResolveAuthor(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthorResponse{
+		Id:   resp.Author.Id,
+		Name: resp.Author.Name,
 	}, nil
 }
 
 type AuthorResponse struct { // simplify struct to optimize memory
-	Id string
+	Id   string
+	Name string
 }
@@ -11,6 +11,8 @@ import (
 
 type CategoryClient interface {
 	GetCategory(ctx context.Context, id string) (*CategoryResponse, error)
+	ResolveCategory(ctx context.Context, name string) (*CategoryResponse, error)
+	ListSubcategoryIds(ctx context.Context, id string) ([]string, error)
 }
 
 type categoryClient struct {
@@ -40,10 +42,48 @@ func (c *categoryClient) GetCategory(ctx context.Context, id string) (*CategoryR
 	}
 
 	return &CategoryResponse{
-		Id: resp.Category.Id,
+		Id:   resp.Category.Id,
+		Name: resp.Category.Name,
 	}, nil
 }
 
+// ResolveCategory finds the category with the name, the category service creates it when there is none
+func (c *categoryClient) ResolveCategory(ctx context.Context, name string) (*CategoryResponse, error) {
+	reqProto := protoCategory.ResolveCategoryRequest{
+		Name: name,
+	}
+
+	resp, err := c.client.ResolveCategory(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CategoryResponse{
+		Id:   resp.Category.Id,
+		Name: resp.Category.Name,
+	}, nil
+}
+
+// ListSubcategoryIds returns the ids of every descendant of the category, at any depth
+func (c *categoryClient) ListSubcategoryIds(ctx context.Context, id string) ([]string, error) {
+	reqProto := protoCategory.ListSubcategoriesRequest{
+		ParentId:  id,
+		Recursive: true,
+	}
+
+	resp, err := c.client.ListSubcategories(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(resp.Categories))
+	for _, category := range resp.Categories {
+		ids = append(ids, category.Id)
+	}
+	return ids, nil
+}
+
 type CategoryResponse struct { // simplify struct to optimize memory
-	Id string
+	Id   string
+	Name string
 }
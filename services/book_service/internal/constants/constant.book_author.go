@@ -0,0 +1,7 @@
+package constants
+
+const (
+	BookAuthorRoleAuthor     = "author"
+	BookAuthorRoleEditor     = "editor"
+	BookAuthorRoleTranslator = "translator"
+)
@@ -0,0 +1,27 @@
+package constants
+
+import "time"
+
+const (
+	CatalogueFormatCSV    = "csv"
+	CatalogueFormatMARC21 = "marc21"
+
+	BookImportStatusPending   = "PENDING"
+	BookImportStatusRunning   = "RUNNING"
+	BookImportStatusCompleted = "COMPLETED"
+	BookImportStatusFailed    = "FAILED"
+
+	// MaxBookImportFileSize bounds the file uploaded for an import job
+	MaxBookImportFileSize = 20 << 20
+	// MaxBookImportRowErrors bounds the row errors kept on a job, the failed rows still count all of them
+	MaxBookImportRowErrors = 500
+	// BookImportProgressInterval is the number of rows between two saves of the job progress
+	BookImportProgressInterval = 100
+	// BookImportStaleAfter is how long a running job may go without progress before it is taken for interrupted
+	BookImportStaleAfter = 10 * time.Minute
+
+	// BookExportPageSize is the number of books read at once while exporting the catalogue
+	BookExportPageSize = 200
+	// BookExportChunkSize is the size of the chunks the export is streamed in
+	BookExportChunkSize = 32 << 10
+)
@@ -0,0 +1,7 @@
+package constants
+
+const (
+	BookCopyStatusAvailable = "AVAILABLE"
+	BookCopyStatusOnLoan    = "ON_LOAN"
+	BookCopyStatusRetired   = "RETIRED"
+)
@@ -0,0 +1,15 @@
+package constants
+
+const (
+	BookSortByRelevance       = "relevance"
+	BookSortByTitle           = "title"
+	BookSortByPublicationYear = "publication_year"
+	BookSortByCreatedAt       = "created_at"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+
+	// Search highlights wrap the matched terms of the title in these tags
+	SearchHighlightStart = "<mark>"
+	SearchHighlightStop  = "</mark>"
+)
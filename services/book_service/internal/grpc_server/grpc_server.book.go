@@ -3,10 +3,12 @@ package grpc_server
 import (
 	"book_service/internal/constants"
 	"book_service/internal/models"
+	"book_service/internal/repository"
 	"book_service/internal/service"
 	"book_service/pkg/logger"
 	protoBook "book_service/proto/book_service"
 	"context"
+	"errors"
 	"fmt"
 
 	"book_service/pkg/utils"
@@ -16,21 +18,25 @@ import (
 )
 
 type bookGRPCServer struct {
-	bookService service.BookService
-	logger      *logger.Logger
+	bookService      service.BookService
+	copyService      service.BookCopyService
+	catalogueService service.BookCatalogueService
+	logger           *logger.Logger
 	protoBook.UnimplementedBookServiceServer
 }
 
-func NewBookGRPCServer(bookService service.BookService, logger *logger.Logger) protoBook.BookServiceServer {
+func NewBookGRPCServer(bookService service.BookService, copyService service.BookCopyService, catalogueService service.BookCatalogueService, logger *logger.Logger) protoBook.BookServiceServer {
 	return &bookGRPCServer{
-		bookService: bookService,
-		logger:      logger,
+		bookService:      bookService,
+		copyService:      copyService,
+		catalogueService: catalogueService,
+		logger:           logger,
 	}
 }
 
 func (s *bookGRPCServer) CreateBook(ctx context.Context, req *protoBook.CreateBookRequest) (*protoBook.CreateBookResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CreateBook request", map[string]interface{}{"title": req.Title, "author_id": req.AuthorId, "category_id": req.CategoryId}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CreateBook request", map[string]interface{}{"title": req.Title, "authors": req.Authors, "category_id": req.CategoryId}, nil)
 
 	// Validate request from client
 	if err := req.Validate(); err != nil {
@@ -38,8 +44,9 @@ func (s *bookGRPCServer) CreateBook(ctx context.Context, req *protoBook.CreateBo
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	// check author existence
-	err := s.bookService.ValidateAuthorExistence(ctx, req.AuthorId)
+	// check existence of every credited author
+	authors := toBookAuthorRecords(req.Authors)
+	err := s.bookService.ValidateAuthorExistence(ctx, models.BookAuthorIds(authors))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Author not found", nil, err)
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -53,29 +60,26 @@ func (s *bookGRPCServer) CreateBook(ctx context.Context, req *protoBook.CreateBo
 	}
 
 	createdBook, err := s.bookService.CreateBook(ctx, &models.BookRequest{
-		Title:      req.Title,
-		AuthorId:   req.AuthorId,
-		CategoryId: req.CategoryId,
-		Stock:      int(req.Stock),
+		Title:           req.Title,
+		Authors:         authors,
+		CategoryId:      req.CategoryId,
+		PublicationYear: int(req.PublicationYear),
+		ISBN:            req.Isbn,
+		Publisher:       req.Publisher,
+		Language:        req.Language,
+		PageCount:       int(req.PageCount),
+		Edition:         req.Edition,
+		Description:     req.Description,
 	})
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to create book", nil, err)
-		return nil, status.Error(codes.Internal, "failed to create new book")
+		return nil, bookError(err, "failed to create new book")
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book created successfully", map[string]interface{}{"book_id": createdBook.Id}, nil)
 
 	return &protoBook.CreateBookResponse{
-		Book: &protoBook.Book{
-			Id:         createdBook.Id,
-			Title:      createdBook.Title,
-			AuthorId:   createdBook.AuthorId,
-			CategoryId: createdBook.CategoryId,
-			Stock:      int32(createdBook.Stock),
-			Version:    int32(createdBook.Version),
-			CreatedAt:  createdBook.CreatedAt.Unix(),
-			UpdatedAt:  createdBook.UpdatedAt.Unix(),
-		},
+		Book: toProtoBook(createdBook),
 	}, nil
 }
 
@@ -97,16 +101,7 @@ func (s *bookGRPCServer) GetBooksByAuthor(ctx context.Context, req *protoBook.Ge
 
 	var protoBooks []*protoBook.Book
 	for _, book := range books {
-		protoBooks = append(protoBooks, &protoBook.Book{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   book.AuthorId,
-			CategoryId: book.CategoryId,
-			Stock:      int32(book.Stock),
-			Version:    int32(book.Version),
-			CreatedAt:  book.CreatedAt.Unix(),
-			UpdatedAt:  book.UpdatedAt.Unix(),
-		})
+		protoBooks = append(protoBooks, toProtoBook(book))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books retrieved successfully by author", nil, nil)
@@ -120,7 +115,7 @@ func (s *bookGRPCServer) GetBooksByAuthor(ctx context.Context, req *protoBook.Ge
 
 func (s *bookGRPCServer) GetBooksByCategory(ctx context.Context, req *protoBook.GetBooksByCategoryRequest) (*protoBook.ListBooksResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetBooksByCategory request", map[string]interface{}{"category_id": req.CategoryId}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetBooksByCategory request", map[string]interface{}{"category_id": req.CategoryId, "include_descendants": req.IncludeDescendants}, nil)
 
 	// Validate request from client
 	if err := req.Validate(); err != nil {
@@ -128,7 +123,7 @@ func (s *bookGRPCServer) GetBooksByCategory(ctx context.Context, req *protoBook.
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	books, totalItems, err := s.bookService.GetBookByCategoryId(ctx, req.CategoryId, int(req.Page), int(req.PageSize))
+	books, totalItems, err := s.bookService.GetBookByCategoryId(ctx, req.CategoryId, req.IncludeDescendants, int(req.Page), int(req.PageSize))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve books by category id", nil, err)
 		return nil, status.Error(codes.Internal, "failed to retrieve books by category id")
@@ -136,16 +131,7 @@ func (s *bookGRPCServer) GetBooksByCategory(ctx context.Context, req *protoBook.
 
 	var protoBooks []*protoBook.Book
 	for _, book := range books {
-		protoBooks = append(protoBooks, &protoBook.Book{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   book.AuthorId,
-			CategoryId: book.CategoryId,
-			Stock:      int32(book.Stock),
-			Version:    int32(book.Version),
-			CreatedAt:  book.CreatedAt.Unix(),
-			UpdatedAt:  book.UpdatedAt.Unix(),
-		})
+		protoBooks = append(protoBooks, toProtoBook(book))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books retrieved successfully by category", nil, nil)
@@ -176,16 +162,30 @@ func (s *bookGRPCServer) GetBook(ctx context.Context, req *protoBook.GetBookRequ
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book retrieved successfully", map[string]interface{}{"book_id": book.Id}, nil)
 
 	return &protoBook.GetBookResponse{
-		Book: &protoBook.Book{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   book.AuthorId,
-			CategoryId: book.CategoryId,
-			Stock:      int32(book.Stock),
-			Version:    int32(book.Version),
-			CreatedAt:  book.CreatedAt.Unix(),
-			UpdatedAt:  book.UpdatedAt.Unix(),
-		},
+		Book: toProtoBook(book),
+	}, nil
+}
+
+func (s *bookGRPCServer) GetBookByISBN(ctx context.Context, req *protoBook.GetBookByISBNRequest) (*protoBook.GetBookResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetBookByISBN request", map[string]interface{}{"isbn": req.Isbn}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid GetBookByISBN request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	book, err := s.bookService.GetBookByISBN(ctx, req.Isbn)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retrieve book with ISBN '%s'", req.Isbn), nil, err)
+		return nil, bookError(err, fmt.Sprintf("failed to retrieve book with ISBN '%s'", req.Isbn))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book retrieved successfully", map[string]interface{}{"book_id": book.Id}, nil)
+
+	return &protoBook.GetBookResponse{
+		Book: toProtoBook(book),
 	}, nil
 }
 
@@ -208,16 +208,7 @@ func (s *bookGRPCServer) ListBooks(ctx context.Context, req *protoBook.ListBooks
 
 	var protoBooks []*protoBook.Book
 	for _, book := range books {
-		protoBooks = append(protoBooks, &protoBook.Book{
-			Id:         book.Id,
-			Title:      book.Title,
-			AuthorId:   book.AuthorId,
-			CategoryId: book.CategoryId,
-			Stock:      int32(book.Stock),
-			Version:    int32(book.Version),
-			CreatedAt:  book.CreatedAt.Unix(),
-			UpdatedAt:  book.UpdatedAt.Unix(),
-		})
+		protoBooks = append(protoBooks, toProtoBook(book))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books list retrieved successfully", nil, nil)
@@ -229,9 +220,58 @@ func (s *bookGRPCServer) ListBooks(ctx context.Context, req *protoBook.ListBooks
 	}, nil
 }
 
+func (s *bookGRPCServer) SearchBooks(ctx context.Context, req *protoBook.SearchBooksRequest) (*protoBook.SearchBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received SearchBooks request", map[string]interface{}{"query": req.Query, "author_id": req.AuthorId, "category_id": req.CategoryId, "available_only": req.AvailableOnly, "year_from": req.YearFrom, "year_to": req.YearTo, "sort_by": req.SortBy, "sort_order": req.SortOrder}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid SearchBooks request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+	if req.YearFrom > 0 && req.YearTo > 0 && req.YearFrom > req.YearTo {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid SearchBooks year range", nil, nil)
+		return nil, status.Error(codes.InvalidArgument, "year_from must not be after year_to")
+	}
+
+	hits, totalItems, err := s.bookService.SearchBooks(ctx, &models.BookSearchFilter{
+		Query:         req.Query,
+		AuthorId:      req.AuthorId,
+		CategoryId:    req.CategoryId,
+		AvailableOnly: req.AvailableOnly,
+		YearFrom:      int(req.YearFrom),
+		YearTo:        int(req.YearTo),
+		SortBy:        req.SortBy,
+		SortOrder:     req.SortOrder,
+		Page:          int(req.Page),
+		PageSize:      int(req.PageSize),
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to search books", nil, err)
+		return nil, status.Error(codes.Internal, "failed to search books")
+	}
+
+	protoHits := []*protoBook.BookSearchHit{}
+	for _, hit := range hits {
+		protoHits = append(protoHits, &protoBook.BookSearchHit{
+			Book:      toProtoBook(&hit.BookRecord),
+			Highlight: hit.Highlight,
+			Rank:      float32(hit.Rank),
+		})
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books searched successfully", map[string]interface{}{"total_items": totalItems}, nil)
+
+	return &protoBook.SearchBooksResponse{
+		Hits:       protoHits,
+		TotalItems: int32(totalItems),
+		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+	}, nil
+}
+
 func (s *bookGRPCServer) UpdateBook(ctx context.Context, req *protoBook.UpdateBookRequest) (*protoBook.UpdateBookResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateBook request", map[string]interface{}{"book_id": req.Id, "title": req.Title, "author_id": req.AuthorId, "category_id": req.CategoryId}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateBook request", map[string]interface{}{"book_id": req.Id, "title": req.Title, "authors": req.Authors, "category_id": req.CategoryId}, nil)
 
 	// Validate request from client
 	if err := req.Validate(); err != nil {
@@ -239,8 +279,9 @@ func (s *bookGRPCServer) UpdateBook(ctx context.Context, req *protoBook.UpdateBo
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	// check author existence
-	err := s.bookService.ValidateAuthorExistence(ctx, req.AuthorId)
+	// check existence of every credited author
+	authors := toBookAuthorRecords(req.Authors)
+	err := s.bookService.ValidateAuthorExistence(ctx, models.BookAuthorIds(authors))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Author not found", nil, err)
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -254,30 +295,27 @@ func (s *bookGRPCServer) UpdateBook(ctx context.Context, req *protoBook.UpdateBo
 	}
 
 	updatedBook, err := s.bookService.UpdateBook(ctx, req.Id, &models.BookRequest{
-		Title:      req.Title,
-		AuthorId:   req.AuthorId,
-		CategoryId: req.CategoryId,
-		Stock:      int(req.Stock),
-		Version:    int(req.Version),
+		Title:           req.Title,
+		Authors:         authors,
+		CategoryId:      req.CategoryId,
+		PublicationYear: int(req.PublicationYear),
+		ISBN:            req.Isbn,
+		Publisher:       req.Publisher,
+		Language:        req.Language,
+		PageCount:       int(req.PageCount),
+		Edition:         req.Edition,
+		Description:     req.Description,
+		Version:         int(req.Version),
 	})
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to update book with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update book with id '%s'", req.Id))
+		return nil, bookError(err, fmt.Sprintf("failed to update book with id '%s'", req.Id))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book updated successfully", map[string]interface{}{"book_id": updatedBook.Id}, nil)
 
 	return &protoBook.UpdateBookResponse{
-		Book: &protoBook.Book{
-			Id:         updatedBook.Id,
-			Title:      updatedBook.Title,
-			AuthorId:   updatedBook.AuthorId,
-			CategoryId: updatedBook.CategoryId,
-			Stock:      int32(updatedBook.Stock),
-			Version:    int32(updatedBook.Version),
-			CreatedAt:  updatedBook.CreatedAt.Unix(),
-			UpdatedAt:  updatedBook.UpdatedAt.Unix(),
-		},
+		Book: toProtoBook(updatedBook),
 	}, nil
 }
 
@@ -294,7 +332,7 @@ func (s *bookGRPCServer) DeleteBook(ctx context.Context, req *protoBook.DeleteBo
 	err := s.bookService.DeleteBook(ctx, req.Id, int(req.Version))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to delete book with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete book with id '%s'", req.Id))
+		return nil, bookError(err, fmt.Sprintf("failed to delete book with id '%s'", req.Id))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book deleted successfully", map[string]interface{}{"book_id": req.Id}, nil)
@@ -304,71 +342,66 @@ func (s *bookGRPCServer) DeleteBook(ctx context.Context, req *protoBook.DeleteBo
 	}, nil
 }
 
-func (s *bookGRPCServer) UpdateBookStock(ctx context.Context, req *protoBook.UpdateBookStockRequest) (*protoBook.UpdateBookStockResponse, error) {
-	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateBookStock request", map[string]interface{}{"book_id": req.Id, "new_stock": req.Stock}, nil)
-
-	// Validate request from client
-	if err := req.Validate(); err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UpdateBookStock request", nil, err)
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
-	}
-
-	err := s.bookService.UpdateBookStock(ctx, req.Id, int(req.Stock), int(req.Version))
-	if err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to update stock for book with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update stock for book with id '%s'", req.Id))
+func bookError(err error, message string) error {
+	switch {
+	case errors.Is(err, utils.ErrInvalidISBN):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrDuplicateBookAuthor), errors.Is(err, service.ErrReassignToSelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrDuplicateISBN):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrBookNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrBooksOnLoan), errors.Is(err, service.ErrBookReferenceDeleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, message)
 	}
-
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book stock updated successfully", map[string]interface{}{"book_id": req.Id, "new_stock": req.Stock}, nil)
-
-	return &protoBook.UpdateBookStockResponse{
-		Message: fmt.Sprintf("Stock for book with id '%s' updated successfully", req.Id),
-	}, nil
 }
 
-func (s *bookGRPCServer) IncrementBookStock(ctx context.Context, req *protoBook.IncrementBookStockRequest) (*protoBook.IncrementBookStockResponse, error) {
-	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received IncrementBookStock request", map[string]interface{}{"book_id": req.Id}, nil)
-
-	// Validate request from client
-	if err := req.Validate(); err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid IncrementBookStock request", nil, err)
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+func toProtoBook(book *models.BookRecord) *protoBook.Book {
+	b := &protoBook.Book{
+		Id:              book.Id,
+		Title:           book.Title,
+		AuthorIds:       book.AuthorIds(),
+		Authors:         toProtoBookAuthors(book.Authors),
+		CategoryId:      book.CategoryId,
+		PublicationYear: int32(book.PublicationYear),
+		Isbn:            book.ISBN,
+		Publisher:       book.Publisher,
+		Language:        book.Language,
+		PageCount:       int32(book.PageCount),
+		Edition:         book.Edition,
+		Description:     book.Description,
+		Stock:           int32(book.Stock),
+		Version:         int32(book.Version),
+		CreatedAt:       book.CreatedAt.Unix(),
+		UpdatedAt:       book.UpdatedAt.Unix(),
 	}
-
-	err := s.bookService.IncrementBookStock(ctx, req.Id, int(req.Version))
-	if err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to increment stock for book with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to increment stock for book with id '%s'", req.Id))
+	if book.DeletedAt != nil {
+		b.DeletedAt = book.DeletedAt.Unix()
 	}
-
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book stock incremented successfully", map[string]interface{}{"book_id": req.Id}, nil)
-
-	return &protoBook.IncrementBookStockResponse{
-		Message: fmt.Sprintf("Stock for book with id '%s' incremented successfully", req.Id),
-	}, nil
+	return b
 }
 
-func (s *bookGRPCServer) DecrementBookStock(ctx context.Context, req *protoBook.DecrementBookStockRequest) (*protoBook.DecrementBookStockResponse, error) {
-	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DecrementBookStock request", map[string]interface{}{"book_id": req.Id}, nil)
-
-	// Validate request from client
-	if err := req.Validate(); err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid DecrementBookStock request", nil, err)
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+func toBookAuthorRecords(authors []*protoBook.BookAuthor) []models.BookAuthorRecord {
+	records := make([]models.BookAuthorRecord, 0, len(authors))
+	for _, author := range authors {
+		records = append(records, models.BookAuthorRecord{
+			AuthorId: author.AuthorId,
+			Role:     author.Role,
+		})
 	}
+	return records
+}
 
-	err := s.bookService.DecrementBookStock(ctx, req.Id, int(req.Version))
-	if err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to decrement stock for book with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to decrement stock for book with id '%s'", req.Id))
+func toProtoBookAuthors(authors []models.BookAuthorRecord) []*protoBook.BookAuthor {
+	protoAuthors := make([]*protoBook.BookAuthor, 0, len(authors))
+	for _, author := range authors {
+		protoAuthors = append(protoAuthors, &protoBook.BookAuthor{
+			AuthorId: author.AuthorId,
+			Role:     author.Role,
+		})
 	}
-
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book stock decremented successfully", map[string]interface{}{"book_id": req.Id}, nil)
-
-	return &protoBook.DecrementBookStockResponse{
-		Message: fmt.Sprintf("Stock for book with id '%s' decremented successfully", req.Id),
-	}, nil
+	return protoAuthors
 }
@@ -0,0 +1,180 @@
+package grpc_server
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"book_service/internal/repository"
+	"book_service/internal/service"
+	"book_service/pkg/utils"
+	protoBook "book_service/proto/book_service"
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImportBooks receives the header of the file followed by its content in chunks and queues an import job for it
+func (s *bookGRPCServer) ImportBooks(stream protoBook.BookService_ImportBooksServer) error {
+	ctx := stream.Context()
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ImportBooks request", nil, nil)
+
+	first, err := stream.Recv()
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to receive ImportBooks header", nil, err)
+		return status.Error(codes.InvalidArgument, "Invalid request: missing import header")
+	}
+	header := first.GetHeader()
+	if header == nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "ImportBooks stream did not start with a header", nil, nil)
+		return status.Error(codes.InvalidArgument, "Invalid request: the import must start with a header")
+	}
+	if err := header.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ImportBooks header", nil, err)
+		return status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	var payload []byte
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to receive import file", nil, err)
+			return status.Error(codes.Canceled, "import upload was interrupted")
+		}
+
+		chunk := req.GetChunk()
+		if len(payload)+len(chunk) > constants.MaxBookImportFileSize {
+			s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Import file too large", map[string]interface{}{"file_name": header.FileName}, nil)
+			return status.Error(codes.ResourceExhausted, service.ErrImportFileTooLarge.Error())
+		}
+		payload = append(payload, chunk...)
+	}
+
+	job, err := s.catalogueService.CreateImportJob(ctx, &models.BookImportRequest{
+		Format:      header.Format,
+		FileName:    header.FileName,
+		RequestedBy: header.RequestedBy,
+		Payload:     payload,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to create import job", map[string]interface{}{"file_name": header.FileName}, err)
+		return catalogueError(err, "failed to create import job")
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Import job created successfully", map[string]interface{}{"job_id": job.Id, "bytes": len(payload)}, nil)
+
+	return stream.SendAndClose(&protoBook.ImportJobResponse{
+		Job: toProtoImportJob(job),
+	})
+}
+
+func (s *bookGRPCServer) GetImportJob(ctx context.Context, req *protoBook.GetImportJobRequest) (*protoBook.ImportJobResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetImportJob request", map[string]interface{}{"job_id": req.Id}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid GetImportJob request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	job, err := s.catalogueService.GetImportJob(ctx, req.Id)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retrieve import job with id '%s'", req.Id), nil, err)
+		return nil, catalogueError(err, fmt.Sprintf("failed to retrieve import job with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Import job retrieved successfully", map[string]interface{}{"job_id": job.Id, "status": job.Status}, nil)
+
+	return &protoBook.ImportJobResponse{
+		Job: toProtoImportJob(job),
+	}, nil
+}
+
+// ExportBooks streams the whole catalogue in the requested format
+func (s *bookGRPCServer) ExportBooks(req *protoBook.ExportBooksRequest, stream protoBook.BookService_ExportBooksServer) error {
+	ctx := stream.Context()
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ExportBooks request", map[string]interface{}{"format": req.Format}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ExportBooks request", nil, err)
+		return status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	writer := bufio.NewWriterSize(&exportChunkWriter{stream: stream}, constants.BookExportChunkSize)
+	exported, err := s.catalogueService.ExportBooks(ctx, req.Format, writer)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to export books", map[string]interface{}{"exported": exported}, err)
+		return catalogueError(err, "failed to export books")
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books exported successfully", map[string]interface{}{"exported": exported, "format": req.Format}, nil)
+	return nil
+}
+
+// exportChunkWriter sends everything written to it as one chunk of the export stream
+type exportChunkWriter struct {
+	stream protoBook.BookService_ExportBooksServer
+}
+
+func (w *exportChunkWriter) Write(data []byte) (int, error) {
+	if err := w.stream.Send(&protoBook.ExportBooksChunk{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func catalogueError(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrUnsupportedCatalogueFormat),
+		errors.Is(err, service.ErrEmptyImportFile),
+		errors.Is(err, service.ErrInvalidImportFile):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrImportFileTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, repository.ErrBookImportJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
+
+func toProtoImportJob(job *models.BookImportJobRecord) *protoBook.ImportJob {
+	protoJob := &protoBook.ImportJob{
+		Id:           job.Id,
+		Format:       job.Format,
+		FileName:     job.FileName,
+		Status:       job.Status,
+		TotalRows:    int32(job.TotalRows),
+		ImportedRows: int32(job.ImportedRows),
+		FailedRows:   int32(job.FailedRows),
+		Error:        job.Error,
+		RequestedBy:  job.RequestedBy,
+		CreatedAt:    job.CreatedAt.Unix(),
+	}
+	for _, rowError := range job.RowErrors {
+		protoJob.Errors = append(protoJob.Errors, &protoBook.ImportRowError{
+			Row:     int32(rowError.Row),
+			Message: rowError.Message,
+		})
+	}
+	if job.StartedAt != nil {
+		protoJob.StartedAt = job.StartedAt.Unix()
+	}
+	if job.FinishedAt != nil {
+		protoJob.FinishedAt = job.FinishedAt.Unix()
+	}
+	return protoJob
+}
@@ -0,0 +1,217 @@
+package grpc_server
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"book_service/internal/repository"
+	"book_service/pkg/utils"
+	protoBook "book_service/proto/book_service"
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *bookGRPCServer) AddBookCopy(ctx context.Context, req *protoBook.AddBookCopyRequest) (*protoBook.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received AddBookCopy request", map[string]interface{}{"book_id": req.BookId, "barcode": req.Barcode}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid AddBookCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	addedCopy, err := s.copyService.AddCopy(ctx, &models.BookCopyRecord{
+		BookId:        req.BookId,
+		Barcode:       req.Barcode,
+		Condition:     req.Condition,
+		ShelfLocation: req.ShelfLocation,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to add copy '%s'", req.Barcode), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to add copy '%s' to book with id '%s'", req.Barcode, req.BookId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy added successfully", map[string]interface{}{"copy_id": addedCopy.Id}, nil)
+
+	return &protoBook.BookCopyResponse{
+		Copy: toProtoBookCopy(addedCopy),
+	}, nil
+}
+
+func (s *bookGRPCServer) UpdateBookCopy(ctx context.Context, req *protoBook.UpdateBookCopyRequest) (*protoBook.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateBookCopy request", map[string]interface{}{"copy_id": req.Id, "condition": req.Condition}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UpdateBookCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	updatedCopy, err := s.copyService.UpdateCopy(ctx, &models.BookCopyRecord{
+		Id:            req.Id,
+		Condition:     req.Condition,
+		ShelfLocation: req.ShelfLocation,
+		Version:       int(req.Version),
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to update copy with id '%s'", req.Id), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update copy with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy updated successfully", map[string]interface{}{"copy_id": updatedCopy.Id}, nil)
+
+	return &protoBook.BookCopyResponse{
+		Copy: toProtoBookCopy(updatedCopy),
+	}, nil
+}
+
+func (s *bookGRPCServer) RetireBookCopy(ctx context.Context, req *protoBook.RetireBookCopyRequest) (*protoBook.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RetireBookCopy request", map[string]interface{}{"copy_id": req.Id}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RetireBookCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	retiredCopy, err := s.copyService.RetireCopy(ctx, req.Id, int(req.Version))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retire copy with id '%s'", req.Id), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to retire copy with id '%s', only available copies can be retired", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy retired successfully", map[string]interface{}{"copy_id": retiredCopy.Id}, nil)
+
+	return &protoBook.BookCopyResponse{
+		Copy: toProtoBookCopy(retiredCopy),
+	}, nil
+}
+
+func (s *bookGRPCServer) GetBookCopyByBarcode(ctx context.Context, req *protoBook.GetBookCopyByBarcodeRequest) (*protoBook.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetBookCopyByBarcode request", map[string]interface{}{"barcode": req.Barcode}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid GetBookCopyByBarcode request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	bookCopy, err := s.copyService.GetCopyByBarcode(ctx, req.Barcode)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retrieve copy with barcode '%s'", req.Barcode), nil, err)
+		return nil, bookCopyError(err, fmt.Sprintf("failed to retrieve copy with barcode '%s'", req.Barcode))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy retrieved successfully", map[string]interface{}{"copy_id": bookCopy.Id}, nil)
+
+	return &protoBook.BookCopyResponse{
+		Copy: toProtoBookCopy(bookCopy),
+	}, nil
+}
+
+func (s *bookGRPCServer) ListBookCopies(ctx context.Context, req *protoBook.ListBookCopiesRequest) (*protoBook.ListBookCopiesResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListBookCopies request", map[string]interface{}{"book_id": req.BookId, "status": req.Status}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListBookCopies request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	copies, err := s.copyService.ListCopies(ctx, req.BookId, req.Status)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve book copies", nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to retrieve copies of book with id '%s'", req.BookId))
+	}
+
+	var protoCopies []*protoBook.BookCopy
+	for _, bookCopy := range copies {
+		protoCopies = append(protoCopies, toProtoBookCopy(bookCopy))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copies retrieved successfully", nil, nil)
+
+	return &protoBook.ListBookCopiesResponse{
+		Copies: protoCopies,
+	}, nil
+}
+
+func (s *bookGRPCServer) CheckOutBookCopy(ctx context.Context, req *protoBook.CheckOutBookCopyRequest) (*protoBook.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CheckOutBookCopy request", map[string]interface{}{"book_id": req.GetBookId(), "barcode": req.GetBarcode()}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CheckOutBookCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	bookCopy, err := s.copyService.CheckOutCopy(ctx, req.GetBookId(), req.GetBarcode())
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to check out book copy", nil, err)
+		return nil, bookCopyError(err, "failed to check out book copy")
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy checked out successfully", map[string]interface{}{"copy_id": bookCopy.Id}, nil)
+
+	return &protoBook.BookCopyResponse{
+		Copy: toProtoBookCopy(bookCopy),
+	}, nil
+}
+
+func (s *bookGRPCServer) CheckInBookCopy(ctx context.Context, req *protoBook.CheckInBookCopyRequest) (*protoBook.BookCopyResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CheckInBookCopy request", map[string]interface{}{"copy_id": req.Id, "condition": req.Condition}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CheckInBookCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	bookCopy, err := s.copyService.CheckInCopy(ctx, req.Id, req.Condition)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to check in copy with id '%s'", req.Id), nil, err)
+		return nil, bookCopyError(err, fmt.Sprintf("failed to check in copy with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book copy checked in successfully", map[string]interface{}{"copy_id": bookCopy.Id}, nil)
+
+	return &protoBook.BookCopyResponse{
+		Copy: toProtoBookCopy(bookCopy),
+	}, nil
+}
+
+// bookCopyError maps the copy errors a caller can act on to their gRPC code, anything else is internal and reported with message
+func bookCopyError(err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrBookCopyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrBookCopyUnavailable), errors.Is(err, repository.ErrBookCopyNotOnLoan):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
+
+func toProtoBookCopy(bookCopy *models.BookCopyRecord) *protoBook.BookCopy {
+	return &protoBook.BookCopy{
+		Id:            bookCopy.Id,
+		BookId:        bookCopy.BookId,
+		Barcode:       bookCopy.Barcode,
+		Condition:     bookCopy.Condition,
+		ShelfLocation: bookCopy.ShelfLocation,
+		Status:        bookCopy.Status,
+		Version:       int32(bookCopy.Version),
+		CreatedAt:     bookCopy.CreatedAt.Unix(),
+		UpdatedAt:     bookCopy.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,154 @@
+package grpc_server
+
+import (
+	"book_service/internal/constants"
+	"book_service/pkg/utils"
+	protoBook "book_service/proto/book_service"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *bookGRPCServer) CountBooksByAuthor(ctx context.Context, req *protoBook.CountBooksByAuthorRequest) (*protoBook.CountBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CountBooksByAuthor request", map[string]interface{}{"author_id": req.AuthorId}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CountBooksByAuthor request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	totalBooks, err := s.bookService.CountBooksByAuthorId(ctx, req.AuthorId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to count books by author id", nil, err)
+		return nil, status.Error(codes.Internal, "failed to count books by author id")
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books by author counted successfully", map[string]interface{}{"author_id": req.AuthorId, "total_books": totalBooks}, nil)
+
+	return &protoBook.CountBooksResponse{TotalBooks: int32(totalBooks)}, nil
+}
+
+func (s *bookGRPCServer) CountBooksByCategory(ctx context.Context, req *protoBook.CountBooksByCategoryRequest) (*protoBook.CountBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CountBooksByCategory request", map[string]interface{}{"category_id": req.CategoryId}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CountBooksByCategory request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	totalBooks, err := s.bookService.CountBooksByCategoryId(ctx, req.CategoryId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to count books by category id", nil, err)
+		return nil, status.Error(codes.Internal, "failed to count books by category id")
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books by category counted successfully", map[string]interface{}{"category_id": req.CategoryId, "total_books": totalBooks}, nil)
+
+	return &protoBook.CountBooksResponse{TotalBooks: int32(totalBooks)}, nil
+}
+
+func (s *bookGRPCServer) ReassignBooksByAuthor(ctx context.Context, req *protoBook.ReassignBooksByAuthorRequest) (*protoBook.BulkBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	extra := map[string]interface{}{"author_id": req.AuthorId, "new_author_id": req.NewAuthorId}
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ReassignBooksByAuthor request", extra, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ReassignBooksByAuthor request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	// check existence of the new author
+	if err := s.bookService.ValidateAuthorExistence(ctx, []string{req.NewAuthorId}); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Author not found", extra, err)
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	affected, err := s.bookService.ReassignBooksByAuthorId(ctx, req.AuthorId, req.NewAuthorId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to reassign books by author id", extra, err)
+		return nil, bookError(err, fmt.Sprintf("failed to reassign books of author with id '%s'", req.AuthorId))
+	}
+
+	extra["affected_books"] = affected
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books by author reassigned successfully", extra, nil)
+
+	return &protoBook.BulkBooksResponse{AffectedBooks: int32(affected)}, nil
+}
+
+func (s *bookGRPCServer) ReassignBooksByCategory(ctx context.Context, req *protoBook.ReassignBooksByCategoryRequest) (*protoBook.BulkBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	extra := map[string]interface{}{"category_id": req.CategoryId, "new_category_id": req.NewCategoryId}
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ReassignBooksByCategory request", extra, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ReassignBooksByCategory request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	// check existence of the new category
+	if err := s.bookService.ValidateCategoryExistence(ctx, req.NewCategoryId); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Category not found", extra, err)
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	affected, err := s.bookService.ReassignBooksByCategoryId(ctx, req.CategoryId, req.NewCategoryId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to reassign books by category id", extra, err)
+		return nil, bookError(err, fmt.Sprintf("failed to reassign books of category with id '%s'", req.CategoryId))
+	}
+
+	extra["affected_books"] = affected
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books by category reassigned successfully", extra, nil)
+
+	return &protoBook.BulkBooksResponse{AffectedBooks: int32(affected)}, nil
+}
+
+func (s *bookGRPCServer) DeleteBooksByAuthor(ctx context.Context, req *protoBook.DeleteBooksByAuthorRequest) (*protoBook.BulkBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteBooksByAuthor request", map[string]interface{}{"author_id": req.AuthorId}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid DeleteBooksByAuthor request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	affected, err := s.bookService.DeleteBooksByAuthorId(ctx, req.AuthorId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to delete books by author id", nil, err)
+		return nil, bookError(err, fmt.Sprintf("failed to delete books of author with id '%s'", req.AuthorId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books by author deleted successfully", map[string]interface{}{"author_id": req.AuthorId, "affected_books": affected}, nil)
+
+	return &protoBook.BulkBooksResponse{AffectedBooks: int32(affected)}, nil
+}
+
+func (s *bookGRPCServer) DeleteBooksByCategory(ctx context.Context, req *protoBook.DeleteBooksByCategoryRequest) (*protoBook.BulkBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteBooksByCategory request", map[string]interface{}{"category_id": req.CategoryId}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid DeleteBooksByCategory request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	affected, err := s.bookService.DeleteBooksByCategoryId(ctx, req.CategoryId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to delete books by category id", nil, err)
+		return nil, bookError(err, fmt.Sprintf("failed to delete books of category with id '%s'", req.CategoryId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Books by category deleted successfully", map[string]interface{}{"category_id": req.CategoryId, "affected_books": affected}, nil)
+
+	return &protoBook.BulkBooksResponse{AffectedBooks: int32(affected)}, nil
+}
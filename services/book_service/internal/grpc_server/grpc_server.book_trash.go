@@ -0,0 +1,65 @@
+package grpc_server
+
+import (
+	"book_service/internal/constants"
+	"book_service/pkg/utils"
+	protoBook "book_service/proto/book_service"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *bookGRPCServer) ListDeletedBooks(ctx context.Context, req *protoBook.ListBooksRequest) (*protoBook.ListBooksResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListDeletedBooks request", nil, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListDeletedBooks request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	books, totalItems, err := s.bookService.ListDeletedBooks(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve deleted books list", nil, err)
+		return nil, status.Error(codes.Internal, "failed to retrieve deleted book list")
+	}
+
+	var protoBooks []*protoBook.Book
+	for _, book := range books {
+		protoBooks = append(protoBooks, toProtoBook(book))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Deleted books list retrieved successfully", nil, nil)
+
+	return &protoBook.ListBooksResponse{
+		Books:      protoBooks,
+		TotalItems: int32(totalItems),
+		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+	}, nil
+}
+
+func (s *bookGRPCServer) RestoreBook(ctx context.Context, req *protoBook.RestoreBookRequest) (*protoBook.GetBookResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RestoreBook request", map[string]interface{}{"book_id": req.Id}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RestoreBook request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	book, err := s.bookService.RestoreBook(ctx, req.Id)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to restore book with id '%s'", req.Id), nil, err)
+		return nil, bookError(err, fmt.Sprintf("failed to restore book with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Book restored successfully", map[string]interface{}{"book_id": book.Id}, nil)
+
+	return &protoBook.GetBookResponse{
+		Book: toProtoBook(book),
+	}, nil
+}
@@ -0,0 +1,17 @@
+package models
+
+// BookAuthorRecord credits an author on a book
+type BookAuthorRecord struct {
+	AuthorId string `db:"author_id"`
+	Role     string `db:"role"`
+	Position int    `db:"position"` // 1-based credit order
+}
+
+// BookAuthorIds returns the author ids of the credits in the same order
+func BookAuthorIds(authors []BookAuthorRecord) []string {
+	ids := make([]string, 0, len(authors))
+	for _, author := range authors {
+		ids = append(ids, author.AuthorId)
+	}
+	return ids
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// CatalogueEntry is a book as read from an import file or written to an export,
+// the authors and the category are referred to by name
+type CatalogueEntry struct {
+	Id              string // set on export only
+	Title           string
+	Authors         []CatalogueAuthor
+	Category        string
+	ISBN            string
+	PublicationYear int
+	Publisher       string
+	Language        string
+	PageCount       int
+	Edition         string
+	Description     string
+}
+
+type CatalogueAuthor struct {
+	Name string
+	Role string // empty means author
+}
+
+// BookImportRequest is an uploaded catalogue file waiting to become an import job
+type BookImportRequest struct {
+	Format      string
+	FileName    string
+	RequestedBy string
+	Payload     []byte
+}
+
+// BookImportJobRecord is a catalogue import running in the background
+type BookImportJobRecord struct {
+	Id           string
+	Format       string
+	FileName     string
+	Payload      []byte // loaded only when the job is claimed
+	Status       string
+	TotalRows    int
+	ImportedRows int
+	FailedRows   int
+	RowErrors    []BookImportRowError
+	Error        string // why the whole job failed
+	RequestedBy  string
+	CreatedAt    time.Time
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+}
+
+type BookImportRowError struct {
+	Row     int    `json:"row"` // 1-based record number, the CSV header is not counted
+	Message string `json:"message"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+type BookCopyRecord struct {
+	Id            string    `db:"id"`
+	BookId        string    `db:"book_id"`
+	Barcode       string    `db:"barcode"`
+	Condition     string    `db:"condition"`
+	ShelfLocation string    `db:"shelf_location"`
+	Status        string    `db:"status"`
+	Version       int       `db:"version"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
@@ -3,12 +3,25 @@ package models
 import "time"
 
 type BookRecord struct {
-	Id         string    `db:"id"`
-	Title      string    `db:"title"`
-	AuthorId   string    `db:"author_id"`
-	CategoryId string    `db:"category_id"`
-	Stock      int       `db:"stock"`
-	Version    int       `db:"version" json:"version"` // Field version untuk optimistic locking
-	CreatedAt  time.Time `db:"created_at"`
-	UpdatedAt  time.Time `db:"updated_at"`
+	Id              string             `db:"id"`
+	Title           string             `db:"title"`
+	Authors         []BookAuthorRecord // in credit order
+	CategoryId      string             `db:"category_id"`
+	PublicationYear int                `db:"publication_year"` // 0 when unknown
+	ISBN            string             `db:"isbn"`             // ISBN-13, empty when unknown
+	Publisher       string             `db:"publisher"`
+	Language        string             `db:"language"`   // ISO 639 code
+	PageCount       int                `db:"page_count"` // 0 when unknown
+	Edition         string             `db:"edition"`
+	Description     string             `db:"description"`
+	Stock           int                `db:"stock"`                  // Number of available copies
+	Version         int                `db:"version" json:"version"` // Field version untuk optimistic locking
+	CreatedAt       time.Time          `db:"created_at"`
+	UpdatedAt       time.Time          `db:"updated_at"`
+	DeletedAt       *time.Time         `db:"deleted_at"` // nil unless the book is in the trash
+}
+
+// AuthorIds returns the ids of the credited authors in credit order
+func (b *BookRecord) AuthorIds() []string {
+	return BookAuthorIds(b.Authors)
 }
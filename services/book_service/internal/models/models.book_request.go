@@ -1,9 +1,15 @@
 package models
 
 type BookRequest struct {
-	Title      string
-	AuthorId   string
-	CategoryId string
-	Stock      int
-	Version    int
+	Title           string
+	Authors         []BookAuthorRecord // in credit order, the role defaults to author
+	CategoryId      string
+	PublicationYear int
+	ISBN            string // ISBN-10 or ISBN-13 as entered, normalized by the service
+	Publisher       string
+	Language        string
+	PageCount       int
+	Edition         string
+	Description     string
+	Version         int
 }
@@ -0,0 +1,22 @@
+package models
+
+// BookSearchFilter narrows, orders and paginates a book search, zero values disable a filter
+type BookSearchFilter struct {
+	Query         string
+	AuthorId      string
+	CategoryId    string
+	AvailableOnly bool
+	YearFrom      int
+	YearTo        int
+	SortBy        string // "relevance", "title", "publication_year" or "created_at"
+	SortOrder     string // "asc" or "desc"
+	Page          int
+	PageSize      int
+}
+
+// BookSearchHit is a book matching a search with its highlighted title and relevance
+type BookSearchHit struct {
+	BookRecord
+	Highlight string  `db:"highlight"`
+	Rank      float64 `db:"rank"`
+}
@@ -10,22 +10,47 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+var (
+	ErrBookNotFound  = errors.New("book not found")
+	ErrDuplicateISBN = errors.New("a book with this ISBN already exists")
+)
+
+// bookColumns lists the columns read by scanBook, the stock is the number of available copies
+// and the credited authors come as parallel arrays of ids and roles in credit order
+const bookColumns = `id, title,
+	ARRAY(SELECT ba.author_id::TEXT FROM book_authors ba WHERE ba.book_id = books.id ORDER BY ba.position) AS author_ids,
+	ARRAY(SELECT ba.role FROM book_authors ba WHERE ba.book_id = books.id ORDER BY ba.position) AS author_roles,
+	category_id, COALESCE(publication_year, 0) AS publication_year,
+	COALESCE(isbn, '') AS isbn, publisher, language, COALESCE(page_count, 0) AS page_count, edition, description,
+	(SELECT COUNT(*) FROM book_copies c WHERE c.book_id = books.id AND c.status = 'AVAILABLE') AS stock,
+	version, created_at, updated_at, deleted_at`
+
 type BookRepository interface {
 	CreateBook(ctx context.Context, req *models.BookRecord) (*models.BookRecord, error)
 	GetBook(ctx context.Context, id string) (*models.BookRecord, error)
+	GetBookByISBN(ctx context.Context, isbn string) (*models.BookRecord, error)
 	GetBookByAuthorId(ctx context.Context, authorId string, page int, pageSize int) ([]*models.BookRecord, error)
-	GetBookByCategoryId(ctx context.Context, categoryId string, page int, pageSize int) ([]*models.BookRecord, error)
+	GetBookByCategoryId(ctx context.Context, categoryIds []string, page int, pageSize int) ([]*models.BookRecord, error)
 	ListBooks(ctx context.Context, page int, pageSize int) ([]*models.BookRecord, error)
+	ListBooksAfter(ctx context.Context, afterId string, limit int) ([]*models.BookRecord, error)
+	SearchBooks(ctx context.Context, filter *models.BookSearchFilter) ([]*models.BookSearchHit, int, error)
 	UpdateBook(ctx context.Context, req *models.BookRecord) (*models.BookRecord, error)
 	DeleteBook(ctx context.Context, id string, version int) error
-	UpdateBookStock(ctx context.Context, bookId string, newStock, version int) error
-	IncrementBookStock(ctx context.Context, bookId string, version int) error
-	DecrementBookStock(ctx context.Context, bookId string, version int) error
 	CountBooks(ctx context.Context) (int, error)
-	CountBooksByCategoryId(ctx context.Context, categoryId string) (int, error)
+	CountBooksByCategoryId(ctx context.Context, categoryIds []string) (int, error)
 	CountBooksByAuthorId(ctx context.Context, authorid string) (int, error)
+	ReassignBooksByAuthorId(ctx context.Context, authorId string, newAuthorId string) (int, error)
+	ReassignBooksByCategoryId(ctx context.Context, categoryId string, newCategoryId string) (int, error)
+	DeleteBooksByAuthorId(ctx context.Context, authorId string) (int, error)
+	DeleteBooksByCategoryId(ctx context.Context, categoryId string) (int, error)
+	ListDeletedBooks(ctx context.Context, page int, pageSize int) ([]*models.BookRecord, error)
+	CountDeletedBooks(ctx context.Context) (int, error)
+	GetDeletedBook(ctx context.Context, id string) (*models.BookRecord, error)
+	RestoreBook(ctx context.Context, id string) (*models.BookRecord, error)
+	PurgeDeletedBooks(ctx context.Context, deletedBefore time.Time) (int, error)
 }
 
 type bookRepository struct {
@@ -38,37 +63,55 @@ func NewBookRepository(db *sqlx.DB) BookRepository {
 	}
 }
 
+// CreateBook inserts the book and its author credits in one transaction
 func (r *bookRepository) CreateBook(ctx context.Context, req *models.BookRecord) (*models.BookRecord, error) {
 	log.Printf("Creating book: %+v\n", req)
 	query := `
 		INSERT INTO 
-			books (title, author_id, category_id, stock)
+			books (title, category_id, publication_year, isbn, publisher, language, page_count, edition, description)
 		VALUES 
-			($1, $2, $3, $4)
+			($1, $2, NULLIF($3, 0), NULLIF($4, ''), $5, $6, NULLIF($7, 0), $8, $9)
 		RETURNING 
-			id, title, author_id, category_id, stock, created_at, updated_at
-	`
-	book := &models.BookRecord{}
+			` + bookColumns
 
-	err := r.db.QueryRowContext(ctx, query,
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting CreateBook transaction: %v\n", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	book := &models.BookRecord{}
+	err = scanBook(tx.QueryRowContext(ctx, query,
 		req.Title,
-		req.AuthorId,
 		req.CategoryId,
-		req.Stock,
-	).Scan(
-		&book.Id,
-		&book.Title,
-		&book.AuthorId,
-		&book.CategoryId,
-		&book.Stock,
-		&book.CreatedAt,
-		&book.UpdatedAt,
-	)
+		req.PublicationYear,
+		req.ISBN,
+		req.Publisher,
+		req.Language,
+		req.PageCount,
+		req.Edition,
+		req.Description,
+	), book)
 	if err != nil {
+		if isUniqueViolation(err, "books_isbn_key") {
+			log.Printf("Book with ISBN %s already exists\n", req.ISBN)
+			return nil, ErrDuplicateISBN
+		}
 		log.Printf("Error creating book: %v\n", err)
 		return nil, err
 	}
 
+	if err := replaceBookAuthors(ctx, tx, book.Id, req.Authors); err != nil {
+		return nil, err
+	}
+	book.Authors = req.Authors
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing CreateBook transaction: %v\n", err)
+		return nil, err
+	}
+
 	log.Printf("Book created successfully: %+v\n", book)
 	return book, nil
 }
@@ -76,27 +119,16 @@ func (r *bookRepository) CreateBook(ctx context.Context, req *models.BookRecord)
 func (r *bookRepository) GetBook(ctx context.Context, id string) (*models.BookRecord, error) {
 	log.Printf("Fetching book with ID: %s\n", id)
 	query := `SELECT 
-				id, title, author_id, category_id, stock, version, created_at, updated_at 
+				` + bookColumns + `
 			  FROM 
 			  	books 
 			  WHERE 
-			  	id = $1`
+			  	id = $1 AND deleted_at IS NULL`
 	book := &models.BookRecord{}
-	if err := r.db.QueryRowContext(ctx, query,
-		id,
-	).Scan(
-		&book.Id,
-		&book.Title,
-		&book.AuthorId,
-		&book.CategoryId,
-		&book.Stock,
-		&book.Version,
-		&book.CreatedAt,
-		&book.UpdatedAt,
-	); err != nil {
+	if err := scanBook(r.db.QueryRowContext(ctx, query, id), book); err != nil {
 		if err == sql.ErrNoRows {
 			log.Printf("Book not found with ID: %s\n", id)
-			return nil, errors.New("book not found")
+			return nil, ErrBookNotFound
 		}
 		log.Printf("Error fetching book: %v\n", err)
 		return nil, err
@@ -106,16 +138,38 @@ func (r *bookRepository) GetBook(ctx context.Context, id string) (*models.BookRe
 	return book, nil
 }
 
+func (r *bookRepository) GetBookByISBN(ctx context.Context, isbn string) (*models.BookRecord, error) {
+	log.Printf("Fetching book with ISBN: %s\n", isbn)
+	query := `SELECT 
+				` + bookColumns + `
+			  FROM 
+			  	books 
+			  WHERE 
+			  	isbn = $1 AND deleted_at IS NULL`
+	book := &models.BookRecord{}
+	if err := scanBook(r.db.QueryRowContext(ctx, query, isbn), book); err != nil {
+		if err == sql.ErrNoRows {
+			log.Printf("Book not found with ISBN: %s\n", isbn)
+			return nil, ErrBookNotFound
+		}
+		log.Printf("Error fetching book by ISBN: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Book fetched successfully: %+v\n", book)
+	return book, nil
+}
+
 func (r *bookRepository) GetBookByAuthorId(ctx context.Context, authorId string, page int, pageSize int) ([]*models.BookRecord, error) {
 	log.Printf("Fetching books by author ID: %s with pagination (Page: %d, PageSize: %d)\n", authorId, page, pageSize)
 
 	offset := (page - 1) * pageSize
 	query := `SELECT 
-				id, title, author_id, category_id, stock, version, created_at, updated_at 
+				` + bookColumns + `
 			  FROM 
 			  	books 
 			  WHERE 
-			  	author_id = $1 
+			  	EXISTS (SELECT 1 FROM book_authors ba WHERE ba.book_id = books.id AND ba.author_id = $1) AND deleted_at IS NULL 
 			  LIMIT 
 			  	$2 OFFSET $3`
 	rows, err := r.db.QueryContext(ctx, query, authorId, pageSize, offset)
@@ -128,16 +182,7 @@ func (r *bookRepository) GetBookByAuthorId(ctx context.Context, authorId string,
 	var books []*models.BookRecord
 	for rows.Next() {
 		book := &models.BookRecord{}
-		if err := rows.Scan(
-			&book.Id,
-			&book.Title,
-			&book.AuthorId,
-			&book.CategoryId,
-			&book.Stock,
-			&book.Version,
-			&book.CreatedAt,
-			&book.UpdatedAt,
-		); err != nil {
+		if err := scanBook(rows, book); err != nil {
 			log.Printf("Error scanning book row: %v\n", err)
 			return nil, err
 		}
@@ -148,19 +193,20 @@ func (r *bookRepository) GetBookByAuthorId(ctx context.Context, authorId string,
 	return books, nil
 }
 
-func (r *bookRepository) GetBookByCategoryId(ctx context.Context, categoryId string, page int, pageSize int) ([]*models.BookRecord, error) {
-	log.Printf("Fetching books by category ID: %s with pagination (Page: %d, PageSize: %d)\n", categoryId, page, pageSize)
+// GetBookByCategoryId lists the books in any of the categories
+func (r *bookRepository) GetBookByCategoryId(ctx context.Context, categoryIds []string, page int, pageSize int) ([]*models.BookRecord, error) {
+	log.Printf("Fetching books by category IDs: %v with pagination (Page: %d, PageSize: %d)\n", categoryIds, page, pageSize)
 
 	offset := (page - 1) * pageSize
 	query := `SELECT 
-				id, title, author_id, category_id, stock, version, created_at, updated_at 
+				` + bookColumns + `
 			  FROM 
 			  	books 
 			  WHERE 
-			  	category_id = $1 
+			  	category_id = ANY($1) AND deleted_at IS NULL 
 			  LIMIT 
 			  	$2 OFFSET $3`
-	rows, err := r.db.QueryContext(ctx, query, categoryId, pageSize, offset)
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(categoryIds), pageSize, offset)
 	if err != nil {
 		log.Printf("Error fetching books by category ID: %v\n", err)
 		return nil, err
@@ -170,23 +216,14 @@ func (r *bookRepository) GetBookByCategoryId(ctx context.Context, categoryId str
 	var books []*models.BookRecord
 	for rows.Next() {
 		book := &models.BookRecord{}
-		if err := rows.Scan(
-			&book.Id,
-			&book.Title,
-			&book.AuthorId,
-			&book.CategoryId,
-			&book.Stock,
-			&book.Version,
-			&book.CreatedAt,
-			&book.UpdatedAt,
-		); err != nil {
+		if err := scanBook(rows, book); err != nil {
 			log.Printf("Error scanning book row: %v\n", err)
 			return nil, err
 		}
 		books = append(books, book)
 	}
 
-	log.Printf("Books fetched successfully: %d books found for category ID: %s\n", len(books), categoryId)
+	log.Printf("Books fetched successfully: %d books found for category IDs: %v\n", len(books), categoryIds)
 	return books, nil
 }
 
@@ -195,9 +232,11 @@ func (r *bookRepository) ListBooks(ctx context.Context, page int, pageSize int)
 
 	offset := (page - 1) * pageSize
 	query := `SELECT 
-				id, title, author_id, category_id, stock, version, created_at, updated_at 
+				` + bookColumns + `
 			  FROM 
 			  	books 
+			  WHERE 
+			  	deleted_at IS NULL 
 			  LIMIT 
 			  	$1 OFFSET $2`
 	rows, err := r.db.QueryContext(ctx, query, pageSize, offset)
@@ -210,16 +249,7 @@ func (r *bookRepository) ListBooks(ctx context.Context, page int, pageSize int)
 	var books []*models.BookRecord
 	for rows.Next() {
 		book := &models.BookRecord{}
-		if err := rows.Scan(
-			&book.Id,
-			&book.Title,
-			&book.AuthorId,
-			&book.CategoryId,
-			&book.Stock,
-			&book.Version,
-			&book.CreatedAt,
-			&book.UpdatedAt,
-		); err != nil {
+		if err := scanBook(rows, book); err != nil {
 			log.Printf("Error scanning book row: %v\n", err)
 			return nil, err
 		}
@@ -230,6 +260,40 @@ func (r *bookRepository) ListBooks(ctx context.Context, page int, pageSize int)
 	return books, nil
 }
 
+// ListBooksAfter lists books in id order starting after afterId, an empty afterId starts from the first book.
+// Unlike an offset, the position stays right while books are added or removed between two calls.
+func (r *bookRepository) ListBooksAfter(ctx context.Context, afterId string, limit int) ([]*models.BookRecord, error) {
+	log.Printf("Listing %d books after ID: %s\n", limit, afterId)
+
+	query := `SELECT 
+				` + bookColumns + `
+			  FROM 
+			  	books 
+			  WHERE 
+			  	($1 = '' OR id > NULLIF($1, '')::UUID) AND deleted_at IS NULL 
+			  ORDER BY 
+			  	id 
+			  LIMIT 
+			  	$2`
+	rows, err := r.db.QueryContext(ctx, query, afterId, limit)
+	if err != nil {
+		log.Printf("Error listing books after ID %s: %v\n", afterId, err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var books []*models.BookRecord
+	for rows.Next() {
+		book := &models.BookRecord{}
+		if err := scanBook(rows, book); err != nil {
+			log.Printf("Error scanning book row: %v\n", err)
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
+
 func (r *bookRepository) UpdateBook(ctx context.Context, req *models.BookRecord) (*models.BookRecord, error) {
 	log.Printf("Updating book with ID: %s\n", req.Id)
 
@@ -246,30 +310,25 @@ func (r *bookRepository) UpdateBook(ctx context.Context, req *models.BookRecord)
 				UPDATE 
 					books 
 				SET 
-					title = $1, author_id = $2, category_id = $3, stock = $4
+					title = $1, category_id = $2, publication_year = NULLIF($3, 0),
+					isbn = NULLIF($4, ''), publisher = $5, language = $6, page_count = NULLIF($7, 0), edition = $8, description = $9
 				WHERE 
-					id = $5 AND version = $6
+					id = $10 AND version = $11 AND deleted_at IS NULL
 				RETURNING 
-					id, title, author_id, category_id, stock, version, created_at, updated_at
-			`
+					` + bookColumns
 
-			book := &models.BookRecord{}
-			err := r.db.QueryRowContext(ctx, query,
+			book, err := r.updateBookWithAuthors(ctx, query, req.Authors,
 				req.Title,
-				req.AuthorId,
 				req.CategoryId,
-				req.Stock,
+				req.PublicationYear,
+				req.ISBN,
+				req.Publisher,
+				req.Language,
+				req.PageCount,
+				req.Edition,
+				req.Description,
 				req.Id,
 				req.Version, // Optimistic locking check
-			).Scan(
-				&book.Id,
-				&book.Title,
-				&book.AuthorId,
-				&book.CategoryId,
-				&book.Stock,
-				&book.Version,
-				&book.CreatedAt,
-				&book.UpdatedAt,
 			)
 
 			if err == nil {
@@ -293,6 +352,11 @@ func (r *bookRepository) UpdateBook(ctx context.Context, req *models.BookRecord)
 				continue
 			}
 
+			if isUniqueViolation(err, "books_isbn_key") {
+				errCh <- ErrDuplicateISBN
+				return
+			}
+
 			errCh <- err
 			return
 		}
@@ -313,147 +377,91 @@ func (r *bookRepository) UpdateBook(ctx context.Context, req *models.BookRecord)
 	}
 }
 
-func (r *bookRepository) DeleteBook(ctx context.Context, id string, version int) error {
-	log.Printf("Deleting book with ID: %s and version: %d\n", id, version)
-
-	query := `DELETE 
-		 	  FROM 
-			  	books 
-			  WHERE 
-			  	id = $1 AND version = $2`
-	result, err := r.db.ExecContext(ctx, query, id, version)
-
+// updateBookWithAuthors runs the book update and replaces its author credits in one transaction,
+// so the version bump of the book covers the change of authors too
+func (r *bookRepository) updateBookWithAuthors(ctx context.Context, query string, authors []models.BookAuthorRecord, args ...interface{}) (*models.BookRecord, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		log.Printf("Error deleting book with ID %s: %v\n", id, err)
-		return err
+		log.Printf("Error starting UpdateBook transaction: %v\n", err)
+		return nil, err
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		log.Printf("Error fetching rows affected for delete operation: %v\n", err)
-		return err
+	book := &models.BookRecord{}
+	if err := scanBook(tx.QueryRowContext(ctx, query, args...), book); err != nil {
+		return nil, err
 	}
 
-	if rowsAffected == 0 {
-		log.Printf("Optimistic locking failed, no rows deleted for book ID %s with version %d\n", id, version)
-		return errors.New("delete failed due to wrong id or concurrent modification")
+	if err := replaceBookAuthors(ctx, tx, book.Id, authors); err != nil {
+		return nil, err
 	}
+	book.Authors = authors
 
-	log.Printf("Deleted book successfully with ID: %s and version: %d\n", id, version)
-	return nil
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing UpdateBook transaction: %v\n", err)
+		return nil, err
+	}
+	return book, nil
 }
 
-func (r *bookRepository) UpdateBookStock(ctx context.Context, bookId string, newStock int, version int) error {
-	log.Printf("Updating stock for book ID: %s\n", bookId)
-
-	const maxRetries = 3
-	doneCh := make(chan bool, 1)
-	errCh := make(chan error, 1)
-
-	go func() {
-		defer close(doneCh)
-		defer close(errCh)
-
-		for attempt := range maxRetries {
-			query := `
-				UPDATE 
-					books 
-				SET 
-					stock = $1
-				WHERE 
-					id = $2 AND version = $3
-				RETURNING 
-					version
-			`
-
-			var newVersion int
-			err := r.db.QueryRowContext(ctx, query, newStock, bookId, version).Scan(&newVersion)
-
-			if err == nil {
-				log.Printf("Updated stock for book ID %s successfully on attempt %d\n", bookId, attempt+1)
-				doneCh <- true
-				return
-			}
-
-			// Optimistic locking check
-			if errors.Is(err, sql.ErrNoRows) {
-				log.Printf("Optimistic locking failed for book ID %s, retrying... (attempt %d)\n", bookId, attempt+1)
-				time.Sleep(time.Duration(100*(attempt+1)) * time.Millisecond) // Exponential backoff
-
-				// Fetch latest book version
-				updatedBook, fetchErr := r.GetBook(ctx, bookId)
-				if fetchErr != nil {
-					log.Printf("Error fetching latest book record: %v\n", fetchErr)
-					errCh <- fmt.Errorf("error updating stock for book ID %s", bookId)
-					return
-				}
-				version = updatedBook.Version
-				continue
-			}
+// replaceBookAuthors swaps the author credits of a book for the given ones
+func replaceBookAuthors(ctx context.Context, tx *sqlx.Tx, bookId string, authors []models.BookAuthorRecord) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM book_authors WHERE book_id = $1`, bookId); err != nil {
+		log.Printf("Error removing authors of book ID %s: %v\n", bookId, err)
+		return err
+	}
 
-			errCh <- err
-			return
+	query := `
+		INSERT INTO 
+			book_authors (book_id, author_id, role, position)
+		VALUES 
+			($1, $2, $3, $4)`
+	for _, author := range authors {
+		if _, err := tx.ExecContext(ctx, query, bookId, author.AuthorId, author.Role, author.Position); err != nil {
+			log.Printf("Error adding author %s to book ID %s: %v\n", author.AuthorId, bookId, err)
+			return err
 		}
-
-		errCh <- errors.New("update stock failed after max retries")
-	}()
-
-	select {
-	case <-doneCh:
-		log.Printf("Stock updated successfully for book ID: %s\n", bookId)
-		return nil
-	case err := <-errCh:
-		log.Printf("Error updating stock for book ID %s: %v\n", bookId, err)
-		return err
-	case <-ctx.Done():
-		log.Printf("Request timed out while updating stock for book ID: %s\n", bookId)
-		return ctx.Err()
 	}
+	return nil
 }
 
-func (r *bookRepository) IncrementBookStock(ctx context.Context, bookId string, version int) error {
-	log.Printf("Incrementing stock for book ID: %s\n", bookId)
-	updatedBook, err := r.GetBook(ctx, bookId)
-
+func (r *bookRepository) DeleteBook(ctx context.Context, id string, version int) error {
+	log.Printf("Deleting book with ID: %s and version: %d\n", id, version)
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		log.Printf("Error fetching book: %v\n", err)
-		return fmt.Errorf("error fetching book: %v", err)
+		return err
 	}
+	defer tx.Rollback()
 
-	newStock := updatedBook.Stock + 1
-	err = r.UpdateBookStock(ctx, bookId, newStock, version)
-
-	if err != nil {
-		log.Printf("Error updating stock: %v\n", err)
-		return fmt.Errorf("error updating stock: %v", err)
+	// A book cannot go while a copy of it is on loan, the copies stay locked until the book is in the trash
+	if err := checkNoCopiesOnLoan(ctx, tx, `SELECT id FROM books WHERE id = $1`, id); err != nil {
+		return err
 	}
 
-	log.Printf("Stock incremented successfully for book ID: %s\n", bookId)
-	return nil
-}
-
-func (r *bookRepository) DecrementBookStock(ctx context.Context, bookId string, version int) error {
-	log.Printf("Decrementing stock for book ID: %s\n", bookId)
-	updatedBook, err := r.GetBook(ctx, bookId)
+	// The book goes to the trash, PurgeDeletedBooks removes it for good once the retention period is over
+	query := `UPDATE 
+			  	books 
+			  SET 
+			  	deleted_at = CURRENT_TIMESTAMP 
+			  WHERE 
+			  	id = $1 AND version = $2 AND deleted_at IS NULL`
+	rowsAffected, err := execAffected(ctx, tx, query, id, version)
 	if err != nil {
-		log.Printf("Error fetching book: %v\n", err)
-		return fmt.Errorf("error fetching book: %v", err)
+		log.Printf("Error deleting book with ID %s: %v\n", id, err)
+		return err
 	}
 
-	// Ensure stock doesn't go below zero
-	if updatedBook.Stock <= 0 {
-		log.Printf("Stock cannot be negative for book ID %s\n", bookId)
-		return fmt.Errorf("stock cannot be negative for book ID %s", bookId)
+	if rowsAffected == 0 {
+		log.Printf("Optimistic locking failed, no rows deleted for book ID %s with version %d\n", id, version)
+		return errors.New("delete failed due to wrong id or concurrent modification")
 	}
 
-	newStock := updatedBook.Stock - 1
-	err = r.UpdateBookStock(ctx, bookId, newStock, version)
-	if err != nil {
-		log.Printf("Error updating stock: %v\n", err)
-		return fmt.Errorf("error updating stock: %v", err)
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing delete of book ID %s: %v\n", id, err)
+		return err
 	}
 
-	log.Printf("Stock decremented successfully for book ID: %s\n", bookId)
+	log.Printf("Deleted book successfully with ID: %s and version: %d\n", id, version)
 	return nil
 }
 
@@ -463,7 +471,9 @@ func (r *bookRepository) CountBooks(ctx context.Context) (int, error) {
 	query := `SELECT 
 				COUNT(*) 
 			  FROM 
-			  	books`
+			  	books 
+			  WHERE 
+			  	deleted_at IS NULL`
 	var totalItems int
 	err := r.db.QueryRowContext(ctx, query).Scan(&totalItems)
 	if err != nil {
@@ -473,17 +483,17 @@ func (r *bookRepository) CountBooks(ctx context.Context) (int, error) {
 	return totalItems, nil
 }
 
-// CountBooksByCategoryId counts the total number of books in the database by category ID.
-func (r *bookRepository) CountBooksByCategoryId(ctx context.Context, categoryId string) (int, error) {
-	log.Printf("Counting total books by category ID: %s\n", categoryId)
+// CountBooksByCategoryId counts the total number of books in the database in any of the categories.
+func (r *bookRepository) CountBooksByCategoryId(ctx context.Context, categoryIds []string) (int, error) {
+	log.Printf("Counting total books by category IDs: %v\n", categoryIds)
 	query := `SELECT 
 				COUNT(*) 
 			  FROM 
 			  	books 
 			  WHERE 
-			  	category_id = $1`
+			  	category_id = ANY($1) AND deleted_at IS NULL`
 	var totalItems int
-	err := r.db.QueryRowContext(ctx, query, categoryId).Scan(&totalItems)
+	err := r.db.QueryRowContext(ctx, query, pq.Array(categoryIds)).Scan(&totalItems)
 	if err != nil {
 		log.Printf("Error counting books: %v\n", err)
 		return 0, err
@@ -499,7 +509,7 @@ func (r *bookRepository) CountBooksByAuthorId(ctx context.Context, authorid stri
 			  FROM 
 			  	books 
 			  WHERE 
-			  	author_id = $1`
+			  	EXISTS (SELECT 1 FROM book_authors ba WHERE ba.book_id = books.id AND ba.author_id = $1) AND deleted_at IS NULL`
 	var totalItems int
 	err := r.db.QueryRowContext(ctx, query, authorid).Scan(&totalItems)
 	if err != nil {
@@ -508,3 +518,54 @@ func (r *bookRepository) CountBooksByAuthorId(ctx context.Context, authorid stri
 	}
 	return totalItems, nil
 }
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanBook reads a row selected with bookColumns into book, extra receives any columns selected after them
+func scanBook(row rowScanner, book *models.BookRecord, extra ...interface{}) error {
+	var authorIds, authorRoles []string
+	var deletedAt sql.NullTime
+	err := row.Scan(append([]interface{}{
+		&book.Id,
+		&book.Title,
+		pq.Array(&authorIds),
+		pq.Array(&authorRoles),
+		&book.CategoryId,
+		&book.PublicationYear,
+		&book.ISBN,
+		&book.Publisher,
+		&book.Language,
+		&book.PageCount,
+		&book.Edition,
+		&book.Description,
+		&book.Stock,
+		&book.Version,
+		&book.CreatedAt,
+		&book.UpdatedAt,
+		&deletedAt,
+	}, extra...)...)
+	if err != nil {
+		return err
+	}
+	if deletedAt.Valid {
+		book.DeletedAt = &deletedAt.Time
+	}
+
+	book.Authors = make([]models.BookAuthorRecord, 0, len(authorIds))
+	for i, authorId := range authorIds {
+		book.Authors = append(book.Authors, models.BookAuthorRecord{
+			AuthorId: authorId,
+			Role:     authorRoles[i],
+			Position: i + 1,
+		})
+	}
+	return nil
+}
+
+// isUniqueViolation tells whether err is a unique violation of the given constraint
+func isUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == constraint
+}
@@ -0,0 +1,271 @@
+package repository
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrBookCopyNotFound    = errors.New("book copy not found")
+	ErrBookCopyUnavailable = errors.New("no copy of the book is available")
+	ErrBookCopyNotOnLoan   = errors.New("book copy is not on loan")
+)
+
+type BookCopyRepository interface {
+	AddCopy(ctx context.Context, bookCopy *models.BookCopyRecord) (*models.BookCopyRecord, error)
+	GetCopy(ctx context.Context, id string) (*models.BookCopyRecord, error)
+	GetCopyByBarcode(ctx context.Context, barcode string) (*models.BookCopyRecord, error)
+	ListCopies(ctx context.Context, bookId, status string) ([]*models.BookCopyRecord, error)
+	UpdateCopy(ctx context.Context, bookCopy *models.BookCopyRecord) (*models.BookCopyRecord, error)
+	RetireCopy(ctx context.Context, id string, version int) (*models.BookCopyRecord, error)
+	CheckOutCopy(ctx context.Context, bookId string) (*models.BookCopyRecord, error)
+	CheckOutCopyByBarcode(ctx context.Context, barcode string) (*models.BookCopyRecord, error)
+	CheckInCopy(ctx context.Context, id, condition string) (*models.BookCopyRecord, error)
+}
+
+type bookCopyRepository struct {
+	db *sqlx.DB
+}
+
+func NewBookCopyRepository(db *sqlx.DB) BookCopyRepository {
+	return &bookCopyRepository{
+		db: db,
+	}
+}
+
+func (r *bookCopyRepository) AddCopy(ctx context.Context, bookCopy *models.BookCopyRecord) (*models.BookCopyRecord, error) {
+	log.Printf("Adding copy %s to book ID: %s\n", bookCopy.Barcode, bookCopy.BookId)
+	query := `
+		INSERT INTO 
+			book_copies (book_id, barcode, condition, shelf_location)
+		VALUES 
+			($1, $2, $3, $4)
+		RETURNING 
+			id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at
+	`
+
+	addedCopy := &models.BookCopyRecord{}
+	if err := r.db.GetContext(ctx, addedCopy, query, bookCopy.BookId, bookCopy.Barcode, bookCopy.Condition, bookCopy.ShelfLocation); err != nil {
+		log.Printf("Error adding book copy: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Book copy added successfully: %+v\n", addedCopy)
+	return addedCopy, nil
+}
+
+func (r *bookCopyRepository) GetCopy(ctx context.Context, id string) (*models.BookCopyRecord, error) {
+	log.Printf("Fetching book copy with ID: %s\n", id)
+	query := `SELECT 
+				id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at 
+			  FROM 
+			  	book_copies 
+			  WHERE 
+			  	id = $1`
+	return r.getCopy(ctx, query, id)
+}
+
+func (r *bookCopyRepository) GetCopyByBarcode(ctx context.Context, barcode string) (*models.BookCopyRecord, error) {
+	log.Printf("Fetching book copy with barcode: %s\n", barcode)
+	query := `SELECT 
+				id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at 
+			  FROM 
+			  	book_copies 
+			  WHERE 
+			  	barcode = $1`
+	return r.getCopy(ctx, query, barcode)
+}
+
+func (r *bookCopyRepository) ListCopies(ctx context.Context, bookId, status string) ([]*models.BookCopyRecord, error) {
+	log.Printf("Listing copies of book ID: %s with status %q\n", bookId, status)
+	query := `SELECT 
+				id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at 
+			  FROM 
+			  	book_copies 
+			  WHERE 
+			  	book_id = $1 AND ($2 = '' OR status = $2)
+			  ORDER BY 
+			  	barcode`
+
+	var copies []*models.BookCopyRecord
+	if err := r.db.SelectContext(ctx, &copies, query, bookId, status); err != nil {
+		log.Printf("Error listing book copies: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Book copies listed successfully: %d copies found for book ID: %s\n", len(copies), bookId)
+	return copies, nil
+}
+
+func (r *bookCopyRepository) UpdateCopy(ctx context.Context, bookCopy *models.BookCopyRecord) (*models.BookCopyRecord, error) {
+	log.Printf("Updating book copy with ID: %s\n", bookCopy.Id)
+	query := `
+		UPDATE 
+			book_copies 
+		SET 
+			condition = $1, shelf_location = $2
+		WHERE 
+			id = $3 AND version = $4
+		RETURNING 
+			id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at
+	`
+	return r.updateCopy(ctx, query, bookCopy.Condition, bookCopy.ShelfLocation, bookCopy.Id, bookCopy.Version)
+}
+
+// RetireCopy takes a copy out of circulation for good, a copy on loan must be checked in first
+func (r *bookCopyRepository) RetireCopy(ctx context.Context, id string, version int) (*models.BookCopyRecord, error) {
+	log.Printf("Retiring book copy with ID: %s\n", id)
+	query := `
+		UPDATE 
+			book_copies 
+		SET 
+			status = $1
+		WHERE 
+			id = $2 AND version = $3 AND status = $4
+		RETURNING 
+			id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at
+	`
+	return r.updateCopy(ctx, query, constants.BookCopyStatusRetired, id, version, constants.BookCopyStatusAvailable)
+}
+
+// CheckOutCopy marks any available copy of the book as on loan. Copies locked by a concurrent checkout are skipped,
+// so two patrons never get the same copy.
+func (r *bookCopyRepository) CheckOutCopy(ctx context.Context, bookId string) (*models.BookCopyRecord, error) {
+	log.Printf("Checking out a copy of book ID: %s\n", bookId)
+	query := `
+		UPDATE 
+			book_copies 
+		SET 
+			status = $1
+		WHERE 
+			id = (
+				SELECT 
+					id 
+				FROM 
+					book_copies 
+				WHERE 
+					book_id = $2 AND status = $3
+					AND EXISTS (SELECT 1 FROM books WHERE books.id = $2 AND books.deleted_at IS NULL) -- no loans of a deleted book
+				ORDER BY 
+					barcode
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING 
+			id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at
+	`
+
+	bookCopy := &models.BookCopyRecord{}
+	if err := r.db.GetContext(ctx, bookCopy, query, constants.BookCopyStatusOnLoan, bookId, constants.BookCopyStatusAvailable); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("No available copy for book ID: %s\n", bookId)
+			return nil, ErrBookCopyUnavailable
+		}
+		log.Printf("Error checking out a copy of book ID %s: %v\n", bookId, err)
+		return nil, err
+	}
+
+	log.Printf("Book copy %s checked out successfully\n", bookCopy.Barcode)
+	return bookCopy, nil
+}
+
+// CheckOutCopyByBarcode marks the scanned copy as on loan, it fails when the copy is not available
+// or its book is in the trash
+func (r *bookCopyRepository) CheckOutCopyByBarcode(ctx context.Context, barcode string) (*models.BookCopyRecord, error) {
+	log.Printf("Checking out book copy with barcode: %s\n", barcode)
+	query := `
+		UPDATE 
+			book_copies 
+		SET 
+			status = $1
+		WHERE 
+			barcode = $2 AND status = $3
+			AND EXISTS (SELECT 1 FROM books WHERE books.id = book_copies.book_id AND books.deleted_at IS NULL) -- no loans of a deleted book
+		RETURNING 
+			id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at
+	`
+
+	bookCopy := &models.BookCopyRecord{}
+	if err := r.db.GetContext(ctx, bookCopy, query, constants.BookCopyStatusOnLoan, barcode, constants.BookCopyStatusAvailable); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, getErr := r.GetCopyByBarcode(ctx, barcode); getErr != nil {
+				return nil, getErr
+			}
+			log.Printf("Book copy %s is not available\n", barcode)
+			return nil, ErrBookCopyUnavailable
+		}
+		log.Printf("Error checking out book copy %s: %v\n", barcode, err)
+		return nil, err
+	}
+
+	log.Printf("Book copy %s checked out successfully\n", bookCopy.Barcode)
+	return bookCopy, nil
+}
+
+// CheckInCopy puts a copy on loan back into circulation, an empty condition keeps the current one
+func (r *bookCopyRepository) CheckInCopy(ctx context.Context, id, condition string) (*models.BookCopyRecord, error) {
+	log.Printf("Checking in book copy with ID: %s\n", id)
+	query := `
+		UPDATE 
+			book_copies 
+		SET 
+			status = $1, condition = COALESCE(NULLIF($2, ''), condition)
+		WHERE 
+			id = $3 AND status = $4
+		RETURNING 
+			id, book_id, barcode, condition, shelf_location, status, version, created_at, updated_at
+	`
+
+	bookCopy := &models.BookCopyRecord{}
+	if err := r.db.GetContext(ctx, bookCopy, query, constants.BookCopyStatusAvailable, condition, id, constants.BookCopyStatusOnLoan); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if _, getErr := r.GetCopy(ctx, id); getErr != nil {
+				return nil, getErr
+			}
+			log.Printf("Book copy %s is not on loan\n", id)
+			return nil, ErrBookCopyNotOnLoan
+		}
+		log.Printf("Error checking in book copy %s: %v\n", id, err)
+		return nil, err
+	}
+
+	log.Printf("Book copy %s checked in successfully\n", bookCopy.Barcode)
+	return bookCopy, nil
+}
+
+func (r *bookCopyRepository) getCopy(ctx context.Context, query string, args ...interface{}) (*models.BookCopyRecord, error) {
+	bookCopy := &models.BookCopyRecord{}
+	if err := r.db.GetContext(ctx, bookCopy, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Book copy not found: %v\n", args)
+			return nil, ErrBookCopyNotFound
+		}
+		log.Printf("Error fetching book copy: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Book copy fetched successfully: %+v\n", bookCopy)
+	return bookCopy, nil
+}
+
+// updateCopy runs a guarded copy update, no row means a wrong id, a concurrent modification or a disallowed status
+func (r *bookCopyRepository) updateCopy(ctx context.Context, query string, args ...interface{}) (*models.BookCopyRecord, error) {
+	bookCopy := &models.BookCopyRecord{}
+	if err := r.db.GetContext(ctx, bookCopy, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Optimistic locking failed, no book copy updated: %v\n", args)
+			return nil, errors.New("update failed due to wrong id, concurrent modification or copy status")
+		}
+		log.Printf("Error updating book copy: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Book copy updated successfully: %+v\n", bookCopy)
+	return bookCopy, nil
+}
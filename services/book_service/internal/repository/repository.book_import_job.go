@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrBookImportJobNotFound  = errors.New("import job not found")
+	ErrNoPendingBookImportJob = errors.New("no pending import job")
+)
+
+// bookImportJobColumns lists the columns read by scanBookImportJob, the payload is left out on purpose
+const bookImportJobColumns = `id, format, file_name, status, total_rows, imported_rows, failed_rows, row_errors, error,
+	COALESCE(requested_by::TEXT, '') AS requested_by, created_at, started_at, finished_at`
+
+type BookImportJobRepository interface {
+	CreateImportJob(ctx context.Context, req *models.BookImportRequest) (*models.BookImportJobRecord, error)
+	GetImportJob(ctx context.Context, id string) (*models.BookImportJobRecord, error)
+	ClaimPendingImportJob(ctx context.Context) (*models.BookImportJobRecord, error)
+	SaveImportProgress(ctx context.Context, job *models.BookImportJobRecord) error
+	FinishImportJob(ctx context.Context, job *models.BookImportJobRecord) error
+	FailStaleImportJobs(ctx context.Context, staleBefore time.Time, reason string) (int, error)
+}
+
+type bookImportJobRepository struct {
+	db *sqlx.DB
+}
+
+func NewBookImportJobRepository(db *sqlx.DB) BookImportJobRepository {
+	return &bookImportJobRepository{
+		db: db,
+	}
+}
+
+func (r *bookImportJobRepository) CreateImportJob(ctx context.Context, req *models.BookImportRequest) (*models.BookImportJobRecord, error) {
+	log.Printf("Creating %s import job for file %s (%d bytes)\n", req.Format, req.FileName, len(req.Payload))
+	query := `
+		INSERT INTO
+			book_import_jobs (format, file_name, payload, requested_by)
+		VALUES
+			($1, $2, $3, NULLIF($4, '')::UUID)
+		RETURNING
+			` + bookImportJobColumns
+
+	job := &models.BookImportJobRecord{}
+	if err := scanBookImportJob(r.db.QueryRowContext(ctx, query, req.Format, req.FileName, req.Payload, req.RequestedBy), job); err != nil {
+		log.Printf("Error creating import job: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Import job created successfully with ID: %s\n", job.Id)
+	return job, nil
+}
+
+func (r *bookImportJobRepository) GetImportJob(ctx context.Context, id string) (*models.BookImportJobRecord, error) {
+	log.Printf("Fetching import job with ID: %s\n", id)
+	query := `SELECT
+				` + bookImportJobColumns + `
+			  FROM
+			  	book_import_jobs
+			  WHERE
+			  	id = $1`
+
+	job := &models.BookImportJobRecord{}
+	if err := scanBookImportJob(r.db.QueryRowContext(ctx, query, id), job); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Import job not found with ID: %s\n", id)
+			return nil, ErrBookImportJobNotFound
+		}
+		log.Printf("Error fetching import job: %v\n", err)
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// ClaimPendingImportJob marks the oldest pending job as running and returns it with its payload.
+// Jobs locked by another instance are skipped, so each job runs once.
+func (r *bookImportJobRepository) ClaimPendingImportJob(ctx context.Context) (*models.BookImportJobRecord, error) {
+	query := `
+		UPDATE
+			book_import_jobs
+		SET
+			status = $1, started_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id = (
+				SELECT
+					id
+				FROM
+					book_import_jobs
+				WHERE
+					status = $2
+				ORDER BY
+					created_at
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			` + bookImportJobColumns + `, payload`
+
+	job := &models.BookImportJobRecord{}
+	err := scanBookImportJob(r.db.QueryRowContext(ctx, query, constants.BookImportStatusRunning, constants.BookImportStatusPending), job, &job.Payload)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoPendingBookImportJob
+		}
+		log.Printf("Error claiming pending import job: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Import job %s claimed\n", job.Id)
+	return job, nil
+}
+
+// SaveImportProgress stores the row counts and errors so far, which also tells the job is still alive
+func (r *bookImportJobRepository) SaveImportProgress(ctx context.Context, job *models.BookImportJobRecord) error {
+	rowErrors, err := json.Marshal(job.RowErrors)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE
+			book_import_jobs
+		SET
+			total_rows = $2, imported_rows = $3, failed_rows = $4, row_errors = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id = $1`
+	if _, err := r.db.ExecContext(ctx, query, job.Id, job.TotalRows, job.ImportedRows, job.FailedRows, rowErrors); err != nil {
+		log.Printf("Error saving progress of import job %s: %v\n", job.Id, err)
+		return err
+	}
+	return nil
+}
+
+// FinishImportJob stores the final status and counts of the job and drops its payload
+func (r *bookImportJobRepository) FinishImportJob(ctx context.Context, job *models.BookImportJobRecord) error {
+	rowErrors, err := json.Marshal(job.RowErrors)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE
+			book_import_jobs
+		SET
+			status = $2, error = $3, total_rows = $4, imported_rows = $5, failed_rows = $6, row_errors = $7,
+			payload = NULL, finished_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id = $1`
+	if _, err := r.db.ExecContext(ctx, query, job.Id, job.Status, job.Error, job.TotalRows, job.ImportedRows, job.FailedRows, rowErrors); err != nil {
+		log.Printf("Error finishing import job %s: %v\n", job.Id, err)
+		return err
+	}
+
+	log.Printf("Import job %s finished with status %s\n", job.Id, job.Status)
+	return nil
+}
+
+// FailStaleImportJobs fails the running jobs without progress since staleBefore, their instance stopped before finishing them
+func (r *bookImportJobRepository) FailStaleImportJobs(ctx context.Context, staleBefore time.Time, reason string) (int, error) {
+	query := `
+		UPDATE
+			book_import_jobs
+		SET
+			status = $1, error = $2, payload = NULL, finished_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE
+			status = $3 AND updated_at < $4`
+	result, err := r.db.ExecContext(ctx, query, constants.BookImportStatusFailed, reason, constants.BookImportStatusRunning, staleBefore)
+	if err != nil {
+		log.Printf("Error failing stale import jobs: %v\n", err)
+		return 0, err
+	}
+
+	failed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if failed > 0 {
+		log.Printf("Failed %d stale import jobs\n", failed)
+	}
+	return int(failed), nil
+}
+
+// scanBookImportJob reads a row selected with bookImportJobColumns into job, extra receives any columns selected after them
+func scanBookImportJob(row rowScanner, job *models.BookImportJobRecord, extra ...interface{}) error {
+	var rowErrors []byte
+	var startedAt, finishedAt sql.NullTime
+	err := row.Scan(append([]interface{}{
+		&job.Id,
+		&job.Format,
+		&job.FileName,
+		&job.Status,
+		&job.TotalRows,
+		&job.ImportedRows,
+		&job.FailedRows,
+		&rowErrors,
+		&job.Error,
+		&job.RequestedBy,
+		&job.CreatedAt,
+		&startedAt,
+		&finishedAt,
+	}, extra...)...)
+	if err != nil {
+		return err
+	}
+
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+	return json.Unmarshal(rowErrors, &job.RowErrors)
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"book_service/internal/constants"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrBooksOnLoan = errors.New("a copy of the books is on loan")
+
+// soleAuthorBooksQuery selects the live books credited to the author $1 and nobody else
+const soleAuthorBooksQuery = `
+	SELECT
+		ba.book_id
+	FROM
+		book_authors ba
+		JOIN books b ON b.id = ba.book_id
+	WHERE
+		ba.author_id = $1
+		AND b.deleted_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM book_authors o WHERE o.book_id = ba.book_id AND o.author_id <> $1)`
+
+// ReassignBooksByAuthorId moves the credits of an author to another author and returns the number of books changed.
+// A credit the new author already has on the book in the same role is dropped instead of duplicated.
+func (r *bookRepository) ReassignBooksByAuthorId(ctx context.Context, authorId string, newAuthorId string) (int, error) {
+	log.Printf("Reassigning books of author ID %s to author ID %s\n", authorId, newAuthorId)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	// Touching the books locks them and bumps their version, the credits are part of the book
+	affected, err := execAffected(ctx, tx, `
+		UPDATE
+			books
+		SET
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id IN (SELECT book_id FROM book_authors WHERE author_id = $1)`, authorId)
+	if err != nil {
+		log.Printf("Error locking books of author ID %s: %v\n", authorId, err)
+		return 0, err
+	}
+
+	query := `
+		DELETE FROM
+			book_authors ba
+		WHERE
+			ba.author_id = $1
+			AND EXISTS (SELECT 1 FROM book_authors o WHERE o.book_id = ba.book_id AND o.author_id = $2 AND o.role = ba.role)`
+	if _, err := tx.ExecContext(ctx, query, authorId, newAuthorId); err != nil {
+		log.Printf("Error dropping duplicate credits of author ID %s: %v\n", authorId, err)
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE book_authors SET author_id = $2 WHERE author_id = $1`, authorId, newAuthorId); err != nil {
+		log.Printf("Error reassigning credits of author ID %s: %v\n", authorId, err)
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	log.Printf("Reassigned %d books of author ID %s to author ID %s\n", affected, authorId, newAuthorId)
+	return affected, nil
+}
+
+// ReassignBooksByCategoryId moves the books of a category to another category and returns the number of books moved
+func (r *bookRepository) ReassignBooksByCategoryId(ctx context.Context, categoryId string, newCategoryId string) (int, error) {
+	log.Printf("Reassigning books of category ID %s to category ID %s\n", categoryId, newCategoryId)
+	affected, err := execAffected(ctx, r.db, `UPDATE books SET category_id = $2 WHERE category_id = $1`, categoryId, newCategoryId)
+	if err != nil {
+		log.Printf("Error reassigning books of category ID %s: %v\n", categoryId, err)
+		return 0, err
+	}
+
+	log.Printf("Reassigned %d books of category ID %s to category ID %s\n", affected, categoryId, newCategoryId)
+	return affected, nil
+}
+
+// DeleteBooksByAuthorId moves the books credited to the author alone to the trash and removes the author
+// from the other live books, it returns the number of books deleted or changed. Books already in the
+// trash keep their credits so they can be restored. Nothing is deleted while a copy of a book to delete is on loan.
+func (r *bookRepository) DeleteBooksByAuthorId(ctx context.Context, authorId string) (int, error) {
+	log.Printf("Deleting books of author ID: %s\n", authorId)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if err := checkNoCopiesOnLoan(ctx, tx, soleAuthorBooksQuery, authorId); err != nil {
+		return 0, err
+	}
+
+	deleted, err := execAffected(ctx, tx, `UPDATE books SET deleted_at = CURRENT_TIMESTAMP WHERE id IN (`+soleAuthorBooksQuery+`)`, authorId)
+	if err != nil {
+		log.Printf("Error deleting books of author ID %s: %v\n", authorId, err)
+		return 0, err
+	}
+
+	updated, err := execAffected(ctx, tx, `
+		UPDATE
+			books
+		SET
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			id IN (SELECT book_id FROM book_authors WHERE author_id = $1) AND deleted_at IS NULL`, authorId)
+	if err != nil {
+		log.Printf("Error locking co-authored books of author ID %s: %v\n", authorId, err)
+		return 0, err
+	}
+
+	query := `
+		DELETE FROM
+			book_authors ba
+		USING
+			books b
+		WHERE
+			b.id = ba.book_id AND ba.author_id = $1 AND b.deleted_at IS NULL`
+	if _, err := tx.ExecContext(ctx, query, authorId); err != nil {
+		log.Printf("Error removing credits of author ID %s: %v\n", authorId, err)
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	log.Printf("Deleted %d books and uncredited %d books of author ID %s\n", deleted, updated, authorId)
+	return deleted + updated, nil
+}
+
+// DeleteBooksByCategoryId moves the live books of a category to the trash and returns the number of books deleted.
+// Nothing is deleted while a copy of one of the books is on loan.
+func (r *bookRepository) DeleteBooksByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	log.Printf("Deleting books of category ID: %s\n", categoryId)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if err := checkNoCopiesOnLoan(ctx, tx, `SELECT id FROM books WHERE category_id = $1 AND deleted_at IS NULL`, categoryId); err != nil {
+		return 0, err
+	}
+
+	deleted, err := execAffected(ctx, tx, `UPDATE books SET deleted_at = CURRENT_TIMESTAMP WHERE category_id = $1 AND deleted_at IS NULL`, categoryId)
+	if err != nil {
+		log.Printf("Error deleting books of category ID %s: %v\n", categoryId, err)
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	log.Printf("Deleted %d books of category ID %s\n", deleted, categoryId)
+	return deleted, nil
+}
+
+// checkNoCopiesOnLoan returns ErrBooksOnLoan when a copy of the books selected by booksQuery is on loan, booksQuery
+// takes id as $1. The copies stay locked until the transaction ends so none is checked out in the meantime.
+func checkNoCopiesOnLoan(ctx context.Context, tx *sqlx.Tx, booksQuery string, id string) error {
+	query := `
+		SELECT
+			status
+		FROM
+			book_copies
+		WHERE
+			book_id IN (` + booksQuery + `)
+		FOR UPDATE`
+
+	var statuses []string
+	if err := tx.SelectContext(ctx, &statuses, query, id); err != nil {
+		log.Printf("Error locking copies of the books of ID %s: %v\n", id, err)
+		return err
+	}
+	for _, status := range statuses {
+		if status == constants.BookCopyStatusOnLoan {
+			log.Printf("A copy of the books of ID %s is on loan\n", id)
+			return ErrBooksOnLoan
+		}
+	}
+	return nil
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// execAffected runs the statement and returns the number of rows it affected
+func execAffected(ctx context.Context, db execer, query string, args ...interface{}) (int, error) {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// searchHeadlineOptions makes ts_headline return the whole title with every match marked
+var searchHeadlineOptions = fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", constants.SearchHighlightStart, constants.SearchHighlightStop)
+
+// SearchBooks matches the query against the title full-text index and falls back to trigram word similarity,
+// so a misspelled word still finds the book. It returns the requested page of hits and the total number of matches.
+func (r *bookRepository) SearchBooks(ctx context.Context, filter *models.BookSearchFilter) ([]*models.BookSearchHit, int, error) {
+	log.Printf("Searching books with filter: %+v\n", filter)
+
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	highlight, rank := "title", "0"
+
+	if filter.Query != "" {
+		args = append(args, filter.Query)
+		tsQuery := "websearch_to_tsquery('simple', $1)"
+		conditions = append(conditions, fmt.Sprintf("(search_vector @@ %s OR $1 <%% title)", tsQuery))
+		highlight = fmt.Sprintf("ts_headline('simple', title, %s, '%s')", tsQuery, searchHeadlineOptions)
+		rank = fmt.Sprintf("ts_rank(search_vector, %s) + word_similarity($1, title)", tsQuery)
+	}
+	if filter.AuthorId != "" {
+		args = append(args, filter.AuthorId)
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM book_authors ba WHERE ba.book_id = books.id AND ba.author_id = $%d)", len(args)))
+	}
+	if filter.CategoryId != "" {
+		args = append(args, filter.CategoryId)
+		conditions = append(conditions, fmt.Sprintf("category_id = $%d", len(args)))
+	}
+	if filter.AvailableOnly {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM book_copies c WHERE c.book_id = books.id AND c.status = 'AVAILABLE')")
+	}
+	if filter.YearFrom > 0 {
+		args = append(args, filter.YearFrom)
+		conditions = append(conditions, fmt.Sprintf("publication_year >= $%d", len(args)))
+	}
+	if filter.YearTo > 0 {
+		args = append(args, filter.YearTo)
+		conditions = append(conditions, fmt.Sprintf("publication_year <= $%d", len(args)))
+	}
+
+	where := " WHERE " + strings.Join(conditions, " AND ")
+
+	var totalItems int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM books`+where, args...).Scan(&totalItems); err != nil {
+		log.Printf("Error counting search results: %v\n", err)
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT 
+			`+bookColumns+`,
+			%s AS highlight,
+			%s AS rank
+		FROM 
+			books
+	`, highlight, rank) + where + `
+		ORDER BY 
+			` + resolveBookSearchSort(filter) + `
+	`
+
+	args = append(args, filter.PageSize, (filter.Page-1)*filter.PageSize)
+	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Printf("Error searching books: %v\n", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var hits []*models.BookSearchHit
+	for rows.Next() {
+		hit := &models.BookSearchHit{}
+		if err := scanBook(rows, &hit.BookRecord, &hit.Highlight, &hit.Rank); err != nil {
+			log.Printf("Error scanning search hit row: %v\n", err)
+			return nil, 0, err
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		log.Printf("Error iterating search hit rows: %v\n", err)
+		return nil, 0, err
+	}
+
+	log.Printf("Books searched successfully: %d of %d matches returned\n", len(hits), totalItems)
+	return hits, totalItems, nil
+}
+
+// resolveBookSearchSort maps the requested sort onto a whitelisted ORDER BY clause.
+// Relevance is the default and falls back to the title when there is no query to rank against.
+func resolveBookSearchSort(filter *models.BookSearchFilter) string {
+	column, order := "rank", constants.SortOrderDesc
+	switch filter.SortBy {
+	case constants.BookSortByTitle:
+		column, order = "title", constants.SortOrderAsc
+	case constants.BookSortByPublicationYear:
+		column = "books.publication_year"
+	case constants.BookSortByCreatedAt:
+		column = "created_at"
+	default:
+		if filter.Query == "" {
+			column, order = "title", constants.SortOrderAsc
+		}
+	}
+
+	if filter.SortOrder == constants.SortOrderAsc || filter.SortOrder == constants.SortOrderDesc {
+		order = filter.SortOrder
+	}
+
+	return fmt.Sprintf("%s %s NULLS LAST, id %s", column, order, order)
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// ListDeletedBooks lists the books in the trash, the most recently deleted first
+func (r *bookRepository) ListDeletedBooks(ctx context.Context, page int, pageSize int) ([]*models.BookRecord, error) {
+	log.Printf("Listing deleted books with pagination (Page: %d, PageSize: %d)", page, pageSize)
+
+	offset := (page - 1) * pageSize
+	query := `SELECT 
+				` + bookColumns + `
+			  FROM 
+			  	books 
+			  WHERE 
+			  	deleted_at IS NOT NULL 
+			  ORDER BY 
+			  	deleted_at DESC, id 
+			  LIMIT 
+			  	$1 OFFSET $2`
+	rows, err := r.db.QueryContext(ctx, query, pageSize, offset)
+	if err != nil {
+		log.Printf("Error listing deleted books: %v\n", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var books []*models.BookRecord
+	for rows.Next() {
+		book := &models.BookRecord{}
+		if err := scanBook(rows, book); err != nil {
+			log.Printf("Error scanning book row: %v\n", err)
+			return nil, err
+		}
+		books = append(books, book)
+	}
+
+	log.Printf("Deleted books listed successfully: %d books found\n", len(books))
+	return books, nil
+}
+
+// CountDeletedBooks counts the books in the trash
+func (r *bookRepository) CountDeletedBooks(ctx context.Context) (int, error) {
+	log.Printf("Counting deleted books")
+	query := `SELECT 
+				COUNT(*) 
+			  FROM 
+			  	books 
+			  WHERE 
+			  	deleted_at IS NOT NULL`
+	var totalItems int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&totalItems); err != nil {
+		log.Printf("Error counting deleted books: %v\n", err)
+		return 0, err
+	}
+	return totalItems, nil
+}
+
+// GetDeletedBook fetches a book in the trash, a live book is not found
+func (r *bookRepository) GetDeletedBook(ctx context.Context, id string) (*models.BookRecord, error) {
+	log.Printf("Fetching deleted book with ID: %s\n", id)
+	query := `SELECT 
+				` + bookColumns + `
+			  FROM 
+			  	books 
+			  WHERE 
+			  	id = $1 AND deleted_at IS NOT NULL`
+	book := &models.BookRecord{}
+	if err := scanBook(r.db.QueryRowContext(ctx, query, id), book); err != nil {
+		if err == sql.ErrNoRows {
+			log.Printf("Deleted book not found with ID: %s\n", id)
+			return nil, ErrBookNotFound
+		}
+		log.Printf("Error fetching deleted book: %v\n", err)
+		return nil, err
+	}
+	return book, nil
+}
+
+// RestoreBook takes a book out of the trash. The ISBN may have been given to another book in the meantime,
+// the restore then fails with ErrDuplicateISBN.
+func (r *bookRepository) RestoreBook(ctx context.Context, id string) (*models.BookRecord, error) {
+	log.Printf("Restoring book with ID: %s\n", id)
+	query := `UPDATE 
+			  	books 
+			  SET 
+			  	deleted_at = NULL 
+			  WHERE 
+			  	id = $1 AND deleted_at IS NOT NULL 
+			  RETURNING 
+			  	` + bookColumns
+	book := &models.BookRecord{}
+	if err := scanBook(r.db.QueryRowContext(ctx, query, id), book); err != nil {
+		if err == sql.ErrNoRows {
+			log.Printf("Deleted book not found with ID: %s\n", id)
+			return nil, ErrBookNotFound
+		}
+		if isUniqueViolation(err, "books_isbn_key") {
+			return nil, ErrDuplicateISBN
+		}
+		log.Printf("Error restoring book with ID %s: %v\n", id, err)
+		return nil, err
+	}
+
+	log.Printf("Restored book successfully with ID: %s\n", id)
+	return book, nil
+}
+
+// PurgeDeletedBooks removes for good the books deleted before the given time, their copies and credits go with them.
+// A book with a copy still on loan is kept until the copy is returned.
+func (r *bookRepository) PurgeDeletedBooks(ctx context.Context, deletedBefore time.Time) (int, error) {
+	log.Printf("Purging books deleted before %s\n", deletedBefore.Format(time.RFC3339))
+	query := `DELETE 
+			  FROM 
+			  	books 
+			  WHERE 
+			  	deleted_at < $1 
+			  	AND NOT EXISTS (SELECT 1 FROM book_copies c WHERE c.book_id = books.id AND c.status = $2)`
+	purged, err := execAffected(ctx, r.db, query, deletedBefore, constants.BookCopyStatusOnLoan)
+	if err != nil {
+		log.Printf("Error purging deleted books: %v\n", err)
+		return 0, err
+	}
+
+	log.Printf("Purged %d deleted books\n", purged)
+	return purged, nil
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/service"
+	"book_service/pkg/logger"
+	"book_service/pkg/utils"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+type BookImportWorker struct {
+	catalogueService service.BookCatalogueService
+	logger           *logger.Logger
+	interval         time.Duration
+}
+
+func NewBookImportWorker(catalogueService service.BookCatalogueService, logger *logger.Logger, interval time.Duration) *BookImportWorker {
+	return &BookImportWorker{
+		catalogueService: catalogueService,
+		logger:           logger,
+		interval:         interval,
+	}
+}
+
+// Start runs the pending import jobs once immediately and then on every interval until ctx is cancelled
+func (w *BookImportWorker) Start(ctx context.Context) {
+	log.Printf("[%s] Book import worker started with interval %s\n", utils.GetLocation(), w.interval)
+	runEvery(ctx, w.interval, w.run)
+	log.Printf("[%s] Book import worker stopped\n", utils.GetLocation())
+}
+
+func (w *BookImportWorker) run(ctx context.Context) {
+	requestID := fmt.Sprintf("book-import-%d", time.Now().Unix())
+	ctx = context.WithValue(ctx, constants.ContextRequestIDKey, requestID)
+
+	failed, err := w.catalogueService.FailStaleImports(ctx)
+	if err != nil {
+		w.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to fail stale import jobs", nil, err)
+	} else if failed > 0 {
+		w.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelWarn, "Failed stale import jobs", map[string]interface{}{"failed": failed}, nil)
+	}
+
+	processed, err := w.catalogueService.RunPendingImports(ctx)
+	if err != nil {
+		w.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to run import jobs", map[string]interface{}{"processed": processed}, err)
+	} else if processed > 0 {
+		w.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Import jobs processed", map[string]interface{}{"processed": processed}, nil)
+	}
+}
@@ -2,27 +2,43 @@ package service
 
 import (
 	"book_service/internal/clients"
+	"book_service/internal/constants"
 	"book_service/internal/models"
 	"book_service/internal/repository"
 	"book_service/pkg/utils"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 )
 
+var ErrDuplicateBookAuthor = errors.New("an author is credited more than once with the same role")
+
 type BookService interface {
 	CreateBook(ctx context.Context, req *models.BookRequest) (*models.BookRecord, error)
 	GetBook(ctx context.Context, id string) (*models.BookRecord, error)
+	GetBookByISBN(ctx context.Context, isbn string) (*models.BookRecord, error)
 	GetBookByAuthorId(ctx context.Context, authorId string, page int, pageSize int) (books []*models.BookRecord, totalItems int, err error)
-	GetBookByCategoryId(ctx context.Context, categoryId string, page int, pageSize int) (books []*models.BookRecord, totalItems int, err error)
+	GetBookByCategoryId(ctx context.Context, categoryId string, includeDescendants bool, page int, pageSize int) (books []*models.BookRecord, totalItems int, err error)
 	ListBooks(ctx context.Context, page int, pageSize int) (books []*models.BookRecord, totalItems int, err error)
+	SearchBooks(ctx context.Context, filter *models.BookSearchFilter) (hits []*models.BookSearchHit, totalItems int, err error)
 	UpdateBook(ctx context.Context, id string, req *models.BookRequest) (*models.BookRecord, error)
 	DeleteBook(ctx context.Context, id string, version int) error
-	UpdateBookStock(ctx context.Context, id string, newStock, versiont int) error
-	IncrementBookStock(ctx context.Context, id string, version int) error
-	DecrementBookStock(ctx context.Context, id string, version int) error
 
-	ValidateAuthorExistence(ctx context.Context, authorId string) error
+	CountBooksByAuthorId(ctx context.Context, authorId string) (int, error)
+	CountBooksByCategoryId(ctx context.Context, categoryId string) (int, error)
+	ReassignBooksByAuthorId(ctx context.Context, authorId string, newAuthorId string) (int, error)
+	ReassignBooksByCategoryId(ctx context.Context, categoryId string, newCategoryId string) (int, error)
+	DeleteBooksByAuthorId(ctx context.Context, authorId string) (int, error)
+	DeleteBooksByCategoryId(ctx context.Context, categoryId string) (int, error)
+
+	ListDeletedBooks(ctx context.Context, page int, pageSize int) (books []*models.BookRecord, totalItems int, err error)
+	RestoreBook(ctx context.Context, id string) (*models.BookRecord, error)
+	PurgeDeletedBooks(ctx context.Context, deletedBefore time.Time) (int, error)
+
+	ValidateAuthorExistence(ctx context.Context, authorIds []string) error
 	ValidateCategoryExistence(ctx context.Context, categoryId string) error
 }
 
@@ -43,11 +59,29 @@ func NewBookService(repo repository.BookRepository, authorClient clients.AuthorC
 func (s *bookService) CreateBook(ctx context.Context, req *models.BookRequest) (*models.BookRecord, error) {
 	log.Printf("[%s] Creating new book with title: %s\n", utils.GetLocation(), req.Title)
 
+	isbn, err := utils.NormalizeISBN(req.ISBN)
+	if err != nil {
+		log.Printf("[%s] Invalid ISBN %q: %v\n", utils.GetLocation(), req.ISBN, err)
+		return nil, err
+	}
+
+	authors, err := creditBookAuthors(req.Authors)
+	if err != nil {
+		log.Printf("[%s] Invalid authors for book %s: %v\n", utils.GetLocation(), req.Title, err)
+		return nil, err
+	}
+
 	book := &models.BookRecord{
-		Title:      req.Title,
-		AuthorId:   req.AuthorId,
-		CategoryId: req.CategoryId,
-		Stock:      req.Stock,
+		Title:           req.Title,
+		Authors:         authors,
+		CategoryId:      req.CategoryId,
+		PublicationYear: req.PublicationYear,
+		ISBN:            isbn,
+		Publisher:       req.Publisher,
+		Language:        req.Language,
+		PageCount:       req.PageCount,
+		Edition:         req.Edition,
+		Description:     req.Description,
 	}
 
 	createdBook, err := s.repo.CreateBook(ctx, book)
@@ -73,6 +107,26 @@ func (s *bookService) GetBook(ctx context.Context, id string) (*models.BookRecor
 	return book, nil
 }
 
+// GetBookByISBN accepts an ISBN-10 or ISBN-13 with or without hyphens
+func (s *bookService) GetBookByISBN(ctx context.Context, isbn string) (*models.BookRecord, error) {
+	log.Printf("[%s] Fetching book with ISBN: %s\n", utils.GetLocation(), isbn)
+
+	normalized, err := utils.NormalizeISBN(isbn)
+	if err != nil || normalized == "" {
+		log.Printf("[%s] Invalid ISBN %q: %v\n", utils.GetLocation(), isbn, err)
+		return nil, utils.ErrInvalidISBN
+	}
+
+	book, err := s.repo.GetBookByISBN(ctx, normalized)
+	if err != nil {
+		log.Printf("[%s] Failed to get book with ISBN %s: %v\n", utils.GetLocation(), normalized, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Book with ISBN %s fetched successfully\n", utils.GetLocation(), normalized)
+	return book, nil
+}
+
 func (s *bookService) GetBookByAuthorId(ctx context.Context, authorId string, page int, pageSize int) ([]*models.BookRecord, int, error) {
 	log.Printf("[%s] Fetching list of books by author ID: %s with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), authorId, page, pageSize)
 
@@ -92,16 +146,27 @@ func (s *bookService) GetBookByAuthorId(ctx context.Context, authorId string, pa
 	return books, totalItems, nil
 }
 
-func (s *bookService) GetBookByCategoryId(ctx context.Context, categoryId string, page int, pageSize int) ([]*models.BookRecord, int, error) {
-	log.Printf("[%s] Fetching list of books by category ID: %s with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), categoryId, page, pageSize)
+// GetBookByCategoryId lists the books of the category, and of all its subcategories when includeDescendants is set
+func (s *bookService) GetBookByCategoryId(ctx context.Context, categoryId string, includeDescendants bool, page int, pageSize int) ([]*models.BookRecord, int, error) {
+	log.Printf("[%s] Fetching list of books by category ID: %s (include descendants: %t) with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), categoryId, includeDescendants, page, pageSize)
+
+	categoryIds := []string{categoryId}
+	if includeDescendants {
+		descendantIds, err := s.categoryClient.ListSubcategoryIds(ctx, categoryId)
+		if err != nil {
+			log.Printf("[%s] Failed to list subcategories of category ID %s: %v\n", utils.GetLocation(), categoryId, err)
+			return nil, 0, err
+		}
+		categoryIds = append(categoryIds, descendantIds...)
+	}
 
-	books, err := s.repo.GetBookByCategoryId(ctx, categoryId, page, pageSize)
+	books, err := s.repo.GetBookByCategoryId(ctx, categoryIds, page, pageSize)
 	if err != nil {
 		log.Printf("[%s] Failed to fetch books by category ID %s: %v\n", utils.GetLocation(), categoryId, err)
 		return nil, 0, err
 	}
 
-	totalItems, err := s.repo.CountBooksByCategoryId(ctx, categoryId)
+	totalItems, err := s.repo.CountBooksByCategoryId(ctx, categoryIds)
 	if err != nil {
 		log.Printf("[%s] Failed to count books by category ID %s: %v\n", utils.GetLocation(), categoryId, err)
 		return nil, 0, err
@@ -129,16 +194,47 @@ func (s *bookService) ListBooks(ctx context.Context, page int, pageSize int) (bo
 	return books, totalItems, nil
 }
 
+func (s *bookService) SearchBooks(ctx context.Context, filter *models.BookSearchFilter) ([]*models.BookSearchHit, int, error) {
+	log.Printf("[%s] Searching books for %q (Page: %d, PageSize: %d)\n", utils.GetLocation(), filter.Query, filter.Page, filter.PageSize)
+
+	hits, totalItems, err := s.repo.SearchBooks(ctx, filter)
+	if err != nil {
+		log.Printf("[%s] Failed to search books: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	log.Printf("[%s] Found %d books matching %q\n", utils.GetLocation(), totalItems, filter.Query)
+	return hits, totalItems, nil
+}
+
 func (s *bookService) UpdateBook(ctx context.Context, id string, req *models.BookRequest) (*models.BookRecord, error) {
 	log.Printf("[%s] Updating book with ID: %s\n", utils.GetLocation(), id)
 
+	isbn, err := utils.NormalizeISBN(req.ISBN)
+	if err != nil {
+		log.Printf("[%s] Invalid ISBN %q: %v\n", utils.GetLocation(), req.ISBN, err)
+		return nil, err
+	}
+
+	authors, err := creditBookAuthors(req.Authors)
+	if err != nil {
+		log.Printf("[%s] Invalid authors for book ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
 	book := &models.BookRecord{
-		Id:         id,
-		Title:      req.Title,
-		AuthorId:   req.AuthorId,
-		CategoryId: req.CategoryId,
-		Stock:      int(req.Stock),
-		Version:    req.Version,
+		Id:              id,
+		Title:           req.Title,
+		Authors:         authors,
+		CategoryId:      req.CategoryId,
+		PublicationYear: req.PublicationYear,
+		ISBN:            isbn,
+		Publisher:       req.Publisher,
+		Language:        req.Language,
+		PageCount:       req.PageCount,
+		Edition:         req.Edition,
+		Description:     req.Description,
+		Version:         req.Version,
 	}
 
 	updatedBook, err := s.repo.UpdateBook(ctx, book)
@@ -164,56 +260,38 @@ func (s *bookService) DeleteBook(ctx context.Context, id string, version int) er
 	return nil
 }
 
-func (s *bookService) UpdateBookStock(ctx context.Context, id string, newStock, version int) error {
-	log.Printf("[%s] Updating stock for book with ID: %s\n", utils.GetLocation(), id)
-
-	err := s.repo.UpdateBookStock(ctx, id, newStock, version)
-	if err != nil {
-		log.Printf("[%s] Failed to update stock for book with ID %s: %v\n", utils.GetLocation(), id, err)
-		return err
+// ValidateAuthorExistence checks every listed author and reports all the missing ones at once
+func (s *bookService) ValidateAuthorExistence(ctx context.Context, authorIds []string) error {
+	log.Printf("[%s] Validating author existence with IDs: %v\n", utils.GetLocation(), authorIds)
+
+	checked := make(map[string]bool, len(authorIds))
+	var missing []string
+	for _, authorId := range authorIds {
+		if checked[authorId] {
+			continue
+		}
+		checked[authorId] = true
+
+		author, _ := s.authorClient.GetAuthor(ctx, authorId)
+		if author == nil {
+			log.Printf("[%s] Author with ID %s not found\n", utils.GetLocation(), authorId)
+			missing = append(missing, fmt.Sprintf("'%s'", authorId))
+		} else if author.Id != authorId {
+			// A merged author resolves to its survivor, the book must be credited to the survivor instead
+			log.Printf("[%s] Author with ID %s was merged into ID %s\n", utils.GetLocation(), authorId, author.Id)
+			missing = append(missing, fmt.Sprintf("'%s' (merged into '%s')", authorId, author.Id))
+		}
 	}
 
-	log.Printf("[%s] Stock for book with ID %s updated to %d successfully\n", utils.GetLocation(), id, newStock)
-	return nil
-}
-
-func (s *bookService) IncrementBookStock(ctx context.Context, id string, versiont int) error {
-	log.Printf("[%s] Incrementing stock for book with ID: %s\n", utils.GetLocation(), id)
-
-	err := s.repo.IncrementBookStock(ctx, id, versiont)
-	if err != nil {
-		log.Printf("[%s] Failed to increment stock for book with ID %s: %v\n", utils.GetLocation(), id, err)
-		return err
+	switch len(missing) {
+	case 0:
+		log.Printf("[%s] Authors with IDs %v exist\n", utils.GetLocation(), authorIds)
+		return nil
+	case 1:
+		return fmt.Errorf("author with ID %s not found", missing[0])
+	default:
+		return fmt.Errorf("authors with IDs %s not found", strings.Join(missing, ", "))
 	}
-
-	log.Printf("[%s] Stock for book with ID %s incremented successfully\n", utils.GetLocation(), id)
-	return nil
-}
-
-func (s *bookService) DecrementBookStock(ctx context.Context, id string, version int) error {
-	log.Printf("[%s] Decrementing stock for book with ID: %s\n", utils.GetLocation(), id)
-
-	err := s.repo.DecrementBookStock(ctx, id, version)
-	if err != nil {
-		log.Printf("[%s] Failed to decrement stock for book with ID %s: %v\n", utils.GetLocation(), id, err)
-		return err
-	}
-
-	log.Printf("[%s] Stock for book with ID %s decremented successfully\n", utils.GetLocation(), id)
-	return nil
-}
-
-func (s *bookService) ValidateAuthorExistence(ctx context.Context, authorId string) error {
-	log.Printf("[%s] Validating author existence with ID: %s\n", utils.GetLocation(), authorId)
-
-	author, _ := s.authorClient.GetAuthor(ctx, authorId)
-	if author == nil {
-		log.Printf("[%s] Author with ID %s not found\n", utils.GetLocation(), authorId)
-		return fmt.Errorf("author with ID '%s' not found", authorId)
-	}
-
-	log.Printf("[%s] Author with ID %s exists\n", utils.GetLocation(), authorId)
-	return nil
 }
 
 func (s *bookService) ValidateCategoryExistence(ctx context.Context, categoryId string) error {
@@ -224,7 +302,32 @@ func (s *bookService) ValidateCategoryExistence(ctx context.Context, categoryId
 		log.Printf("[%s] Category with ID %s not found\n", utils.GetLocation(), categoryId)
 		return fmt.Errorf("category with ID '%s' not found", categoryId)
 	}
+	if category.Id != categoryId {
+		log.Printf("[%s] Category with ID %s was merged into ID %s\n", utils.GetLocation(), categoryId, category.Id)
+		return fmt.Errorf("category with ID '%s' not found (merged into '%s')", categoryId, category.Id)
+	}
 
 	log.Printf("[%s] Category with ID %s exists\n", utils.GetLocation(), categoryId)
 	return nil
 }
+
+// creditBookAuthors numbers the authors in the given order and defaults their role to author
+func creditBookAuthors(authors []models.BookAuthorRecord) ([]models.BookAuthorRecord, error) {
+	credited := make([]models.BookAuthorRecord, 0, len(authors))
+	seen := make(map[models.BookAuthorRecord]bool, len(authors))
+	for i, author := range authors {
+		if author.Role == "" {
+			author.Role = constants.BookAuthorRoleAuthor
+		}
+
+		key := models.BookAuthorRecord{AuthorId: author.AuthorId, Role: author.Role}
+		if seen[key] {
+			return nil, ErrDuplicateBookAuthor
+		}
+		seen[key] = true
+
+		author.Position = i + 1
+		credited = append(credited, author)
+	}
+	return credited, nil
+}
@@ -0,0 +1,435 @@
+package service
+
+import (
+	"book_service/internal/clients"
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"book_service/internal/repository"
+	"book_service/pkg/utils"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrUnsupportedCatalogueFormat = errors.New("unsupported catalogue format")
+	ErrEmptyImportFile            = errors.New("import file is empty")
+	ErrImportFileTooLarge         = fmt.Errorf("import file exceeds %d bytes", constants.MaxBookImportFileSize)
+	ErrInvalidImportFile          = errors.New("invalid import file")
+)
+
+var catalogueLanguagePattern = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+// catalogueReader reads the entries of an import file, an error other than io.EOF only concerns the current entry
+type catalogueReader interface {
+	Next() (*models.CatalogueEntry, error)
+}
+
+// catalogueWriter writes the entries of an export, Flush must be called once all of them are written
+type catalogueWriter interface {
+	Write(entry *models.CatalogueEntry) error
+	Flush() error
+}
+
+func newCatalogueReader(format string, r io.Reader) (catalogueReader, error) {
+	switch format {
+	case constants.CatalogueFormatCSV:
+		return newCSVCatalogueReader(r)
+	case constants.CatalogueFormatMARC21:
+		return newMARC21CatalogueReader(r), nil
+	default:
+		return nil, ErrUnsupportedCatalogueFormat
+	}
+}
+
+func newCatalogueWriter(format string, w io.Writer) (catalogueWriter, error) {
+	switch format {
+	case constants.CatalogueFormatCSV:
+		return newCSVCatalogueWriter(w), nil
+	case constants.CatalogueFormatMARC21:
+		return newMARC21CatalogueWriter(w), nil
+	default:
+		return nil, ErrUnsupportedCatalogueFormat
+	}
+}
+
+type BookCatalogueService interface {
+	CreateImportJob(ctx context.Context, req *models.BookImportRequest) (*models.BookImportJobRecord, error)
+	GetImportJob(ctx context.Context, id string) (*models.BookImportJobRecord, error)
+	RunPendingImports(ctx context.Context) (processed int, err error)
+	FailStaleImports(ctx context.Context) (failed int, err error)
+	ExportBooks(ctx context.Context, format string, w io.Writer) (exported int, err error)
+}
+
+type bookCatalogueService struct {
+	importRepo     repository.BookImportJobRepository
+	bookRepo       repository.BookRepository
+	bookService    BookService
+	authorClient   clients.AuthorClient
+	categoryClient clients.CategoryClient
+}
+
+func NewBookCatalogueService(importRepo repository.BookImportJobRepository, bookRepo repository.BookRepository, bookService BookService, authorClient clients.AuthorClient, categoryClient clients.CategoryClient) BookCatalogueService {
+	return &bookCatalogueService{
+		importRepo:     importRepo,
+		bookRepo:       bookRepo,
+		bookService:    bookService,
+		authorClient:   authorClient,
+		categoryClient: categoryClient,
+	}
+}
+
+// CreateImportJob stores the file as a pending job, the rows are imported later by RunPendingImports.
+// The file is only checked for being readable at all, problems with single rows are reported on the job.
+func (s *bookCatalogueService) CreateImportJob(ctx context.Context, req *models.BookImportRequest) (*models.BookImportJobRecord, error) {
+	log.Printf("[%s] Creating %s import job for file %s\n", utils.GetLocation(), req.Format, req.FileName)
+
+	if len(bytes.TrimSpace(req.Payload)) == 0 {
+		return nil, ErrEmptyImportFile
+	}
+	if len(req.Payload) > constants.MaxBookImportFileSize {
+		return nil, ErrImportFileTooLarge
+	}
+	if _, err := newCatalogueReader(req.Format, bytes.NewReader(req.Payload)); err != nil {
+		log.Printf("[%s] Rejected import file %s: %v\n", utils.GetLocation(), req.FileName, err)
+		return nil, err
+	}
+
+	job, err := s.importRepo.CreateImportJob(ctx, req)
+	if err != nil {
+		log.Printf("[%s] Failed to create import job: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Import job %s created for file %s\n", utils.GetLocation(), job.Id, req.FileName)
+	return job, nil
+}
+
+func (s *bookCatalogueService) GetImportJob(ctx context.Context, id string) (*models.BookImportJobRecord, error) {
+	log.Printf("[%s] Fetching import job with ID: %s\n", utils.GetLocation(), id)
+
+	job, err := s.importRepo.GetImportJob(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get import job with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// RunPendingImports runs the pending jobs one after the other until none is left or ctx is cancelled
+func (s *bookCatalogueService) RunPendingImports(ctx context.Context) (int, error) {
+	processed := 0
+	for ctx.Err() == nil {
+		job, err := s.importRepo.ClaimPendingImportJob(ctx)
+		if errors.Is(err, repository.ErrNoPendingBookImportJob) {
+			break
+		}
+		if err != nil {
+			log.Printf("[%s] Failed to claim import job: %v\n", utils.GetLocation(), err)
+			return processed, err
+		}
+
+		if err := s.runImportJob(ctx, job); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// FailStaleImports fails the jobs left running by an instance that stopped while importing them
+func (s *bookCatalogueService) FailStaleImports(ctx context.Context) (int, error) {
+	return s.importRepo.FailStaleImportJobs(ctx, time.Now().Add(-constants.BookImportStaleAfter), "import was interrupted, upload the file again")
+}
+
+func (s *bookCatalogueService) runImportJob(ctx context.Context, job *models.BookImportJobRecord) error {
+	log.Printf("[%s] Running %s import job %s for file %s\n", utils.GetLocation(), job.Format, job.Id, job.FileName)
+
+	reader, err := newCatalogueReader(job.Format, bytes.NewReader(job.Payload))
+	if err != nil {
+		job.Status = constants.BookImportStatusFailed
+		job.Error = err.Error()
+		return s.importRepo.FinishImportJob(ctx, job)
+	}
+
+	lookup := newCatalogueLookup(s.authorClient, s.categoryClient)
+	for ctx.Err() == nil {
+		entry, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		job.TotalRows++
+		if err == nil {
+			err = s.importEntry(ctx, lookup, entry)
+		}
+		if err != nil {
+			job.FailedRows++
+			if len(job.RowErrors) < constants.MaxBookImportRowErrors {
+				job.RowErrors = append(job.RowErrors, models.BookImportRowError{Row: job.TotalRows, Message: err.Error()})
+			}
+		} else {
+			job.ImportedRows++
+		}
+
+		if job.TotalRows%constants.BookImportProgressInterval == 0 {
+			if err := s.importRepo.SaveImportProgress(ctx, job); err != nil {
+				log.Printf("[%s] Failed to save progress of import job %s: %v\n", utils.GetLocation(), job.Id, err)
+			}
+		}
+	}
+
+	job.Status = constants.BookImportStatusCompleted
+	if ctx.Err() != nil {
+		// The rows imported so far stay, the job is failed right away instead of waiting to go stale
+		job.Status = constants.BookImportStatusFailed
+		job.Error = fmt.Sprintf("import was interrupted after %d rows, upload the remaining rows again", job.TotalRows)
+		ctx = context.Background()
+	}
+	if err := s.importRepo.FinishImportJob(ctx, job); err != nil {
+		return err
+	}
+
+	log.Printf("[%s] Import job %s finished: %d imported, %d failed\n", utils.GetLocation(), job.Id, job.ImportedRows, job.FailedRows)
+	return nil
+}
+
+func (s *bookCatalogueService) importEntry(ctx context.Context, lookup *catalogueLookup, entry *models.CatalogueEntry) error {
+	if err := validateCatalogueEntry(entry); err != nil {
+		return err
+	}
+
+	authors := make([]models.BookAuthorRecord, 0, len(entry.Authors))
+	for _, author := range entry.Authors {
+		authorId, err := lookup.authorId(ctx, author.Name)
+		if err != nil {
+			return err
+		}
+		authors = append(authors, models.BookAuthorRecord{AuthorId: authorId, Role: author.Role})
+	}
+
+	categoryId, err := lookup.categoryId(ctx, entry.Category)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.bookService.CreateBook(ctx, &models.BookRequest{
+		Title:           entry.Title,
+		Authors:         authors,
+		CategoryId:      categoryId,
+		PublicationYear: entry.PublicationYear,
+		ISBN:            entry.ISBN,
+		Publisher:       entry.Publisher,
+		Language:        entry.Language,
+		PageCount:       entry.PageCount,
+		Edition:         entry.Edition,
+		Description:     entry.Description,
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, utils.ErrInvalidISBN), errors.Is(err, repository.ErrDuplicateISBN), errors.Is(err, ErrDuplicateBookAuthor):
+		return err
+	default:
+		return errors.New("failed to save book")
+	}
+}
+
+// ExportBooks writes every book to w in the format, books are read page by page in id order
+func (s *bookCatalogueService) ExportBooks(ctx context.Context, format string, w io.Writer) (int, error) {
+	log.Printf("[%s] Exporting books as %s\n", utils.GetLocation(), format)
+
+	writer, err := newCatalogueWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	lookup := newCatalogueLookup(s.authorClient, s.categoryClient)
+	exported := 0
+	afterId := ""
+	for {
+		books, err := s.bookRepo.ListBooksAfter(ctx, afterId, constants.BookExportPageSize)
+		if err != nil {
+			log.Printf("[%s] Failed to list books after ID %s: %v\n", utils.GetLocation(), afterId, err)
+			return exported, err
+		}
+
+		for _, book := range books {
+			if err := writer.Write(s.toCatalogueEntry(ctx, lookup, book)); err != nil {
+				log.Printf("[%s] Failed to write book %s: %v\n", utils.GetLocation(), book.Id, err)
+				return exported, err
+			}
+			exported++
+		}
+
+		if len(books) < constants.BookExportPageSize {
+			break
+		}
+		afterId = books[len(books)-1].Id
+	}
+
+	if err := writer.Flush(); err != nil {
+		return exported, err
+	}
+
+	log.Printf("[%s] Exported %d books as %s\n", utils.GetLocation(), exported, format)
+	return exported, nil
+}
+
+// toCatalogueEntry names the authors and category of the book, a name that cannot be looked up is left empty
+func (s *bookCatalogueService) toCatalogueEntry(ctx context.Context, lookup *catalogueLookup, book *models.BookRecord) *models.CatalogueEntry {
+	entry := &models.CatalogueEntry{
+		Id:              book.Id,
+		Title:           book.Title,
+		Category:        lookup.categoryName(ctx, book.CategoryId),
+		ISBN:            book.ISBN,
+		PublicationYear: book.PublicationYear,
+		Publisher:       book.Publisher,
+		Language:        book.Language,
+		PageCount:       book.PageCount,
+		Edition:         book.Edition,
+		Description:     book.Description,
+	}
+	for _, author := range book.Authors {
+		entry.Authors = append(entry.Authors, models.CatalogueAuthor{Name: lookup.authorName(ctx, author.AuthorId), Role: author.Role})
+	}
+	return entry
+}
+
+// validateCatalogueEntry applies the limits of a book created through the API to an imported entry
+func validateCatalogueEntry(entry *models.CatalogueEntry) error {
+	if n := utf8.RuneCountInString(entry.Title); n < 3 || n > 255 {
+		return errors.New("title must be between 3 and 255 characters")
+	}
+	if len(entry.Authors) < 1 || len(entry.Authors) > 20 {
+		return errors.New("a book must have between 1 and 20 authors")
+	}
+	for _, author := range entry.Authors {
+		if n := utf8.RuneCountInString(author.Name); n < 3 || n > 50 {
+			return fmt.Errorf("author name %q must be between 3 and 50 characters", author.Name)
+		}
+		switch author.Role {
+		case "", constants.BookAuthorRoleAuthor, constants.BookAuthorRoleEditor, constants.BookAuthorRoleTranslator:
+		default:
+			return fmt.Errorf("unknown role %q for author %q", author.Role, author.Name)
+		}
+	}
+	if n := utf8.RuneCountInString(entry.Category); n < 3 || n > 50 {
+		return errors.New("category must be between 3 and 50 characters")
+	}
+	if entry.PublicationYear < 0 || entry.PublicationYear > 9999 {
+		return errors.New("publication year must be between 0 and 9999")
+	}
+	if entry.PageCount < 0 || entry.PageCount > 100000 {
+		return errors.New("page count must be between 0 and 100000")
+	}
+	if entry.Language != "" && !catalogueLanguagePattern.MatchString(entry.Language) {
+		return fmt.Errorf("language %q is not an ISO 639 code", entry.Language)
+	}
+	if utf8.RuneCountInString(entry.Publisher) > 255 {
+		return errors.New("publisher must be at most 255 characters")
+	}
+	if utf8.RuneCountInString(entry.Edition) > 50 {
+		return errors.New("edition must be at most 50 characters")
+	}
+	if utf8.RuneCountInString(entry.Description) > 5000 {
+		return errors.New("description must be at most 5000 characters")
+	}
+	return nil
+}
+
+// catalogueLookup caches the author and category names seen during one import or export,
+// names are compared case-insensitively like the author and category services do
+type catalogueLookup struct {
+	authorClient   clients.AuthorClient
+	categoryClient clients.CategoryClient
+	authorIds      map[string]string
+	categoryIds    map[string]string
+	authorNames    map[string]string
+	categoryNames  map[string]string
+}
+
+func newCatalogueLookup(authorClient clients.AuthorClient, categoryClient clients.CategoryClient) *catalogueLookup {
+	return &catalogueLookup{
+		authorClient:   authorClient,
+		categoryClient: categoryClient,
+		authorIds:      make(map[string]string),
+		categoryIds:    make(map[string]string),
+		authorNames:    make(map[string]string),
+		categoryNames:  make(map[string]string),
+	}
+}
+
+// authorId returns the id of the author with the name, the author is created when there is none
+func (l *catalogueLookup) authorId(ctx context.Context, name string) (string, error) {
+	key := strings.ToLower(name)
+	if id, ok := l.authorIds[key]; ok {
+		return id, nil
+	}
+
+	author, err := l.authorClient.ResolveAuthor(ctx, name)
+	if err != nil {
+		log.Printf("[%s] Failed to resolve author %q: %v\n", utils.GetLocation(), name, err)
+		return "", fmt.Errorf("failed to resolve author %q", name)
+	}
+	l.authorIds[key] = author.Id
+	l.authorNames[author.Id] = author.Name
+	return author.Id, nil
+}
+
+// categoryId returns the id of the category with the name, the category is created when there is none
+func (l *catalogueLookup) categoryId(ctx context.Context, name string) (string, error) {
+	key := strings.ToLower(name)
+	if id, ok := l.categoryIds[key]; ok {
+		return id, nil
+	}
+
+	category, err := l.categoryClient.ResolveCategory(ctx, name)
+	if err != nil {
+		log.Printf("[%s] Failed to resolve category %q: %v\n", utils.GetLocation(), name, err)
+		return "", fmt.Errorf("failed to resolve category %q", name)
+	}
+	l.categoryIds[key] = category.Id
+	l.categoryNames[category.Id] = category.Name
+	return category.Id, nil
+}
+
+func (l *catalogueLookup) authorName(ctx context.Context, id string) string {
+	if name, ok := l.authorNames[id]; ok {
+		return name
+	}
+
+	name := ""
+	author, err := l.authorClient.GetAuthor(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get author %s, exporting it without a name: %v\n", utils.GetLocation(), id, err)
+	} else {
+		name = author.Name
+	}
+	l.authorNames[id] = name
+	return name
+}
+
+func (l *catalogueLookup) categoryName(ctx context.Context, id string) string {
+	if name, ok := l.categoryNames[id]; ok {
+		return name
+	}
+
+	name := ""
+	category, err := l.categoryClient.GetCategory(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get category %s, exporting it without a name: %v\n", utils.GetLocation(), id, err)
+	} else {
+		name = category.Name
+	}
+	l.categoryNames[id] = name
+	return name
+}
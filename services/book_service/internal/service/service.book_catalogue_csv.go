@@ -0,0 +1,195 @@
+package service
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// csvCatalogueColumns are the columns of an export in order, an import may order them freely
+// and only needs the required ones. The id column is ignored on import.
+var (
+	csvCatalogueColumns = []string{
+		"id", "title", "authors", "category", "isbn", "publication_year",
+		"publisher", "language", "page_count", "edition", "description",
+	}
+	csvRequiredColumns = []string{"title", "authors", "category"}
+)
+
+// csvAuthorSeparator separates the authors of a book within the authors column,
+// each written as "Name" or "Name (role)"
+const csvAuthorSeparator = ";"
+
+type csvCatalogueReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+// newCSVCatalogueReader reads the header row, column names are matched case-insensitively
+func newCSVCatalogueReader(r io.Reader) (*csvCatalogueReader, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrEmptyImportFile
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+	for _, column := range csvRequiredColumns {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", ErrInvalidImportFile, column)
+		}
+	}
+
+	return &csvCatalogueReader{
+		reader:  reader,
+		columns: columns,
+	}, nil
+}
+
+func (r *csvCatalogueReader) Next() (*models.CatalogueEntry, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	value := func(column string) string {
+		i, ok := r.columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entry := &models.CatalogueEntry{
+		Title:       value("title"),
+		Authors:     parseCSVAuthors(value("authors")),
+		Category:    value("category"),
+		ISBN:        value("isbn"),
+		Publisher:   value("publisher"),
+		Language:    strings.ToLower(value("language")),
+		Edition:     value("edition"),
+		Description: value("description"),
+	}
+	if entry.PublicationYear, err = parseCSVNumber(value("publication_year"), "publication_year"); err != nil {
+		return nil, err
+	}
+	if entry.PageCount, err = parseCSVNumber(value("page_count"), "page_count"); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func parseCSVNumber(value, column string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not a number", column, value)
+	}
+	return number, nil
+}
+
+func parseCSVAuthors(value string) []models.CatalogueAuthor {
+	var authors []models.CatalogueAuthor
+	for _, credit := range strings.Split(value, csvAuthorSeparator) {
+		credit = strings.TrimSpace(credit)
+		if credit == "" {
+			continue
+		}
+
+		author := models.CatalogueAuthor{Name: credit}
+		if open := strings.LastIndex(credit, "("); open > 0 && strings.HasSuffix(credit, ")") {
+			author.Name = strings.TrimSpace(credit[:open])
+			author.Role = strings.ToLower(strings.TrimSpace(credit[open+1 : len(credit)-1]))
+		}
+		authors = append(authors, author)
+	}
+	return authors
+}
+
+func formatCSVAuthors(authors []models.CatalogueAuthor) string {
+	credits := make([]string, 0, len(authors))
+	for _, author := range authors {
+		if author.Role == "" || author.Role == constants.BookAuthorRoleAuthor {
+			credits = append(credits, author.Name)
+		} else {
+			credits = append(credits, fmt.Sprintf("%s (%s)", author.Name, author.Role))
+		}
+	}
+	return strings.Join(credits, csvAuthorSeparator+" ")
+}
+
+type csvCatalogueWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func newCSVCatalogueWriter(w io.Writer) *csvCatalogueWriter {
+	return &csvCatalogueWriter{
+		writer: csv.NewWriter(w),
+	}
+}
+
+func (w *csvCatalogueWriter) Write(entry *models.CatalogueEntry) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	return w.writer.Write([]string{
+		entry.Id,
+		entry.Title,
+		formatCSVAuthors(entry.Authors),
+		entry.Category,
+		entry.ISBN,
+		formatCSVNumber(entry.PublicationYear),
+		entry.Publisher,
+		entry.Language,
+		formatCSVNumber(entry.PageCount),
+		entry.Edition,
+		entry.Description,
+	})
+}
+
+// Flush writes the header even when no book was written, so an empty catalogue still exports a valid file
+func (w *csvCatalogueWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvCatalogueWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writer.Write(csvCatalogueColumns)
+}
+
+// formatCSVNumber leaves unknown values, stored as 0, empty
+func formatCSVNumber(number int) string {
+	if number == 0 {
+		return ""
+	}
+	return strconv.Itoa(number)
+}
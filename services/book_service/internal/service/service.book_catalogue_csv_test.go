@@ -0,0 +1,109 @@
+package service
+
+import (
+	"book_service/internal/models"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCSVAuthors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []models.CatalogueAuthor
+	}{
+		{name: "empty", value: ""},
+		{name: "only separators", value: " ; ;"},
+		{name: "single author", value: "Frank Herbert", want: []models.CatalogueAuthor{{Name: "Frank Herbert"}}},
+		{
+			name:  "roles and spacing",
+			value: " Frank Herbert ;Brian Herbert (Editor); Jane Doe ( translator )",
+			want: []models.CatalogueAuthor{
+				{Name: "Frank Herbert"},
+				{Name: "Brian Herbert", Role: "editor"},
+				{Name: "Jane Doe", Role: "translator"},
+			},
+		},
+		{
+			name:  "parentheses within the name",
+			value: "Prince (Artist) (author)",
+			want:  []models.CatalogueAuthor{{Name: "Prince (Artist)", Role: "author"}},
+		},
+		{name: "unclosed role", value: "Jane Doe (editor", want: []models.CatalogueAuthor{{Name: "Jane Doe (editor"}}},
+		{name: "only a role", value: "(editor)", want: []models.CatalogueAuthor{{Name: "(editor)"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCSVAuthors(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseCSVAuthors(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCSVAuthorsRoundTrips(t *testing.T) {
+	authors := []models.CatalogueAuthor{
+		{Name: "Frank Herbert", Role: "author"},
+		{Name: "Brian Herbert", Role: "editor"},
+	}
+
+	got := parseCSVAuthors(formatCSVAuthors(authors))
+	want := []models.CatalogueAuthor{{Name: "Frank Herbert"}, {Name: "Brian Herbert", Role: "editor"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("round trip = %+v, want %+v", got, want)
+	}
+}
+
+func TestCSVCatalogueReader(t *testing.T) {
+	file := "\ufeffTitle, Authors ,CATEGORY,publication_year,extra\n" +
+		"Dune,Frank Herbert,Science Fiction,1965,x\n" +
+		"Short row,Someone\n" +
+		"Bad year,Someone,Fiction,soon\n"
+
+	reader, err := newCSVCatalogueReader(strings.NewReader(file))
+	if err != nil {
+		t.Fatalf("newCSVCatalogueReader() error = %v", err)
+	}
+
+	entry, err := reader.Next()
+	if err != nil || entry.Title != "Dune" || entry.Category != "Science Fiction" || entry.PublicationYear != 1965 || len(entry.Authors) != 1 {
+		t.Fatalf("Next() = %+v, %v, want Dune", entry, err)
+	}
+
+	entry, err = reader.Next()
+	if err != nil || entry.Title != "Short row" || entry.Category != "" {
+		t.Fatalf("Next() = %+v, %v, want the short row with its missing columns empty", entry, err)
+	}
+
+	if _, err = reader.Next(); err == nil {
+		t.Fatal("Next() on a non numeric year succeeded")
+	}
+
+	if _, err = reader.Next(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Next() at the end error = %v, want io.EOF", err)
+	}
+}
+
+func TestNewCSVCatalogueReaderRejectsBadHeaders(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want error
+	}{
+		{name: "empty file", file: "", want: ErrEmptyImportFile},
+		{name: "missing required column", file: "title,authors\nDune,Frank Herbert\n", want: ErrInvalidImportFile},
+		{name: "unterminated quote", file: "title,\"authors\n", want: ErrInvalidImportFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newCSVCatalogueReader(strings.NewReader(tt.file)); !errors.Is(err, tt.want) {
+				t.Fatalf("newCSVCatalogueReader() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
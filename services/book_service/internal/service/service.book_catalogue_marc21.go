@@ -0,0 +1,231 @@
+package service
+
+import (
+	"book_service/internal/constants"
+	"book_service/internal/models"
+	"book_service/pkg/marc21"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MARC21 tags a book is read from and written to
+const (
+	marcTagControlNumber = "001"
+	marcTagFixedData     = "008"
+	marcTagISBN          = "020"
+	marcTagLanguage      = "041"
+	marcTagMainAuthor    = "100"
+	marcTagTitle         = "245"
+	marcTagEdition       = "250"
+	marcTagPublication   = "264"
+	marcTagImprint       = "260" // superseded by 264, still common in older records
+	marcTagExtent        = "300"
+	marcTagSummary       = "520"
+	marcTagSubject       = "650"
+	marcTagAddedAuthor   = "700"
+)
+
+var (
+	marcYearPattern   = regexp.MustCompile(`[0-9]{4}`)
+	marcNumberPattern = regexp.MustCompile(`[0-9]+`)
+)
+
+// marcRoles maps the relator terms ($e) and codes ($4) of an author to the roles of a book,
+// any other relator is imported as author
+var marcRoles = map[string]string{
+	"author":     constants.BookAuthorRoleAuthor,
+	"aut":        constants.BookAuthorRoleAuthor,
+	"editor":     constants.BookAuthorRoleEditor,
+	"edt":        constants.BookAuthorRoleEditor,
+	"translator": constants.BookAuthorRoleTranslator,
+	"trl":        constants.BookAuthorRoleTranslator,
+}
+
+type marc21CatalogueReader struct {
+	reader *marc21.Reader
+}
+
+func newMARC21CatalogueReader(r io.Reader) *marc21CatalogueReader {
+	return &marc21CatalogueReader{
+		reader: marc21.NewReader(r),
+	}
+}
+
+func (r *marc21CatalogueReader) Next() (*models.CatalogueEntry, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &models.CatalogueEntry{}
+	if field := record.Field(marcTagTitle); field != nil {
+		entry.Title = trimMARCPunctuation(field.Subfield('a'))
+		if subtitle := trimMARCPunctuation(field.Subfield('b')); subtitle != "" {
+			entry.Title += ": " + subtitle
+		}
+	}
+
+	for i := range record.Fields {
+		field := &record.Fields[i]
+		if field.Tag == marcTagMainAuthor || field.Tag == marcTagAddedAuthor {
+			entry.Authors = append(entry.Authors, toCatalogueAuthor(field))
+		}
+	}
+
+	if field := record.Field(marcTagSubject); field != nil {
+		entry.Category = trimMARCPunctuation(field.Subfield('a'))
+	}
+	if field := record.Field(marcTagISBN); field != nil {
+		// The ISBN may be followed by a qualifier such as "(pbk.)"
+		if isbn := strings.Fields(field.Subfield('a')); len(isbn) > 0 {
+			entry.ISBN = isbn[0]
+		}
+	}
+	if field := record.Field(marcTagEdition); field != nil {
+		entry.Edition = trimMARCPunctuation(field.Subfield('a'))
+	}
+	if field := publicationField(record); field != nil {
+		entry.Publisher = trimMARCPunctuation(field.Subfield('b'))
+		if year := marcYearPattern.FindString(field.Subfield('c')); year != "" {
+			entry.PublicationYear, _ = strconv.Atoi(year)
+		}
+	}
+	if field := record.Field(marcTagExtent); field != nil {
+		if pages := marcNumberPattern.FindString(field.Subfield('a')); pages != "" {
+			entry.PageCount, _ = strconv.Atoi(pages)
+		}
+	}
+	if field := record.Field(marcTagSummary); field != nil {
+		entry.Description = strings.TrimSpace(field.Subfield('a'))
+	}
+
+	// The fixed-length data holds the date of publication at 7-10 and the language at 35-37
+	if field := record.Field(marcTagFixedData); field != nil && len(field.Value) >= 38 {
+		if entry.PublicationYear == 0 {
+			if year, err := strconv.Atoi(field.Value[7:11]); err == nil {
+				entry.PublicationYear = year
+			}
+		}
+		entry.Language = strings.ToLower(strings.TrimSpace(field.Value[35:38]))
+	}
+	if field := record.Field(marcTagLanguage); field != nil && field.Subfield('a') != "" {
+		entry.Language = strings.ToLower(strings.TrimSpace(field.Subfield('a')))
+	}
+	if !catalogueLanguagePattern.MatchString(entry.Language) {
+		// Blanks, fill characters and "und" mean the language is not recorded
+		entry.Language = ""
+	}
+
+	return entry, nil
+}
+
+// publicationField prefers the publication statement (264 with second indicator 1) over the older imprint
+func publicationField(record *marc21.Record) *marc21.Field {
+	fields := record.FieldsByTag(marcTagPublication)
+	for _, field := range fields {
+		if field.Indicators[1] == '1' {
+			return field
+		}
+	}
+	if len(fields) > 0 {
+		return fields[0]
+	}
+	return record.Field(marcTagImprint)
+}
+
+// toCatalogueAuthor reads an author field, a name entered as "Surname, Forename" (first indicator 1) is turned around
+func toCatalogueAuthor(field *marc21.Field) models.CatalogueAuthor {
+	name := trimMARCPunctuation(field.Subfield('a'))
+	if field.Indicators[0] == '1' {
+		if surname, forename, ok := strings.Cut(name, ", "); ok {
+			name = strings.TrimSpace(forename) + " " + strings.TrimSpace(surname)
+		}
+	}
+
+	relator := field.Subfield('e')
+	if relator == "" {
+		relator = field.Subfield('4')
+	}
+	return models.CatalogueAuthor{
+		Name: name,
+		Role: marcRoles[strings.ToLower(trimMARCPunctuation(relator))],
+	}
+}
+
+// trimMARCPunctuation strips the ISBD punctuation that separates the subfields of a record
+func trimMARCPunctuation(value string) string {
+	return strings.TrimSpace(strings.TrimRight(strings.TrimSpace(value), " /:;,."))
+}
+
+type marc21CatalogueWriter struct {
+	writer *marc21.Writer
+}
+
+func newMARC21CatalogueWriter(w io.Writer) *marc21CatalogueWriter {
+	return &marc21CatalogueWriter{
+		writer: marc21.NewWriter(w),
+	}
+}
+
+func (w *marc21CatalogueWriter) Write(entry *models.CatalogueEntry) error {
+	record := &marc21.Record{}
+	record.AddControlField(marcTagControlNumber, entry.Id)
+	record.AddControlField(marcTagFixedData, marcFixedData(entry))
+
+	record.AddDataField(marcTagISBN, ' ', ' ', marc21.Subfield{Code: 'a', Value: entry.ISBN})
+	record.AddDataField(marcTagLanguage, ' ', ' ', marc21.Subfield{Code: 'a', Value: entry.Language})
+	for i, author := range entry.Authors {
+		tag := marcTagAddedAuthor
+		if i == 0 {
+			tag = marcTagMainAuthor
+		}
+		role := author.Role
+		if role == "" {
+			role = constants.BookAuthorRoleAuthor
+		}
+		record.AddDataField(tag, '0', ' ', marc21.Subfield{Code: 'a', Value: author.Name}, marc21.Subfield{Code: 'e', Value: role})
+	}
+	record.AddDataField(marcTagTitle, '1', '0', marc21.Subfield{Code: 'a', Value: entry.Title})
+	record.AddDataField(marcTagEdition, ' ', ' ', marc21.Subfield{Code: 'a', Value: entry.Edition})
+	publicationYear := ""
+	if entry.PublicationYear > 0 {
+		publicationYear = strconv.Itoa(entry.PublicationYear)
+	}
+	record.AddDataField(marcTagPublication, ' ', '1',
+		marc21.Subfield{Code: 'b', Value: entry.Publisher},
+		marc21.Subfield{Code: 'c', Value: publicationYear},
+	)
+	if entry.PageCount > 0 {
+		record.AddDataField(marcTagExtent, ' ', ' ', marc21.Subfield{Code: 'a', Value: fmt.Sprintf("%d pages", entry.PageCount)})
+	}
+	record.AddDataField(marcTagSummary, ' ', ' ', marc21.Subfield{Code: 'a', Value: entry.Description})
+	// Second indicator 4: the category is a local term, not from a subject thesaurus
+	record.AddDataField(marcTagSubject, ' ', '4', marc21.Subfield{Code: 'a', Value: entry.Category})
+
+	return w.writer.Write(record)
+}
+
+func (w *marc21CatalogueWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+// marcFixedData builds the 40 characters of the 008 field, only the entry date,
+// publication date and language are filled in
+func marcFixedData(entry *models.CatalogueEntry) string {
+	dateType, year := "n", "uuuu"
+	if entry.PublicationYear > 0 {
+		dateType, year = "s", fmt.Sprintf("%04d", entry.PublicationYear)
+	}
+
+	// The 008 language is a three-letter MARC code, a two-letter code is only written to 041
+	language := "und"
+	if len(entry.Language) == 3 {
+		language = entry.Language
+	}
+
+	return fmt.Sprintf("%s%s%s%-4s%-3s%-17s%s d", time.Now().Format("060102"), dateType, year, "", "xx", "", language)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"book_service/internal/models"
+	"book_service/internal/repository"
+	"book_service/pkg/utils"
+	"context"
+	"fmt"
+	"log"
+)
+
+type BookCopyService interface {
+	AddCopy(ctx context.Context, req *models.BookCopyRecord) (*models.BookCopyRecord, error)
+	UpdateCopy(ctx context.Context, req *models.BookCopyRecord) (*models.BookCopyRecord, error)
+	RetireCopy(ctx context.Context, id string, version int) (*models.BookCopyRecord, error)
+	GetCopyByBarcode(ctx context.Context, barcode string) (*models.BookCopyRecord, error)
+	ListCopies(ctx context.Context, bookId, status string) ([]*models.BookCopyRecord, error)
+	CheckOutCopy(ctx context.Context, bookId, barcode string) (*models.BookCopyRecord, error)
+	CheckInCopy(ctx context.Context, id, condition string) (*models.BookCopyRecord, error)
+}
+
+type bookCopyService struct {
+	repo     repository.BookCopyRepository
+	bookRepo repository.BookRepository
+}
+
+func NewBookCopyService(repo repository.BookCopyRepository, bookRepo repository.BookRepository) BookCopyService {
+	return &bookCopyService{
+		repo:     repo,
+		bookRepo: bookRepo,
+	}
+}
+
+func (s *bookCopyService) AddCopy(ctx context.Context, req *models.BookCopyRecord) (*models.BookCopyRecord, error) {
+	log.Printf("[%s] Adding copy %s to book with ID: %s\n", utils.GetLocation(), req.Barcode, req.BookId)
+
+	if _, err := s.bookRepo.GetBook(ctx, req.BookId); err != nil {
+		log.Printf("[%s] Book with ID %s not found: %v\n", utils.GetLocation(), req.BookId, err)
+		return nil, fmt.Errorf("book with ID '%s' not found", req.BookId)
+	}
+
+	addedCopy, err := s.repo.AddCopy(ctx, req)
+	if err != nil {
+		log.Printf("[%s] Failed to add copy %s: %v\n", utils.GetLocation(), req.Barcode, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Copy %s added successfully with ID %s\n", utils.GetLocation(), req.Barcode, addedCopy.Id)
+	return addedCopy, nil
+}
+
+func (s *bookCopyService) UpdateCopy(ctx context.Context, req *models.BookCopyRecord) (*models.BookCopyRecord, error) {
+	log.Printf("[%s] Updating copy with ID: %s\n", utils.GetLocation(), req.Id)
+
+	updatedCopy, err := s.repo.UpdateCopy(ctx, req)
+	if err != nil {
+		log.Printf("[%s] Failed to update copy with ID %s: %v\n", utils.GetLocation(), req.Id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Copy with ID %s updated successfully\n", utils.GetLocation(), req.Id)
+	return updatedCopy, nil
+}
+
+func (s *bookCopyService) RetireCopy(ctx context.Context, id string, version int) (*models.BookCopyRecord, error) {
+	log.Printf("[%s] Retiring copy with ID: %s\n", utils.GetLocation(), id)
+
+	retiredCopy, err := s.repo.RetireCopy(ctx, id, version)
+	if err != nil {
+		log.Printf("[%s] Failed to retire copy with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Copy with ID %s retired successfully\n", utils.GetLocation(), id)
+	return retiredCopy, nil
+}
+
+func (s *bookCopyService) GetCopyByBarcode(ctx context.Context, barcode string) (*models.BookCopyRecord, error) {
+	log.Printf("[%s] Fetching copy with barcode: %s\n", utils.GetLocation(), barcode)
+
+	bookCopy, err := s.repo.GetCopyByBarcode(ctx, barcode)
+	if err != nil {
+		log.Printf("[%s] Failed to get copy with barcode %s: %v\n", utils.GetLocation(), barcode, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Copy with barcode %s fetched successfully\n", utils.GetLocation(), barcode)
+	return bookCopy, nil
+}
+
+func (s *bookCopyService) ListCopies(ctx context.Context, bookId, status string) ([]*models.BookCopyRecord, error) {
+	log.Printf("[%s] Fetching copies of book with ID: %s\n", utils.GetLocation(), bookId)
+
+	copies, err := s.repo.ListCopies(ctx, bookId, status)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch copies of book with ID %s: %v\n", utils.GetLocation(), bookId, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully fetched %d copies of book with ID %s\n", utils.GetLocation(), len(copies), bookId)
+	return copies, nil
+}
+
+// CheckOutCopy lends the scanned copy when a barcode is given, otherwise any available copy of the book
+func (s *bookCopyService) CheckOutCopy(ctx context.Context, bookId, barcode string) (*models.BookCopyRecord, error) {
+	var (
+		bookCopy *models.BookCopyRecord
+		err      error
+	)
+	if barcode != "" {
+		log.Printf("[%s] Checking out copy with barcode: %s\n", utils.GetLocation(), barcode)
+		bookCopy, err = s.repo.CheckOutCopyByBarcode(ctx, barcode)
+	} else {
+		log.Printf("[%s] Checking out a copy of book with ID: %s\n", utils.GetLocation(), bookId)
+		bookCopy, err = s.repo.CheckOutCopy(ctx, bookId)
+	}
+	if err != nil {
+		log.Printf("[%s] Failed to check out copy: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Copy %s of book with ID %s checked out successfully\n", utils.GetLocation(), bookCopy.Barcode, bookCopy.BookId)
+	return bookCopy, nil
+}
+
+func (s *bookCopyService) CheckInCopy(ctx context.Context, id, condition string) (*models.BookCopyRecord, error) {
+	log.Printf("[%s] Checking in copy with ID: %s\n", utils.GetLocation(), id)
+
+	bookCopy, err := s.repo.CheckInCopy(ctx, id, condition)
+	if err != nil {
+		log.Printf("[%s] Failed to check in copy with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Copy %s checked in successfully\n", utils.GetLocation(), bookCopy.Barcode)
+	return bookCopy, nil
+}
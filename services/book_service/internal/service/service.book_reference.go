@@ -0,0 +1,98 @@
+package service
+
+import (
+	"book_service/pkg/utils"
+	"context"
+	"errors"
+	"log"
+)
+
+var ErrReassignToSelf = errors.New("books cannot be reassigned to the same author or category")
+
+// CountBooksByAuthorId counts the books crediting the author in any role
+func (s *bookService) CountBooksByAuthorId(ctx context.Context, authorId string) (int, error) {
+	log.Printf("[%s] Counting books by author ID: %s\n", utils.GetLocation(), authorId)
+
+	totalBooks, err := s.repo.CountBooksByAuthorId(ctx, authorId)
+	if err != nil {
+		log.Printf("[%s] Failed to count books by author ID %s: %v\n", utils.GetLocation(), authorId, err)
+		return 0, err
+	}
+
+	return totalBooks, nil
+}
+
+// CountBooksByCategoryId counts the books of the category, the books of its subcategories are not counted
+func (s *bookService) CountBooksByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	log.Printf("[%s] Counting books by category ID: %s\n", utils.GetLocation(), categoryId)
+
+	totalBooks, err := s.repo.CountBooksByCategoryId(ctx, []string{categoryId})
+	if err != nil {
+		log.Printf("[%s] Failed to count books by category ID %s: %v\n", utils.GetLocation(), categoryId, err)
+		return 0, err
+	}
+
+	return totalBooks, nil
+}
+
+// ReassignBooksByAuthorId credits the new author instead of the author on every book, the caller checks the new author exists
+func (s *bookService) ReassignBooksByAuthorId(ctx context.Context, authorId string, newAuthorId string) (int, error) {
+	log.Printf("[%s] Reassigning books of author ID %s to author ID %s\n", utils.GetLocation(), authorId, newAuthorId)
+	if authorId == newAuthorId {
+		return 0, ErrReassignToSelf
+	}
+
+	affected, err := s.repo.ReassignBooksByAuthorId(ctx, authorId, newAuthorId)
+	if err != nil {
+		log.Printf("[%s] Failed to reassign books of author ID %s: %v\n", utils.GetLocation(), authorId, err)
+		return 0, err
+	}
+
+	log.Printf("[%s] Reassigned %d books of author ID %s to author ID %s\n", utils.GetLocation(), affected, authorId, newAuthorId)
+	return affected, nil
+}
+
+// ReassignBooksByCategoryId moves every book of the category to the new category, the caller checks the new category exists
+func (s *bookService) ReassignBooksByCategoryId(ctx context.Context, categoryId string, newCategoryId string) (int, error) {
+	log.Printf("[%s] Reassigning books of category ID %s to category ID %s\n", utils.GetLocation(), categoryId, newCategoryId)
+	if categoryId == newCategoryId {
+		return 0, ErrReassignToSelf
+	}
+
+	affected, err := s.repo.ReassignBooksByCategoryId(ctx, categoryId, newCategoryId)
+	if err != nil {
+		log.Printf("[%s] Failed to reassign books of category ID %s: %v\n", utils.GetLocation(), categoryId, err)
+		return 0, err
+	}
+
+	log.Printf("[%s] Reassigned %d books of category ID %s to category ID %s\n", utils.GetLocation(), affected, categoryId, newCategoryId)
+	return affected, nil
+}
+
+// DeleteBooksByAuthorId deletes the books written by the author alone and uncredits the author on the others
+func (s *bookService) DeleteBooksByAuthorId(ctx context.Context, authorId string) (int, error) {
+	log.Printf("[%s] Deleting books of author ID: %s\n", utils.GetLocation(), authorId)
+
+	affected, err := s.repo.DeleteBooksByAuthorId(ctx, authorId)
+	if err != nil {
+		log.Printf("[%s] Failed to delete books of author ID %s: %v\n", utils.GetLocation(), authorId, err)
+		return 0, err
+	}
+
+	log.Printf("[%s] Deleted or uncredited %d books of author ID %s\n", utils.GetLocation(), affected, authorId)
+	return affected, nil
+}
+
+// DeleteBooksByCategoryId deletes every book of the category
+func (s *bookService) DeleteBooksByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	log.Printf("[%s] Deleting books of category ID: %s\n", utils.GetLocation(), categoryId)
+
+	affected, err := s.repo.DeleteBooksByCategoryId(ctx, categoryId)
+	if err != nil {
+		log.Printf("[%s] Failed to delete books of category ID %s: %v\n", utils.GetLocation(), categoryId, err)
+		return 0, err
+	}
+
+	log.Printf("[%s] Deleted %d books of category ID %s\n", utils.GetLocation(), affected, categoryId)
+	return affected, nil
+}
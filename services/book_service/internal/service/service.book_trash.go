@@ -0,0 +1,72 @@
+package service
+
+import (
+	"book_service/internal/models"
+	"book_service/pkg/utils"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+var ErrBookReferenceDeleted = errors.New("the book refers to a deleted author or category")
+
+// ListDeletedBooks lists the books in the trash, the most recently deleted first
+func (s *bookService) ListDeletedBooks(ctx context.Context, page int, pageSize int) (books []*models.BookRecord, totalItems int, err error) {
+	log.Printf("[%s] Fetching list of deleted books with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), page, pageSize)
+
+	books, err = s.repo.ListDeletedBooks(ctx, page, pageSize)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch deleted books: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	totalItems, err = s.repo.CountDeletedBooks(ctx)
+	if err != nil {
+		log.Printf("[%s] Failed to count deleted books: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	return books, totalItems, nil
+}
+
+// RestoreBook takes a book out of the trash, its authors and category must not be deleted themselves
+func (s *bookService) RestoreBook(ctx context.Context, id string) (*models.BookRecord, error) {
+	log.Printf("[%s] Restoring book with ID: %s\n", utils.GetLocation(), id)
+
+	book, err := s.repo.GetDeletedBook(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch deleted book with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	if err := s.ValidateAuthorExistence(ctx, book.AuthorIds()); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBookReferenceDeleted, err)
+	}
+	if err := s.ValidateCategoryExistence(ctx, book.CategoryId); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBookReferenceDeleted, err)
+	}
+
+	book, err = s.repo.RestoreBook(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to restore book with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Book with ID %s restored successfully\n", utils.GetLocation(), id)
+	return book, nil
+}
+
+// PurgeDeletedBooks removes for good the books that have been in the trash since before deletedBefore
+func (s *bookService) PurgeDeletedBooks(ctx context.Context, deletedBefore time.Time) (int, error) {
+	log.Printf("[%s] Purging books deleted before %s\n", utils.GetLocation(), deletedBefore.Format(time.RFC3339))
+
+	purged, err := s.repo.PurgeDeletedBooks(ctx, deletedBefore)
+	if err != nil {
+		log.Printf("[%s] Failed to purge deleted books: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,107 @@
+package marc21
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Reader reads ISO 2709 records one at a time. A malformed record is reported on its own
+// and the next call continues with the record after it.
+type Reader struct {
+	reader *bufio.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		reader: bufio.NewReader(r),
+	}
+}
+
+// Read returns the next record, or io.EOF when there is none left
+func (r *Reader) Read() (*Record, error) {
+	data, err := r.reader.ReadBytes(RecordTerminator)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	// Some exports put a line break between records
+	data = bytes.TrimLeft(data, "\r\n")
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, io.EOF
+	}
+	if data[len(data)-1] != RecordTerminator {
+		return nil, fmt.Errorf("%w: missing record terminator", ErrInvalidRecord)
+	}
+
+	return parseRecord(data)
+}
+
+func parseRecord(data []byte) (*Record, error) {
+	if len(data) < leaderLength+1 {
+		return nil, fmt.Errorf("%w: record shorter than its leader", ErrInvalidRecord)
+	}
+
+	leader := data[:leaderLength]
+	baseAddress, ok := parseNumber(leader[12:17])
+	if !ok || baseAddress <= leaderLength || baseAddress > len(data) {
+		return nil, fmt.Errorf("%w: bad base address of data %q", ErrInvalidRecord, leader[12:17])
+	}
+
+	directory := data[leaderLength : baseAddress-1]
+	if len(directory)%directoryEntryLength != 0 {
+		return nil, fmt.Errorf("%w: directory length %d is not a multiple of %d", ErrInvalidRecord, len(directory), directoryEntryLength)
+	}
+
+	record := &Record{Leader: string(leader)}
+	for i := 0; i < len(directory); i += directoryEntryLength {
+		entry := directory[i : i+directoryEntryLength]
+		tag := string(entry[:3])
+		length, lengthOk := parseNumber(entry[3:7])
+		start, startOk := parseNumber(entry[7:12])
+		if !lengthOk || !startOk || baseAddress+start+length > len(data) {
+			return nil, fmt.Errorf("%w: bad directory entry for field %s", ErrInvalidRecord, tag)
+		}
+
+		value := bytes.TrimSuffix(data[baseAddress+start:baseAddress+start+length], []byte{FieldTerminator})
+		field := Field{Tag: tag}
+		if field.IsControl() {
+			field.Value = string(value)
+			record.Fields = append(record.Fields, field)
+			continue
+		}
+
+		if len(value) < 2 {
+			return nil, fmt.Errorf("%w: field %s has no indicators", ErrInvalidRecord, tag)
+		}
+		field.Indicators = [2]byte{value[0], value[1]}
+		for _, subfield := range bytes.Split(value[2:], []byte{SubfieldDelimiter}) {
+			if len(subfield) == 0 {
+				continue
+			}
+			field.Subfields = append(field.Subfields, Subfield{Code: subfield[0], Value: string(subfield[1:])})
+		}
+		record.Fields = append(record.Fields, field)
+	}
+
+	return record, nil
+}
+
+// parseNumber reads a fixed width number of the leader or directory, every byte must be a digit
+// so signs and spaces cannot turn an offset negative
+func parseNumber(digits []byte) (int, bool) {
+	if len(digits) == 0 {
+		return 0, false
+	}
+
+	n := 0
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return 0, false
+		}
+		n = n*10 + int(digit-'0')
+	}
+	return n, true
+}
@@ -0,0 +1,128 @@
+package marc21
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func testRecord() *Record {
+	record := &Record{}
+	record.AddControlField("001", "book-1")
+	record.Fields = append(record.Fields, Field{
+		Tag:        "245",
+		Indicators: [2]byte{'1', '0'},
+		Subfields:  []Subfield{{Code: 'a', Value: "Dune"}, {Code: 'c', Value: "Frank Herbert"}},
+	})
+	return record
+}
+
+func encode(t *testing.T, records ...*Record) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := NewWriter(&buf)
+	for _, record := range records {
+		if err := writer.Write(record); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+// rawRecord assembles a record from a directory written by hand, the leader is filled in around it
+func rawRecord(directory, data string) []byte {
+	baseAddress := leaderLength + len(directory) + 1
+	length := baseAddress + len(data) + 1
+	leader := fmt.Sprintf("%05dnam a22%05d   4500", length, baseAddress)
+	return []byte(leader + directory + string(FieldTerminator) + data + string(RecordTerminator))
+}
+
+func TestReaderReadsWrittenRecords(t *testing.T) {
+	data := encode(t, testRecord(), testRecord())
+	reader := NewReader(bytes.NewReader(data))
+
+	for i := 0; i < 2; i++ {
+		record, err := reader.Read()
+		if err != nil {
+			t.Fatalf("Read() #%d error = %v", i, err)
+		}
+		if got := record.Field("001"); got == nil || got.Value != "book-1" {
+			t.Fatalf("001 = %+v, want book-1", got)
+		}
+		title := record.Field("245")
+		if title == nil || title.Indicators != [2]byte{'1', '0'} || title.Subfield('a') != "Dune" || title.Subfield('c') != "Frank Herbert" {
+			t.Fatalf("245 = %+v, want the title field", title)
+		}
+	}
+	if _, err := reader.Read(); !errors.Is(err, io.EOF) {
+		t.Fatalf("Read() after the last record error = %v, want io.EOF", err)
+	}
+}
+
+func TestParseRecordRejectsMalformedRecords(t *testing.T) {
+	field := "10" + string(SubfieldDelimiter) + "aDune" + string(FieldTerminator)
+	valid := rawRecord("245"+fmt.Sprintf("%04d%05d", len(field), 0), field)
+
+	withLeader := func(from, to int, value string) []byte {
+		record := bytes.Clone(valid)
+		copy(record[from:to], value)
+		return record
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "shorter than the leader", data: []byte("00010nam")},
+		{name: "negative base address", data: withLeader(12, 17, "-0037")},
+		{name: "signed base address", data: withLeader(12, 17, "+0037")},
+		{name: "base address inside the leader", data: withLeader(12, 17, "00010")},
+		{name: "base address past the end", data: withLeader(12, 17, "99999")},
+		{name: "directory not a multiple of the entry length", data: rawRecord("2450009000", field)},
+		{name: "negative start", data: rawRecord("2450001-9999", field)},
+		{name: "negative length", data: rawRecord("245-00100000", field)},
+		{name: "signed start", data: rawRecord("2450009+0000", field)},
+		{name: "length with spaces", data: rawRecord("245  0900000", field)},
+		{name: "field past the end", data: rawRecord("245900000000", field)},
+		{name: "data field without indicators", data: rawRecord("245000100000", string(FieldTerminator))},
+	}
+
+	if _, err := parseRecord(valid); err != nil {
+		t.Fatalf("parseRecord(valid) error = %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseRecord(tt.data); !errors.Is(err, ErrInvalidRecord) {
+				t.Fatalf("parseRecord() error = %v, want ErrInvalidRecord", err)
+			}
+		})
+	}
+}
+
+func TestReaderContinuesAfterMalformedRecord(t *testing.T) {
+	malformed := rawRecord("245-001-9999", "10"+string(FieldTerminator))
+	data := append(malformed, encode(t, testRecord())...)
+	reader := NewReader(bytes.NewReader(data))
+
+	if _, err := reader.Read(); !errors.Is(err, ErrInvalidRecord) {
+		t.Fatalf("Read() error = %v, want ErrInvalidRecord", err)
+	}
+	record, err := reader.Read()
+	if err != nil || record.Field("001") == nil {
+		t.Fatalf("Read() after a malformed record = %+v, %v, want the next record", record, err)
+	}
+}
+
+func TestReaderRejectsMissingRecordTerminator(t *testing.T) {
+	data := encode(t, testRecord())
+	reader := NewReader(bytes.NewReader(data[:len(data)-1]))
+
+	if _, err := reader.Read(); !errors.Is(err, ErrInvalidRecord) {
+		t.Fatalf("Read() error = %v, want ErrInvalidRecord", err)
+	}
+}
@@ -0,0 +1,92 @@
+package marc21
+
+import "errors"
+
+// Delimiters of the ISO 2709 exchange format
+const (
+	SubfieldDelimiter byte = 0x1F
+	FieldTerminator   byte = 0x1E
+	RecordTerminator  byte = 0x1D
+
+	leaderLength         = 24
+	directoryEntryLength = 12
+)
+
+var (
+	ErrInvalidRecord  = errors.New("invalid MARC21 record")
+	ErrRecordTooLarge = errors.New("MARC21 record exceeds 99999 bytes")
+)
+
+// Record is a MARC21 bibliographic record, the fields keep the order they were read or added in
+type Record struct {
+	Leader string
+	Fields []Field
+}
+
+// Field is a control field (tags 001 to 009) with a plain value, or a data field with indicators and subfields
+type Field struct {
+	Tag        string
+	Indicators [2]byte
+	Value      string
+	Subfields  []Subfield
+}
+
+type Subfield struct {
+	Code  byte
+	Value string
+}
+
+// IsControl tells whether the field is a control field, which has neither indicators nor subfields
+func (f *Field) IsControl() bool {
+	return f.Tag < "010"
+}
+
+// Subfield returns the value of the first subfield with the code, empty when there is none
+func (f *Field) Subfield(code byte) string {
+	for _, subfield := range f.Subfields {
+		if subfield.Code == code {
+			return subfield.Value
+		}
+	}
+	return ""
+}
+
+// Field returns the first field with the tag, nil when there is none
+func (r *Record) Field(tag string) *Field {
+	for i := range r.Fields {
+		if r.Fields[i].Tag == tag {
+			return &r.Fields[i]
+		}
+	}
+	return nil
+}
+
+// FieldsByTag returns every field with the tag in record order
+func (r *Record) FieldsByTag(tag string) []*Field {
+	var fields []*Field
+	for i := range r.Fields {
+		if r.Fields[i].Tag == tag {
+			fields = append(fields, &r.Fields[i])
+		}
+	}
+	return fields
+}
+
+// AddControlField appends a control field
+func (r *Record) AddControlField(tag, value string) {
+	r.Fields = append(r.Fields, Field{Tag: tag, Value: value})
+}
+
+// AddDataField appends a data field, subfields with an empty value are left out
+// and the field is skipped altogether when none is left
+func (r *Record) AddDataField(tag string, ind1, ind2 byte, subfields ...Subfield) {
+	field := Field{Tag: tag, Indicators: [2]byte{ind1, ind2}}
+	for _, subfield := range subfields {
+		if subfield.Value != "" {
+			field.Subfields = append(field.Subfields, subfield)
+		}
+	}
+	if len(field.Subfields) > 0 {
+		r.Fields = append(r.Fields, field)
+	}
+}
@@ -0,0 +1,73 @@
+package marc21
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// defaultLeader describes a new bibliographic record of a monograph in UTF-8
+const defaultLeader = "00000nam a2200000   4500"
+
+// Writer writes records in the ISO 2709 exchange format
+type Writer struct {
+	writer *bufio.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		writer: bufio.NewWriter(w),
+	}
+}
+
+// Write encodes the record, the record length, base address and entry map of the leader are computed
+func (w *Writer) Write(record *Record) error {
+	var directory, data bytes.Buffer
+	for _, field := range record.Fields {
+		start := data.Len()
+		if field.IsControl() {
+			data.WriteString(field.Value)
+		} else {
+			data.Write(field.Indicators[:])
+			for _, subfield := range field.Subfields {
+				data.WriteByte(SubfieldDelimiter)
+				data.WriteByte(subfield.Code)
+				data.WriteString(subfield.Value)
+			}
+		}
+		data.WriteByte(FieldTerminator)
+
+		length := data.Len() - start
+		if length > 9999 {
+			return fmt.Errorf("%w: field %s is %d bytes long", ErrRecordTooLarge, field.Tag, length)
+		}
+		fmt.Fprintf(&directory, "%-3.3s%04d%05d", field.Tag, length, start)
+	}
+
+	baseAddress := leaderLength + directory.Len() + 1
+	recordLength := baseAddress + data.Len() + 1
+	if recordLength > 99999 {
+		return ErrRecordTooLarge
+	}
+
+	leader := []byte(defaultLeader)
+	if len(record.Leader) == leaderLength {
+		copy(leader, record.Leader)
+	}
+	copy(leader[0:5], fmt.Sprintf("%05d", recordLength))
+	copy(leader[10:12], "22")
+	copy(leader[12:17], fmt.Sprintf("%05d", baseAddress))
+	copy(leader[20:24], "4500")
+
+	w.writer.Write(leader)
+	w.writer.Write(directory.Bytes())
+	w.writer.WriteByte(FieldTerminator)
+	w.writer.Write(data.Bytes())
+	return w.writer.WriteByte(RecordTerminator)
+}
+
+// Flush writes any buffered records to the underlying writer
+func (w *Writer) Flush() error {
+	return w.writer.Flush()
+}
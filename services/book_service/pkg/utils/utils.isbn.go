@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidISBN = errors.New("invalid ISBN, expected a valid ISBN-10 or ISBN-13")
+
+// NormalizeISBN validates an ISBN-10 or ISBN-13 checksum and returns the number as ISBN-13 without separators.
+// Hyphens and spaces are ignored, an empty input stays empty.
+func NormalizeISBN(raw string) (string, error) {
+	isbn := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(raw))
+
+	switch len(isbn) {
+	case 0:
+		return "", nil
+	case 10:
+		if !isValidISBN10(isbn) {
+			return "", ErrInvalidISBN
+		}
+		// ISBN-10 numbers live in the 978 prefix of ISBN-13
+		isbn13 := "978" + isbn[:9]
+		return isbn13 + string(isbn13CheckDigit(isbn13)), nil
+	case 13:
+		if !isDigits(isbn) || (!strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979")) || isbn13CheckDigit(isbn[:12]) != isbn[12] {
+			return "", ErrInvalidISBN
+		}
+		return isbn, nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+// isValidISBN10 checks the mod 11 checksum, the check digit may be X for 10
+func isValidISBN10(isbn string) bool {
+	if !isDigits(isbn[:9]) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(isbn[i]-'0') * (10 - i)
+	}
+	switch check := isbn[9]; {
+	case check == 'X':
+		sum += 10
+	case check >= '0' && check <= '9':
+		sum += int(check - '0')
+	default:
+		return false
+	}
+
+	return sum%11 == 0
+}
+
+// isbn13CheckDigit computes the check digit of the first 12 digits, weighted alternately by 1 and 3
+func isbn13CheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(digits[i]-'0') * weight
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
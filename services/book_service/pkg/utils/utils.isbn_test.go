@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeISBN(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", raw: "", want: ""},
+		{name: "isbn-13 with hyphens", raw: "978-0-306-40615-7", want: "9780306406157"},
+		{name: "isbn-13 with spaces", raw: "978 0 306 40615 7", want: "9780306406157"},
+		{name: "isbn-13 with 979 prefix", raw: "979-10-90636-07-1", want: "9791090636071"},
+		{name: "isbn-10 converted", raw: "0-306-40615-2", want: "9780306406157"},
+		{name: "isbn-10 with X check digit", raw: "0-8044-2957-X", want: "9780804429573"},
+		{name: "isbn-10 with lower case x", raw: "080442957x", want: "9780804429573"},
+		{name: "isbn-13 wrong check digit", raw: "9780306406158", wantErr: true},
+		{name: "isbn-13 unknown prefix", raw: "9770306406157", wantErr: true},
+		{name: "isbn-13 with letter", raw: "97803064061X7", wantErr: true},
+		{name: "isbn-10 wrong check digit", raw: "0306406153", wantErr: true},
+		{name: "isbn-10 with X inside", raw: "08044295X7", wantErr: true},
+		{name: "isbn-10 with letter", raw: "03064O6152", wantErr: true},
+		{name: "too short", raw: "978030640615", wantErr: true},
+		{name: "too long", raw: "97803064061577", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeISBN(tt.raw)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidISBN) {
+					t.Fatalf("NormalizeISBN(%q) = %q, %v, want ErrInvalidISBN", tt.raw, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("NormalizeISBN(%q) = %q, %v, want %q", tt.raw, got, err, tt.want)
+			}
+		})
+	}
+}
@@ -27,6 +27,10 @@ type AuthorServiceClient interface {
 	ListAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error)
 	UpdateAuthor(ctx context.Context, in *UpdateAuthorRequest, opts ...grpc.CallOption) (*UpdateAuthorResponse, error)
 	DeleteAuthor(ctx context.Context, in *DeleteAuthorRequest, opts ...grpc.CallOption) (*DeleteAuthorResponse, error)
+	ListDeletedAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error)
+	RestoreAuthor(ctx context.Context, in *RestoreAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error)
+	MergeAuthors(ctx context.Context, in *MergeAuthorsRequest, opts ...grpc.CallOption) (*MergeAuthorsResponse, error)
+	ResolveAuthor(ctx context.Context, in *ResolveAuthorRequest, opts ...grpc.CallOption) (*ResolveAuthorResponse, error)
 }
 
 type authorServiceClient struct {
@@ -82,6 +86,42 @@ func (c *authorServiceClient) DeleteAuthor(ctx context.Context, in *DeleteAuthor
 	return out, nil
 }
 
+func (c *authorServiceClient) ListDeletedAuthors(ctx context.Context, in *ListAuthorsRequest, opts ...grpc.CallOption) (*ListAuthorsResponse, error) {
+	out := new(ListAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/author_service.AuthorService/ListDeletedAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) RestoreAuthor(ctx context.Context, in *RestoreAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error) {
+	out := new(GetAuthorResponse)
+	err := c.cc.Invoke(ctx, "/author_service.AuthorService/RestoreAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) MergeAuthors(ctx context.Context, in *MergeAuthorsRequest, opts ...grpc.CallOption) (*MergeAuthorsResponse, error) {
+	out := new(MergeAuthorsResponse)
+	err := c.cc.Invoke(ctx, "/author_service.AuthorService/MergeAuthors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorServiceClient) ResolveAuthor(ctx context.Context, in *ResolveAuthorRequest, opts ...grpc.CallOption) (*ResolveAuthorResponse, error) {
+	out := new(ResolveAuthorResponse)
+	err := c.cc.Invoke(ctx, "/author_service.AuthorService/ResolveAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthorServiceServer is the server API for AuthorService service.
 // All implementations must embed UnimplementedAuthorServiceServer
 // for forward compatibility
@@ -91,6 +131,10 @@ type AuthorServiceServer interface {
 	ListAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error)
 	UpdateAuthor(context.Context, *UpdateAuthorRequest) (*UpdateAuthorResponse, error)
 	DeleteAuthor(context.Context, *DeleteAuthorRequest) (*DeleteAuthorResponse, error)
+	ListDeletedAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error)
+	RestoreAuthor(context.Context, *RestoreAuthorRequest) (*GetAuthorResponse, error)
+	MergeAuthors(context.Context, *MergeAuthorsRequest) (*MergeAuthorsResponse, error)
+	ResolveAuthor(context.Context, *ResolveAuthorRequest) (*ResolveAuthorResponse, error)
 	mustEmbedUnimplementedAuthorServiceServer()
 }
 
@@ -113,6 +157,18 @@ func (UnimplementedAuthorServiceServer) UpdateAuthor(context.Context, *UpdateAut
 func (UnimplementedAuthorServiceServer) DeleteAuthor(context.Context, *DeleteAuthorRequest) (*DeleteAuthorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAuthor not implemented")
 }
+func (UnimplementedAuthorServiceServer) ListDeletedAuthors(context.Context, *ListAuthorsRequest) (*ListAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedAuthors not implemented")
+}
+func (UnimplementedAuthorServiceServer) RestoreAuthor(context.Context, *RestoreAuthorRequest) (*GetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAuthor not implemented")
+}
+func (UnimplementedAuthorServiceServer) MergeAuthors(context.Context, *MergeAuthorsRequest) (*MergeAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAuthors not implemented")
+}
+func (UnimplementedAuthorServiceServer) ResolveAuthor(context.Context, *ResolveAuthorRequest) (*ResolveAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAuthor not implemented")
+}
 func (UnimplementedAuthorServiceServer) mustEmbedUnimplementedAuthorServiceServer() {}
 
 // UnsafeAuthorServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +272,78 @@ func _AuthorService_DeleteAuthor_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthorService_ListDeletedAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).ListDeletedAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/author_service.AuthorService/ListDeletedAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).ListDeletedAuthors(ctx, req.(*ListAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_RestoreAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).RestoreAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/author_service.AuthorService/RestoreAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).RestoreAuthor(ctx, req.(*RestoreAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_MergeAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAuthorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).MergeAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/author_service.AuthorService/MergeAuthors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).MergeAuthors(ctx, req.(*MergeAuthorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthorService_ResolveAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorServiceServer).ResolveAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/author_service.AuthorService/ResolveAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorServiceServer).ResolveAuthor(ctx, req.(*ResolveAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthorService_ServiceDesc is the grpc.ServiceDesc for AuthorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +371,22 @@ var AuthorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAuthor",
 			Handler:    _AuthorService_DeleteAuthor_Handler,
 		},
+		{
+			MethodName: "ListDeletedAuthors",
+			Handler:    _AuthorService_ListDeletedAuthors_Handler,
+		},
+		{
+			MethodName: "RestoreAuthor",
+			Handler:    _AuthorService_RestoreAuthor_Handler,
+		},
+		{
+			MethodName: "MergeAuthors",
+			Handler:    _AuthorService_MergeAuthors_Handler,
+		},
+		{
+			MethodName: "ResolveAuthor",
+			Handler:    _AuthorService_ResolveAuthor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "author_service.proto",
@@ -2,9 +2,11 @@ package main
 
 import (
 	"category_service/configs"
+	"category_service/internal/clients"
 	"category_service/internal/constants"
 	"category_service/internal/grpc_server"
 	"category_service/internal/repository"
+	"category_service/internal/scheduler"
 	"category_service/internal/service"
 	loggerPackage "category_service/pkg/logger"
 	"category_service/pkg/rabbitmq"
@@ -77,9 +79,21 @@ func main() {
 	}
 	defer logger.Close()
 
+	// Clients
+	bookClient, err := clients.NewBookClient()
+	if err != nil {
+		log.Fatalf("Failed to establish book client connection %v", err)
+	}
+
 	// Repository and Service Layer
 	categoryRepo := repository.NewCategoryRepository(db)
-	categoryService := service.NewCategoryService(categoryRepo)
+	categoryService := service.NewCategoryService(categoryRepo, bookClient)
+
+	// Background workers share one context so they stop together
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	trashPurger := scheduler.NewTrashPurger(categoryService, logger, time.Duration(configs.AppConfig.TrashPurgeInterval)*time.Minute, time.Duration(configs.AppConfig.TrashRetentionDays)*24*time.Hour)
+	go trashPurger.Start(workerCtx)
 
 	// gRPC Server
 	address := fmt.Sprintf(":%s", configs.AppConfig.GrpcPort)
@@ -126,6 +140,9 @@ func main() {
 	grpcServer.GracefulStop()
 	log.Println("gRPC server stopped")
 
+	// Stop the background workers
+	stopWorkers()
+
 	// Perform additional cleanup tasks ???
 	// ...
 
@@ -0,0 +1,73 @@
+package clients
+
+import (
+	"category_service/configs"
+	protoBook "category_service/proto/book_service"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// BookClient reaches the books referencing a category, the book service owns them
+type BookClient interface {
+	CountBooksByCategory(ctx context.Context, categoryId string) (int, error)
+	ReassignBooksByCategory(ctx context.Context, categoryId string, newCategoryId string) (int, error)
+	DeleteBooksByCategory(ctx context.Context, categoryId string) (int, error)
+}
+
+type bookClient struct {
+	client protoBook.BookServiceClient
+}
+
+func NewBookClient() (BookClient, error) {
+	conn, err := grpc.NewClient(configs.AppConfig.BookServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	client := protoBook.NewBookServiceClient(conn)
+	return &bookClient{
+		client: client,
+	}, nil
+}
+
+func (b *bookClient) CountBooksByCategory(ctx context.Context, categoryId string) (int, error) {
+	reqProto := protoBook.CountBooksByCategoryRequest{
+		CategoryId: categoryId,
+	}
+
+	resp, err := b.client.CountBooksByCategory(ctx, &reqProto)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.TotalBooks), nil
+}
+
+func (b *bookClient) ReassignBooksByCategory(ctx context.Context, categoryId string, newCategoryId string) (int, error) {
+	reqProto := protoBook.ReassignBooksByCategoryRequest{
+		CategoryId:    categoryId,
+		NewCategoryId: newCategoryId,
+	}
+
+	resp, err := b.client.ReassignBooksByCategory(ctx, &reqProto)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.AffectedBooks), nil
+}
+
+func (b *bookClient) DeleteBooksByCategory(ctx context.Context, categoryId string) (int, error) {
+	reqProto := protoBook.DeleteBooksByCategoryRequest{
+		CategoryId: categoryId,
+	}
+
+	resp, err := b.client.DeleteBooksByCategory(ctx, &reqProto)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.AffectedBooks), nil
+}
@@ -3,11 +3,13 @@ package grpc_server
 import (
 	"category_service/internal/constants"
 	"category_service/internal/models"
+	"category_service/internal/repository"
 	"category_service/internal/service"
 	"category_service/pkg/logger"
 	"category_service/pkg/utils"
 	protoCategory "category_service/proto/category_service"
 	"context"
+	"errors"
 	"fmt"
 
 	"google.golang.org/grpc/codes"
@@ -29,29 +31,23 @@ func NewCategoryGRPCServer(categoryService service.CategoryService, logger *logg
 
 func (s *categoryGRPCServer) CreateCategory(ctx context.Context, req *protoCategory.CreateCategoryRequest) (*protoCategory.CreateCategoryResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CreateCategory request", map[string]interface{}{"name": req.Name}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CreateCategory request", map[string]interface{}{"name": req.Name, "parent_id": req.ParentId}, nil)
 
 	if err := req.Validate(); err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CreateCategory request", nil, err)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	createdCategory, err := s.categoryService.CreateCategory(ctx, &models.CategoryRequest{Name: req.Name})
+	createdCategory, err := s.categoryService.CreateCategory(ctx, &models.CategoryRequest{Name: req.Name, ParentId: req.ParentId})
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to create category", nil, err)
-		return nil, status.Error(codes.Internal, "failed to create new category")
+		return nil, categoryError(err, "failed to create new category")
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category created successfully", map[string]interface{}{"category_id": createdCategory.Id}, nil)
 
 	return &protoCategory.CreateCategoryResponse{
-		Category: &protoCategory.Category{
-			Id:        createdCategory.Id,
-			Name:      createdCategory.Name,
-			Version:   int32(createdCategory.Version),
-			CreatedAt: createdCategory.CreatedAt.Unix(),
-			UpdatedAt: createdCategory.UpdatedAt.Unix(),
-		},
+		Category: toProtoCategory(createdCategory),
 	}, nil
 }
 
@@ -67,19 +63,13 @@ func (s *categoryGRPCServer) GetCategory(ctx context.Context, req *protoCategory
 	category, err := s.categoryService.GetCategory(ctx, req.Id)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retrieve category with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to retrieve category with id '%s'", req.Id))
+		return nil, categoryError(err, fmt.Sprintf("failed to retrieve category with id '%s'", req.Id))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category retrieved successfully", map[string]interface{}{"category_id": category.Id}, nil)
 
 	return &protoCategory.GetCategoryResponse{
-		Category: &protoCategory.Category{
-			Id:        category.Id,
-			Name:      category.Name,
-			Version:   int32(category.Version),
-			CreatedAt: category.CreatedAt.Unix(),
-			UpdatedAt: category.UpdatedAt.Unix(),
-		},
+		Category: toProtoCategory(category),
 	}, nil
 }
 
@@ -100,13 +90,7 @@ func (s *categoryGRPCServer) ListCategories(ctx context.Context, req *protoCateg
 
 	var protoCategories []*protoCategory.Category
 	for _, category := range categories {
-		protoCategories = append(protoCategories, &protoCategory.Category{
-			Id:        category.Id,
-			Name:      category.Name,
-			Version:   int32(category.Version),
-			CreatedAt: category.CreatedAt.Unix(),
-			UpdatedAt: category.UpdatedAt.Unix(),
-		})
+		protoCategories = append(protoCategories, toProtoCategory(category))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Categories list retrieved successfully", nil, nil)
@@ -120,7 +104,7 @@ func (s *categoryGRPCServer) ListCategories(ctx context.Context, req *protoCateg
 
 func (s *categoryGRPCServer) UpdateCategory(ctx context.Context, req *protoCategory.UpdateCategoryRequest) (*protoCategory.UpdateCategoryResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateCategory request", map[string]interface{}{"category_id": req.Id, "name": req.Name}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateCategory request", map[string]interface{}{"category_id": req.Id, "name": req.Name, "parent_id": req.ParentId}, nil)
 
 	if err := req.Validate(); err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UpdateCategory request", nil, err)
@@ -128,45 +112,166 @@ func (s *categoryGRPCServer) UpdateCategory(ctx context.Context, req *protoCateg
 	}
 
 	updatedCategory, err := s.categoryService.UpdateCategory(ctx, req.Id, &models.CategoryRequest{
-		Name:    req.Name,
-		Version: int(req.Version),
+		Name:     req.Name,
+		ParentId: req.ParentId,
+		Version:  int(req.Version),
 	})
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to update category with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update category with id '%s'", req.Id))
+		return nil, categoryError(err, fmt.Sprintf("failed to update category with id '%s'", req.Id))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category updated successfully", map[string]interface{}{"category_id": updatedCategory.Id}, nil)
 
 	return &protoCategory.UpdateCategoryResponse{
-		Category: &protoCategory.Category{
-			Id:        updatedCategory.Id,
-			Name:      updatedCategory.Name,
-			Version:   req.Version,
-			CreatedAt: updatedCategory.CreatedAt.Unix(),
-			UpdatedAt: updatedCategory.UpdatedAt.Unix(),
-		},
+		Category: toProtoCategory(updatedCategory),
 	}, nil
 }
 
 func (s *categoryGRPCServer) DeleteCategory(ctx context.Context, req *protoCategory.DeleteCategoryRequest) (*protoCategory.DeleteCategoryResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteCategory request", map[string]interface{}{"category_id": req.Id}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received DeleteCategory request", map[string]interface{}{"category_id": req.Id, "on_books": req.OnBooks}, nil)
 
 	if err := req.Validate(); err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid DeleteCategory request", nil, err)
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	err := s.categoryService.DeleteCategory(ctx, req.Id, int(req.Version))
+	affectedBooks, err := s.categoryService.DeleteCategory(ctx, req.Id, &models.CategoryDeleteRequest{
+		Version:    int(req.Version),
+		OnBooks:    req.OnBooks,
+		ReassignTo: req.ReassignTo,
+	})
 	if err != nil {
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to delete category with id '%s'", req.Id), nil, err)
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete category with id '%s'", req.Id))
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to delete category with id '%s'", req.Id), map[string]interface{}{"on_books": req.OnBooks, "reassign_to": req.ReassignTo}, err)
+		return nil, categoryError(err, fmt.Sprintf("failed to delete category with id '%s'", req.Id))
 	}
 
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category deleted successfully", map[string]interface{}{"category_id": req.Id}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category deleted successfully", map[string]interface{}{"category_id": req.Id, "on_books": req.OnBooks, "affected_books": affectedBooks}, nil)
 
 	return &protoCategory.DeleteCategoryResponse{
-		Message: fmt.Sprintf("success delete category with id %s", req.Id),
+		Message:       fmt.Sprintf("success delete category with id %s", req.Id),
+		AffectedBooks: int32(affectedBooks),
+	}, nil
+}
+
+func (s *categoryGRPCServer) ResolveCategory(ctx context.Context, req *protoCategory.ResolveCategoryRequest) (*protoCategory.ResolveCategoryResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ResolveCategory request", map[string]interface{}{"name": req.Name}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ResolveCategory request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	category, created, err := s.categoryService.ResolveCategory(ctx, req.Name)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to resolve category with name '%s'", req.Name), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to resolve category with name '%s'", req.Name))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category resolved successfully", map[string]interface{}{"category_id": category.Id, "created": created}, nil)
+
+	return &protoCategory.ResolveCategoryResponse{
+		Category: toProtoCategory(category),
+		Created:  created,
+	}, nil
+}
+
+func (s *categoryGRPCServer) GetCategoryTree(ctx context.Context, req *protoCategory.GetCategoryTreeRequest) (*protoCategory.GetCategoryTreeResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetCategoryTree request", map[string]interface{}{"root_id": req.RootId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid GetCategoryTree request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	nodes, err := s.categoryService.GetCategoryTree(ctx, req.RootId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve category tree", nil, err)
+		return nil, categoryError(err, "failed to retrieve category tree")
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category tree retrieved successfully", map[string]interface{}{"roots": len(nodes)}, nil)
+
+	return &protoCategory.GetCategoryTreeResponse{
+		Nodes: toProtoCategoryNodes(nodes),
+	}, nil
+}
+
+func (s *categoryGRPCServer) ListSubcategories(ctx context.Context, req *protoCategory.ListSubcategoriesRequest) (*protoCategory.ListSubcategoriesResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListSubcategories request", map[string]interface{}{"parent_id": req.ParentId, "recursive": req.Recursive}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListSubcategories request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	categories, err := s.categoryService.ListSubcategories(ctx, req.ParentId, req.Recursive)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retrieve subcategories of category with id '%s'", req.ParentId), nil, err)
+		return nil, categoryError(err, fmt.Sprintf("failed to retrieve subcategories of category with id '%s'", req.ParentId))
+	}
+
+	protoCategories := make([]*protoCategory.Category, 0, len(categories))
+	for _, category := range categories {
+		protoCategories = append(protoCategories, toProtoCategory(category))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Subcategories retrieved successfully", map[string]interface{}{"parent_id": req.ParentId, "count": len(categories)}, nil)
+
+	return &protoCategory.ListSubcategoriesResponse{
+		Categories: protoCategories,
 	}, nil
 }
+
+func categoryError(err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrParentCategoryNotFound), errors.Is(err, repository.ErrCategoryCycle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrCategoryHasSubcategories), errors.Is(err, service.ErrCategoryHasBooks), errors.Is(err, service.ErrParentCategoryDeleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, repository.ErrCategoryVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrReassignTargetRequired), errors.Is(err, service.ErrReassignTargetNotFound), errors.Is(err, service.ErrReassignToSelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrMergeIntoSelf), errors.Is(err, service.ErrMergeDuplicateRepeated):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Errors returned by the book service keep their code
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+	return status.Error(codes.Internal, message)
+}
+
+func toProtoCategory(category *models.CategoryRecord) *protoCategory.Category {
+	c := &protoCategory.Category{
+		Id:        category.Id,
+		Name:      category.Name,
+		ParentId:  category.ParentId,
+		Version:   int32(category.Version),
+		CreatedAt: category.CreatedAt.Unix(),
+		UpdatedAt: category.UpdatedAt.Unix(),
+	}
+	if category.DeletedAt != nil {
+		c.DeletedAt = category.DeletedAt.Unix()
+	}
+	return c
+}
+
+func toProtoCategoryNodes(nodes []*models.CategoryNode) []*protoCategory.CategoryNode {
+	protoNodes := make([]*protoCategory.CategoryNode, 0, len(nodes))
+	for _, node := range nodes {
+		protoNodes = append(protoNodes, &protoCategory.CategoryNode{
+			Category: toProtoCategory(node.Category),
+			Children: toProtoCategoryNodes(node.Children),
+		})
+	}
+	return protoNodes
+}
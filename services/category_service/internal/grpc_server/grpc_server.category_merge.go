@@ -0,0 +1,48 @@
+package grpc_server
+
+import (
+	"category_service/internal/constants"
+	"category_service/internal/models"
+	"category_service/pkg/utils"
+	protoCategory "category_service/proto/category_service"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *categoryGRPCServer) MergeCategories(ctx context.Context, req *protoCategory.MergeCategoriesRequest) (*protoCategory.MergeCategoriesResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received MergeCategories request", map[string]interface{}{"survivor_id": req.SurvivorId, "duplicates": len(req.Duplicates)}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid MergeCategories request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	duplicates := make([]models.MergeDuplicate, 0, len(req.Duplicates))
+	for _, duplicate := range req.Duplicates {
+		duplicates = append(duplicates, models.MergeDuplicate{
+			Id:      duplicate.Id,
+			Version: int(duplicate.Version),
+		})
+	}
+
+	survivor, affectedBooks, err := s.categoryService.MergeCategories(ctx, req.SurvivorId, &models.CategoryMergeRequest{
+		SurvivorVersion: int(req.SurvivorVersion),
+		Duplicates:      duplicates,
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to merge categories into category with id '%s'", req.SurvivorId), nil, err)
+		return nil, categoryError(err, fmt.Sprintf("failed to merge categories into category with id '%s'", req.SurvivorId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Categories merged successfully", map[string]interface{}{"survivor_id": survivor.Id, "duplicates": len(duplicates), "affected_books": affectedBooks}, nil)
+
+	return &protoCategory.MergeCategoriesResponse{
+		Survivor:      toProtoCategory(survivor),
+		AffectedBooks: int32(affectedBooks),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package grpc_server
+
+import (
+	"category_service/internal/constants"
+	"category_service/pkg/utils"
+	protoCategory "category_service/proto/category_service"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *categoryGRPCServer) ListDeletedCategories(ctx context.Context, req *protoCategory.ListCategoriesRequest) (*protoCategory.ListCategoriesResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListDeletedCategories request", nil, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListDeletedCategories request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	categories, totalItems, err := s.categoryService.ListDeletedCategories(ctx)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve deleted categories list", nil, err)
+		return nil, status.Error(codes.Internal, "failed to retrieve deleted category list")
+	}
+
+	var protoCategories []*protoCategory.Category
+	for _, category := range categories {
+		protoCategories = append(protoCategories, toProtoCategory(category))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Deleted categories list retrieved successfully", nil, nil)
+
+	return &protoCategory.ListCategoriesResponse{
+		Categories: protoCategories,
+		TotalItems: int32(totalItems),
+		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+	}, nil
+}
+
+func (s *categoryGRPCServer) RestoreCategory(ctx context.Context, req *protoCategory.RestoreCategoryRequest) (*protoCategory.GetCategoryResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RestoreCategory request", map[string]interface{}{"category_id": req.Id}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RestoreCategory request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	category, err := s.categoryService.RestoreCategory(ctx, req.Id)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to restore category with id '%s'", req.Id), nil, err)
+		return nil, categoryError(err, fmt.Sprintf("failed to restore category with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Category restored successfully", map[string]interface{}{"category_id": category.Id}, nil)
+
+	return &protoCategory.GetCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
@@ -3,9 +3,17 @@ package models
 import "time"
 
 type CategoryRecord struct {
-	Id        string    `db:"id"`
-	Name      string    `db:"name"`
-	Version   int       `db:"version"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	Id        string     `db:"id"`
+	Name      string     `db:"name"`
+	ParentId  string     `db:"parent_id"` // empty for a top-level category
+	Version   int        `db:"version"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	DeletedAt *time.Time `db:"deleted_at"` // nil unless the category is in the trash
+}
+
+// CategoryNode is a category with its subcategories
+type CategoryNode struct {
+	Category *CategoryRecord
+	Children []*CategoryNode // sorted by name
 }
@@ -1,6 +1,24 @@
 package models
 
 type CategoryRequest struct {
-	Name    string
+	Name     string
+	ParentId string // empty for a top-level category
+	Version  int
+}
+
+type CategoryDeleteRequest struct {
+	Version    int
+	OnBooks    string // one of the constants.OnBooks values, empty rejects
+	ReassignTo string // the category receiving the books with constants.OnBooksReassign
+}
+
+type CategoryMergeRequest struct {
+	SurvivorVersion int
+	Duplicates      []MergeDuplicate
+}
+
+// MergeDuplicate is a category merged into the survivor, at the version the caller last saw
+type MergeDuplicate struct {
+	Id      string
 	Version int
 }
@@ -10,8 +10,20 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+var (
+	ErrCategoryNotFound         = errors.New("category not found")
+	ErrParentCategoryNotFound   = errors.New("parent category not found")
+	ErrCategoryCycle            = errors.New("a category cannot be placed under itself or one of its subcategories")
+	ErrCategoryHasSubcategories = errors.New("category still has subcategories")
+	ErrCategoryVersionConflict  = errors.New("delete failed due to wrong id or concurrent modification")
+)
+
+// categoryColumns lists the columns of a category in the order they are scanned
+const categoryColumns = `id, name, COALESCE(parent_id::TEXT, '') AS parent_id, version, created_at, updated_at`
+
 type CategoryRepository interface {
 	CreateCategory(ctx context.Context, req *models.CategoryRecord) (*models.CategoryRecord, error)
 	GetCategory(ctx context.Context, id string) (*models.CategoryRecord, error)
@@ -19,6 +31,16 @@ type CategoryRepository interface {
 	UpdateCategory(ctx context.Context, req *models.CategoryRecord) (*models.CategoryRecord, error)
 	DeleteCategory(ctx context.Context, id string, version int) error
 	CountCategories(ctx context.Context) (int, error)
+	ResolveCategoryByName(ctx context.Context, name string) (category *models.CategoryRecord, created bool, err error)
+	ListCategoryTree(ctx context.Context, rootId string) ([]*models.CategoryRecord, error)
+	ListSubcategories(ctx context.Context, parentId string, recursive bool) ([]*models.CategoryRecord, error)
+	ListDeletedCategories(ctx context.Context) ([]*models.CategoryRecord, error)
+	CountDeletedCategories(ctx context.Context) (int, error)
+	GetDeletedCategory(ctx context.Context, id string) (*models.CategoryRecord, error)
+	RestoreCategory(ctx context.Context, id string) (*models.CategoryRecord, error)
+	PurgeDeletedCategories(ctx context.Context, deletedBefore time.Time) (int, error)
+	GetCategoryRedirect(ctx context.Context, oldId string) (survivorId string, err error)
+	MergeCategories(ctx context.Context, survivorId string, survivorVersion int, duplicates []models.MergeDuplicate, moveBooks func(duplicateId string) error) (*models.CategoryRecord, error)
 }
 
 type categoryRepository struct {
@@ -32,22 +54,31 @@ func NewCategoryRepository(db *sqlx.DB) CategoryRepository {
 // CreateCategory inserts a new category into the database and returns the created category.
 func (r *categoryRepository) CreateCategory(ctx context.Context, req *models.CategoryRecord) (*models.CategoryRecord, error) {
 	log.Printf("Executing CreateCategory with name: %s\n", req.Name)
+	// Nothing is inserted under a parent that does not exist or is in the trash
 	query := `INSERT INTO 
-				categories (name) 
-			  VALUES 
-			  	($1) 
+				categories (name, parent_id) 
+			  SELECT 
+			  	$1, NULLIF($2, '')::UUID 
+			  WHERE 
+			  	$2 = '' OR EXISTS (SELECT 1 FROM categories WHERE id = NULLIF($2, '')::UUID AND deleted_at IS NULL) 
 			  RETURNING 
-			  	id, name, version, created_at, updated_at`
+			  	` + categoryColumns
 	category := &models.CategoryRecord{}
 	err := r.db.QueryRowContext(ctx, query,
 		req.Name,
+		req.ParentId,
 	).Scan(
 		&category.Id,
 		&category.Name,
+		&category.ParentId,
 		&category.Version,
 		&category.CreatedAt,
 		&category.UpdatedAt,
 	)
+	if err == sql.ErrNoRows || isForeignKeyViolation(err) {
+		log.Printf("Parent category %s not found\n", req.ParentId)
+		return nil, ErrParentCategoryNotFound
+	}
 	if err != nil {
 		log.Printf("Error in CreateCategory: %v\n", err)
 		return nil, err
@@ -60,24 +91,25 @@ func (r *categoryRepository) CreateCategory(ctx context.Context, req *models.Cat
 func (r *categoryRepository) GetCategory(ctx context.Context, id string) (*models.CategoryRecord, error) {
 	log.Printf("Executing GetCategory for ID: %s\n", id)
 	query := `SELECT 
-				id, name, version, created_at, updated_at 
+				` + categoryColumns + ` 
 			  FROM 
 			  	categories 
 			  WHERE 
-			  	id = $1`
+			  	id = $1 AND deleted_at IS NULL`
 	category := &models.CategoryRecord{}
 	err := r.db.QueryRowContext(ctx, query,
 		id,
 	).Scan(
 		&category.Id,
 		&category.Name,
+		&category.ParentId,
 		&category.Version,
 		&category.CreatedAt,
 		&category.UpdatedAt,
 	)
 	if err == sql.ErrNoRows {
 		log.Printf("Category with ID %s not found\n", id)
-		return nil, errors.New("category not found")
+		return nil, ErrCategoryNotFound
 	}
 	if err != nil {
 		log.Printf("Error in GetCategory for ID %s: %v\n", id, err)
@@ -91,9 +123,11 @@ func (r *categoryRepository) GetCategory(ctx context.Context, id string) (*model
 func (r *categoryRepository) ListCategories(ctx context.Context) ([]*models.CategoryRecord, error) {
 	log.Printf("Executing ListCategories")
 	query := `SELECT 
-				id, name, version, created_at, updated_at 
+				` + categoryColumns + ` 
 			  FROM 
-			  	categories`
+			  	categories 
+			  WHERE 
+			  	deleted_at IS NULL`
 	rows, err := r.db.QueryContext(ctx, query)
 	if err != nil {
 		log.Printf("Error in ListCategories: %v\n", err)
@@ -107,6 +141,7 @@ func (r *categoryRepository) ListCategories(ctx context.Context) ([]*models.Cate
 		if err := rows.Scan(
 			&category.Id,
 			&category.Name,
+			&category.ParentId,
 			&category.Version,
 			&category.CreatedAt,
 			&category.UpdatedAt,
@@ -139,26 +174,7 @@ func (r *categoryRepository) UpdateCategory(ctx context.Context, req *models.Cat
 		defer close(errorChan)
 
 		for attempt := range maxRetries {
-			query := `UPDATE 
-						categories 
-					  SET 
-					  	name = $1 
-					  WHERE 
-					  	id = $2  AND version = $3
-					  RETURNING 
-					  	id, name, version, created_at, updated_at`
-			category := &models.CategoryRecord{}
-			err := r.db.QueryRowContext(ctx, query,
-				req.Name,
-				req.Id,
-				req.Version, // Optimistic locking
-			).Scan(
-				&category.Id,
-				&category.Name,
-				&category.Version,
-				&category.CreatedAt,
-				&category.UpdatedAt,
-			)
+			category, err := r.updateCategory(ctx, req)
 			if err == nil {
 				log.Printf("Updated category with ID %s successfully on attempt %d\n", req.Id, attempt+1)
 				resultChan <- category
@@ -200,16 +216,134 @@ func (r *categoryRepository) UpdateCategory(ctx context.Context, req *models.Cat
 	}
 }
 
-// DeleteCategory deletes a category by ID.
+// updateCategory renames and moves the category in one transaction, sql.ErrNoRows means the version did not match
+func (r *categoryRepository) updateCategory(ctx context.Context, req *models.CategoryRecord) (*models.CategoryRecord, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if req.ParentId != "" {
+		// Moves under a parent are serialized so two concurrent moves cannot close a cycle between them
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('categories:tree'))`); err != nil {
+			return nil, err
+		}
+		if err := checkCategoryParent(ctx, tx, req.Id, req.ParentId); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `UPDATE 
+				categories 
+			  SET 
+			  	name = $1, parent_id = NULLIF($2, '')::UUID 
+			  WHERE 
+			  	id = $3 AND version = $4 AND deleted_at IS NULL
+			  RETURNING 
+			  	` + categoryColumns
+	category := &models.CategoryRecord{}
+	if err := tx.GetContext(ctx, category, query, req.Name, req.ParentId, req.Id, req.Version); err != nil { // Optimistic locking
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// checkCategoryParent walks up from the new parent, it must exist outside the trash and must not be
+// the category or one of its subcategories
+func checkCategoryParent(ctx context.Context, tx *sqlx.Tx, id, parentId string) error {
+	query := `WITH RECURSIVE ancestors AS (
+				SELECT id, parent_id FROM categories WHERE id = $1 AND deleted_at IS NULL
+				UNION ALL
+				SELECT c.id, c.parent_id FROM categories c JOIN ancestors a ON c.id = a.parent_id
+			  )
+			  SELECT 
+			  	COUNT(*) > 0, COALESCE(BOOL_OR(id = $2), FALSE) 
+			  FROM 
+			  	ancestors`
+	var found, cycle bool
+	if err := tx.QueryRowContext(ctx, query, parentId, id).Scan(&found, &cycle); err != nil {
+		log.Printf("Error checking parent %s of category %s: %v\n", parentId, id, err)
+		return err
+	}
+	if !found {
+		return ErrParentCategoryNotFound
+	}
+	if cycle {
+		log.Printf("Moving category %s under %s would create a cycle\n", id, parentId)
+		return ErrCategoryCycle
+	}
+	return nil
+}
+
+// ResolveCategoryByName returns the oldest category with the name ignoring case, or creates it.
+// The advisory lock on the name keeps concurrent imports from creating the same category twice.
+func (r *categoryRepository) ResolveCategoryByName(ctx context.Context, name string) (*models.CategoryRecord, bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting ResolveCategoryByName transaction: %v\n", err)
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('categories:' || LOWER($1)))`, name); err != nil {
+		log.Printf("Error locking category name %s: %v\n", name, err)
+		return nil, false, err
+	}
+
+	query := `SELECT 
+				` + categoryColumns + ` 
+			  FROM 
+			  	categories 
+			  WHERE 
+			  	LOWER(name) = LOWER($1) AND deleted_at IS NULL 
+			  ORDER BY 
+			  	created_at 
+			  LIMIT 1`
+
+	category := &models.CategoryRecord{}
+	created := false
+	err = tx.GetContext(ctx, category, query, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		query = `INSERT INTO 
+					categories (name) 
+				 VALUES 
+				 	($1) 
+				 RETURNING 
+				 	` + categoryColumns
+		err = tx.GetContext(ctx, category, query, name)
+		created = true
+	}
+	if err != nil {
+		log.Printf("Error resolving category by name %s: %v\n", name, err)
+		return nil, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing ResolveCategoryByName transaction: %v\n", err)
+		return nil, false, err
+	}
+
+	log.Printf("Resolved category %s to ID: %s (created: %t)\n", name, category.Id, created)
+	return category, created, nil
+}
+
+// DeleteCategory moves a category to the trash, PurgeDeletedCategories removes it for good once the retention
+// period is over. The caller checks the category has no subcategories left.
 func (r *categoryRepository) DeleteCategory(ctx context.Context, id string, version int) error {
 	log.Printf("Executing DeleteCategory for ID: %s\n", id)
 
-	query := `DELETE FROM 
+	query := `UPDATE 
 				categories 
+			  SET 
+			  	deleted_at = CURRENT_TIMESTAMP 
 			  WHERE 
-			  	id = $1 AND version = $2`
+			  	id = $1 AND version = $2 AND deleted_at IS NULL`
 	result, err := r.db.ExecContext(ctx, query, id, version)
-
 	if err != nil {
 		log.Printf("Error in DeleteCategory for ID %s: %v\n", id, err)
 		return err
@@ -223,7 +357,7 @@ func (r *categoryRepository) DeleteCategory(ctx context.Context, id string, vers
 
 	if rowsAffected == 0 {
 		log.Printf("Optimistic locking failed, no rows deleted for category ID %s with version %d\n", id, version)
-		return errors.New("delete failed due to wrong id or concurrent modification")
+		return ErrCategoryVersionConflict
 	}
 
 	log.Printf("Deleted category successfully with ID: %s and version: %d\n", id, version)
@@ -233,7 +367,7 @@ func (r *categoryRepository) DeleteCategory(ctx context.Context, id string, vers
 // CountCategories counts the total number of categories in the database.
 func (r *categoryRepository) CountCategories(ctx context.Context) (int, error) {
 	log.Printf("Counting total categories")
-	query := `SELECT COUNT(*) FROM categories`
+	query := `SELECT COUNT(*) FROM categories WHERE deleted_at IS NULL`
 	var totalItems int
 	err := r.db.QueryRowContext(ctx, query).Scan(&totalItems)
 	if err != nil {
@@ -242,3 +376,83 @@ func (r *categoryRepository) CountCategories(ctx context.Context) (int, error) {
 	}
 	return totalItems, nil
 }
+
+// ListCategoryTree lists the category with the id and all its descendants, or every category when rootId is empty
+func (r *categoryRepository) ListCategoryTree(ctx context.Context, rootId string) ([]*models.CategoryRecord, error) {
+	log.Printf("Executing ListCategoryTree for root ID: %s\n", rootId)
+
+	query := `SELECT 
+				` + categoryColumns + ` 
+			  FROM 
+			  	categories 
+			  WHERE 
+			  	deleted_at IS NULL 
+			  ORDER BY 
+			  	name`
+	args := []interface{}{}
+	if rootId != "" {
+		query = `WITH RECURSIVE tree AS (
+					SELECT * FROM categories WHERE id = $1 AND deleted_at IS NULL
+					UNION ALL
+					SELECT c.* FROM categories c JOIN tree t ON c.parent_id = t.id WHERE c.deleted_at IS NULL
+				 )
+				 SELECT 
+				 	` + categoryColumns + ` 
+				 FROM 
+				 	tree 
+				 ORDER BY 
+				 	name`
+		args = append(args, rootId)
+	}
+
+	var categories []*models.CategoryRecord
+	if err := r.db.SelectContext(ctx, &categories, query, args...); err != nil {
+		log.Printf("Error in ListCategoryTree for root ID %s: %v\n", rootId, err)
+		return nil, err
+	}
+
+	log.Printf("Successfully retrieved %d categories of the tree\n", len(categories))
+	return categories, nil
+}
+
+// ListSubcategories lists the direct children of the category, or all its descendants when recursive is set
+func (r *categoryRepository) ListSubcategories(ctx context.Context, parentId string, recursive bool) ([]*models.CategoryRecord, error) {
+	log.Printf("Executing ListSubcategories for parent ID: %s (recursive: %t)\n", parentId, recursive)
+
+	query := `SELECT 
+				` + categoryColumns + ` 
+			  FROM 
+			  	categories 
+			  WHERE 
+			  	parent_id = $1 AND deleted_at IS NULL 
+			  ORDER BY 
+			  	name`
+	if recursive {
+		query = `WITH RECURSIVE descendants AS (
+					SELECT * FROM categories WHERE parent_id = $1 AND deleted_at IS NULL
+					UNION ALL
+					SELECT c.* FROM categories c JOIN descendants d ON c.parent_id = d.id WHERE c.deleted_at IS NULL
+				 )
+				 SELECT 
+				 	` + categoryColumns + ` 
+				 FROM 
+				 	descendants 
+				 ORDER BY 
+				 	name`
+	}
+
+	categories := []*models.CategoryRecord{}
+	if err := r.db.SelectContext(ctx, &categories, query, parentId); err != nil {
+		log.Printf("Error in ListSubcategories for parent ID %s: %v\n", parentId, err)
+		return nil, err
+	}
+
+	log.Printf("Successfully retrieved %d subcategories of category ID: %s\n", len(categories), parentId)
+	return categories, nil
+}
+
+// isForeignKeyViolation tells whether err is raised by a parent_id that points to no category
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"category_service/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+)
+
+// GetCategoryRedirect returns the category an old category ID was merged into, or ErrCategoryNotFound.
+func (r *categoryRepository) GetCategoryRedirect(ctx context.Context, oldId string) (string, error) {
+	query := `SELECT survivor_id FROM category_redirects WHERE old_id = $1`
+
+	var survivorId string
+	if err := r.db.QueryRowContext(ctx, query, oldId).Scan(&survivorId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrCategoryNotFound
+		}
+		log.Printf("Error fetching redirect of category ID %s: %v\n", oldId, err)
+		return "", err
+	}
+
+	log.Printf("Category ID %s redirects to ID: %s\n", oldId, survivorId)
+	return survivorId, nil
+}
+
+// MergeCategories deletes the duplicates and redirects their IDs to the survivor, the subcategories of a duplicate
+// and the IDs already redirected to it move to the survivor. The survivor's version is bumped, and nothing
+// changes unless every version matches or when the survivor is a subcategory of a duplicate.
+// moveBooks is called for every duplicate once the categories are locked and merged, right before the commit, so the
+// books only move when the merge holds. When it fails the duplicates stay, and the merge can be retried as is.
+func (r *categoryRepository) MergeCategories(ctx context.Context, survivorId string, survivorVersion int, duplicates []models.MergeDuplicate, moveBooks func(duplicateId string) error) (*models.CategoryRecord, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("Error starting MergeCategories transaction: %v\n", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Moving the subcategories changes the tree, serialized with the other moves
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('categories:tree'))`); err != nil {
+		return nil, err
+	}
+
+	query := `UPDATE 
+				categories 
+			  SET 
+			  	updated_at = CURRENT_TIMESTAMP 
+			  WHERE 
+			  	id = $1 AND version = $2 AND deleted_at IS NULL 
+			  RETURNING 
+			  	` + categoryColumns
+	survivor := &models.CategoryRecord{}
+	if err := tx.GetContext(ctx, survivor, query, survivorId, survivorVersion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Optimistic locking failed for survivor category ID %s with version %d\n", survivorId, survivorVersion)
+			return nil, ErrCategoryVersionConflict
+		}
+		log.Printf("Error locking survivor category ID %s: %v\n", survivorId, err)
+		return nil, err
+	}
+
+	// Every duplicate is locked and checked before anything moves, a duplicate may be the subcategory of another
+	// and would have its version bumped by the move
+	for _, duplicate := range duplicates {
+		var version int
+		if err := tx.GetContext(ctx, &version, `SELECT version FROM categories WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, duplicate.Id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Error locking duplicate category ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		} else if err != nil || version != duplicate.Version {
+			log.Printf("Optimistic locking failed for duplicate category ID %s with version %d\n", duplicate.Id, duplicate.Version)
+			return nil, ErrCategoryVersionConflict
+		}
+
+		// The subcategories of the duplicate move under the survivor, which must not be one of them
+		if err := checkCategoryParent(ctx, tx, duplicate.Id, survivorId); err != nil {
+			return nil, err
+		}
+	}
+
+	// All subcategories move before any delete, parent_id restricts deleting a category that still has children
+	for _, duplicate := range duplicates {
+		if _, err := tx.ExecContext(ctx, `UPDATE categories SET parent_id = $2 WHERE parent_id = $1`, duplicate.Id, survivorId); err != nil {
+			log.Printf("Error moving subcategories of category ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+	}
+
+	for _, duplicate := range duplicates {
+		// Moved before the delete, which would cascade to the redirects pointing at the duplicate
+		if _, err := tx.ExecContext(ctx, `UPDATE category_redirects SET survivor_id = $2 WHERE survivor_id = $1`, duplicate.Id, survivorId); err != nil {
+			log.Printf("Error moving redirects of category ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+
+		// The version was checked under the lock above
+		if _, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE id = $1`, duplicate.Id); err != nil {
+			log.Printf("Error deleting duplicate category ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `INSERT INTO category_redirects (old_id, survivor_id) VALUES ($1, $2)`, duplicate.Id, survivorId); err != nil {
+			log.Printf("Error redirecting category ID %s: %v\n", duplicate.Id, err)
+			return nil, err
+		}
+	}
+
+	// The deleted duplicates stay locked while their books move, so no concurrent edit can undo the merge
+	for _, duplicate := range duplicates {
+		if err := moveBooks(duplicate.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("Error committing MergeCategories transaction: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Merged %d categories into category ID: %s\n", len(duplicates), survivorId)
+	return survivor, nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"category_service/internal/models"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// ListDeletedCategories fetches the categories in the trash, the most recently deleted first.
+func (r *categoryRepository) ListDeletedCategories(ctx context.Context) ([]*models.CategoryRecord, error) {
+	log.Printf("Executing ListDeletedCategories")
+	query := `SELECT 
+				` + categoryColumns + `, deleted_at 
+			  FROM 
+			  	categories 
+			  WHERE 
+			  	deleted_at IS NOT NULL 
+			  ORDER BY 
+			  	deleted_at DESC, id`
+
+	var categories []*models.CategoryRecord
+	if err := r.db.SelectContext(ctx, &categories, query); err != nil {
+		log.Printf("Error in ListDeletedCategories: %v\n", err)
+		return nil, err
+	}
+
+	log.Printf("Successfully retrieved %d deleted categories\n", len(categories))
+	return categories, nil
+}
+
+// CountDeletedCategories counts the categories in the trash.
+func (r *categoryRepository) CountDeletedCategories(ctx context.Context) (int, error) {
+	log.Printf("Counting deleted categories")
+	query := `SELECT COUNT(*) FROM categories WHERE deleted_at IS NOT NULL`
+	var totalItems int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&totalItems); err != nil {
+		log.Printf("Error counting deleted categories: %v\n", err)
+		return 0, err
+	}
+	return totalItems, nil
+}
+
+// GetDeletedCategory fetches a category in the trash, a live category is not found.
+func (r *categoryRepository) GetDeletedCategory(ctx context.Context, id string) (*models.CategoryRecord, error) {
+	log.Printf("Executing GetDeletedCategory for ID: %s\n", id)
+	query := `SELECT 
+				` + categoryColumns + `, deleted_at 
+			  FROM 
+			  	categories 
+			  WHERE 
+			  	id = $1 AND deleted_at IS NOT NULL`
+
+	category := &models.CategoryRecord{}
+	err := r.db.GetContext(ctx, category, query, id)
+	if err == sql.ErrNoRows {
+		log.Printf("Deleted category with ID %s not found\n", id)
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		log.Printf("Error in GetDeletedCategory for ID %s: %v\n", id, err)
+		return nil, err
+	}
+	return category, nil
+}
+
+// RestoreCategory takes a category out of the trash, the caller checks its parent is not in the trash.
+func (r *categoryRepository) RestoreCategory(ctx context.Context, id string) (*models.CategoryRecord, error) {
+	log.Printf("Executing RestoreCategory for ID: %s\n", id)
+	query := `UPDATE 
+				categories 
+			  SET 
+			  	deleted_at = NULL 
+			  WHERE 
+			  	id = $1 AND deleted_at IS NOT NULL 
+			  RETURNING 
+			  	` + categoryColumns + `, deleted_at`
+
+	category := &models.CategoryRecord{}
+	err := r.db.GetContext(ctx, category, query, id)
+	if err == sql.ErrNoRows {
+		log.Printf("Deleted category with ID %s not found\n", id)
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		log.Printf("Error in RestoreCategory for ID %s: %v\n", id, err)
+		return nil, err
+	}
+
+	log.Printf("Successfully restored category with ID: %s\n", id)
+	return category, nil
+}
+
+// PurgeDeletedCategories removes for good the categories deleted before the given time. A parent can only go once
+// its subcategories are gone, so the leaves are removed level by level; a parent whose subcategory is kept stays too.
+func (r *categoryRepository) PurgeDeletedCategories(ctx context.Context, deletedBefore time.Time) (int, error) {
+	log.Printf("Executing PurgeDeletedCategories for categories deleted before %s\n", deletedBefore.Format(time.RFC3339))
+	query := `DELETE FROM 
+				categories 
+			  WHERE 
+			  	deleted_at < $1 
+			  	AND NOT EXISTS (SELECT 1 FROM categories c WHERE c.parent_id = categories.id)`
+
+	purged := 0
+	for {
+		result, err := r.db.ExecContext(ctx, query, deletedBefore)
+		if err != nil {
+			log.Printf("Error in PurgeDeletedCategories: %v\n", err)
+			return purged, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			log.Printf("Error fetching rows affected for purge operation: %v\n", err)
+			return purged, err
+		}
+		if rowsAffected == 0 {
+			break
+		}
+		purged += int(rowsAffected)
+	}
+
+	log.Printf("Purged %d deleted categories\n", purged)
+	return purged, nil
+}
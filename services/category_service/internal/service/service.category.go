@@ -1,11 +1,17 @@
 package service
 
 import (
+	"category_service/internal/clients"
+	"category_service/internal/constants"
 	"category_service/internal/models"
 	"category_service/internal/repository"
 	"category_service/pkg/utils"
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 )
 
 type CategoryService interface {
@@ -13,20 +19,28 @@ type CategoryService interface {
 	GetCategory(ctx context.Context, id string) (*models.CategoryRecord, error)
 	ListCategories(ctx context.Context, page int, pageSize int) (categories []*models.CategoryRecord, totalItems int, err error)
 	UpdateCategory(ctx context.Context, id string, req *models.CategoryRequest) (*models.CategoryRecord, error)
-	DeleteCategory(ctx context.Context, id string, version int) error
+	DeleteCategory(ctx context.Context, id string, req *models.CategoryDeleteRequest) (affectedBooks int, err error)
+	ResolveCategory(ctx context.Context, name string) (category *models.CategoryRecord, created bool, err error)
+	GetCategoryTree(ctx context.Context, rootId string) ([]*models.CategoryNode, error)
+	ListSubcategories(ctx context.Context, parentId string, recursive bool) ([]*models.CategoryRecord, error)
+	ListDeletedCategories(ctx context.Context) (categories []*models.CategoryRecord, totalItems int, err error)
+	RestoreCategory(ctx context.Context, id string) (*models.CategoryRecord, error)
+	PurgeDeletedCategories(ctx context.Context, deletedBefore time.Time) (int, error)
+	MergeCategories(ctx context.Context, survivorId string, req *models.CategoryMergeRequest) (survivor *models.CategoryRecord, affectedBooks int, err error)
 }
 
 type categoryService struct {
-	repo repository.CategoryRepository
+	repo       repository.CategoryRepository
+	bookClient clients.BookClient
 }
 
-func NewCategoryService(repo repository.CategoryRepository) CategoryService {
-	return &categoryService{repo: repo}
+func NewCategoryService(repo repository.CategoryRepository, bookClient clients.BookClient) CategoryService {
+	return &categoryService{repo: repo, bookClient: bookClient}
 }
 
 func (s *categoryService) CreateCategory(ctx context.Context, req *models.CategoryRequest) (*models.CategoryRecord, error) {
 	log.Printf("[%s] Creating new category with name: %s\n", utils.GetLocation(), req.Name)
-	category := &models.CategoryRecord{Name: req.Name}
+	category := &models.CategoryRecord{Name: req.Name, ParentId: req.ParentId}
 
 	createdCategory, err := s.repo.CreateCategory(ctx, category)
 	if err != nil {
@@ -42,6 +56,12 @@ func (s *categoryService) GetCategory(ctx context.Context, id string) (*models.C
 	log.Printf("[%s] Fetching category with ID: %s\n", utils.GetLocation(), id)
 
 	category, err := s.repo.GetCategory(ctx, id)
+	if errors.Is(err, repository.ErrCategoryNotFound) {
+		// The category may have been merged into another
+		if survivorId, redirectErr := s.repo.GetCategoryRedirect(ctx, id); redirectErr == nil {
+			category, err = s.repo.GetCategory(ctx, survivorId)
+		}
+	}
 	if err != nil {
 		log.Printf("[%s] Failed to get category with ID %s: %v\n", utils.GetLocation(), id, err)
 		return nil, err
@@ -74,9 +94,10 @@ func (s *categoryService) UpdateCategory(ctx context.Context, id string, req *mo
 	log.Printf("[%s] Updating category with ID: %s\n", utils.GetLocation(), id)
 
 	category := &models.CategoryRecord{
-		Id:      id,
-		Name:    req.Name,
-		Version: req.Version,
+		Id:       id,
+		Name:     req.Name,
+		ParentId: req.ParentId,
+		Version:  req.Version,
 	}
 
 	updatedCategory, err := s.repo.UpdateCategory(ctx, category)
@@ -89,15 +110,157 @@ func (s *categoryService) UpdateCategory(ctx context.Context, id string, req *mo
 	return updatedCategory, nil
 }
 
-func (s *categoryService) DeleteCategory(ctx context.Context, id string, version int) error {
-	log.Printf("[%s] Deleting category with ID: %s\n", utils.GetLocation(), id)
+// DeleteCategory deletes a category by ID, what happens to the books of the category is chosen by req.OnBooks.
+// It returns the number of books reassigned or deleted along with the category.
+func (s *categoryService) DeleteCategory(ctx context.Context, id string, req *models.CategoryDeleteRequest) (int, error) {
+	log.Printf("[%s] Deleting category with ID: %s (on books: %s)\n", utils.GetLocation(), id, req.OnBooks)
 
-	err := s.repo.DeleteCategory(ctx, id, version)
+	// Check everything that would fail the delete first so the books are not touched for nothing
+	category, err := s.repo.GetCategory(ctx, id)
+	if errors.Is(err, repository.ErrCategoryNotFound) {
+		// The category may have been merged into another
+		if survivorId, redirectErr := s.repo.GetCategoryRedirect(ctx, id); redirectErr == nil {
+			category, err = s.repo.GetCategory(ctx, survivorId)
+		}
+	}
+	if err != nil {
+		log.Printf("[%s] Failed to get category with ID %s: %v\n", utils.GetLocation(), id, err)
+		return 0, err
+	}
+	if category.Version != req.Version {
+		log.Printf("[%s] Category with ID %s is at version %d, not %d\n", utils.GetLocation(), id, category.Version, req.Version)
+		return 0, repository.ErrCategoryVersionConflict
+	}
+	subcategories, err := s.repo.ListSubcategories(ctx, id, false)
+	if err != nil {
+		log.Printf("[%s] Failed to list subcategories of category with ID %s: %v\n", utils.GetLocation(), id, err)
+		return 0, err
+	}
+	if len(subcategories) > 0 {
+		return 0, repository.ErrCategoryHasSubcategories
+	}
+
+	affectedBooks, err := s.releaseBooks(ctx, id, req)
+	if err != nil {
+		log.Printf("[%s] Failed to release books of category with ID %s: %v\n", utils.GetLocation(), id, err)
+		return 0, err
+	}
+
+	err = s.repo.DeleteCategory(ctx, id, req.Version)
 	if err != nil {
 		log.Printf("[%s] Failed to delete category with ID %s: %v\n", utils.GetLocation(), id, err)
-		return err
+		return 0, err
+	}
+
+	log.Printf("[%s] Category with ID %s deleted successfully, %d books affected\n", utils.GetLocation(), id, affectedBooks)
+	return affectedBooks, nil
+}
+
+// releaseBooks makes sure no book is left in the category, or fails with ErrCategoryHasBooks when req rejects it
+func (s *categoryService) releaseBooks(ctx context.Context, id string, req *models.CategoryDeleteRequest) (int, error) {
+	switch req.OnBooks {
+	case constants.OnBooksReassign:
+		if req.ReassignTo == "" {
+			return 0, ErrReassignTargetRequired
+		}
+		if req.ReassignTo == id {
+			return 0, ErrReassignToSelf
+		}
+		if _, err := s.repo.GetCategory(ctx, req.ReassignTo); err != nil {
+			if errors.Is(err, repository.ErrCategoryNotFound) {
+				return 0, ErrReassignTargetNotFound
+			}
+			return 0, err
+		}
+		return s.bookClient.ReassignBooksByCategory(ctx, id, req.ReassignTo)
+
+	case constants.OnBooksCascade:
+		return s.bookClient.DeleteBooksByCategory(ctx, id)
+
+	default:
+		totalBooks, err := s.bookClient.CountBooksByCategory(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		if totalBooks > 0 {
+			return 0, fmt.Errorf("%w: %d books reference the category", ErrCategoryHasBooks, totalBooks)
+		}
+		return 0, nil
+	}
+}
+
+// ResolveCategory finds a category by name ignoring case and surrounding spaces, creating it when there is none.
+func (s *categoryService) ResolveCategory(ctx context.Context, name string) (*models.CategoryRecord, bool, error) {
+	name = strings.TrimSpace(name)
+	log.Printf("[%s] Resolving category with name: %s\n", utils.GetLocation(), name)
+
+	category, created, err := s.repo.ResolveCategoryByName(ctx, name)
+	if err != nil {
+		log.Printf("[%s] Failed to resolve category with name %s: %v\n", utils.GetLocation(), name, err)
+		return nil, false, err
 	}
 
-	log.Printf("[%s] Category with ID %s deleted successfully\n", utils.GetLocation(), id)
-	return nil
+	log.Printf("[%s] Category %s resolved to ID %s\n", utils.GetLocation(), name, category.Id)
+	return category, created, nil
+}
+
+// GetCategoryTree returns the category with the id and its subcategories as a single node,
+// or every top-level category with its subcategories when rootId is empty
+func (s *categoryService) GetCategoryTree(ctx context.Context, rootId string) ([]*models.CategoryNode, error) {
+	log.Printf("[%s] Fetching category tree with root ID: %s\n", utils.GetLocation(), rootId)
+
+	if rootId != "" {
+		if _, err := s.repo.GetCategory(ctx, rootId); err != nil {
+			log.Printf("[%s] Failed to get root category with ID %s: %v\n", utils.GetLocation(), rootId, err)
+			return nil, err
+		}
+	}
+
+	categories, err := s.repo.ListCategoryTree(ctx, rootId)
+	if err != nil {
+		log.Printf("[%s] Failed to list category tree: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	nodes := buildCategoryTree(categories)
+	log.Printf("[%s] Category tree fetched successfully with %d categories\n", utils.GetLocation(), len(categories))
+	return nodes, nil
+}
+
+func (s *categoryService) ListSubcategories(ctx context.Context, parentId string, recursive bool) ([]*models.CategoryRecord, error) {
+	log.Printf("[%s] Fetching subcategories of category ID: %s (recursive: %t)\n", utils.GetLocation(), parentId, recursive)
+
+	if _, err := s.repo.GetCategory(ctx, parentId); err != nil {
+		log.Printf("[%s] Failed to get category with ID %s: %v\n", utils.GetLocation(), parentId, err)
+		return nil, err
+	}
+
+	categories, err := s.repo.ListSubcategories(ctx, parentId, recursive)
+	if err != nil {
+		log.Printf("[%s] Failed to list subcategories of category ID %s: %v\n", utils.GetLocation(), parentId, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully fetched %d subcategories of category ID %s\n", utils.GetLocation(), len(categories), parentId)
+	return categories, nil
+}
+
+// buildCategoryTree links the categories to their parents, a category whose parent is not in the list becomes a root.
+// The children keep the order of the list.
+func buildCategoryTree(categories []*models.CategoryRecord) []*models.CategoryNode {
+	nodes := make(map[string]*models.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.Id] = &models.CategoryNode{Category: category}
+	}
+
+	roots := []*models.CategoryNode{}
+	for _, category := range categories {
+		node := nodes[category.Id]
+		if parent, ok := nodes[category.ParentId]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
 }
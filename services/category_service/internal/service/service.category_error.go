@@ -8,4 +8,14 @@ var (
 	ErrGetListCategory = errors.New("failed to retrieve category list")
 	ErrUpdateCategory  = errors.New("failed to update category data")
 	ErrDeleteCategory  = errors.New("failed to delete category data")
+
+	ErrCategoryHasBooks       = errors.New("category still has books")
+	ErrReassignTargetRequired = errors.New("reassign_to is required to reassign the books")
+	ErrReassignTargetNotFound = errors.New("category to reassign the books to not found")
+	ErrReassignToSelf         = errors.New("books cannot be reassigned to the category being deleted")
+
+	ErrParentCategoryDeleted = errors.New("the parent category is deleted, restore it first")
+
+	ErrMergeIntoSelf          = errors.New("a category cannot be merged into itself")
+	ErrMergeDuplicateRepeated = errors.New("a category is listed more than once to merge")
 )
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"category_service/internal/models"
+	"category_service/internal/repository"
+	"category_service/pkg/utils"
+	"context"
+	"log"
+)
+
+// MergeCategories moves the books of the duplicates to the survivor and deletes the duplicates, their IDs keep
+// resolving to the survivor through GetCategory. It returns the survivor and the number of books moved.
+func (s *categoryService) MergeCategories(ctx context.Context, survivorId string, req *models.CategoryMergeRequest) (*models.CategoryRecord, int, error) {
+	log.Printf("[%s] Merging %d categories into category with ID: %s\n", utils.GetLocation(), len(req.Duplicates), survivorId)
+
+	// Check every version first so a stale merge is rejected before the transaction, which checks them again
+	if err := s.checkMergeVersion(ctx, survivorId, req.SurvivorVersion); err != nil {
+		return nil, 0, err
+	}
+	seen := make(map[string]bool, len(req.Duplicates))
+	for _, duplicate := range req.Duplicates {
+		if duplicate.Id == survivorId {
+			return nil, 0, ErrMergeIntoSelf
+		}
+		if seen[duplicate.Id] {
+			return nil, 0, ErrMergeDuplicateRepeated
+		}
+		seen[duplicate.Id] = true
+
+		if err := s.checkMergeVersion(ctx, duplicate.Id, duplicate.Version); err != nil {
+			return nil, 0, err
+		}
+
+		// The subcategories of a duplicate move under the survivor, which cannot be one of them
+		subcategories, err := s.repo.ListSubcategories(ctx, duplicate.Id, true)
+		if err != nil {
+			log.Printf("[%s] Failed to list subcategories of category with ID %s: %v\n", utils.GetLocation(), duplicate.Id, err)
+			return nil, 0, err
+		}
+		for _, subcategory := range subcategories {
+			if subcategory.Id == survivorId {
+				return nil, 0, repository.ErrCategoryCycle
+			}
+		}
+	}
+
+	// The books move inside the merge transaction, after the categories are locked and merged
+	affectedBooks := 0
+	survivor, err := s.repo.MergeCategories(ctx, survivorId, req.SurvivorVersion, req.Duplicates, func(duplicateId string) error {
+		affected, err := s.bookClient.ReassignBooksByCategory(ctx, duplicateId, survivorId)
+		if err != nil {
+			log.Printf("[%s] Failed to move books of category with ID %s: %v\n", utils.GetLocation(), duplicateId, err)
+			return err
+		}
+		affectedBooks += affected
+		return nil
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to merge categories into category with ID %s: %v\n", utils.GetLocation(), survivorId, err)
+		return nil, 0, err
+	}
+
+	log.Printf("[%s] Merged %d categories into category with ID %s, %d books moved\n", utils.GetLocation(), len(req.Duplicates), survivorId, affectedBooks)
+	return survivor, affectedBooks, nil
+}
+
+// checkMergeVersion makes sure the category exists outside the trash at the given version
+func (s *categoryService) checkMergeVersion(ctx context.Context, id string, version int) error {
+	category, err := s.repo.GetCategory(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get category with ID %s: %v\n", utils.GetLocation(), id, err)
+		return err
+	}
+	if category.Version != version {
+		log.Printf("[%s] Category with ID %s is at version %d, not %d\n", utils.GetLocation(), id, category.Version, version)
+		return repository.ErrCategoryVersionConflict
+	}
+	return nil
+}
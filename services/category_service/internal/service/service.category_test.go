@@ -0,0 +1,66 @@
+package service
+
+import (
+	"category_service/internal/models"
+	"strings"
+	"testing"
+)
+
+// treeShape renders nodes as "name(child,child)" so a whole tree can be compared at once
+func treeShape(nodes []*models.CategoryNode) string {
+	shapes := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		shape := node.Category.Name
+		if len(node.Children) > 0 {
+			shape += "(" + treeShape(node.Children) + ")"
+		}
+		shapes = append(shapes, shape)
+	}
+	return strings.Join(shapes, ",")
+}
+
+func TestBuildCategoryTree(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []*models.CategoryRecord
+		want       string
+	}{
+		{name: "empty", want: ""},
+		{
+			name: "whole tree",
+			categories: []*models.CategoryRecord{
+				{Id: "1", Name: "Fiction"},
+				{Id: "2", Name: "Fantasy", ParentId: "1"},
+				{Id: "3", Name: "Science Fiction", ParentId: "1"},
+				{Id: "4", Name: "Space Opera", ParentId: "3"},
+				{Id: "5", Name: "History"},
+			},
+			want: "Fiction(Fantasy,Science Fiction(Space Opera)),History",
+		},
+		{
+			name: "children listed before their parent",
+			categories: []*models.CategoryRecord{
+				{Id: "4", Name: "Space Opera", ParentId: "3"},
+				{Id: "3", Name: "Science Fiction", ParentId: "1"},
+				{Id: "1", Name: "Fiction"},
+			},
+			want: "Fiction(Science Fiction(Space Opera))",
+		},
+		{
+			name: "subtree whose root parent is not listed",
+			categories: []*models.CategoryRecord{
+				{Id: "3", Name: "Science Fiction", ParentId: "1"},
+				{Id: "4", Name: "Space Opera", ParentId: "3"},
+			},
+			want: "Science Fiction(Space Opera)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := treeShape(buildCategoryTree(tt.categories)); got != tt.want {
+				t.Fatalf("buildCategoryTree() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"category_service/internal/models"
+	"category_service/internal/repository"
+	"category_service/pkg/utils"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// ListDeletedCategories fetches the categories in the trash, the most recently deleted first.
+func (s *categoryService) ListDeletedCategories(ctx context.Context) (categories []*models.CategoryRecord, totalItems int, err error) {
+	log.Printf("[%s] Fetching list of deleted categories\n", utils.GetLocation())
+
+	categories, err = s.repo.ListDeletedCategories(ctx)
+	if err != nil {
+		log.Printf("[%s] Failed to list deleted categories: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	totalItems, err = s.repo.CountDeletedCategories(ctx)
+	if err != nil {
+		log.Printf("[%s] Failed to count deleted categories: %v\n", utils.GetLocation(), err)
+		return nil, 0, err
+	}
+
+	log.Printf("[%s] Successfully fetched %d deleted categories\n", utils.GetLocation(), len(categories))
+	return categories, totalItems, nil
+}
+
+// RestoreCategory takes a category out of the trash, its parent has to be restored before it.
+// The books deleted along with the category stay in the trash, each is restored on its own.
+func (s *categoryService) RestoreCategory(ctx context.Context, id string) (*models.CategoryRecord, error) {
+	log.Printf("[%s] Restoring category with ID: %s\n", utils.GetLocation(), id)
+
+	category, err := s.repo.GetDeletedCategory(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get deleted category with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+	if category.ParentId != "" {
+		if _, err := s.repo.GetCategory(ctx, category.ParentId); err != nil {
+			if errors.Is(err, repository.ErrCategoryNotFound) {
+				return nil, ErrParentCategoryDeleted
+			}
+			return nil, err
+		}
+	}
+
+	category, err = s.repo.RestoreCategory(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to restore category with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Category with ID %s restored successfully\n", utils.GetLocation(), id)
+	return category, nil
+}
+
+// PurgeDeletedCategories removes for good the categories that have been in the trash since before deletedBefore.
+func (s *categoryService) PurgeDeletedCategories(ctx context.Context, deletedBefore time.Time) (int, error) {
+	log.Printf("[%s] Purging categories deleted before %s\n", utils.GetLocation(), deletedBefore.Format(time.RFC3339))
+
+	purged, err := s.repo.PurgeDeletedCategories(ctx, deletedBefore)
+	if err != nil {
+		log.Printf("[%s] Failed to purge deleted categories: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return purged, nil
+}
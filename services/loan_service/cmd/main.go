@@ -8,6 +8,7 @@ import (
 	"loan_service/internal/constants"
 	"loan_service/internal/grpc_server"
 	"loan_service/internal/repository"
+	"loan_service/internal/scheduler"
 	"loan_service/internal/service"
 	loggerPackage "loan_service/pkg/logger"
 	"loan_service/pkg/rabbitmq"
@@ -56,6 +57,11 @@ func main() {
 		log.Fatalf("Failed to establish book client connection %v", err)
 	}
 
+	userClient, err := clients.NewUserClient()
+	if err != nil {
+		log.Fatalf("Failed to establish user client connection %v", err)
+	}
+
 	// Connect to RabbitMQ
 	conn, err := amqp.Dial(configs.AppConfig.RabbitMQURL)
 	if err != nil {
@@ -81,6 +87,11 @@ func main() {
 		log.Fatalf("Failed to declare exchange: %v", err)
 	}
 
+	err = rabbitMQPublisher.DeclareExchange(constants.LoanEventExchange, constants.ExchangeTypeDirect)
+	if err != nil {
+		log.Fatalf("Failed to declare exchange: %v", err)
+	}
+
 	// Logger
 	var logger *loggerPackage.Logger
 	if configs.AppConfig.LoggerWorkerType == constants.LoggerWorkerTypeSingle {
@@ -92,7 +103,23 @@ func main() {
 
 	// Repository and Service Layer
 	loanRepo := repository.NewLoanRepository(db)
-	loanService := service.NewLoanService(loanRepo, bookClient, rabbitMQPublisher)
+	fineRepo := repository.NewFineRepository(db)
+	holdRepo := repository.NewHoldRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	holdService := service.NewHoldService(holdRepo, bookClient)
+	loanService := service.NewLoanService(loanRepo, fineRepo, holdService, bookClient, userClient)
+	fineService := service.NewFineService(fineRepo)
+	outboxService := service.NewOutboxService(outboxRepo, rabbitMQPublisher)
+
+	// Background sweepers share one context so they stop together
+	sweeperCtx, stopSweeper := context.WithCancel(context.Background())
+	defer stopSweeper()
+	overdueSweeper := scheduler.NewOverdueSweeper(loanService, logger, time.Duration(configs.AppConfig.OverdueSweepInterval)*time.Minute)
+	go overdueSweeper.Start(sweeperCtx)
+	holdExpirySweeper := scheduler.NewHoldExpirySweeper(holdService, logger, time.Duration(configs.AppConfig.HoldExpirySweepInterval)*time.Minute)
+	go holdExpirySweeper.Start(sweeperCtx)
+	outboxRelay := scheduler.NewOutboxRelay(outboxService, logger, time.Duration(configs.AppConfig.OutboxRelayInterval)*time.Second)
+	go outboxRelay.Start(sweeperCtx)
 
 	// gRPC Server
 	address := fmt.Sprintf(":%s", configs.AppConfig.GrpcPort)
@@ -102,7 +129,7 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	loanServer := grpc_server.NewLoanGRPCServer(loanService, logger)
+	loanServer := grpc_server.NewLoanGRPCServer(loanService, fineService, holdService, logger)
 	protoLoan.RegisterLoanServiceServer(grpcServer, loanServer)
 	healthCheckServer := grpc_server.NewHealthGRPCServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthCheckServer)
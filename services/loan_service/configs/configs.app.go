@@ -8,13 +8,22 @@ import (
 )
 
 type Config struct {
-	GrpcPort               string
-	DSN                    string
-	RabbitMQURL            string
-	LoggerWorkerType       string
-	BookServiceURL         string
-	LoggerWorkerNum        int
-	LoggerWorkerBufferSize int
+	GrpcPort                string
+	DSN                     string
+	RabbitMQURL             string
+	LoggerWorkerType        string
+	BookServiceURL          string
+	UserServiceURL          string
+	LoggerWorkerNum         int
+	LoggerWorkerBufferSize  int
+	OverdueSweepInterval    int
+	OverdueFinePerDay       int
+	LostItemFine            int
+	MaxUnpaidFineAmount     int
+	HoldPickupWindow        int
+	HoldExpirySweepInterval int
+	OutboxRelayInterval     int
+	OutboxRelayBatchSize    int
 }
 
 var AppConfig Config
@@ -42,6 +51,7 @@ func InitializeAppConfig() error {
 		"RABBITMQ_URL":       &AppConfig.RabbitMQURL,
 		"LOGGER_WORKER_TYPE": &AppConfig.LoggerWorkerType,
 		"BOOK_SERVICE_URL":   &AppConfig.BookServiceURL,
+		"USER_SERVICE_URL":   &AppConfig.UserServiceURL,
 	}
 
 	// Assign string values
@@ -64,5 +74,45 @@ func InitializeAppConfig() error {
 		return err
 	}
 
+	AppConfig.OverdueSweepInterval, err = getIntEnv("OVERDUE_SWEEP_INTERVAL")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.OverdueFinePerDay, err = getIntEnv("OVERDUE_FINE_PER_DAY")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.LostItemFine, err = getIntEnv("LOST_ITEM_FINE")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.MaxUnpaidFineAmount, err = getIntEnv("MAX_UNPAID_FINE_AMOUNT")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.HoldPickupWindow, err = getIntEnv("HOLD_PICKUP_WINDOW")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.HoldExpirySweepInterval, err = getIntEnv("HOLD_EXPIRY_SWEEP_INTERVAL")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.OutboxRelayInterval, err = getIntEnv("OUTBOX_RELAY_INTERVAL")
+	if err != nil {
+		return err
+	}
+
+	AppConfig.OutboxRelayBatchSize, err = getIntEnv("OUTBOX_RELAY_BATCH_SIZE")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -11,9 +11,9 @@ import (
 
 type BookClient interface {
 	GetBook(ctx context.Context, id string) (*BookResponse, error)
-	UpdateBookStock(ctx context.Context, id string, newStock, version int) error
-	IncrementBookStock(ctx context.Context, id string, version int) error
-	DecrementBookStock(ctx context.Context, id string, version int) error
+	GetBookCopyByBarcode(ctx context.Context, barcode string) (*CopyResponse, error)
+	CheckOutBookCopy(ctx context.Context, bookId, barcode string) (*CopyResponse, error)
+	CheckInBookCopy(ctx context.Context, id, condition string) (*CopyResponse, error)
 }
 
 type bookClient struct {
@@ -38,6 +38,13 @@ type BookResponse struct { // simplify struct to optimize memory
 	Stock int
 }
 
+type CopyResponse struct {
+	Id      string
+	BookId  string
+	Barcode string
+	Status  string
+}
+
 func (a *bookClient) GetBook(ctx context.Context, id string) (*BookResponse, error) {
 	reqProto := protoBook.GetBookRequest{
 		Id: id,
@@ -55,35 +62,55 @@ func (a *bookClient) GetBook(ctx context.Context, id string) (*BookResponse, err
 	}, nil
 }
 
-func (a *bookClient) UpdateBookStock(ctx context.Context, id string, newStock, version int) error {
-	reqProto := protoBook.UpdateBookStockRequest{
-		Id:      id,
-		Stock:   int32(newStock),
-		Version: int32(version),
+func (a *bookClient) GetBookCopyByBarcode(ctx context.Context, barcode string) (*CopyResponse, error) {
+	reqProto := protoBook.GetBookCopyByBarcodeRequest{
+		Barcode: barcode,
+	}
+
+	resp, err := a.client.GetBookCopyByBarcode(ctx, &reqProto)
+	if err != nil {
+		return nil, err
 	}
-	_, err := a.client.UpdateBookStock(ctx, &reqProto)
 
-	return err
+	return toCopyResponse(resp.Copy), nil
 }
 
-func (a *bookClient) IncrementBookStock(ctx context.Context, id string, version int) error {
-	reqProto := protoBook.IncrementBookStockRequest{
-		Id:      id,
-		Version: int32(version),
+// CheckOutBookCopy takes the copy with the barcode when one is given, otherwise any available copy of the book
+func (a *bookClient) CheckOutBookCopy(ctx context.Context, bookId, barcode string) (*CopyResponse, error) {
+	reqProto := protoBook.CheckOutBookCopyRequest{}
+	if barcode != "" {
+		reqProto.Target = &protoBook.CheckOutBookCopyRequest_Barcode{Barcode: barcode}
+	} else {
+		reqProto.Target = &protoBook.CheckOutBookCopyRequest_BookId{BookId: bookId}
 	}
 
-	_, err := a.client.IncrementBookStock(ctx, &reqProto)
+	resp, err := a.client.CheckOutBookCopy(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return toCopyResponse(resp.Copy), nil
 }
 
-func (a *bookClient) DecrementBookStock(ctx context.Context, id string, version int) error {
-	reqProto := protoBook.DecrementBookStockRequest{
-		Id:      id,
-		Version: int32(version),
+func (a *bookClient) CheckInBookCopy(ctx context.Context, id, condition string) (*CopyResponse, error) {
+	reqProto := protoBook.CheckInBookCopyRequest{
+		Id:        id,
+		Condition: condition,
 	}
 
-	_, err := a.client.DecrementBookStock(ctx, &reqProto)
+	resp, err := a.client.CheckInBookCopy(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return toCopyResponse(resp.Copy), nil
+}
+
+func toCopyResponse(bookCopy *protoBook.BookCopy) *CopyResponse {
+	return &CopyResponse{
+		Id:      bookCopy.Id,
+		BookId:  bookCopy.BookId,
+		Barcode: bookCopy.Barcode,
+		Status:  bookCopy.Status,
+	}
 }
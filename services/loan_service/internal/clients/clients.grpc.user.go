@@ -0,0 +1,77 @@
+package clients
+
+import (
+	"context"
+	"loan_service/configs"
+	protoUser "loan_service/proto/user_service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type UserClient interface {
+	GetUser(ctx context.Context, userId string) (*UserResponse, error)
+	GetUserEntitlements(ctx context.Context, userId string) (*EntitlementsResponse, error)
+}
+
+type userClient struct {
+	client protoUser.UserServiceClient
+}
+
+func NewUserClient() (UserClient, error) {
+	conn, err := grpc.NewClient(configs.AppConfig.UserServiceURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	client := protoUser.NewUserServiceClient(conn)
+	return &userClient{
+		client: client,
+	}, nil
+}
+
+type UserResponse struct {
+	Id    string
+	Email string
+}
+
+type EntitlementsResponse struct {
+	TierName           string
+	MaxConcurrentLoans int
+	LoanDurationDays   int
+	MaxRenewals        int
+}
+
+func (a *userClient) GetUser(ctx context.Context, userId string) (*UserResponse, error) {
+	reqProto := protoUser.GetUserByIdRequest{
+		UserId: userId,
+	}
+
+	resp, err := a.client.GetUserById(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserResponse{
+		Id:    resp.User.Id,
+		Email: resp.User.Email,
+	}, nil
+}
+
+func (a *userClient) GetUserEntitlements(ctx context.Context, userId string) (*EntitlementsResponse, error) {
+	reqProto := protoUser.GetUserEntitlementsRequest{
+		UserId: userId,
+	}
+
+	resp, err := a.client.GetUserEntitlements(ctx, &reqProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntitlementsResponse{
+		TierName:           resp.TierName,
+		MaxConcurrentLoans: int(resp.MaxConcurrentLoans),
+		LoanDurationDays:   int(resp.LoanDurationDays),
+		MaxRenewals:        int(resp.MaxRenewals),
+	}, nil
+}
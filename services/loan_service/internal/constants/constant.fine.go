@@ -0,0 +1,18 @@
+package constants
+
+const (
+	FineTypeOverdue = "OVERDUE"
+	FineTypeLost    = "LOST"
+)
+
+const (
+	FineStatusUnpaid = "UNPAID"
+	FineStatusPaid   = "PAID"
+	FineStatusWaived = "WAIVED"
+)
+
+const (
+	FineEntryCharge  = "CHARGE"
+	FineEntryPayment = "PAYMENT"
+	FineEntryWaiver  = "WAIVER"
+)
@@ -0,0 +1,9 @@
+package constants
+
+const (
+	HoldStatusWaiting   = "WAITING"
+	HoldStatusReady     = "READY"
+	HoldStatusFulfilled = "FULFILLED"
+	HoldStatusCancelled = "CANCELLED"
+	HoldStatusExpired   = "EXPIRED"
+)
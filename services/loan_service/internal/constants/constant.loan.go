@@ -0,0 +1,12 @@
+package constants
+
+const (
+	LoanStatusBorrowed = "BORROWED"
+	LoanStatusReturned = "RETURNED"
+	LoanStatusOverdue  = "OVERDUE"
+	LoanStatusLost     = "LOST"
+	LoanStatusFound    = "FOUND"
+
+	// LoanChangedBySystem marks status changes made by the background sweepers
+	LoanChangedBySystem = "system"
+)
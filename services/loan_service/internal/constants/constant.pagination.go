@@ -0,0 +1,12 @@
+package constants
+
+const (
+	SortByLoanDate  = "loan_date"
+	SortByUpdatedAt = "updated_at"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+
+	DefaultSortBy    = SortByLoanDate
+	DefaultSortOrder = SortOrderDesc
+)
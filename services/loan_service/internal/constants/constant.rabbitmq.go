@@ -3,13 +3,17 @@ package constants
 const (
 	ExchangeTypeDirect = "direct"
 
-	EmailExchange = "email_exchange"
-	LogExchange   = "log_exchange"
+	EmailExchange     = "email_exchange"
+	LogExchange       = "log_exchange"
+	LoanEventExchange = "loan_event_exchange"
 
 	OTPQueue                = "otp_code"
 	LoanNotificationQueue   = "loan_notification"
 	ReturnNotificationQueue = "return_notification"
+	HoldReadyQueue          = "hold_ready_notification"
 	LogQueue                = "log_queue"
+	LoanOverdueQueue        = "loan_overdue"
+	LoanStatusChangedQueue  = "loan_status_changed"
 
 	LogServiceLoan = "loan-service"
 
@@ -0,0 +1,105 @@
+package grpc_server
+
+import (
+	"context"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/pkg/utils"
+	protoLoan "loan_service/proto/loan_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *loanGRPCServer) ListUserFines(ctx context.Context, req *protoLoan.ListUserFinesRequest) (*protoLoan.ListFinesResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListUserFines request", map[string]interface{}{"user_id": req.UserId, "status": req.Status}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListUserFines request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	fines, code, totalItems, outstanding, err := s.fineService.ListUserFines(ctx, req.UserId, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve user fines", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	var protoFines []*protoLoan.Fine
+	for _, fine := range fines {
+		protoFines = append(protoFines, toProtoFine(fine))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User fines retrieved successfully", map[string]interface{}{"user_id": req.UserId, "outstanding": outstanding}, nil)
+
+	return &protoLoan.ListFinesResponse{
+		Fines:       protoFines,
+		TotalItems:  int32(totalItems),
+		TotalPages:  int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		Outstanding: outstanding,
+	}, nil
+}
+
+func (s *loanGRPCServer) PayFine(ctx context.Context, req *protoLoan.PayFineRequest) (*protoLoan.FineResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received PayFine request", map[string]interface{}{"fine_id": req.Id, "user_id": req.UserId, "amount": req.Amount}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid PayFine request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	fine, code, err := s.fineService.PayFine(ctx, req.Id, req.UserId, req.Amount, int(req.Version))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to pay fine", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fine paid successfully", map[string]interface{}{"fine_id": fine.Id, "status": fine.Status}, nil)
+
+	return &protoLoan.FineResponse{
+		Fine: toProtoFine(fine),
+	}, nil
+}
+
+func (s *loanGRPCServer) WaiveFine(ctx context.Context, req *protoLoan.WaiveFineRequest) (*protoLoan.FineResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received WaiveFine request", map[string]interface{}{"fine_id": req.Id, "note": req.Note}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid WaiveFine request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	fine, code, err := s.fineService.WaiveFine(ctx, req.Id, req.Note, int(req.Version))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to waive fine", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Fine waived successfully", map[string]interface{}{"fine_id": fine.Id}, nil)
+
+	return &protoLoan.FineResponse{
+		Fine: toProtoFine(fine),
+	}, nil
+}
+
+// toProtoFine converts a fine record into its protobuf representation
+func toProtoFine(fine *models.FineRecord) *protoLoan.Fine {
+	return &protoLoan.Fine{
+		Id:         fine.Id,
+		UserId:     fine.UserId,
+		LoanId:     fine.LoanId,
+		Type:       fine.Type,
+		Amount:     fine.Amount,
+		PaidAmount: fine.PaidAmount,
+		Status:     fine.Status,
+		Version:    int32(fine.Version),
+		CreatedAt:  fine.CreatedAt.Unix(),
+		UpdatedAt:  fine.UpdatedAt.Unix(),
+	}
+}
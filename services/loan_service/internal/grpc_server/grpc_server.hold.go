@@ -0,0 +1,117 @@
+package grpc_server
+
+import (
+	"context"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/pkg/utils"
+	protoLoan "loan_service/proto/loan_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *loanGRPCServer) PlaceHold(ctx context.Context, req *protoLoan.PlaceHoldRequest) (*protoLoan.HoldResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received PlaceHold request", map[string]interface{}{"user_id": req.UserId, "book_id": req.BookId, "email": req.Email}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid PlaceHold request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	hold, code, err := s.holdService.PlaceHold(ctx, req.UserId, req.Email, req.BookId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to place hold", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Hold placed successfully", map[string]interface{}{"hold_id": hold.Id, "queue_position": hold.QueuePosition}, nil)
+
+	return &protoLoan.HoldResponse{
+		Hold: toProtoHold(hold),
+	}, nil
+}
+
+func (s *loanGRPCServer) CancelHold(ctx context.Context, req *protoLoan.CancelHoldRequest) (*protoLoan.HoldResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CancelHold request", map[string]interface{}{"hold_id": req.Id, "user_id": req.UserId}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CancelHold request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	hold, code, err := s.holdService.CancelHold(
+		context.WithValue(ctx, constants.ContextRequestIDKey, requestID),
+		req.Id,
+		req.UserId,
+		int(req.Version),
+	)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to cancel hold", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Hold cancelled successfully", map[string]interface{}{"hold_id": hold.Id}, nil)
+
+	return &protoLoan.HoldResponse{
+		Hold: toProtoHold(hold),
+	}, nil
+}
+
+func (s *loanGRPCServer) ListUserHolds(ctx context.Context, req *protoLoan.ListUserHoldsRequest) (*protoLoan.ListHoldsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListUserHolds request", map[string]interface{}{"user_id": req.UserId, "status": req.Status}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid ListUserHolds request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	holds, code, totalItems, err := s.holdService.ListUserHolds(ctx, req.UserId, req.Status, int(req.Page), int(req.PageSize))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve user holds", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	var protoHolds []*protoLoan.Hold
+	for _, hold := range holds {
+		protoHolds = append(protoHolds, toProtoHold(hold))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User holds retrieved successfully", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	return &protoLoan.ListHoldsResponse{
+		Holds:      protoHolds,
+		TotalItems: int32(totalItems),
+		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+	}, nil
+}
+
+// toProtoHold converts a hold record into its protobuf representation, missing ready and expiry times are sent as 0
+func toProtoHold(hold *models.HoldRecord) *protoLoan.Hold {
+	var readyAt, expiresAt int64
+	if hold.ReadyAt != nil {
+		readyAt = hold.ReadyAt.Unix()
+	}
+	if hold.ExpiresAt != nil {
+		expiresAt = hold.ExpiresAt.Unix()
+	}
+
+	return &protoLoan.Hold{
+		Id:            hold.Id,
+		UserId:        hold.UserId,
+		BookId:        hold.BookId,
+		Status:        hold.Status,
+		QueuePosition: int32(hold.QueuePosition),
+		ReadyAt:       readyAt,
+		ExpiresAt:     expiresAt,
+		Version:       int32(hold.Version),
+		CreatedAt:     hold.CreatedAt.Unix(),
+		UpdatedAt:     hold.UpdatedAt.Unix(),
+	}
+}
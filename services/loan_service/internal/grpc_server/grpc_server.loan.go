@@ -3,6 +3,7 @@ package grpc_server
 import (
 	"context"
 	"loan_service/internal/constants"
+	"loan_service/internal/models"
 	"loan_service/internal/service"
 	"loan_service/pkg/logger"
 	"loan_service/pkg/utils"
@@ -15,13 +16,17 @@ import (
 
 type loanGRPCServer struct {
 	loanService service.LoanService
+	fineService service.FineService
+	holdService service.HoldService
 	logger      *logger.Logger
 	protoLoan.UnimplementedLoanServiceServer
 }
 
-func NewLoanGRPCServer(loanService service.LoanService, logger *logger.Logger) protoLoan.LoanServiceServer {
+func NewLoanGRPCServer(loanService service.LoanService, fineService service.FineService, holdService service.HoldService, logger *logger.Logger) protoLoan.LoanServiceServer {
 	return &loanGRPCServer{
 		loanService: loanService,
+		fineService: fineService,
+		holdService: holdService,
 		logger:      logger,
 	}
 }
@@ -41,7 +46,6 @@ func (s *loanGRPCServer) CreateLoan(ctx context.Context, req *protoLoan.CreateLo
 		req.UserId,
 		req.Email,
 		req.BookId,
-		int(req.BookVersion),
 	)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to create loan", nil, err)
@@ -51,22 +55,13 @@ func (s *loanGRPCServer) CreateLoan(ctx context.Context, req *protoLoan.CreateLo
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan created successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
 
 	return &protoLoan.LoanResponse{
-		Loan: &protoLoan.Loan{
-			Id:        loan.Id,
-			UserId:    loan.UserId,
-			BookId:    loan.BookId,
-			LoanDate:  loan.LoanDate.Unix(),
-			Status:    loan.Status,
-			Version:   int32(loan.Version),
-			CreatedAt: loan.CreatedAt.Unix(),
-			UpdatedAt: loan.UpdatedAt.Unix(),
-		},
+		Loan: toProtoLoan(loan),
 	}, nil
 }
 
 func (s *loanGRPCServer) ReturnLoan(ctx context.Context, req *protoLoan.ReturnLoanRequest) (*protoLoan.LoanResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ReturnLoan request", map[string]interface{}{"loan_id": req.Id, "user_id": req.UserId, "email": req.Email, "return_date": req.ReturnDate}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ReturnLoan request", map[string]interface{}{"loan_id": req.Id, "user_id": req.UserId, "email": req.Email}, nil)
 
 	// Validate request from client
 	if err := req.Validate(); err != nil {
@@ -79,9 +74,7 @@ func (s *loanGRPCServer) ReturnLoan(ctx context.Context, req *protoLoan.ReturnLo
 		req.Id,
 		req.UserId,
 		req.Email,
-		time.Unix(req.ReturnDate, 0),
 		int(req.Version),
-		int(req.BookVersion),
 	)
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to return loan", nil, err)
@@ -91,17 +84,30 @@ func (s *loanGRPCServer) ReturnLoan(ctx context.Context, req *protoLoan.ReturnLo
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan returned successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
 
 	return &protoLoan.LoanResponse{
-		Loan: &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: loan.ReturnDate.Unix(),
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		},
+		Loan: toProtoLoan(loan),
+	}, nil
+}
+
+func (s *loanGRPCServer) RenewLoan(ctx context.Context, req *protoLoan.RenewLoanRequest) (*protoLoan.LoanResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received RenewLoan request", map[string]interface{}{"loan_id": req.Id, "user_id": req.UserId}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid RenewLoan request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	loan, code, err := s.loanService.RenewLoan(ctx, req.Id, req.UserId, int(req.Version))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to renew loan", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan renewed successfully", map[string]interface{}{"loan_id": loan.Id, "due_date": loan.DueDate}, nil)
+
+	return &protoLoan.LoanResponse{
+		Loan: toProtoLoan(loan),
 	}, nil
 }
 
@@ -121,33 +127,16 @@ func (s *loanGRPCServer) GetLoan(ctx context.Context, req *protoLoan.GetLoanRequ
 		return nil, status.Error(code, err.Error())
 	}
 
-	var returnDate int64
-	if loan.ReturnDate != nil {
-		returnDate = loan.ReturnDate.Unix()
-	} else {
-		returnDate = 0
-	}
-
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan retrieved successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
 
 	return &protoLoan.LoanResponse{
-		Loan: &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: returnDate,
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		},
+		Loan: toProtoLoan(loan),
 	}, nil
 }
 
 func (s *loanGRPCServer) UpdateLoanStatus(ctx context.Context, req *protoLoan.UpdateLoanStatusRequest) (*protoLoan.LoanResponse, error) {
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateLoanStatus request", map[string]interface{}{"loan_id": req.Id, "status": req.Status}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateLoanStatus request", map[string]interface{}{"loan_id": req.Id, "status": req.Status.String()}, nil)
 
 	// Validate request from client
 	if err := req.Validate(); err != nil {
@@ -155,33 +144,108 @@ func (s *loanGRPCServer) UpdateLoanStatus(ctx context.Context, req *protoLoan.Up
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	loan, code, err := s.loanService.UpdateLoanStatus(ctx, req.Id, req.Status, int(req.Version))
+	loan, code, err := s.loanService.UpdateLoanStatus(ctx, req.Id, req.Status.String(), req.ChangedBy, req.Note, int(req.Version))
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to update loan status", nil, err)
 		return nil, status.Error(code, err.Error())
 	}
 
-	var returnDate int64
-	if loan.ReturnDate != nil {
-		returnDate = loan.ReturnDate.Unix()
-	} else {
-		returnDate = 0
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan status updated successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
+
+	return &protoLoan.LoanResponse{
+		Loan: toProtoLoan(loan),
+	}, nil
+}
+
+func (s *loanGRPCServer) CheckOutCopy(ctx context.Context, req *protoLoan.CheckOutCopyRequest) (*protoLoan.LoanResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CheckOutCopy request", map[string]interface{}{"user_id": req.UserId, "barcode": req.Barcode, "changed_by": req.ChangedBy}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CheckOutCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan status updated successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
+	loan, code, err := s.loanService.CheckOutCopy(
+		context.WithValue(ctx, constants.ContextRequestIDKey, requestID),
+		req.UserId,
+		req.Barcode,
+		req.ChangedBy,
+	)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to check out copy", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Copy checked out successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
+
+	return &protoLoan.LoanResponse{
+		Loan: toProtoLoan(loan),
+	}, nil
+}
+
+func (s *loanGRPCServer) CheckInCopy(ctx context.Context, req *protoLoan.CheckInCopyRequest) (*protoLoan.LoanResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CheckInCopy request", map[string]interface{}{"barcode": req.Barcode, "changed_by": req.ChangedBy, "condition": req.Condition}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CheckInCopy request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	loan, code, err := s.loanService.CheckInCopy(
+		context.WithValue(ctx, constants.ContextRequestIDKey, requestID),
+		req.Barcode,
+		req.ChangedBy,
+		req.Condition,
+	)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to check in copy", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Copy checked in successfully", map[string]interface{}{"loan_id": loan.Id}, nil)
 
 	return &protoLoan.LoanResponse{
-		Loan: &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: returnDate,
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		},
+		Loan: toProtoLoan(loan),
+	}, nil
+}
+
+func (s *loanGRPCServer) GetLoanHistory(ctx context.Context, req *protoLoan.GetLoanHistoryRequest) (*protoLoan.LoanHistoryResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetLoanHistory request", map[string]interface{}{"loan_id": req.Id}, nil)
+
+	// Validate request from client
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid GetLoanHistory request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	history, code, err := s.loanService.GetLoanHistory(ctx, req.Id)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve loan history", nil, err)
+		return nil, status.Error(code, err.Error())
+	}
+
+	var protoHistory []*protoLoan.LoanStatusChange
+	for _, change := range history {
+		protoHistory = append(protoHistory, &protoLoan.LoanStatusChange{
+			Id:         change.Id,
+			LoanId:     change.LoanId,
+			FromStatus: toProtoLoanStatus(change.FromStatus),
+			ToStatus:   toProtoLoanStatus(change.ToStatus),
+			ChangedBy:  change.ChangedBy,
+			Note:       change.Note,
+			CreatedAt:  change.CreatedAt.Unix(),
+		})
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan history retrieved successfully", map[string]interface{}{"loan_id": req.Id, "total": len(history)}, nil)
+
+	return &protoLoan.LoanHistoryResponse{
+		History: protoHistory,
 	}, nil
 }
 
@@ -195,7 +259,7 @@ func (s *loanGRPCServer) ListUserLoans(ctx context.Context, req *protoLoan.ListU
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	loans, code, totalItems, err := s.loanService.ListUserLoans(ctx, req.UserId, int(req.Page), int(req.PageSize))
+	loans, code, totalItems, nextPageToken, err := s.loanService.ListUserLoans(ctx, req.UserId, toLoanFilter(req.Page, req.PageSize, req.Options), req.Options.GetPageToken())
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve user loans", nil, err)
 		return nil, status.Error(code, err.Error())
@@ -203,32 +267,16 @@ func (s *loanGRPCServer) ListUserLoans(ctx context.Context, req *protoLoan.ListU
 
 	var protoLoans []*protoLoan.Loan
 	for _, loan := range loans {
-		var returnDate int64
-		if loan.ReturnDate != nil {
-			returnDate = loan.ReturnDate.Unix()
-		} else {
-			returnDate = 0
-		}
-
-		protoLoans = append(protoLoans, &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: returnDate,
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		})
+		protoLoans = append(protoLoans, toProtoLoan(loan))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User loans retrieved successfully", nil, nil)
 
 	return &protoLoan.ListLoansResponse{
-		Loans:      protoLoans,
-		TotalItems: int32(totalItems),
-		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		Loans:         protoLoans,
+		TotalItems:    int32(totalItems),
+		TotalPages:    int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -242,7 +290,7 @@ func (s *loanGRPCServer) ListLoans(ctx context.Context, req *protoLoan.ListLoans
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
 	}
 
-	loans, code, totalItems, err := s.loanService.ListLoans(ctx, int(req.Page), int(req.PageSize))
+	loans, code, totalItems, nextPageToken, err := s.loanService.ListLoans(ctx, toLoanFilter(req.Page, req.PageSize, req.Options), req.Options.GetPageToken())
 	if err != nil {
 		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve loan list", nil, err)
 		return nil, status.Error(code, err.Error())
@@ -250,32 +298,16 @@ func (s *loanGRPCServer) ListLoans(ctx context.Context, req *protoLoan.ListLoans
 
 	var protoLoans []*protoLoan.Loan
 	for _, loan := range loans {
-		var returnDate int64
-		if loan.ReturnDate != nil {
-			returnDate = loan.ReturnDate.Unix()
-		} else {
-			returnDate = 0
-		}
-
-		protoLoans = append(protoLoans, &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: returnDate,
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		})
+		protoLoans = append(protoLoans, toProtoLoan(loan))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loan list retrieved successfully", nil, nil)
 
 	return &protoLoan.ListLoansResponse{
-		Loans:      protoLoans,
-		TotalItems: int32(totalItems),
-		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		Loans:         protoLoans,
+		TotalItems:    int32(totalItems),
+		TotalPages:    int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -284,7 +316,7 @@ func (s *loanGRPCServer) GetUserLoansByStatus(ctx context.Context, req *protoLoa
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
 
 	// Logging permintaan untuk mendapatkan pinjaman berdasarkan status pengguna
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetUserLoansByStatus request", map[string]interface{}{"user_id": req.UserId, "status": req.Status}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetUserLoansByStatus request", map[string]interface{}{"user_id": req.UserId, "status": req.Status.String()}, nil)
 
 	// Validasi permintaan dari klien
 	if err := req.Validate(); err != nil {
@@ -294,44 +326,28 @@ func (s *loanGRPCServer) GetUserLoansByStatus(ctx context.Context, req *protoLoa
 	}
 
 	// Mengambil pinjaman berdasarkan status pengguna
-	loans, code, totalItems, err := s.loanService.GetUserLoansByStatus(ctx, req.UserId, req.Status, int(req.Page), int(req.PageSize))
+	loans, code, totalItems, nextPageToken, err := s.loanService.GetUserLoansByStatus(ctx, req.UserId, req.Status.String(), toLoanFilter(req.Page, req.PageSize, req.Options), req.Options.GetPageToken())
 	if err != nil {
 		// Logging error pada saat mengambil data pinjaman
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve user loans by status", map[string]interface{}{"user_id": req.UserId, "status": req.Status}, err)
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve user loans by status", map[string]interface{}{"user_id": req.UserId, "status": req.Status.String()}, err)
 		return nil, status.Error(code, err.Error())
 	}
 
 	// Membentuk daftar pinjaman untuk respons
 	var protoLoans []*protoLoan.Loan
 	for _, loan := range loans {
-		var returnDate int64
-		if loan.ReturnDate != nil {
-			returnDate = loan.ReturnDate.Unix()
-		} else {
-			returnDate = 0
-		}
-
-		protoLoans = append(protoLoans, &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: returnDate,
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		})
+		protoLoans = append(protoLoans, toProtoLoan(loan))
 	}
 
 	// Logging bahwa data pinjaman berdasarkan status pengguna berhasil didapatkan
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User loans by status retrieved successfully", map[string]interface{}{"user_id": req.UserId, "status": req.Status}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User loans by status retrieved successfully", map[string]interface{}{"user_id": req.UserId, "status": req.Status.String()}, nil)
 
 	// Mengembalikan respons dengan daftar pinjaman
 	return &protoLoan.ListLoansResponse{
-		Loans:      protoLoans,
-		TotalItems: int32(totalItems),
-		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		Loans:         protoLoans,
+		TotalItems:    int32(totalItems),
+		TotalPages:    int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -340,7 +356,7 @@ func (s *loanGRPCServer) GetLoansByStatus(ctx context.Context, req *protoLoan.Ge
 	requestID := utils.GetRequestIDFromMetadataContext(ctx)
 
 	// Logging permintaan untuk mendapatkan pinjaman berdasarkan status
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetLoansByStatus request", map[string]interface{}{"status": req.Status}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetLoansByStatus request", map[string]interface{}{"status": req.Status.String()}, nil)
 
 	// Validasi permintaan dari klien
 	if err := req.Validate(); err != nil {
@@ -350,43 +366,76 @@ func (s *loanGRPCServer) GetLoansByStatus(ctx context.Context, req *protoLoan.Ge
 	}
 
 	// Mengambil pinjaman berdasarkan status
-	loans, code, totalItems, err := s.loanService.GetLoansByStatus(ctx, req.Status, int(req.Page), int(req.PageSize))
+	loans, code, totalItems, nextPageToken, err := s.loanService.GetLoansByStatus(ctx, req.Status.String(), toLoanFilter(req.Page, req.PageSize, req.Options), req.Options.GetPageToken())
 	if err != nil {
 		// Logging error pada saat mengambil data pinjaman
-		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve loans by status", map[string]interface{}{"status": req.Status}, err)
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve loans by status", map[string]interface{}{"status": req.Status.String()}, err)
 		return nil, status.Error(code, err.Error())
 	}
 
 	// Membentuk daftar pinjaman untuk respons
 	var protoLoans []*protoLoan.Loan
 	for _, loan := range loans {
-		var returnDate int64
-		if loan.ReturnDate != nil {
-			returnDate = loan.ReturnDate.Unix()
-		} else {
-			returnDate = 0
-		}
-
-		protoLoans = append(protoLoans, &protoLoan.Loan{
-			Id:         loan.Id,
-			UserId:     loan.UserId,
-			BookId:     loan.BookId,
-			LoanDate:   loan.LoanDate.Unix(),
-			ReturnDate: returnDate,
-			Status:     loan.Status,
-			Version:    int32(loan.Version),
-			CreatedAt:  loan.CreatedAt.Unix(),
-			UpdatedAt:  loan.UpdatedAt.Unix(),
-		})
+		protoLoans = append(protoLoans, toProtoLoan(loan))
 	}
 
 	// Logging bahwa data pinjaman berdasarkan status berhasil didapatkan
-	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loans by status retrieved successfully", map[string]interface{}{"status": req.Status}, nil)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Loans by status retrieved successfully", map[string]interface{}{"status": req.Status.String()}, nil)
 
 	// Mengembalikan respons dengan daftar pinjaman
 	return &protoLoan.ListLoansResponse{
-		Loans:      protoLoans,
-		TotalItems: int32(totalItems),
-		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		Loans:         protoLoans,
+		TotalItems:    int32(totalItems),
+		TotalPages:    int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// toLoanFilter converts the pagination fields and listing options of a request into a repository filter
+func toLoanFilter(page, pageSize int32, options *protoLoan.LoanListOptions) *models.LoanFilter {
+	filter := &models.LoanFilter{
+		Page:      int(page),
+		PageSize:  int(pageSize),
+		SortBy:    options.GetSortBy(),
+		SortOrder: options.GetSortOrder(),
+	}
+
+	if options.GetFromDate() > 0 {
+		fromDate := time.Unix(options.GetFromDate(), 0)
+		filter.FromDate = &fromDate
+	}
+	if options.GetToDate() > 0 {
+		toDate := time.Unix(options.GetToDate(), 0)
+		filter.ToDate = &toDate
+	}
+
+	return filter
+}
+
+// toProtoLoan converts a loan record into its protobuf representation, a missing return date is sent as 0
+func toProtoLoan(loan *models.LoanRecord) *protoLoan.Loan {
+	var returnDate int64
+	if loan.ReturnDate != nil {
+		returnDate = loan.ReturnDate.Unix()
+	}
+
+	return &protoLoan.Loan{
+		Id:           loan.Id,
+		UserId:       loan.UserId,
+		BookId:       loan.BookId,
+		CopyId:       loan.CopyId,
+		LoanDate:     loan.LoanDate.Unix(),
+		DueDate:      loan.DueDate.Unix(),
+		ReturnDate:   returnDate,
+		RenewalCount: int32(loan.RenewalCount),
+		Status:       toProtoLoanStatus(loan.Status),
+		Version:      int32(loan.Version),
+		CreatedAt:    loan.CreatedAt.Unix(),
+		UpdatedAt:    loan.UpdatedAt.Unix(),
+	}
+}
+
+// toProtoLoanStatus maps a stored loan status onto the proto enum, an empty status maps to LOAN_STATUS_UNSPECIFIED
+func toProtoLoanStatus(status string) protoLoan.LoanStatus {
+	return protoLoan.LoanStatus(protoLoan.LoanStatus_value[status])
+}
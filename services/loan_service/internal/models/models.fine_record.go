@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type FineRecord struct {
+	Id         string    `db:"id"`
+	UserId     string    `db:"user_id"`
+	LoanId     string    `db:"loan_id"`
+	Type       string    `db:"type"`   // "OVERDUE", "LOST"
+	Amount     int64     `db:"amount"` // rupiah
+	PaidAmount int64     `db:"paid_amount"`
+	Status     string    `db:"status"` // "UNPAID", "PAID", "WAIVED"
+	Version    int       `db:"version"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// FineEntry is a fine charged or waived together with a loan transition.
+// A waiver forgives what is outstanding on the unpaid fines of Type on the loan, its Amount is not used.
+type FineEntry struct {
+	EntryType string // "CHARGE", "WAIVER"
+	Type      string
+	Amount    int64
+	Note      string
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type HoldRecord struct {
+	Id        string     `db:"id"`
+	UserId    string     `db:"user_id"`
+	BookId    string     `db:"book_id"`
+	Email     string     `db:"email"`
+	Status    string     `db:"status"` // "WAITING", "READY", "FULFILLED", "CANCELLED", "EXPIRED"
+	ReadyAt   *time.Time `db:"ready_at"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	Version   int        `db:"version"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+
+	QueuePosition int `db:"queue_position"` // 1-based place among waiting holds, only filled by listing queries
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// LoanFilter orders, narrows and paginates loan listings
+type LoanFilter struct {
+	Page      int
+	PageSize  int
+	SortBy    string      // "loan_date" or "updated_at"
+	SortOrder string      // "asc" or "desc"
+	FromDate  *time.Time  // inclusive lower bound on loan_date
+	ToDate    *time.Time  // inclusive upper bound on loan_date
+	Cursor    *LoanCursor // keyset position, takes precedence over Page when set
+}
+
+// LoanCursor marks the last row of a page for keyset pagination. It names the sort it was taken
+// under, a position in one ordering means nothing in another.
+type LoanCursor struct {
+	SortBy    string    `json:"s"`
+	SortOrder string    `json:"o"`
+	SortValue time.Time `json:"v"`
+	Id        string    `json:"id"`
+}
@@ -14,3 +14,30 @@ type ReturnNotificationMessage struct {
 	Email     string `json:"email"`
 	Book      string `json:"book"` // book title
 }
+
+type HoldReadyMessage struct {
+	RequestID string    `json:"X-Correlation-ID"` // for logging purpose
+	Email     string    `json:"email"`
+	Book      string    `json:"book"` // book title
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type LoanOverdueEvent struct {
+	RequestID string    `json:"X-Correlation-ID"` // for logging purpose
+	LoanId    string    `json:"loan_id"`
+	UserId    string    `json:"user_id"`
+	BookId    string    `json:"book_id"`
+	DueDate   time.Time `json:"due_date"`
+	OverdueAt time.Time `json:"overdue_at"`
+}
+
+type LoanStatusChangedEvent struct {
+	RequestID  string    `json:"X-Correlation-ID"` // for logging purpose
+	LoanId     string    `json:"loan_id"`
+	UserId     string    `json:"user_id"`
+	BookId     string    `json:"book_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ChangedBy  string    `json:"changed_by"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
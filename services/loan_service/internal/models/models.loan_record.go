@@ -3,13 +3,16 @@ package models
 import "time"
 
 type LoanRecord struct {
-	Id         string     `db:"id"`
-	UserId     string     `db:"user_id"`
-	BookId     string     `db:"book_id"`
-	LoanDate   time.Time  `db:"loan_date"`
-	ReturnDate *time.Time `db:"return_date"`
-	Status     string     `db:"status"` // "BORROWED", "RETURNED", "LOST"
-	Version    int        `db:"version"`
-	CreatedAt  time.Time  `db:"created_at"`
-	UpdatedAt  time.Time  `db:"updated_at"`
+	Id           string     `db:"id"`
+	UserId       string     `db:"user_id"`
+	BookId       string     `db:"book_id"`
+	CopyId       string     `db:"copy_id"`
+	LoanDate     time.Time  `db:"loan_date"`
+	DueDate      time.Time  `db:"due_date"`
+	ReturnDate   *time.Time `db:"return_date"`
+	RenewalCount int        `db:"renewal_count"`
+	Status       string     `db:"status"` // "BORROWED", "RETURNED", "OVERDUE", "LOST", "FOUND"
+	Version      int        `db:"version"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
 }
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type LoanStatusChange struct {
+	Id         string    `db:"id"`
+	LoanId     string    `db:"loan_id"`
+	FromStatus string    `db:"from_status"` // empty for the initial BORROWED entry
+	ToStatus   string    `db:"to_status"`
+	ChangedBy  string    `db:"changed_by"` // user ID, or "system" for the sweepers
+	Note       string    `db:"note"`
+	CreatedAt  time.Time `db:"created_at"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxMessage is a RabbitMQ message stored in the same transaction as the change it announces,
+// the outbox relay publishes it once that transaction has committed
+type OutboxMessage struct {
+	Id          string          `db:"id"`
+	Exchange    string          `db:"exchange"`
+	RoutingKey  string          `db:"routing_key"`
+	Payload     json.RawMessage `db:"payload"`
+	Attempts    int             `db:"attempts"`
+	CreatedAt   time.Time       `db:"created_at"`
+	PublishedAt *time.Time      `db:"published_at"`
+}
+
+func NewOutboxMessage(exchange, routingKey string, body any) (*OutboxMessage, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxMessage{
+		Exchange:   exchange,
+		RoutingKey: routingKey,
+		Payload:    payload,
+	}, nil
+}
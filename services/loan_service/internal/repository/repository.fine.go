@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/pkg/utils"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type FineRepository interface {
+	GetFine(ctx context.Context, id string) (*models.FineRecord, error)
+	ListUserFines(ctx context.Context, userId, status string, page, pageSize int) ([]*models.FineRecord, error)
+	CountUserFines(ctx context.Context, userId, status string) (int, error)
+	GetUnpaidFineTotal(ctx context.Context, userId string) (int64, error)
+	PayFine(ctx context.Context, id string, version int, amount int64, note string) (*models.FineRecord, error)
+	WaiveFine(ctx context.Context, id string, version int, note string) (*models.FineRecord, error)
+}
+
+type fineRepository struct {
+	db *sqlx.DB
+}
+
+func NewFineRepository(db *sqlx.DB) FineRepository {
+	return &fineRepository{db: db}
+}
+
+// Every statement that changes a fine also appends the matching ledger entry within the same query,
+// so the fine and its ledger can never drift apart.
+
+func (r *fineRepository) GetFine(ctx context.Context, id string) (*models.FineRecord, error) {
+	query := `
+		SELECT
+			id, user_id, loan_id, type, amount, paid_amount, status, version, created_at, updated_at
+		FROM
+			fines
+		WHERE
+			id = $1
+	`
+
+	log.Printf("[%s] Executing query to get fine by ID: %s\n", utils.GetLocation(), id)
+
+	fine := &models.FineRecord{}
+	if err := r.db.GetContext(ctx, fine, query, id); err != nil {
+		log.Printf("[%s] Error executing GetFine query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Fine retrieved successfully: %+v\n", utils.GetLocation(), fine)
+	return fine, nil
+}
+
+func (r *fineRepository) ListUserFines(ctx context.Context, userId, status string, page, pageSize int) ([]*models.FineRecord, error) {
+	// An empty status lists fines of every status
+	query := `
+		SELECT
+			id, user_id, loan_id, type, amount, paid_amount, status, version, created_at, updated_at
+		FROM
+			fines
+		WHERE
+			user_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY
+			created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	offset := (page - 1) * pageSize
+
+	log.Printf("[%s] Executing query to list fines for user %s with status %q (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, status, page, pageSize)
+
+	var fines []*models.FineRecord
+	if err := r.db.SelectContext(ctx, &fines, query, userId, status, pageSize, offset); err != nil {
+		log.Printf("[%s] Error executing ListUserFines query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Retrieved %d fines for user %s\n", utils.GetLocation(), len(fines), userId)
+	return fines, nil
+}
+
+func (r *fineRepository) CountUserFines(ctx context.Context, userId, status string) (int, error) {
+	query := `
+		SELECT
+			COUNT(*)
+		FROM
+			fines
+		WHERE
+			user_id = $1 AND ($2 = '' OR status = $2)
+	`
+
+	log.Printf("[%s] Executing query to count fines for user %s with status %q\n", utils.GetLocation(), userId, status)
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, userId, status); err != nil {
+		log.Printf("[%s] Error executing CountUserFines query: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *fineRepository) GetUnpaidFineTotal(ctx context.Context, userId string) (int64, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(amount - paid_amount), 0)
+		FROM
+			fines
+		WHERE
+			user_id = $1 AND status = 'UNPAID'
+	`
+
+	log.Printf("[%s] Executing query to sum unpaid fines for user %s\n", utils.GetLocation(), userId)
+
+	var total int64
+	if err := r.db.GetContext(ctx, &total, query, userId); err != nil {
+		log.Printf("[%s] Error executing GetUnpaidFineTotal query: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// PayFine applies a (partial) payment, the fine becomes PAID once the paid amount covers the whole fine
+func (r *fineRepository) PayFine(ctx context.Context, id string, version int, amount int64, note string) (*models.FineRecord, error) {
+	query := `
+		WITH fine AS (
+			UPDATE
+				fines
+			SET
+				paid_amount = paid_amount + $3,
+				status = CASE WHEN paid_amount + $3 = amount THEN 'PAID' ELSE status END
+			WHERE
+				id = $1 AND version = $2 AND status = 'UNPAID' AND paid_amount + $3 <= amount
+			RETURNING
+				id, user_id, loan_id, type, amount, paid_amount, status, version, created_at, updated_at
+		), entry AS (
+			INSERT INTO
+				fine_ledger (fine_id, user_id, entry_type, amount, note)
+			SELECT
+				id, user_id, 'PAYMENT', $3, $4
+			FROM
+				fine
+		)
+		SELECT
+			id, user_id, loan_id, type, amount, paid_amount, status, version, created_at, updated_at
+		FROM
+			fine
+	`
+
+	log.Printf("[%s] Executing query to pay %d for fine with ID: %s\n", utils.GetLocation(), amount, id)
+
+	fine := &models.FineRecord{}
+	if err := r.db.GetContext(ctx, fine, query, id, version, amount, note); err != nil {
+		log.Printf("[%s] Error executing PayFine query: %v\n", utils.GetLocation(), err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("payment failed due to wrong id, fine status, amount or concurrent modification")
+		}
+		return nil, err
+	}
+
+	log.Printf("[%s] Fine paid successfully: %+v\n", utils.GetLocation(), fine)
+	return fine, nil
+}
+
+// WaiveFine forgives whatever is still outstanding on an unpaid fine
+func (r *fineRepository) WaiveFine(ctx context.Context, id string, version int, note string) (*models.FineRecord, error) {
+	query := `
+		WITH fine AS (
+			UPDATE
+				fines
+			SET
+				status = 'WAIVED'
+			WHERE
+				id = $1 AND version = $2 AND status = 'UNPAID'
+			RETURNING
+				id, user_id, loan_id, type, amount, paid_amount, status, version, created_at, updated_at
+		), entry AS (
+			INSERT INTO
+				fine_ledger (fine_id, user_id, entry_type, amount, note)
+			SELECT
+				id, user_id, 'WAIVER', amount - paid_amount, $3
+			FROM
+				fine
+		)
+		SELECT
+			id, user_id, loan_id, type, amount, paid_amount, status, version, created_at, updated_at
+		FROM
+			fine
+	`
+
+	log.Printf("[%s] Executing query to waive fine with ID: %s\n", utils.GetLocation(), id)
+
+	fine := &models.FineRecord{}
+	if err := r.db.GetContext(ctx, fine, query, id, version, note); err != nil {
+		log.Printf("[%s] Error executing WaiveFine query: %v\n", utils.GetLocation(), err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("waive failed due to wrong id, fine status or concurrent modification")
+		}
+		return nil, err
+	}
+
+	log.Printf("[%s] Fine waived successfully: %+v\n", utils.GetLocation(), fine)
+	return fine, nil
+}
+
+// insertFineEntries charges or waives the fines of a loan inside the caller's transaction
+func insertFineEntries(ctx context.Context, tx *sqlx.Tx, loan *models.LoanRecord, entries []*models.FineEntry) error {
+	chargeQuery := `
+		WITH fine AS (
+			INSERT INTO
+				fines (user_id, loan_id, type, amount)
+			VALUES
+				($1, $2, $3, $4)
+			RETURNING
+				id, user_id, amount
+		)
+		INSERT INTO
+			fine_ledger (fine_id, user_id, entry_type, amount, note)
+		SELECT
+			id, user_id, 'CHARGE', amount, $5
+		FROM
+			fine
+	`
+	waiverQuery := `
+		WITH fine AS (
+			UPDATE
+				fines
+			SET
+				status = 'WAIVED'
+			WHERE
+				loan_id = $1 AND type = $2 AND status = 'UNPAID'
+			RETURNING
+				id, user_id, amount, paid_amount
+		)
+		INSERT INTO
+			fine_ledger (fine_id, user_id, entry_type, amount, note)
+		SELECT
+			id, user_id, 'WAIVER', amount - paid_amount, $3
+		FROM
+			fine
+	`
+
+	for _, entry := range entries {
+		var err error
+		switch entry.EntryType {
+		case constants.FineEntryCharge:
+			_, err = tx.ExecContext(ctx, chargeQuery, loan.UserId, loan.Id, entry.Type, entry.Amount, entry.Note)
+		case constants.FineEntryWaiver:
+			_, err = tx.ExecContext(ctx, waiverQuery, loan.Id, entry.Type, entry.Note)
+		default:
+			err = fmt.Errorf("unknown fine entry type %q", entry.EntryType)
+		}
+		if err != nil {
+			log.Printf("[%s] Error recording %s fine entry for loan %s: %v\n", utils.GetLocation(), entry.Type, loan.Id, err)
+			return err
+		}
+	}
+
+	return nil
+}
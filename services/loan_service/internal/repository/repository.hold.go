@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"loan_service/internal/models"
+	"loan_service/pkg/utils"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type HoldRepository interface {
+	CreateHold(ctx context.Context, hold *models.HoldRecord) (*models.HoldRecord, error)
+	GetHold(ctx context.Context, id string) (*models.HoldRecord, error)
+	GetActiveHoldByBookIdAndUserId(ctx context.Context, bookId, userId string) (*models.HoldRecord, error)
+	CountReadyHolds(ctx context.Context, bookId, excludeUserId string) (int, error)
+	ListUserHolds(ctx context.Context, userId, status string, page, pageSize int) ([]*models.HoldRecord, error)
+	CountUserHolds(ctx context.Context, userId, status string) (int, error)
+	CancelHold(ctx context.Context, id string, version int) (*models.HoldRecord, error)
+	PromoteNextHold(ctx context.Context, bookId string, expiresAt time.Time, newMessage func(hold *models.HoldRecord) (*models.OutboxMessage, error)) (*models.HoldRecord, error)
+	ListWaitingHoldBookIds(ctx context.Context) ([]string, error)
+	ExpireReadyHolds(ctx context.Context, now time.Time) ([]*models.HoldRecord, error)
+}
+
+type holdRepository struct {
+	db *sqlx.DB
+}
+
+func NewHoldRepository(db *sqlx.DB) HoldRepository {
+	return &holdRepository{db: db}
+}
+
+// queuePositionColumn computes the 1-based place of a waiting hold in its book queue, other statuses get 0
+const queuePositionColumn = `
+	CASE WHEN h.status = 'WAITING' THEN (
+		SELECT COUNT(*) FROM holds q
+		WHERE q.book_id = h.book_id AND q.status = 'WAITING' AND (q.created_at, q.id) <= (h.created_at, h.id)
+	) ELSE 0 END AS queue_position
+`
+
+func (r *holdRepository) CreateHold(ctx context.Context, req *models.HoldRecord) (*models.HoldRecord, error) {
+	query := `
+		INSERT INTO
+			holds (user_id, book_id, email)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			id, user_id, book_id, email, status, ready_at, expires_at, version, created_at, updated_at
+	`
+
+	log.Printf("[%s] Executing query to create hold: %s with parameters: %+v\n", utils.GetLocation(), query, req)
+
+	hold := &models.HoldRecord{}
+	if err := r.db.GetContext(ctx, hold, query, req.UserId, req.BookId, req.Email); err != nil {
+		log.Printf("[%s] Error executing CreateHold query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Hold created successfully: %+v\n", utils.GetLocation(), hold)
+	return hold, nil
+}
+
+func (r *holdRepository) GetHold(ctx context.Context, id string) (*models.HoldRecord, error) {
+	query := `
+		SELECT
+			h.id, h.user_id, h.book_id, h.email, h.status, h.ready_at, h.expires_at, h.version, h.created_at, h.updated_at,
+			` + queuePositionColumn + `
+		FROM
+			holds h
+		WHERE
+			h.id = $1
+	`
+
+	log.Printf("[%s] Executing query to get hold by ID: %s\n", utils.GetLocation(), id)
+
+	hold := &models.HoldRecord{}
+	if err := r.db.GetContext(ctx, hold, query, id); err != nil {
+		log.Printf("[%s] Error executing GetHold query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Hold retrieved successfully: %+v\n", utils.GetLocation(), hold)
+	return hold, nil
+}
+
+func (r *holdRepository) GetActiveHoldByBookIdAndUserId(ctx context.Context, bookId, userId string) (*models.HoldRecord, error) {
+	query := `
+		SELECT
+			id, user_id, book_id, email, status, ready_at, expires_at, version, created_at, updated_at
+		FROM
+			holds
+		WHERE
+			book_id = $1 AND user_id = $2 AND status IN ('WAITING', 'READY')
+	`
+
+	log.Printf("[%s] Executing query to get active hold for book %s and user %s\n", utils.GetLocation(), bookId, userId)
+
+	hold := &models.HoldRecord{}
+	if err := r.db.GetContext(ctx, hold, query, bookId, userId); err != nil {
+		log.Printf("[%s] Error executing GetActiveHoldByBookIdAndUserId query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// CountReadyHolds counts the copies of a book set aside for READY holds, ignoring the hold of excludeUserId
+func (r *holdRepository) CountReadyHolds(ctx context.Context, bookId, excludeUserId string) (int, error) {
+	query := `
+		SELECT
+			COUNT(*)
+		FROM
+			holds
+		WHERE
+			book_id = $1 AND status = 'READY' AND user_id::text <> $2
+	`
+
+	log.Printf("[%s] Executing query to count ready holds for book %s\n", utils.GetLocation(), bookId)
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, bookId, excludeUserId); err != nil {
+		log.Printf("[%s] Error executing CountReadyHolds query: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *holdRepository) ListUserHolds(ctx context.Context, userId, status string, page, pageSize int) ([]*models.HoldRecord, error) {
+	// An empty status lists holds of every status
+	query := `
+		SELECT
+			h.id, h.user_id, h.book_id, h.email, h.status, h.ready_at, h.expires_at, h.version, h.created_at, h.updated_at,
+			` + queuePositionColumn + `
+		FROM
+			holds h
+		WHERE
+			h.user_id = $1 AND ($2 = '' OR h.status = $2)
+		ORDER BY
+			h.created_at DESC, h.id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	offset := (page - 1) * pageSize
+
+	log.Printf("[%s] Executing query to list holds for user %s with status %q (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, status, page, pageSize)
+
+	var holds []*models.HoldRecord
+	if err := r.db.SelectContext(ctx, &holds, query, userId, status, pageSize, offset); err != nil {
+		log.Printf("[%s] Error executing ListUserHolds query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Retrieved %d holds for user %s\n", utils.GetLocation(), len(holds), userId)
+	return holds, nil
+}
+
+func (r *holdRepository) CountUserHolds(ctx context.Context, userId, status string) (int, error) {
+	query := `
+		SELECT
+			COUNT(*)
+		FROM
+			holds
+		WHERE
+			user_id = $1 AND ($2 = '' OR status = $2)
+	`
+
+	log.Printf("[%s] Executing query to count holds for user %s with status %q\n", utils.GetLocation(), userId, status)
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, userId, status); err != nil {
+		log.Printf("[%s] Error executing CountUserHolds query: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *holdRepository) CancelHold(ctx context.Context, id string, version int) (*models.HoldRecord, error) {
+	query := `
+		UPDATE
+			holds
+		SET
+			status = 'CANCELLED'
+		WHERE
+			id = $1 AND version = $2 AND status IN ('WAITING', 'READY')
+		RETURNING
+			id, user_id, book_id, email, status, ready_at, expires_at, version, created_at, updated_at
+	`
+
+	log.Printf("[%s] Executing query to cancel hold with ID: %s\n", utils.GetLocation(), id)
+
+	hold := &models.HoldRecord{}
+	if err := r.db.GetContext(ctx, hold, query, id, version); err != nil {
+		log.Printf("[%s] Error executing CancelHold query: %v\n", utils.GetLocation(), err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("cancel failed due to wrong id, hold status or concurrent modification")
+		}
+		return nil, err
+	}
+
+	log.Printf("[%s] Hold cancelled successfully: %+v\n", utils.GetLocation(), hold)
+	return hold, nil
+}
+
+// fulfillHold closes an active hold inside the caller's transaction once its owner borrowed the book
+func fulfillHold(ctx context.Context, tx *sqlx.Tx, id string) error {
+	query := `
+		UPDATE
+			holds
+		SET
+			status = 'FULFILLED'
+		WHERE
+			id = $1 AND status IN ('WAITING', 'READY')
+	`
+
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		log.Printf("[%s] Error fulfilling hold %s: %v\n", utils.GetLocation(), id, err)
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[%s] Error fulfilling hold %s: %v\n", utils.GetLocation(), id, err)
+		return err
+	}
+	if rows == 0 {
+		log.Printf("[%s] Hold %s is no longer active\n", utils.GetLocation(), id)
+		return errors.New("hold is no longer active")
+	}
+
+	return nil
+}
+
+// PromoteNextHold moves the oldest waiting hold of a book to READY, it returns nil when nobody is waiting.
+// The message built by newMessage for the promoted hold is stored in the outbox within the same transaction.
+func (r *holdRepository) PromoteNextHold(ctx context.Context, bookId string, expiresAt time.Time, newMessage func(hold *models.HoldRecord) (*models.OutboxMessage, error)) (*models.HoldRecord, error) {
+	query := `
+		UPDATE
+			holds
+		SET
+			status = 'READY', ready_at = NOW(), expires_at = $2
+		WHERE
+			id = (
+				SELECT id FROM holds
+				WHERE book_id = $1 AND status = 'WAITING'
+				ORDER BY created_at, id
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			id, user_id, book_id, email, status, ready_at, expires_at, version, created_at, updated_at
+	`
+
+	log.Printf("[%s] Executing query to promote next hold for book %s\n", utils.GetLocation(), bookId)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("[%s] Error starting PromoteNextHold transaction: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	hold := &models.HoldRecord{}
+	if err := tx.GetContext(ctx, hold, query, bookId, expiresAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Printf("[%s] No waiting hold for book %s\n", utils.GetLocation(), bookId)
+			return nil, nil
+		}
+		log.Printf("[%s] Error executing PromoteNextHold query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	message, err := newMessage(hold)
+	if err != nil {
+		log.Printf("[%s] Error building ready message for hold %s: %v\n", utils.GetLocation(), hold.Id, err)
+		return nil, err
+	}
+	if err := insertOutboxMessages(ctx, tx, []*models.OutboxMessage{message}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[%s] Error committing PromoteNextHold transaction: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Hold promoted successfully: %+v\n", utils.GetLocation(), hold)
+	return hold, nil
+}
+
+// ListWaitingHoldBookIds lists the books that have at least one WAITING hold
+func (r *holdRepository) ListWaitingHoldBookIds(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT DISTINCT
+			book_id
+		FROM
+			holds
+		WHERE
+			status = 'WAITING'
+	`
+
+	log.Printf("[%s] Executing query to list books with waiting holds\n", utils.GetLocation())
+
+	var bookIds []string
+	if err := r.db.SelectContext(ctx, &bookIds, query); err != nil {
+		log.Printf("[%s] Error executing ListWaitingHoldBookIds query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	return bookIds, nil
+}
+
+// ExpireReadyHolds moves every READY hold whose pickup window has passed to EXPIRED and returns the affected holds
+func (r *holdRepository) ExpireReadyHolds(ctx context.Context, now time.Time) ([]*models.HoldRecord, error) {
+	query := `
+		UPDATE
+			holds
+		SET
+			status = 'EXPIRED'
+		WHERE
+			status = 'READY' AND expires_at < $1
+		RETURNING
+			id, user_id, book_id, email, status, ready_at, expires_at, version, created_at, updated_at
+	`
+
+	log.Printf("[%s] Executing query to expire ready holds before %s\n", utils.GetLocation(), now)
+
+	var holds []*models.HoldRecord
+	if err := r.db.SelectContext(ctx, &holds, query, now); err != nil {
+		log.Printf("[%s] Error executing ExpireReadyHolds query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] %d holds expired\n", utils.GetLocation(), len(holds))
+	return holds, nil
+}
@@ -5,28 +5,38 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"loan_service/internal/constants"
 	"loan_service/internal/models"
 	"loan_service/pkg/utils"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// ErrLoanLimitReached is returned by CreateLoan when the user already holds as many loans as their tier allows
+var ErrLoanLimitReached = errors.New("user has reached the loan limit")
+
 type LoanRepository interface {
-	CreateLoan(ctx context.Context, loan *models.LoanRecord) (*models.LoanRecord, error)
+	CreateLoan(ctx context.Context, loan *models.LoanRecord, maxActiveLoans int, holdId string, outbox []*models.OutboxMessage) (*models.LoanRecord, error)
 	GetLoan(ctx context.Context, id string) (*models.LoanRecord, error)
 	GetBorrowedLoanByBookIdAndUserId(ctx context.Context, bookId, userId string) (*models.LoanRecord, error)
-	UpdateLoanStatus(ctx context.Context, loan *models.LoanRecord) (*models.LoanRecord, error)
-	ListUserLoans(ctx context.Context, userId string) ([]*models.LoanRecord, error)
-	ListLoans(ctx context.Context) ([]*models.LoanRecord, error)
-	GetUserLoansByStatus(ctx context.Context, userId, status string) ([]*models.LoanRecord, error)
-	GetLoansByStatus(ctx context.Context, status string) ([]*models.LoanRecord, error)
-	ReturnLoan(ctx context.Context, id string, version int) (*models.LoanRecord, error)
-	CountLoans(ctx context.Context) (int, error)
-	CountLoansByUserId(ctx context.Context, userId string) (int, error)
-	CountLoansByStatus(ctx context.Context, status string) (int, error)
-	CountLoansByUserIdAndStatus(ctx context.Context, userId string, status string) (int, error)
+	GetActiveLoanByCopyId(ctx context.Context, copyId string) (*models.LoanRecord, error)
+	TransitionLoanStatus(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error)
+	GetLoanHistory(ctx context.Context, loanId string) ([]*models.LoanStatusChange, error)
+	ListUserLoans(ctx context.Context, userId string, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error)
+	ListLoans(ctx context.Context, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error)
+	GetUserLoansByStatus(ctx context.Context, userId, status string, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error)
+	GetLoansByStatus(ctx context.Context, status string, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error)
+	ReturnLoan(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error)
+	RenewLoan(ctx context.Context, id string, version int, dueDate time.Time) (*models.LoanRecord, error)
+	MarkOverdueLoans(ctx context.Context, now time.Time, newEvent func(loan *models.LoanRecord) (*models.OutboxMessage, error)) ([]*models.LoanRecord, error)
+	CountLoans(ctx context.Context, filter *models.LoanFilter) (int, error)
+	CountLoansByUserId(ctx context.Context, userId string, filter *models.LoanFilter) (int, error)
+	CountLoansByStatus(ctx context.Context, status string, filter *models.LoanFilter) (int, error)
+	CountLoansByUserIdAndStatus(ctx context.Context, userId string, status string, filter *models.LoanFilter) (int, error)
+	CountActiveLoansByUserId(ctx context.Context, userId string) (int, error)
 }
 
 type loanRepository struct {
@@ -37,32 +47,71 @@ func NewLoanRepository(db *sqlx.DB) LoanRepository {
 	return &loanRepository{db: db}
 }
 
-func (r *loanRepository) CreateLoan(ctx context.Context, req *models.LoanRecord) (*models.LoanRecord, error) {
+// CreateLoan inserts the loan, its initial history entry and its outbox messages in one transaction.
+// The hold the loan was waiting on, if holdId is set, is fulfilled in the same transaction. The loans of the
+// user are counted under a lock per user, so concurrent borrows cannot take them past maxActiveLoans.
+func (r *loanRepository) CreateLoan(ctx context.Context, req *models.LoanRecord, maxActiveLoans int, holdId string, outbox []*models.OutboxMessage) (*models.LoanRecord, error) {
 	query := `
 		INSERT INTO 
-			loans (user_id, book_id, loan_date, status)
+			loans (user_id, book_id, copy_id, loan_date, due_date, status)
 		VALUES 
-			(:user_id, :book_id, :loan_date, :status)
+			($1, $2, $3, $4, $5, $6)
 		RETURNING 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
 	`
 
 	log.Printf("[%s] Executing query to create loan: %s with parameters: %+v\n", utils.GetLocation(), query, req)
 
-	loan := &models.LoanRecord{}
-	rows, err := r.db.NamedQueryContext(ctx, query, req)
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
+		log.Printf("[%s] Error starting CreateLoan transaction: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Held until the transaction ends, the next borrow of the user counts this loan
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, "loans:"+req.UserId); err != nil {
+		log.Printf("[%s] Error locking loans of user %s: %v\n", utils.GetLocation(), req.UserId, err)
+		return nil, err
+	}
+	var activeLoans int
+	if err := tx.GetContext(ctx, &activeLoans, `SELECT COUNT(*) FROM loans WHERE user_id = $1 AND status IN ('BORROWED', 'OVERDUE')`, req.UserId); err != nil {
+		log.Printf("[%s] Error counting active loans of user %s: %v\n", utils.GetLocation(), req.UserId, err)
+		return nil, err
+	}
+	if activeLoans >= maxActiveLoans {
+		return nil, ErrLoanLimitReached
+	}
+
+	loan := &models.LoanRecord{}
+	if err := tx.GetContext(ctx, loan, query, req.UserId, req.BookId, req.CopyId, req.LoanDate, req.DueDate, req.Status); err != nil {
 		log.Printf("[%s] Error executing CreateLoan query: %v\n", utils.GetLocation(), err)
 		return nil, err
 	}
-	defer rows.Close()
 
-	if rows.Next() {
-		if err := rows.StructScan(loan); err != nil {
-			log.Printf("[%s] Error scanning result of CreateLoan query: %v\n", utils.GetLocation(), err)
+	if err := insertLoanStatusChange(ctx, tx, &models.LoanStatusChange{
+		LoanId:    loan.Id,
+		ToStatus:  loan.Status,
+		ChangedBy: loan.UserId,
+	}); err != nil {
+		return nil, err
+	}
+
+	if holdId != "" {
+		if err := fulfillHold(ctx, tx, holdId); err != nil {
 			return nil, err
 		}
 	}
+
+	if err := insertOutboxMessages(ctx, tx, outbox); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[%s] Error committing CreateLoan transaction: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
 	log.Printf("[%s] Loan created successfully: %+v\n", utils.GetLocation(), loan)
 	return loan, nil
 }
@@ -70,7 +119,7 @@ func (r *loanRepository) CreateLoan(ctx context.Context, req *models.LoanRecord)
 func (r *loanRepository) GetLoan(ctx context.Context, id string) (*models.LoanRecord, error) {
 	query := `
 		SELECT 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
 		FROM 
 			loans
 		WHERE 
@@ -80,7 +129,6 @@ func (r *loanRepository) GetLoan(ctx context.Context, id string) (*models.LoanRe
 	log.Printf("[%s] Executing query to get loan with ID: %s\n", utils.GetLocation(), id)
 
 	loan := &models.LoanRecord{}
-	var returnDate sql.NullTime
 	if err := r.db.GetContext(ctx, loan, query, id); err != nil {
 		log.Printf("[%s] Error executing GetLoan query: %v\n", utils.GetLocation(), err)
 		if errors.Is(err, sql.ErrNoRows) {
@@ -89,9 +137,6 @@ func (r *loanRepository) GetLoan(ctx context.Context, id string) (*models.LoanRe
 		return nil, err
 	}
 
-	if returnDate.Valid {
-		loan.ReturnDate = &returnDate.Time
-	}
 	log.Printf("[%s] Loan retrieved successfully: %+v\n", utils.GetLocation(), loan)
 	return loan, nil
 }
@@ -99,17 +144,16 @@ func (r *loanRepository) GetLoan(ctx context.Context, id string) (*models.LoanRe
 func (r *loanRepository) GetBorrowedLoanByBookIdAndUserId(ctx context.Context, bookId, userId string) (*models.LoanRecord, error) {
 	query := `
 		SELECT 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
 		FROM 
 			loans
 		WHERE 
-			book_id = $1 AND user_id = $2 AND status = 'BORROWED'
+			book_id = $1 AND user_id = $2 AND status IN ('BORROWED', 'OVERDUE')
 	`
 
 	log.Printf("[%s] Executing query to get borrowed loan for book ID: %s and user ID: %s\n", utils.GetLocation(), bookId, userId)
 
 	loan := &models.LoanRecord{}
-	var returnDate sql.NullTime
 	if err := r.db.GetContext(ctx, loan, query, bookId, userId); err != nil {
 		log.Printf("[%s] Error executing GetBorrowedLoanByBookIdAndUserId query: %v\n", utils.GetLocation(), err)
 		if errors.Is(err, sql.ErrNoRows) {
@@ -118,168 +162,289 @@ func (r *loanRepository) GetBorrowedLoanByBookIdAndUserId(ctx context.Context, b
 		return nil, err
 	}
 
-	if returnDate.Valid {
-		loan.ReturnDate = &returnDate.Time
-	}
 	log.Printf("[%s] Borrowed loan retrieved successfully: %+v\n", utils.GetLocation(), loan)
 	return loan, nil
 }
 
-func (r *loanRepository) UpdateLoanStatus(ctx context.Context, req *models.LoanRecord) (*models.LoanRecord, error) {
-	log.Printf("[%s] Executing query to update loan status for loan ID: %s\n", utils.GetLocation(), req.Id)
-
-	const maxRetries = 3
-	resultChan := make(chan *models.LoanRecord, 1)
-	errChan := make(chan error, 1)
-
-	go func() {
-		defer close(resultChan)
-		defer close(errChan)
+// GetActiveLoanByCopyId returns the loan currently holding the copy, a lost copy is still held by its loan
+func (r *loanRepository) GetActiveLoanByCopyId(ctx context.Context, copyId string) (*models.LoanRecord, error) {
+	query := `
+		SELECT 
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
+		FROM 
+			loans
+		WHERE 
+			copy_id = $1 AND status IN ('BORROWED', 'OVERDUE', 'LOST')
+	`
 
-		for attempt := range maxRetries {
-			query := `
-				UPDATE 
-					loans
-				SET 
-					status = :status
-				WHERE 
-					id = :id AND version = :version
-				RETURNING 
-					id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
-			`
-
-			loan := &models.LoanRecord{}
-			err := r.db.GetContext(ctx, loan, query, req)
+	log.Printf("[%s] Executing query to get active loan for copy ID: %s\n", utils.GetLocation(), copyId)
 
-			if err == nil {
-				log.Printf("[%s] Loan status updated successfully on attempt %d: %+v\n", utils.GetLocation(), attempt+1, loan)
-				resultChan <- loan
-				return
-			}
+	loan := &models.LoanRecord{}
+	if err := r.db.GetContext(ctx, loan, query, copyId); err != nil {
+		log.Printf("[%s] Error executing GetActiveLoanByCopyId query: %v\n", utils.GetLocation(), err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("loan not found")
+		}
+		return nil, err
+	}
 
-			if errors.Is(err, sql.ErrNoRows) {
-				log.Printf("[%s] Optimistic locking failed for loan ID %s, retrying... (attempt %d)\n", utils.GetLocation(), req.Id, attempt+1)
-				time.Sleep(time.Duration(100*(attempt+1)) * time.Millisecond) // Exponential backoff
+	log.Printf("[%s] Active loan retrieved successfully: %+v\n", utils.GetLocation(), loan)
+	return loan, nil
+}
 
-				// Fetch latest loan record
-				updatedLoan, err := r.GetLoan(ctx, req.Id)
-				if err != nil {
-					log.Printf("Error fetching latest loan record: %v\n", err)
-					errChan <- fmt.Errorf("error updating loan with ID %s: %v", req.Id, err) // always makes error be general
-					return
-				}
-				req.Version = updatedLoan.Version
-				continue
-			}
+// TransitionLoanStatus moves a loan from change.FromStatus to change.ToStatus, records the change in the loan history
+// and stores the outbox messages and the fines built by fines for the updated loan in one transaction.
+// Leaving the loan returns it, so RETURNED and FOUND set the return date.
+// It does not retry, the update is rejected when the version or the status moved on in the meantime.
+func (r *loanRepository) TransitionLoanStatus(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error) {
+	log.Printf("[%s] Executing query to move loan %s from %s to %s\n", utils.GetLocation(), change.LoanId, change.FromStatus, change.ToStatus)
 
-			errChan <- err
-			return
+	loan, err := r.transitionLoanStatus(ctx, version, change, outbox, fines)
+	if err != nil {
+		log.Printf("[%s] Error executing TransitionLoanStatus query: %v\n", utils.GetLocation(), err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("status update failed due to wrong id, loan status or concurrent modification")
 		}
-
-		errChan <- errors.New("update failed after max retries")
-	}()
-
-	select {
-	case result := <-resultChan:
-		log.Printf("[%s] Loan status updated successfully: %+v\n", utils.GetLocation(), result)
-		return result, nil
-	case err := <-errChan:
-		log.Printf("[%s] Error updating loan status: %v\n", utils.GetLocation(), err)
 		return nil, err
-	case <-ctx.Done():
-		log.Printf("[%s] Context cancelled while updating loan status\n", utils.GetLocation())
-		return nil, ctx.Err()
 	}
+
+	log.Printf("[%s] Loan status updated successfully: %+v\n", utils.GetLocation(), loan)
+	return loan, nil
 }
 
-func (r *loanRepository) ListUserLoans(ctx context.Context, userId string) ([]*models.LoanRecord, error) {
+// transitionLoanStatus runs one status transition with the fines it settles, it yields sql.ErrNoRows on a version or status conflict
+func (r *loanRepository) transitionLoanStatus(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error) {
 	query := `
-		SELECT 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
-		FROM 
+		UPDATE 
 			loans
+		SET 
+			status = $1,
+			return_date = CASE WHEN $1 IN ('RETURNED', 'FOUND') THEN NOW() ELSE return_date END
 		WHERE 
-			user_id = $1
+			id = $2 AND version = $3 AND status = $4
+		RETURNING 
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
 	`
 
-	log.Printf("[%s] Executing query to list loans for user ID: %s\n", utils.GetLocation(), userId)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
-	var loans []*models.LoanRecord
-	if err := r.db.SelectContext(ctx, &loans, query, userId); err != nil {
-		log.Printf("[%s] Error executing ListUserLoans query: %v\n", utils.GetLocation(), err)
+	loan := &models.LoanRecord{}
+	if err := tx.GetContext(ctx, loan, query, change.ToStatus, change.LoanId, version, change.FromStatus); err != nil {
 		return nil, err
 	}
 
-	log.Printf("[%s] Found %d loans for user ID: %s\n", utils.GetLocation(), len(loans), userId)
-	return loans, nil
+	if err := insertLoanStatusChange(ctx, tx, change); err != nil {
+		return nil, err
+	}
+
+	if err := insertOutboxMessages(ctx, tx, outbox); err != nil {
+		return nil, err
+	}
+
+	// The fines depend on the updated loan, they are charged or waived with the transition
+	if err := insertFineEntries(ctx, tx, loan, fines(loan)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return loan, nil
 }
 
-func (r *loanRepository) ListLoans(ctx context.Context) ([]*models.LoanRecord, error) {
+// GetLoanHistory lists the status changes of a loan, oldest first
+func (r *loanRepository) GetLoanHistory(ctx context.Context, loanId string) ([]*models.LoanStatusChange, error) {
 	query := `
 		SELECT 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
+			id, loan_id, COALESCE(from_status, '') AS from_status, to_status, changed_by, note, created_at
 		FROM 
-			loans
+			loan_status_history
+		WHERE 
+			loan_id = $1
+		ORDER BY 
+			created_at, id
 	`
 
-	log.Printf("[%s] Executing query to list all loans\n", utils.GetLocation())
+	log.Printf("[%s] Executing query to get history of loan with ID: %s\n", utils.GetLocation(), loanId)
 
-	var loans []*models.LoanRecord
-	if err := r.db.SelectContext(ctx, &loans, query); err != nil {
-		log.Printf("[%s] Error executing ListLoans query: %v\n", utils.GetLocation(), err)
+	var history []*models.LoanStatusChange
+	if err := r.db.SelectContext(ctx, &history, query, loanId); err != nil {
+		log.Printf("[%s] Error executing GetLoanHistory query: %v\n", utils.GetLocation(), err)
 		return nil, err
 	}
 
-	log.Printf("[%s] Found %d loans\n", utils.GetLocation(), len(loans))
-	return loans, nil
+	log.Printf("[%s] Retrieved %d history entries for loan %s\n", utils.GetLocation(), len(history), loanId)
+	return history, nil
 }
 
-func (r *loanRepository) GetUserLoansByStatus(ctx context.Context, userId, status string) ([]*models.LoanRecord, error) {
+// insertLoanStatusChange records a status change inside the caller's transaction
+func insertLoanStatusChange(ctx context.Context, tx *sqlx.Tx, change *models.LoanStatusChange) error {
 	query := `
-		SELECT 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
-		FROM 
-			loans
-		WHERE
-			user_id = $1 AND status = $2  
+		INSERT INTO 
+			loan_status_history (loan_id, from_status, to_status, changed_by, note)
+		VALUES 
+			($1, NULLIF($2, ''), $3, $4, $5)
 	`
 
-	log.Printf("[%s] Executing query to list loans for user ID: %s with status: %s\n", utils.GetLocation(), userId, status)
+	if _, err := tx.ExecContext(ctx, query, change.LoanId, change.FromStatus, change.ToStatus, change.ChangedBy, change.Note); err != nil {
+		log.Printf("[%s] Error recording status change of loan %s: %v\n", utils.GetLocation(), change.LoanId, err)
+		return err
+	}
 
-	var loans []*models.LoanRecord
-	if err := r.db.SelectContext(ctx, &loans, query, userId, status); err != nil {
+	return nil
+}
+
+func (r *loanRepository) ListUserLoans(ctx context.Context, userId string, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error) {
+	log.Printf("[%s] Executing query to list loans for user ID: %s with filter: %+v\n", utils.GetLocation(), userId, filter)
+
+	loans, next, err := r.listLoans(ctx, []string{"user_id = $1"}, []interface{}{userId}, filter)
+	if err != nil {
+		log.Printf("[%s] Error executing ListUserLoans query: %v\n", utils.GetLocation(), err)
+		return nil, nil, err
+	}
+
+	log.Printf("[%s] Found %d loans for user ID: %s\n", utils.GetLocation(), len(loans), userId)
+	return loans, next, nil
+}
+
+func (r *loanRepository) ListLoans(ctx context.Context, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error) {
+	log.Printf("[%s] Executing query to list all loans with filter: %+v\n", utils.GetLocation(), filter)
+
+	loans, next, err := r.listLoans(ctx, nil, nil, filter)
+	if err != nil {
+		log.Printf("[%s] Error executing ListLoans query: %v\n", utils.GetLocation(), err)
+		return nil, nil, err
+	}
+
+	log.Printf("[%s] Found %d loans\n", utils.GetLocation(), len(loans))
+	return loans, next, nil
+}
+
+func (r *loanRepository) GetUserLoansByStatus(ctx context.Context, userId, status string, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error) {
+	log.Printf("[%s] Executing query to list loans for user ID: %s with status: %s and filter: %+v\n", utils.GetLocation(), userId, status, filter)
+
+	loans, next, err := r.listLoans(ctx, []string{"user_id = $1", "status = $2"}, []interface{}{userId, status}, filter)
+	if err != nil {
 		log.Printf("[%s] Error executing GetUserLoansByStatus query: %v\n", utils.GetLocation(), err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	log.Printf("[%s] Found %d loans for user ID: %s with status: %s\n", utils.GetLocation(), len(loans), userId, status)
-	return loans, nil
+	return loans, next, nil
 }
 
-func (r *loanRepository) GetLoansByStatus(ctx context.Context, status string) ([]*models.LoanRecord, error) {
+func (r *loanRepository) GetLoansByStatus(ctx context.Context, status string, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error) {
+	log.Printf("[%s] Executing query to list loans with status: %s and filter: %+v\n", utils.GetLocation(), status, filter)
+
+	loans, next, err := r.listLoans(ctx, []string{"status = $1"}, []interface{}{status}, filter)
+	if err != nil {
+		log.Printf("[%s] Error executing GetLoansByStatus query: %v\n", utils.GetLocation(), err)
+		return nil, nil, err
+	}
+
+	log.Printf("[%s] Found %d loans with status: %s\n", utils.GetLocation(), len(loans), status)
+	return loans, next, nil
+}
+
+// listLoans runs a paginated listing on top of the given base conditions.
+// It uses keyset pagination when the filter carries a cursor and LIMIT/OFFSET otherwise,
+// and returns the cursor of the next page when more rows are available.
+func (r *loanRepository) listLoans(ctx context.Context, conditions []string, args []interface{}, filter *models.LoanFilter) ([]*models.LoanRecord, *models.LoanCursor, error) {
+	sortColumn, sortOrder := ResolveLoanSort(filter)
+	conditions, args = appendLoanDateRange(conditions, args, filter)
+
+	if filter.Cursor != nil {
+		comparator := "<"
+		if sortOrder == constants.SortOrderAsc {
+			comparator = ">"
+		}
+		args = append(args, filter.Cursor.SortValue, filter.Cursor.Id)
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s ($%d, $%d)", sortColumn, comparator, len(args)-1, len(args)))
+	}
+
 	query := `
 		SELECT 
-			id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
 		FROM 
 			loans
-		WHERE 
-			status = $1
-	`
-
-	log.Printf("[%s] Executing query to list loans with status: %s\n", utils.GetLocation(), status)
+	` + whereClause(conditions) + fmt.Sprintf(`
+		ORDER BY 
+			%s %s, id %s
+	`, sortColumn, sortOrder, sortOrder)
+
+	// Fetch one extra row to know whether another page exists
+	args = append(args, filter.PageSize+1)
+	query += fmt.Sprintf(" LIMIT $%d", len(args))
+	if filter.Cursor == nil {
+		args = append(args, (filter.Page-1)*filter.PageSize)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
 
 	var loans []*models.LoanRecord
-	if err := r.db.SelectContext(ctx, &loans, query, status); err != nil {
-		log.Printf("[%s] Error executing GetLoansByStatus query: %v\n", utils.GetLocation(), err)
-		return nil, err
+	if err := r.db.SelectContext(ctx, &loans, query, args...); err != nil {
+		return nil, nil, err
 	}
 
-	log.Printf("[%s] Found %d loans with status: %s\n", utils.GetLocation(), len(loans), status)
-	return loans, nil
+	if len(loans) <= filter.PageSize {
+		return loans, nil, nil
+	}
+
+	loans = loans[:filter.PageSize]
+	last := loans[len(loans)-1]
+	next := &models.LoanCursor{SortBy: sortColumn, SortOrder: sortOrder, SortValue: last.LoanDate, Id: last.Id}
+	if sortColumn == constants.SortByUpdatedAt {
+		next.SortValue = last.UpdatedAt
+	}
+
+	return loans, next, nil
+}
+
+// ResolveLoanSort maps the requested sort onto a whitelisted column and direction
+func ResolveLoanSort(filter *models.LoanFilter) (string, string) {
+	sortColumn := constants.DefaultSortBy
+	if filter.SortBy == constants.SortByUpdatedAt {
+		sortColumn = constants.SortByUpdatedAt
+	}
+
+	sortOrder := constants.DefaultSortOrder
+	if filter.SortOrder == constants.SortOrderAsc {
+		sortOrder = constants.SortOrderAsc
+	}
+
+	return sortColumn, sortOrder
+}
+
+// appendLoanDateRange adds the optional loan_date bounds of the filter to the conditions
+func appendLoanDateRange(conditions []string, args []interface{}, filter *models.LoanFilter) ([]string, []interface{}) {
+	if filter.FromDate != nil {
+		args = append(args, *filter.FromDate)
+		conditions = append(conditions, fmt.Sprintf("loan_date >= $%d", len(args)))
+	}
+	if filter.ToDate != nil {
+		args = append(args, *filter.ToDate)
+		conditions = append(conditions, fmt.Sprintf("loan_date <= $%d", len(args)))
+	}
+
+	return conditions, args
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND ")
 }
 
-func (r *loanRepository) ReturnLoan(ctx context.Context, id string, version int) (*models.LoanRecord, error) {
-	log.Printf("[%s] Executing query to return loan with ID: %s\n", utils.GetLocation(), id)
+// ReturnLoan moves the loan to RETURNED, records the change, its outbox messages and its fines in the same transaction.
+// On a version conflict it retries against the latest loan as long as that loan can still be returned.
+func (r *loanRepository) ReturnLoan(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error) {
+	log.Printf("[%s] Executing query to return loan with ID: %s\n", utils.GetLocation(), change.LoanId)
 
 	const maxRetries = 3
 	resultChan := make(chan *models.LoanRecord, 1)
@@ -290,19 +455,7 @@ func (r *loanRepository) ReturnLoan(ctx context.Context, id string, version int)
 		defer close(errChan)
 
 		for attempt := range maxRetries {
-			query := `
-				UPDATE 
-					loans
-				SET 
-					status = 'RETURNED', return_date = NOW()
-				WHERE 
-					id = $1 AND version = $2
-				RETURNING 
-					id, user_id, book_id, loan_date, return_date, status, version, created_at, updated_at
-			`
-
-			loan := &models.LoanRecord{}
-			err := r.db.GetContext(ctx, loan, query, id, version)
+			loan, err := r.transitionLoanStatus(ctx, version, change, outbox, fines)
 
 			if err == nil {
 				log.Printf("[%s] Loan status returned successfully on attempt %d: %+v\n", utils.GetLocation(), attempt+1, loan)
@@ -311,17 +464,22 @@ func (r *loanRepository) ReturnLoan(ctx context.Context, id string, version int)
 			}
 
 			if errors.Is(err, sql.ErrNoRows) {
-				log.Printf("[%s] Optimistic locking failed for loan ID %s, retrying... (attempt %d)\n", utils.GetLocation(), id, attempt+1)
+				log.Printf("[%s] Optimistic locking failed for loan ID %s, retrying... (attempt %d)\n", utils.GetLocation(), change.LoanId, attempt+1)
 				time.Sleep(time.Duration(100*(attempt+1)) * time.Millisecond) // Exponential backoff
 
 				// Fetch latest loan record
-				updatedLoan, err := r.GetLoan(ctx, id)
+				updatedLoan, err := r.GetLoan(ctx, change.LoanId)
 				if err != nil {
 					log.Printf("Error fetching latest loan record: %v\n", err)
-					errChan <- fmt.Errorf("error returning loan with ID %s: %v", id, err) // always makes error be general
+					errChan <- fmt.Errorf("error returning loan with ID %s: %v", change.LoanId, err) // always makes error be general
+					return
+				}
+				if updatedLoan.Status != constants.LoanStatusBorrowed && updatedLoan.Status != constants.LoanStatusOverdue {
+					errChan <- fmt.Errorf("loan with ID %s is %s and can no longer be returned", change.LoanId, updatedLoan.Status)
 					return
 				}
 				version = updatedLoan.Version
+				change.FromStatus = updatedLoan.Status
 				continue
 			}
 
@@ -345,51 +503,134 @@ func (r *loanRepository) ReturnLoan(ctx context.Context, id string, version int)
 	}
 }
 
-// CountLoans returns the total number of loans
-func (r *loanRepository) CountLoans(ctx context.Context) (int, error) {
-	log.Printf("Counting total loans")
-	query := `SELECT COUNT(*) FROM loans`
-	var totalItems int
-	err := r.db.QueryRowContext(ctx, query).Scan(&totalItems)
-	if err != nil {
-		log.Printf("Error counting loans: %v\n", err)
-		return 0, err
+// RenewLoan pushes the due date of a borrowed loan and bumps its renewal counter.
+// Unlike status updates it does not retry on version conflicts, so a stale renewal is rejected.
+func (r *loanRepository) RenewLoan(ctx context.Context, id string, version int, dueDate time.Time) (*models.LoanRecord, error) {
+	query := `
+		UPDATE 
+			loans
+		SET 
+			due_date = $1, renewal_count = renewal_count + 1
+		WHERE 
+			id = $2 AND version = $3 AND status = 'BORROWED'
+		RETURNING 
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
+	`
+
+	log.Printf("[%s] Executing query to renew loan with ID: %s until %s\n", utils.GetLocation(), id, dueDate)
+
+	loan := &models.LoanRecord{}
+	if err := r.db.GetContext(ctx, loan, query, dueDate, id, version); err != nil {
+		log.Printf("[%s] Error executing RenewLoan query: %v\n", utils.GetLocation(), err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("renew failed due to wrong id, loan status or concurrent modification")
+		}
+		return nil, err
 	}
-	return totalItems, nil
+
+	log.Printf("[%s] Loan renewed successfully: %+v\n", utils.GetLocation(), loan)
+	return loan, nil
 }
 
-// CountsLoansByUserId returns the total number of loans by user ID
-func (r *loanRepository) CountLoansByUserId(ctx context.Context, userId string) (int, error) {
-	log.Printf("Counting total loans by user ID: %s\n", userId)
-	query := `SELECT COUNT(*) FROM loans WHERE user_id = $1`
-	var totalItems int
-	err := r.db.QueryRowContext(ctx, query, userId).Scan(&totalItems)
+// MarkOverdueLoans moves every borrowed loan whose due date has passed to OVERDUE and returns the affected loans.
+// The history entry and the event built by newEvent for each loan are stored within the same transaction.
+func (r *loanRepository) MarkOverdueLoans(ctx context.Context, now time.Time, newEvent func(loan *models.LoanRecord) (*models.OutboxMessage, error)) ([]*models.LoanRecord, error) {
+	query := `
+		UPDATE 
+			loans
+		SET 
+			status = 'OVERDUE'
+		WHERE 
+			status = 'BORROWED' AND due_date < $1
+		RETURNING 
+			id, user_id, book_id, copy_id, loan_date, due_date, return_date, renewal_count, status, version, created_at, updated_at
+	`
+
+	log.Printf("[%s] Executing query to mark loans due before %s as overdue\n", utils.GetLocation(), now)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		log.Printf("Error counting loans: %v\n", err)
-		return 0, err
+		log.Printf("[%s] Error starting MarkOverdueLoans transaction: %v\n", utils.GetLocation(), err)
+		return nil, err
 	}
-	return totalItems, nil
+	defer tx.Rollback()
+
+	var loans []*models.LoanRecord
+	if err := tx.SelectContext(ctx, &loans, query, now); err != nil {
+		log.Printf("[%s] Error executing MarkOverdueLoans query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	outbox := make([]*models.OutboxMessage, 0, len(loans))
+	for _, loan := range loans {
+		err := insertLoanStatusChange(ctx, tx, &models.LoanStatusChange{
+			LoanId:     loan.Id,
+			FromStatus: constants.LoanStatusBorrowed,
+			ToStatus:   constants.LoanStatusOverdue,
+			ChangedBy:  constants.LoanChangedBySystem,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(loan)
+		if err != nil {
+			log.Printf("[%s] Error building overdue event for loan %s: %v\n", utils.GetLocation(), loan.Id, err)
+			return nil, err
+		}
+		outbox = append(outbox, event)
+	}
+
+	if err := insertOutboxMessages(ctx, tx, outbox); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[%s] Error committing MarkOverdueLoans transaction: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Marked %d loans as overdue\n", utils.GetLocation(), len(loans))
+	return loans, nil
+}
+
+// CountLoans returns the total number of loans matching the filter
+func (r *loanRepository) CountLoans(ctx context.Context, filter *models.LoanFilter) (int, error) {
+	log.Printf("Counting total loans")
+	return r.countLoans(ctx, nil, nil, filter)
+}
+
+// CountsLoansByUserId returns the total number of loans by user ID matching the filter
+func (r *loanRepository) CountLoansByUserId(ctx context.Context, userId string, filter *models.LoanFilter) (int, error) {
+	log.Printf("Counting total loans by user ID: %s\n", userId)
+	return r.countLoans(ctx, []string{"user_id = $1"}, []interface{}{userId}, filter)
 }
 
-// CountLoansByStatus returns the total number of loans by status
-func (r *loanRepository) CountLoansByStatus(ctx context.Context, status string) (int, error) {
+// CountLoansByStatus returns the total number of loans by status matching the filter
+func (r *loanRepository) CountLoansByStatus(ctx context.Context, status string, filter *models.LoanFilter) (int, error) {
 	log.Printf("Counting total loans by status: %s\n", status)
-	query := `SELECT COUNT(*) FROM loans WHERE status = $1`
-	var totalItems int
-	err := r.db.QueryRowContext(ctx, query, status).Scan(&totalItems)
-	if err != nil {
-		log.Printf("Error counting loans: %v\n", err)
-		return 0, err
-	}
-	return totalItems, nil
+	return r.countLoans(ctx, []string{"status = $1"}, []interface{}{status}, filter)
+}
+
+// CountLoansByUserIdAndStatus returns the total number of loans by user ID and status matching the filter
+func (r *loanRepository) CountLoansByUserIdAndStatus(ctx context.Context, userId string, status string, filter *models.LoanFilter) (int, error) {
+	log.Printf("Counting total loans by user ID: %s and by status : %s\n", userId, status)
+	return r.countLoans(ctx, []string{"user_id = $1", "status = $2"}, []interface{}{userId, status}, filter)
 }
 
-// CountLoansByUserIdAndStatus returns the total number of loans by user ID and status
-func (r *loanRepository) CountLoansByUserIdAndStatus(ctx context.Context, userId string, status string) (int, error) {
-	log.Printf("Counting total loans by category ID: %s and by status : %s\n", userId, status)
-	query := `SELECT COUNT(*) FROM loans WHERE user_id = $1 AND status = $2`
+// CountActiveLoansByUserId returns the number of loans the user still holds, overdue ones included
+func (r *loanRepository) CountActiveLoansByUserId(ctx context.Context, userId string) (int, error) {
+	log.Printf("Counting active loans by user ID: %s\n", userId)
+	return r.countLoans(ctx, []string{"user_id = $1", "status IN ('BORROWED', 'OVERDUE')"}, []interface{}{userId}, &models.LoanFilter{})
+}
+
+// countLoans counts the rows matching the base conditions and the filter date range, ignoring the cursor
+func (r *loanRepository) countLoans(ctx context.Context, conditions []string, args []interface{}, filter *models.LoanFilter) (int, error) {
+	conditions, args = appendLoanDateRange(conditions, args, filter)
+	query := `SELECT COUNT(*) FROM loans` + whereClause(conditions)
+
 	var totalItems int
-	err := r.db.QueryRowContext(ctx, query, userId, status).Scan(&totalItems)
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&totalItems)
 	if err != nil {
 		log.Printf("Error counting loans: %v\n", err)
 		return 0, err
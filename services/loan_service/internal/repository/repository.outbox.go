@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"loan_service/internal/models"
+	"loan_service/pkg/utils"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type OutboxRepository interface {
+	RelayPending(ctx context.Context, limit int, publish func(message *models.OutboxMessage) error) (int, error)
+}
+
+type outboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+// RelayPending hands the oldest unpublished messages to publish in insertion order and marks the delivered ones as published.
+// The batch stays row-locked until it is done, so several relays never send the same message twice.
+// It stops at the first failed publish to keep the order, the failed message is retried on the next run.
+func (r *outboxRepository) RelayPending(ctx context.Context, limit int, publish func(message *models.OutboxMessage) error) (int, error) {
+	query := `
+		SELECT
+			id, exchange, routing_key, payload, attempts, created_at, published_at
+		FROM
+			outbox
+		WHERE
+			published_at IS NULL
+		ORDER BY
+			created_at, id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Printf("[%s] Error starting outbox relay transaction: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var messages []*models.OutboxMessage
+	if err := tx.SelectContext(ctx, &messages, query, limit); err != nil {
+		log.Printf("[%s] Error executing RelayPending query: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	published := 0
+	for _, message := range messages {
+		if publishErr := publish(message); publishErr != nil {
+			log.Printf("[%s] Failed to publish outbox message %s: %v\n", utils.GetLocation(), message.Id, publishErr)
+			if _, err := tx.ExecContext(ctx, `UPDATE outbox SET attempts = attempts + 1 WHERE id = $1`, message.Id); err != nil {
+				return published, err
+			}
+			break
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE outbox SET published_at = NOW(), attempts = attempts + 1 WHERE id = $1`, message.Id); err != nil {
+			log.Printf("[%s] Error marking outbox message %s as published: %v\n", utils.GetLocation(), message.Id, err)
+			return published, err
+		}
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[%s] Error committing outbox relay transaction: %v\n", utils.GetLocation(), err)
+		return 0, err
+	}
+
+	return published, nil
+}
+
+// insertOutboxMessages stores messages inside the caller's transaction
+func insertOutboxMessages(ctx context.Context, tx *sqlx.Tx, messages []*models.OutboxMessage) error {
+	query := `
+		INSERT INTO
+			outbox (exchange, routing_key, payload)
+		VALUES
+			($1, $2, $3)
+	`
+
+	for _, message := range messages {
+		if _, err := tx.ExecContext(ctx, query, message.Exchange, message.RoutingKey, []byte(message.Payload)); err != nil {
+			log.Printf("[%s] Error inserting outbox message for %s/%s: %v\n", utils.GetLocation(), message.Exchange, message.RoutingKey, err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// runEvery calls run once immediately and then on every interval until ctx is cancelled
+func runEvery(ctx context.Context, interval time.Duration, run func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	run(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run(ctx)
+		}
+	}
+}
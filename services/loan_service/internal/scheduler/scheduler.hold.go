@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/constants"
+	"loan_service/internal/service"
+	"loan_service/pkg/logger"
+	"loan_service/pkg/utils"
+	"log"
+	"time"
+)
+
+type HoldExpirySweeper struct {
+	holdService service.HoldService
+	logger      *logger.Logger
+	interval    time.Duration
+}
+
+func NewHoldExpirySweeper(holdService service.HoldService, logger *logger.Logger, interval time.Duration) *HoldExpirySweeper {
+	return &HoldExpirySweeper{
+		holdService: holdService,
+		logger:      logger,
+		interval:    interval,
+	}
+}
+
+// Start expires ready holds and promotes waiting ones once immediately and then on every interval until ctx is cancelled
+func (s *HoldExpirySweeper) Start(ctx context.Context) {
+	log.Printf("[%s] Hold expiry sweeper started with interval %s\n", utils.GetLocation(), s.interval)
+	runEvery(ctx, s.interval, s.sweep)
+	log.Printf("[%s] Hold expiry sweeper stopped\n", utils.GetLocation())
+}
+
+func (s *HoldExpirySweeper) sweep(ctx context.Context) {
+	// Each run gets its own request ID so the published logs and emails can be correlated
+	requestID := fmt.Sprintf("hold-expiry-sweep-%d", time.Now().Unix())
+
+	holds, _, err := s.holdService.ExpireHolds(context.WithValue(ctx, constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to expire ready holds", nil, err)
+		return
+	}
+
+	if len(holds) > 0 {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Ready holds expired successfully", map[string]interface{}{"total": len(holds)}, nil)
+	}
+}
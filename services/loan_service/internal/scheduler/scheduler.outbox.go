@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/constants"
+	"loan_service/internal/service"
+	"loan_service/pkg/logger"
+	"loan_service/pkg/utils"
+	"log"
+	"time"
+)
+
+type OutboxRelay struct {
+	outboxService service.OutboxService
+	logger        *logger.Logger
+	interval      time.Duration
+}
+
+func NewOutboxRelay(outboxService service.OutboxService, logger *logger.Logger, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outboxService: outboxService,
+		logger:        logger,
+		interval:      interval,
+	}
+}
+
+// Start relays pending messages once immediately and then on every interval until ctx is cancelled
+func (r *OutboxRelay) Start(ctx context.Context) {
+	log.Printf("[%s] Outbox relay started with interval %s\n", utils.GetLocation(), r.interval)
+	runEvery(ctx, r.interval, r.relay)
+	log.Printf("[%s] Outbox relay stopped\n", utils.GetLocation())
+}
+
+func (r *OutboxRelay) relay(ctx context.Context) {
+	requestID := fmt.Sprintf("outbox-relay-%d", time.Now().Unix())
+
+	published, _, err := r.outboxService.RelayPending(context.WithValue(ctx, constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		r.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to relay outbox messages", map[string]interface{}{"published": published}, err)
+	}
+}
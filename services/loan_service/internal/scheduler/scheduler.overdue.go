@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"loan_service/internal/constants"
+	"loan_service/internal/service"
+	"loan_service/pkg/logger"
+	"loan_service/pkg/utils"
+	"log"
+	"time"
+)
+
+type OverdueSweeper struct {
+	loanService service.LoanService
+	logger      *logger.Logger
+	interval    time.Duration
+}
+
+func NewOverdueSweeper(loanService service.LoanService, logger *logger.Logger, interval time.Duration) *OverdueSweeper {
+	return &OverdueSweeper{
+		loanService: loanService,
+		logger:      logger,
+		interval:    interval,
+	}
+}
+
+// Start sweeps once immediately and then on every interval until ctx is cancelled
+func (s *OverdueSweeper) Start(ctx context.Context) {
+	log.Printf("[%s] Overdue sweeper started with interval %s\n", utils.GetLocation(), s.interval)
+	runEvery(ctx, s.interval, s.sweep)
+	log.Printf("[%s] Overdue sweeper stopped\n", utils.GetLocation())
+}
+
+func (s *OverdueSweeper) sweep(ctx context.Context) {
+	// Each run gets its own request ID so the published logs and events can be correlated
+	requestID := fmt.Sprintf("overdue-sweep-%d", time.Now().Unix())
+
+	loans, _, err := s.loanService.SweepOverdueLoans(context.WithValue(ctx, constants.ContextRequestIDKey, requestID))
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to sweep overdue loans", nil, err)
+		return
+	}
+
+	if len(loans) > 0 {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Overdue loans swept successfully", map[string]interface{}{"total": len(loans)}, nil)
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/internal/repository"
+	"loan_service/pkg/utils"
+	"log"
+
+	"google.golang.org/grpc/codes"
+)
+
+type FineService interface {
+	ListUserFines(ctx context.Context, userId, status string, page, pageSize int) (fines []*models.FineRecord, code codes.Code, totalItems int, outstanding int64, err error)
+	PayFine(ctx context.Context, id, userId string, amount int64, version int) (*models.FineRecord, codes.Code, error)
+	WaiveFine(ctx context.Context, id, note string, version int) (*models.FineRecord, codes.Code, error)
+}
+
+type fineService struct {
+	repo repository.FineRepository
+}
+
+func NewFineService(repo repository.FineRepository) FineService {
+	return &fineService{
+		repo: repo,
+	}
+}
+
+func (s *fineService) ListUserFines(ctx context.Context, userId, status string, page, pageSize int) (fines []*models.FineRecord, code codes.Code, totalItems int, outstanding int64, err error) {
+	log.Printf("[%s] Fetching fines for user %s with status %q (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, status, page, pageSize)
+
+	fines, err = s.repo.ListUserFines(ctx, userId, status, page, pageSize)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch fines for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, 0, 0, errors.New("failed to fetch fines")
+	}
+
+	totalItems, err = s.repo.CountUserFines(ctx, userId, status)
+	if err != nil {
+		log.Printf("[%s] Failed to count fines for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, 0, 0, errors.New("failed to count fines")
+	}
+
+	outstanding, err = s.repo.GetUnpaidFineTotal(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to sum unpaid fines for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, 0, 0, errors.New("failed to sum unpaid fines")
+	}
+
+	log.Printf("[%s] Fetched %d fines for user %s, outstanding %d\n", utils.GetLocation(), len(fines), userId, outstanding)
+	return fines, codes.OK, totalItems, outstanding, nil
+}
+
+func (s *fineService) PayFine(ctx context.Context, id, userId string, amount int64, version int) (*models.FineRecord, codes.Code, error) {
+	log.Printf("[%s] Paying %d for fine with ID %s by user %s\n", utils.GetLocation(), amount, id, userId)
+
+	fine, err := s.repo.GetFine(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Fine with ID %s not found: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.NotFound, fmt.Errorf("fine '%s' not found", id)
+	}
+	if fine.UserId != userId {
+		log.Printf("[%s] User %s does not have access to fine '%s'\n", utils.GetLocation(), userId, id)
+		return nil, codes.PermissionDenied, errors.New("you don't have access to this resource")
+	}
+	if fine.Status != constants.FineStatusUnpaid {
+		log.Printf("[%s] Fine '%s' is already %s\n", utils.GetLocation(), id, fine.Status)
+		return nil, codes.FailedPrecondition, fmt.Errorf("fine '%s' is already %s", id, fine.Status)
+	}
+	if outstanding := fine.Amount - fine.PaidAmount; amount > outstanding {
+		log.Printf("[%s] Payment %d exceeds outstanding amount %d of fine '%s'\n", utils.GetLocation(), amount, outstanding, id)
+		return nil, codes.InvalidArgument, fmt.Errorf("payment exceeds the outstanding amount of %d", outstanding)
+	}
+
+	paidFine, err := s.repo.PayFine(ctx, id, version, amount, "")
+	if err != nil {
+		log.Printf("[%s] Failed to pay fine with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Aborted, fmt.Errorf("failed to pay fine with id %s", id)
+	}
+
+	log.Printf("[%s] Fine with ID %s paid, status %s\n", utils.GetLocation(), id, paidFine.Status)
+	return paidFine, codes.OK, nil
+}
+
+func (s *fineService) WaiveFine(ctx context.Context, id, note string, version int) (*models.FineRecord, codes.Code, error) {
+	log.Printf("[%s] Waiving fine with ID %s\n", utils.GetLocation(), id)
+
+	fine, err := s.repo.GetFine(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Fine with ID %s not found: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.NotFound, fmt.Errorf("fine '%s' not found", id)
+	}
+	if fine.Status != constants.FineStatusUnpaid {
+		log.Printf("[%s] Fine '%s' is already %s\n", utils.GetLocation(), id, fine.Status)
+		return nil, codes.FailedPrecondition, fmt.Errorf("fine '%s' is already %s", id, fine.Status)
+	}
+
+	waivedFine, err := s.repo.WaiveFine(ctx, id, version, note)
+	if err != nil {
+		log.Printf("[%s] Failed to waive fine with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Aborted, fmt.Errorf("failed to waive fine with id %s", id)
+	}
+
+	log.Printf("[%s] Fine with ID %s waived\n", utils.GetLocation(), id)
+	return waivedFine, codes.OK, nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/clients"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/internal/repository"
+	"loan_service/pkg/utils"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+type HoldService interface {
+	PlaceHold(ctx context.Context, userId, email, bookId string) (*models.HoldRecord, codes.Code, error)
+	CancelHold(ctx context.Context, id, userId string, version int) (*models.HoldRecord, codes.Code, error)
+	ListUserHolds(ctx context.Context, userId, status string, page, pageSize int) (holds []*models.HoldRecord, code codes.Code, totalItems int, err error)
+	ExpireHolds(ctx context.Context) ([]*models.HoldRecord, codes.Code, error)
+
+	// Used by the loan flow
+	ReserveForLoan(ctx context.Context, bookId, userId string, stock int) (*models.HoldRecord, codes.Code, error)
+	PromoteNextHold(ctx context.Context, bookId string) (*models.HoldRecord, error)
+}
+
+type holdService struct {
+	bookClient clients.BookClient
+	repo       repository.HoldRepository
+}
+
+func NewHoldService(repo repository.HoldRepository, bookClient clients.BookClient) HoldService {
+	return &holdService{
+		bookClient: bookClient,
+		repo:       repo,
+	}
+}
+
+func (s *holdService) PlaceHold(ctx context.Context, userId, email, bookId string) (*models.HoldRecord, codes.Code, error) {
+	log.Printf("[%s] Placing hold for user %s on book %s\n", utils.GetLocation(), userId, bookId)
+
+	if hold, _ := s.repo.GetActiveHoldByBookIdAndUserId(ctx, bookId, userId); hold != nil {
+		log.Printf("[%s] User %s already has an active hold on book %s\n", utils.GetLocation(), userId, bookId)
+		return nil, codes.AlreadyExists, errors.New("user already has an active hold on this book")
+	}
+
+	book, _ := s.bookClient.GetBook(ctx, bookId)
+	if book == nil {
+		log.Printf("[%s] Book with ID %s not found\n", utils.GetLocation(), bookId)
+		return nil, codes.NotFound, fmt.Errorf("book '%s' not found", bookId)
+	}
+
+	reserved, err := s.repo.CountReadyHolds(ctx, bookId, "")
+	if err != nil {
+		log.Printf("[%s] Failed to count ready holds for book %s: %v\n", utils.GetLocation(), bookId, err)
+		return nil, codes.Internal, errors.New("failed to check book availability")
+	}
+	if book.Stock-reserved > 0 {
+		log.Printf("[%s] Book '%s' is available, no hold needed\n", utils.GetLocation(), bookId)
+		return nil, codes.FailedPrecondition, errors.New("book is available, borrow it directly")
+	}
+
+	createdHold, err := s.repo.CreateHold(ctx, &models.HoldRecord{
+		UserId: userId,
+		BookId: bookId,
+		Email:  email,
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to create hold for user %s on book %s: %v\n", utils.GetLocation(), userId, bookId, err)
+		return nil, codes.Internal, errors.New("failed to place hold")
+	}
+
+	// Re-read to get the queue position
+	hold, err := s.repo.GetHold(ctx, createdHold.Id)
+	if err != nil {
+		log.Printf("[%s] Failed to get hold %s: %v\n", utils.GetLocation(), createdHold.Id, err)
+		return createdHold, codes.OK, nil
+	}
+
+	log.Printf("[%s] Hold %s placed at queue position %d\n", utils.GetLocation(), hold.Id, hold.QueuePosition)
+	return hold, codes.OK, nil
+}
+
+func (s *holdService) CancelHold(ctx context.Context, id, userId string, version int) (*models.HoldRecord, codes.Code, error) {
+	log.Printf("[%s] Cancelling hold with ID %s for user %s\n", utils.GetLocation(), id, userId)
+
+	hold, err := s.repo.GetHold(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Hold with ID %s not found: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.NotFound, fmt.Errorf("hold '%s' not found", id)
+	}
+	if hold.UserId != userId {
+		log.Printf("[%s] User %s does not have access to hold '%s'\n", utils.GetLocation(), userId, id)
+		return nil, codes.PermissionDenied, errors.New("you don't have access to this resource")
+	}
+	if hold.Status != constants.HoldStatusWaiting && hold.Status != constants.HoldStatusReady {
+		log.Printf("[%s] Hold '%s' is already %s\n", utils.GetLocation(), id, hold.Status)
+		return nil, codes.FailedPrecondition, fmt.Errorf("hold '%s' is already %s", id, hold.Status)
+	}
+
+	cancelledHold, err := s.repo.CancelHold(ctx, id, version)
+	if err != nil {
+		log.Printf("[%s] Failed to cancel hold with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Aborted, fmt.Errorf("failed to cancel hold with id %s", id)
+	}
+
+	// The copy set aside for a ready hold goes to the next patron in the queue
+	if hold.Status == constants.HoldStatusReady {
+		if _, err := s.PromoteNextHold(ctx, hold.BookId); err != nil {
+			log.Printf("[%s] Failed to promote next hold for book %s: %v\n", utils.GetLocation(), hold.BookId, err)
+		}
+	}
+
+	log.Printf("[%s] Hold with ID %s cancelled\n", utils.GetLocation(), id)
+	return cancelledHold, codes.OK, nil
+}
+
+func (s *holdService) ListUserHolds(ctx context.Context, userId, status string, page, pageSize int) (holds []*models.HoldRecord, code codes.Code, totalItems int, err error) {
+	log.Printf("[%s] Fetching holds for user %s with status %q (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, status, page, pageSize)
+
+	holds, err = s.repo.ListUserHolds(ctx, userId, status, page, pageSize)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch holds for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, 0, errors.New("failed to fetch holds")
+	}
+
+	totalItems, err = s.repo.CountUserHolds(ctx, userId, status)
+	if err != nil {
+		log.Printf("[%s] Failed to count holds for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, 0, errors.New("failed to count holds")
+	}
+
+	log.Printf("[%s] Fetched %d holds for user %s\n", utils.GetLocation(), len(holds), userId)
+	return holds, codes.OK, totalItems, nil
+}
+
+func (s *holdService) ExpireHolds(ctx context.Context) ([]*models.HoldRecord, codes.Code, error) {
+	log.Printf("[%s] Expiring ready holds past their pickup window\n", utils.GetLocation())
+
+	holds, err := s.repo.ExpireReadyHolds(ctx, time.Now())
+	if err != nil {
+		log.Printf("[%s] Failed to expire ready holds: %v\n", utils.GetLocation(), err)
+		return nil, codes.Internal, errors.New("failed to expire ready holds")
+	}
+
+	log.Printf("[%s] %d holds expired\n", utils.GetLocation(), len(holds))
+
+	// Expired holds free their copies, copies added or checked in through the book service and failed promotions
+	// are picked up here as well
+	s.promoteAvailableHolds(ctx)
+
+	return holds, codes.OK, nil
+}
+
+// promoteAvailableHolds promotes waiting holds for every free copy of their book, a copy is free when it is
+// in stock and not set aside for a ready hold. A failure is logged and retried by the next sweep.
+func (s *holdService) promoteAvailableHolds(ctx context.Context) {
+	bookIds, err := s.repo.ListWaitingHoldBookIds(ctx)
+	if err != nil {
+		log.Printf("[%s] Failed to list books with waiting holds: %v\n", utils.GetLocation(), err)
+		return
+	}
+
+	for _, bookId := range bookIds {
+		book, err := s.bookClient.GetBook(ctx, bookId)
+		if err != nil {
+			log.Printf("[%s] Failed to get book %s for its waiting holds: %v\n", utils.GetLocation(), bookId, err)
+			continue
+		}
+		reserved, err := s.repo.CountReadyHolds(ctx, bookId, "")
+		if err != nil {
+			log.Printf("[%s] Failed to count ready holds for book %s: %v\n", utils.GetLocation(), bookId, err)
+			continue
+		}
+
+		for free := book.Stock - reserved; free > 0; free-- {
+			hold, err := s.promoteNextHold(ctx, book)
+			if err != nil {
+				log.Printf("[%s] Failed to promote next hold for book %s: %v\n", utils.GetLocation(), bookId, err)
+				break
+			}
+			if hold == nil {
+				break
+			}
+		}
+	}
+}
+
+// ReserveForLoan checks that a copy can be lent to the user, copies set aside for other patrons' ready holds are not lendable.
+// It returns the user's own active hold on the book, if any, so it can be fulfilled with the loan.
+func (s *holdService) ReserveForLoan(ctx context.Context, bookId, userId string, stock int) (*models.HoldRecord, codes.Code, error) {
+	hold, _ := s.repo.GetActiveHoldByBookIdAndUserId(ctx, bookId, userId)
+	if hold != nil && hold.Status == constants.HoldStatusReady {
+		return hold, codes.OK, nil
+	}
+
+	reserved, err := s.repo.CountReadyHolds(ctx, bookId, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to count ready holds for book %s: %v\n", utils.GetLocation(), bookId, err)
+		return nil, codes.Internal, errors.New("failed to check book availability")
+	}
+	if stock-reserved <= 0 {
+		log.Printf("[%s] Book '%s' is not available, %d copies are held\n", utils.GetLocation(), bookId, reserved)
+		return nil, codes.Unavailable, errors.New("book is not available, place a hold to join the queue")
+	}
+
+	return hold, codes.OK, nil
+}
+
+// PromoteNextHold gives the next patron in the book queue a pickup window and emails them, it returns nil when nobody is waiting
+func (s *holdService) PromoteNextHold(ctx context.Context, bookId string) (*models.HoldRecord, error) {
+	book, err := s.bookClient.GetBook(ctx, bookId)
+	if err != nil {
+		log.Printf("[%s] Failed to get book %s for its next hold: %v\n", utils.GetLocation(), bookId, err)
+		return nil, errors.New("failed to get hold book")
+	}
+
+	return s.promoteNextHold(ctx, book)
+}
+
+// promoteNextHold promotes the next hold of book, the ready notification is stored with the promotion
+// and relayed once it is committed
+func (s *holdService) promoteNextHold(ctx context.Context, book *clients.BookResponse) (*models.HoldRecord, error) {
+	// Get requestID from context
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	expiresAt := time.Now().Add(time.Duration(configs.AppConfig.HoldPickupWindow) * time.Hour)
+	hold, err := s.repo.PromoteNextHold(ctx, book.Id, expiresAt, func(hold *models.HoldRecord) (*models.OutboxMessage, error) {
+		return models.NewOutboxMessage(constants.EmailExchange, constants.HoldReadyQueue, models.HoldReadyMessage{
+			RequestID: requestID,
+			Email:     hold.Email,
+			Book:      book.Title,
+			ExpiresAt: *hold.ExpiresAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, nil
+	}
+
+	log.Printf("[%s] Hold %s is ready for user %s until %s\n", utils.GetLocation(), hold.Id, hold.UserId, expiresAt)
+	return hold, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"loan_service/configs"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/internal/repository"
+	"testing"
+	"time"
+)
+
+// fakeHoldRepository keeps a FIFO queue of waiting holds per book, any call it does not implement panics
+type fakeHoldRepository struct {
+	repository.HoldRepository
+	waiting map[string][]*models.HoldRecord
+	ready   map[string]int
+	outbox  []*models.OutboxMessage
+}
+
+func (r *fakeHoldRepository) ExpireReadyHolds(ctx context.Context, now time.Time) ([]*models.HoldRecord, error) {
+	return nil, nil
+}
+
+func (r *fakeHoldRepository) ListWaitingHoldBookIds(ctx context.Context) ([]string, error) {
+	var bookIds []string
+	for bookId, holds := range r.waiting {
+		if len(holds) > 0 {
+			bookIds = append(bookIds, bookId)
+		}
+	}
+	return bookIds, nil
+}
+
+func (r *fakeHoldRepository) CountReadyHolds(ctx context.Context, bookId, excludeUserId string) (int, error) {
+	return r.ready[bookId], nil
+}
+
+func (r *fakeHoldRepository) PromoteNextHold(ctx context.Context, bookId string, expiresAt time.Time, newMessage func(hold *models.HoldRecord) (*models.OutboxMessage, error)) (*models.HoldRecord, error) {
+	if len(r.waiting[bookId]) == 0 {
+		return nil, nil
+	}
+	hold := r.waiting[bookId][0]
+	hold.Status = constants.HoldStatusReady
+	hold.ExpiresAt = &expiresAt
+
+	message, err := newMessage(hold)
+	if err != nil {
+		return nil, err
+	}
+	r.waiting[bookId] = r.waiting[bookId][1:]
+	r.ready[bookId]++
+	r.outbox = append(r.outbox, message)
+	return hold, nil
+}
+
+func waitingHold(id, bookId string) *models.HoldRecord {
+	return &models.HoldRecord{Id: id, UserId: "user-" + id, BookId: bookId, Email: id + "@mail.com", Status: constants.HoldStatusWaiting}
+}
+
+func TestExpireHoldsPromotesWaitingHoldsForFreeCopies(t *testing.T) {
+	configs.AppConfig.HoldPickupWindow = 48
+	repo := &fakeHoldRepository{
+		waiting: map[string][]*models.HoldRecord{
+			"book-1": {waitingHold("hold-1", "book-1"), waitingHold("hold-2", "book-1"), waitingHold("hold-3", "book-1")},
+		},
+		ready: map[string]int{"book-1": 1},
+	}
+	// Three copies on the shelf, one of them already set aside for a ready hold
+	svc := NewHoldService(repo, &fakeBookClient{book: testBook()})
+
+	if _, _, err := svc.ExpireHolds(context.Background()); err != nil {
+		t.Fatalf("ExpireHolds() error = %v", err)
+	}
+
+	if len(repo.waiting["book-1"]) != 1 || repo.waiting["book-1"][0].Id != "hold-3" {
+		t.Fatalf("waiting holds = %+v, want only hold-3 left", repo.waiting["book-1"])
+	}
+	if len(repo.outbox) != 2 {
+		t.Fatalf("outbox = %+v, want two ready notifications", repo.outbox)
+	}
+	for i, message := range repo.outbox {
+		var body models.HoldReadyMessage
+		if err := json.Unmarshal(message.Payload, &body); err != nil {
+			t.Fatalf("outbox message %d payload: %v", i, err)
+		}
+		if message.RoutingKey != constants.HoldReadyQueue || body.Book != testBook().Title {
+			t.Fatalf("outbox message %d = %s %+v, want a hold ready notification", i, message.RoutingKey, body)
+		}
+	}
+}
+
+func TestExpireHoldsKeepsQueueWhenNoCopyIsFree(t *testing.T) {
+	repo := &fakeHoldRepository{
+		waiting: map[string][]*models.HoldRecord{"book-1": {waitingHold("hold-1", "book-1")}},
+		ready:   map[string]int{"book-1": 3},
+	}
+	svc := NewHoldService(repo, &fakeBookClient{book: testBook()})
+
+	if _, _, err := svc.ExpireHolds(context.Background()); err != nil {
+		t.Fatalf("ExpireHolds() error = %v", err)
+	}
+
+	if len(repo.waiting["book-1"]) != 1 || len(repo.outbox) != 0 {
+		t.Fatalf("waiting = %+v, outbox = %+v, want the hold left waiting", repo.waiting["book-1"], repo.outbox)
+	}
+}
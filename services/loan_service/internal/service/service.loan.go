@@ -4,109 +4,187 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"loan_service/configs"
 	"loan_service/internal/clients"
 	"loan_service/internal/constants"
 	"loan_service/internal/models"
 	"loan_service/internal/repository"
-	"loan_service/pkg/rabbitmq"
 	"loan_service/pkg/utils"
 	"log"
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type LoanService interface {
-	CreateLoan(ctx context.Context, userId, email, bookId string, book_version int) (*models.LoanRecord, codes.Code, error)
-	ReturnLoan(ctx context.Context, id, userId, email string, returnDate time.Time, version, book_version int) (*models.LoanRecord, codes.Code, error)
+	CreateLoan(ctx context.Context, userId, email, bookId string) (*models.LoanRecord, codes.Code, error)
+	ReturnLoan(ctx context.Context, id, userId, email string, version int) (*models.LoanRecord, codes.Code, error)
+	CheckOutCopy(ctx context.Context, userId, barcode, changedBy string) (*models.LoanRecord, codes.Code, error)
+	CheckInCopy(ctx context.Context, barcode, changedBy, condition string) (*models.LoanRecord, codes.Code, error)
+	RenewLoan(ctx context.Context, id, userId string, version int) (*models.LoanRecord, codes.Code, error)
+	SweepOverdueLoans(ctx context.Context) ([]*models.LoanRecord, codes.Code, error)
 	GetLoan(ctx context.Context, id string) (*models.LoanRecord, codes.Code, error)
 	GetBorrowedLoanByBookIdAndUserId(ctx context.Context, bookId, userId string) (*models.LoanRecord, codes.Code, error)
-	UpdateLoanStatus(ctx context.Context, id, status string, version int) (*models.LoanRecord, codes.Code, error)
-	ListUserLoans(ctx context.Context, userId string, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error)
-	ListLoans(ctx context.Context, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error)
-	GetUserLoansByStatus(ctx context.Context, userId, status string, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error)
-	GetLoansByStatus(ctx context.Context, status string, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error)
+	UpdateLoanStatus(ctx context.Context, id, status, changedBy, note string, version int) (*models.LoanRecord, codes.Code, error)
+	GetLoanHistory(ctx context.Context, id string) ([]*models.LoanStatusChange, codes.Code, error)
+	ListUserLoans(ctx context.Context, userId string, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error)
+	ListLoans(ctx context.Context, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error)
+	GetUserLoansByStatus(ctx context.Context, userId, status string, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error)
+	GetLoansByStatus(ctx context.Context, status string, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error)
 }
 
 type loanService struct {
-	bookClient clients.BookClient
-	repo       repository.LoanRepository
-	publisher  *rabbitmq.Publisher
+	bookClient  clients.BookClient
+	userClient  clients.UserClient
+	repo        repository.LoanRepository
+	fineRepo    repository.FineRepository
+	holdService HoldService
 }
 
-func NewLoanService(repo repository.LoanRepository, bookClient clients.BookClient, publisher *rabbitmq.Publisher) LoanService {
+func NewLoanService(repo repository.LoanRepository, fineRepo repository.FineRepository, holdService HoldService, bookClient clients.BookClient, userClient clients.UserClient) LoanService {
 	return &loanService{
-		bookClient: bookClient,
-		repo:       repo,
-		publisher:  publisher,
+		bookClient:  bookClient,
+		userClient:  userClient,
+		repo:        repo,
+		fineRepo:    fineRepo,
+		holdService: holdService,
 	}
 }
 
-func (s *loanService) CreateLoan(ctx context.Context, userId, email, bookId string, book_version int) (*models.LoanRecord, codes.Code, error) {
+func (s *loanService) CreateLoan(ctx context.Context, userId, email, bookId string) (*models.LoanRecord, codes.Code, error) {
+	log.Printf("[%s] Creating new loan for user %s and book %s\n", utils.GetLocation(), userId, bookId)
+	return s.lendCopy(ctx, userId, email, bookId, "")
+}
+
+// CheckOutCopy lends the scanned copy to a patron at the circulation desk
+func (s *loanService) CheckOutCopy(ctx context.Context, userId, barcode, changedBy string) (*models.LoanRecord, codes.Code, error) {
+	log.Printf("[%s] Checking out copy %s to user %s by %s\n", utils.GetLocation(), barcode, userId, changedBy)
+
+	bookCopy, err := s.bookClient.GetBookCopyByBarcode(ctx, barcode)
+	if err != nil {
+		log.Printf("[%s] Copy with barcode %s not found: %v\n", utils.GetLocation(), barcode, err)
+		return nil, codes.NotFound, fmt.Errorf("copy '%s' not found", barcode)
+	}
+
+	user, err := s.userClient.GetUser(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] User with ID %s not found: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.NotFound, fmt.Errorf("user '%s' not found", userId)
+	}
+
+	return s.lendCopy(ctx, userId, user.Email, bookCopy.BookId, barcode)
+}
+
+// lendCopy runs the checks and the saga shared by self-service loans and desk checkouts.
+// An empty barcode lends any available copy of the book.
+func (s *loanService) lendCopy(ctx context.Context, userId, email, bookId, barcode string) (*models.LoanRecord, codes.Code, error) {
 	// Get requestID from context
 	requestID := utils.GetRequestIDFromContext(ctx)
 
-	log.Printf("[%s] Creating new loan for user %s and book %s\n", utils.GetLocation(), userId, bookId)
-
 	// Check if the user already borrowed this book
 	userLoan, _ := s.repo.GetBorrowedLoanByBookIdAndUserId(ctx, bookId, userId)
-	if userLoan != nil && userLoan.Status == "BORROWED" {
+	if userLoan != nil && (userLoan.Status == constants.LoanStatusBorrowed || userLoan.Status == constants.LoanStatusOverdue) {
 		log.Printf("[%s] User %s already has a borrowed book with ID %s\n", utils.GetLocation(), userId, bookId)
 		return nil, codes.Canceled, errors.New("user must return the borrowed book before creating a new loan")
 	}
 
+	// Refuse to lend while the user owes too much
+	unpaidFines, err := s.fineRepo.GetUnpaidFineTotal(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to sum unpaid fines for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, errors.New("failed to check unpaid fines")
+	}
+	if unpaidFines > int64(configs.AppConfig.MaxUnpaidFineAmount) {
+		log.Printf("[%s] User %s has unpaid fines of %d above the limit\n", utils.GetLocation(), userId, unpaidFines)
+		return nil, codes.FailedPrecondition, fmt.Errorf("user has unpaid fines of %d, pay them before borrowing", unpaidFines)
+	}
+
+	// The user's membership tier caps how many books they hold at once, checked up front so no copy is checked out
+	// in vain and again when the loan is created, where concurrent borrows are counted
+	entitlements, err := s.userClient.GetUserEntitlements(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to get entitlements for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, errors.New("failed to check user entitlements")
+	}
+	activeLoans, err := s.repo.CountActiveLoansByUserId(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to count active loans for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, errors.New("failed to check active loans")
+	}
+	if activeLoans >= entitlements.MaxConcurrentLoans {
+		log.Printf("[%s] User %s reached the %s tier limit of %d concurrent loans\n", utils.GetLocation(), userId, entitlements.TierName, entitlements.MaxConcurrentLoans)
+		return nil, codes.FailedPrecondition, fmt.Errorf("user has reached the limit of %d concurrent loans, return a book before borrowing", entitlements.MaxConcurrentLoans)
+	}
+
 	// Fetch the book details
 	book, _ := s.bookClient.GetBook(ctx, bookId)
 	if book == nil {
 		log.Printf("[%s] Book with ID %s not found\n", utils.GetLocation(), bookId)
 		return nil, codes.NotFound, fmt.Errorf("book '%s' not found", bookId)
 	}
-	if book.Stock == 0 {
-		log.Printf("[%s] Book '%s' is not available\n", utils.GetLocation(), bookId)
-		return nil, codes.Unavailable, errors.New("book is not available")
-	}
 
-	// Decrease book stock
-	err := s.bookClient.DecrementBookStock(ctx, book.Id, book_version)
+	// Copies set aside for other patrons' holds cannot be lent
+	hold, code, err := s.holdService.ReserveForLoan(ctx, book.Id, userId, book.Stock)
 	if err != nil {
-		log.Printf("[%s] Failed to decrement stock for book %s: %v\n", utils.GetLocation(), book.Id, err)
-		return nil, codes.Internal, fmt.Errorf("failed when updating stock for book '%s'", book.Id)
+		return nil, code, err
 	}
 
-	// Create the loan record
+	loanDate := time.Now()
 	loan := &models.LoanRecord{
 		UserId:   userId,
 		BookId:   book.Id,
-		LoanDate: time.Now(),
-		Status:   "BORROWED",
-	}
-
-	createdLoan, err := s.repo.CreateLoan(ctx, loan)
-	if err != nil {
-		log.Printf("[%s] Failed to create loan for user %s and book %s: %v\n", utils.GetLocation(), userId, bookId, err)
-		return nil, codes.Internal, errors.New("failed to create new loan")
+		LoanDate: loanDate,
+		DueDate:  loanDate.AddDate(0, 0, entitlements.LoanDurationDays),
+		Status:   constants.LoanStatusBorrowed,
 	}
 
-	// Publish loan notification
-	err = s.publisher.Publish(constants.EmailExchange, constants.LoanNotificationQueue, models.LoanNotificationMessage{
+	// The loan notification is stored with the loan and relayed once the loan is committed
+	notification, err := models.NewOutboxMessage(constants.EmailExchange, constants.LoanNotificationQueue, models.LoanNotificationMessage{
 		RequestID: requestID,
 		Email:     email,
 		Book:      book.Title,
-		Due:       time.Now().AddDate(0, 0, 7),
+		Due:       loan.DueDate,
 	})
 	if err != nil {
-		log.Printf("[%s] Failed to publish loan notification for user %s: %v\n", utils.GetLocation(), userId, err)
-		return nil, codes.Internal, errors.New("failed to publish loan notification to queue")
+		log.Printf("[%s] Failed to build loan notification for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, errors.New("failed to build loan notification")
+	}
+
+	// Saga step 1: check a copy out of the book service
+	bookCopy, err := s.bookClient.CheckOutBookCopy(ctx, book.Id, barcode)
+	if err != nil {
+		log.Printf("[%s] Failed to check out a copy of book %s: %v\n", utils.GetLocation(), book.Id, err)
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, codes.Unavailable, fmt.Errorf("no copy of book '%s' is available", book.Id)
+		}
+		return nil, codes.Internal, fmt.Errorf("failed when checking out a copy of book '%s'", book.Id)
+	}
+	loan.CopyId = bookCopy.Id
+
+	// Saga step 2: persist the loan and close the hold it was waiting on, on failure the copy is checked back in
+	var holdId string
+	if hold != nil {
+		holdId = hold.Id
+	}
+	createdLoan, err := s.repo.CreateLoan(ctx, loan, entitlements.MaxConcurrentLoans, holdId, []*models.OutboxMessage{notification})
+	if err != nil {
+		log.Printf("[%s] Failed to create loan for user %s and book %s: %v\n", utils.GetLocation(), userId, bookId, err)
+		if _, compensateErr := s.bookClient.CheckInBookCopy(ctx, bookCopy.Id, ""); compensateErr != nil {
+			log.Printf("[%s] Failed to check copy %s back in, it must be corrected manually: %v\n", utils.GetLocation(), bookCopy.Barcode, compensateErr)
+			return nil, codes.Internal, errors.New("failed to create new loan and to check the copy back in")
+		}
+		if errors.Is(err, repository.ErrLoanLimitReached) {
+			return nil, codes.FailedPrecondition, fmt.Errorf("user has reached the limit of %d concurrent loans, return a book before borrowing", entitlements.MaxConcurrentLoans)
+		}
+		return nil, codes.Internal, errors.New("failed to create new loan")
 	}
 
 	log.Printf("[%s] Loan created successfully for user %s and book %s\n", utils.GetLocation(), userId, bookId)
 	return createdLoan, codes.OK, nil
 }
 
-func (s *loanService) ReturnLoan(ctx context.Context, id, userId, email string, returnDate time.Time, version, book_version int) (*models.LoanRecord, codes.Code, error) {
-	// Get requestID from context
-	requestID := utils.GetRequestIDFromContext(ctx)
-
+func (s *loanService) ReturnLoan(ctx context.Context, id, userId, email string, version int) (*models.LoanRecord, codes.Code, error) {
 	log.Printf("[%s] Returning loan with ID %s for user %s\n", utils.GetLocation(), id, userId)
 
 	// Fetch the loan record
@@ -115,7 +193,7 @@ func (s *loanService) ReturnLoan(ctx context.Context, id, userId, email string,
 		log.Printf("[%s] Loan with ID %s not found for user %s: %v\n", utils.GetLocation(), id, userId, err)
 		return nil, codes.NotFound, fmt.Errorf("loan '%s' not found", id)
 	}
-	if loan.Status == "RETURNED" {
+	if loan.Status == constants.LoanStatusReturned {
 		log.Printf("[%s] Loan '%s' already returned\n", utils.GetLocation(), id)
 		return nil, codes.Canceled, fmt.Errorf("loan '%s' already returned", loan.Id)
 	}
@@ -123,6 +201,50 @@ func (s *loanService) ReturnLoan(ctx context.Context, id, userId, email string,
 		log.Printf("[%s] User %s does not have access to loan '%s'\n", utils.GetLocation(), userId, id)
 		return nil, codes.PermissionDenied, errors.New("you don't have access to this resource")
 	}
+	if !canTransitionLoan(loan.Status, constants.LoanStatusReturned) {
+		log.Printf("[%s] Loan '%s' with status %s cannot be returned\n", utils.GetLocation(), id, loan.Status)
+		return nil, codes.FailedPrecondition, fmt.Errorf("loan '%s' is %s and cannot be returned", id, loan.Status)
+	}
+
+	return s.returnLoan(ctx, loan, email, userId, "", version)
+}
+
+// CheckInCopy closes the loan holding the scanned copy at the circulation desk.
+// A copy of a lost loan was found, any other loan is returned.
+func (s *loanService) CheckInCopy(ctx context.Context, barcode, changedBy, condition string) (*models.LoanRecord, codes.Code, error) {
+	log.Printf("[%s] Checking in copy %s by %s\n", utils.GetLocation(), barcode, changedBy)
+
+	bookCopy, err := s.bookClient.GetBookCopyByBarcode(ctx, barcode)
+	if err != nil {
+		log.Printf("[%s] Copy with barcode %s not found: %v\n", utils.GetLocation(), barcode, err)
+		return nil, codes.NotFound, fmt.Errorf("copy '%s' not found", barcode)
+	}
+
+	loan, err := s.repo.GetActiveLoanByCopyId(ctx, bookCopy.Id)
+	if err != nil {
+		log.Printf("[%s] No active loan for copy %s: %v\n", utils.GetLocation(), barcode, err)
+		return nil, codes.FailedPrecondition, fmt.Errorf("copy '%s' is not on loan", barcode)
+	}
+
+	if loan.Status == constants.LoanStatusLost {
+		return s.transitionLoan(ctx, loan, constants.LoanStatusFound, changedBy, "checked in at the desk", condition, loan.Version)
+	}
+
+	user, err := s.userClient.GetUser(ctx, loan.UserId)
+	if err != nil {
+		log.Printf("[%s] Failed to get user %s of loan %s: %v\n", utils.GetLocation(), loan.UserId, loan.Id, err)
+		return nil, codes.Internal, errors.New("failed to get loan patron")
+	}
+
+	return s.returnLoan(ctx, loan, user.Email, changedBy, condition, loan.Version)
+}
+
+// returnLoan checks the loan copy back in and records the return, the patron is notified at email.
+// An empty condition keeps the recorded condition of the copy.
+func (s *loanService) returnLoan(ctx context.Context, loan *models.LoanRecord, email, changedBy, condition string, version int) (*models.LoanRecord, codes.Code, error) {
+	// Get requestID from context
+	requestID := utils.GetRequestIDFromContext(ctx)
+	id := loan.Id
 
 	// Fetch book details
 	book, err := s.bookClient.GetBook(ctx, loan.BookId)
@@ -131,33 +253,118 @@ func (s *loanService) ReturnLoan(ctx context.Context, id, userId, email string,
 		return nil, codes.Internal, errors.New("failed to get loan book")
 	}
 
-	// Return the loan
-	returnedLoan, err := s.repo.ReturnLoan(ctx, id, version)
+	// The return notification is stored with the return and relayed once it is committed
+	notification, err := models.NewOutboxMessage(constants.EmailExchange, constants.ReturnNotificationQueue, models.ReturnNotificationMessage{
+		RequestID: requestID,
+		Email:     email,
+		Book:      book.Title,
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to build return notification for user %s: %v\n", utils.GetLocation(), loan.UserId, err)
+		return nil, codes.Internal, errors.New("failed to build return notification")
+	}
+
+	change := &models.LoanStatusChange{
+		LoanId:     id,
+		FromStatus: loan.Status,
+		ToStatus:   constants.LoanStatusReturned,
+		ChangedBy:  changedBy,
+	}
+	event, err := newLoanStatusChangedEvent(requestID, loan, change)
+	if err != nil {
+		log.Printf("[%s] Failed to build status change event for loan %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Internal, errors.New("failed to build loan status event")
+	}
+
+	// Saga step 1: check the copy back in to the book service
+	bookCopy, err := s.bookClient.CheckInBookCopy(ctx, loan.CopyId, condition)
+	if err != nil {
+		log.Printf("[%s] Failed to check in copy %s of book %s: %v\n", utils.GetLocation(), loan.CopyId, book.Id, err)
+		return nil, codes.Internal, fmt.Errorf("failed when checking in the copy of book '%s'", book.Id)
+	}
+
+	// Saga step 2: persist the return, on failure the copy is checked out again
+	returnedLoan, err := s.repo.ReturnLoan(ctx, version, change, []*models.OutboxMessage{notification, event}, loanFines)
 	if err != nil {
 		log.Printf("[%s] Failed to return loan with ID %s: %v\n", utils.GetLocation(), id, err)
+		if _, compensateErr := s.bookClient.CheckOutBookCopy(ctx, book.Id, bookCopy.Barcode); compensateErr != nil {
+			log.Printf("[%s] Failed to check copy %s out again, it must be corrected manually: %v\n", utils.GetLocation(), bookCopy.Barcode, compensateErr)
+			return nil, codes.Internal, fmt.Errorf("failed to return loan with id %s and to check the copy out again", id)
+		}
 		return nil, codes.Internal, fmt.Errorf("failed to return loan with id %s", id)
 	}
 
-	// Increment book stock
-	err = s.bookClient.IncrementBookStock(ctx, book.Id, book_version)
+	s.afterLoanTransition(ctx, returnedLoan)
+
+	log.Printf("[%s] Loan with ID %s successfully returned by %s\n", utils.GetLocation(), id, changedBy)
+	return returnedLoan, codes.OK, nil
+}
+
+func (s *loanService) RenewLoan(ctx context.Context, id, userId string, version int) (*models.LoanRecord, codes.Code, error) {
+	log.Printf("[%s] Renewing loan with ID %s for user %s\n", utils.GetLocation(), id, userId)
+
+	loan, err := s.repo.GetLoan(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Loan with ID %s not found for user %s: %v\n", utils.GetLocation(), id, userId, err)
+		return nil, codes.NotFound, fmt.Errorf("loan '%s' not found", id)
+	}
+	if loan.UserId != userId {
+		log.Printf("[%s] User %s does not have access to loan '%s'\n", utils.GetLocation(), userId, id)
+		return nil, codes.PermissionDenied, errors.New("you don't have access to this resource")
+	}
+	if loan.Status != constants.LoanStatusBorrowed {
+		log.Printf("[%s] Loan '%s' with status %s cannot be renewed\n", utils.GetLocation(), id, loan.Status)
+		return nil, codes.FailedPrecondition, fmt.Errorf("only borrowed loans can be renewed, loan '%s' is %s", id, loan.Status)
+	}
+
+	// Renewals follow the user's current membership tier
+	entitlements, err := s.userClient.GetUserEntitlements(ctx, userId)
 	if err != nil {
-		log.Printf("[%s] Failed to increment stock for book %s: %v\n", utils.GetLocation(), book.Id, err)
-		return nil, codes.Internal, fmt.Errorf("failed when updating stock for book '%s'", book.Id)
+		log.Printf("[%s] Failed to get entitlements for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, errors.New("failed to check user entitlements")
+	}
+	if loan.RenewalCount >= entitlements.MaxRenewals {
+		log.Printf("[%s] Loan '%s' reached the maximum of %d renewals\n", utils.GetLocation(), id, entitlements.MaxRenewals)
+		return nil, codes.FailedPrecondition, fmt.Errorf("loan '%s' has reached the maximum of %d renewals", id, entitlements.MaxRenewals)
 	}
 
-	// Publish return notification
-	err = s.publisher.Publish(constants.EmailExchange, constants.ReturnNotificationQueue, models.ReturnNotificationMessage{
-		RequestID: requestID,
-		Email:     email,
-		Book:      book.Title,
+	// Extend from the current due date so renewing early does not shorten the loan
+	dueDate := loan.DueDate.AddDate(0, 0, entitlements.LoanDurationDays)
+
+	renewedLoan, err := s.repo.RenewLoan(ctx, id, version, dueDate)
+	if err != nil {
+		log.Printf("[%s] Failed to renew loan with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Aborted, fmt.Errorf("failed to renew loan with id %s", id)
+	}
+
+	log.Printf("[%s] Loan with ID %s renewed until %s\n", utils.GetLocation(), id, renewedLoan.DueDate)
+	return renewedLoan, codes.OK, nil
+}
+
+func (s *loanService) SweepOverdueLoans(ctx context.Context) ([]*models.LoanRecord, codes.Code, error) {
+	// Get requestID from context
+	requestID := utils.GetRequestIDFromContext(ctx)
+
+	log.Printf("[%s] Sweeping overdue loans\n", utils.GetLocation())
+
+	now := time.Now()
+	loans, err := s.repo.MarkOverdueLoans(ctx, now, func(loan *models.LoanRecord) (*models.OutboxMessage, error) {
+		return models.NewOutboxMessage(constants.LoanEventExchange, constants.LoanOverdueQueue, models.LoanOverdueEvent{
+			RequestID: requestID,
+			LoanId:    loan.Id,
+			UserId:    loan.UserId,
+			BookId:    loan.BookId,
+			DueDate:   loan.DueDate,
+			OverdueAt: now,
+		})
 	})
 	if err != nil {
-		log.Printf("[%s] Failed to publish return notification for user %s: %v\n", utils.GetLocation(), userId, err)
-		return nil, codes.Internal, errors.New("failed to publish loan notification to queue")
+		log.Printf("[%s] Failed to mark overdue loans: %v\n", utils.GetLocation(), err)
+		return nil, codes.Internal, errors.New("failed to mark overdue loans")
 	}
 
-	log.Printf("[%s] Loan with ID %s successfully returned by user %s\n", utils.GetLocation(), id, userId)
-	return returnedLoan, codes.OK, nil
+	log.Printf("[%s] %d loans marked as overdue\n", utils.GetLocation(), len(loans))
+	return loans, codes.OK, nil
 }
 
 func (s *loanService) GetLoan(ctx context.Context, id string) (*models.LoanRecord, codes.Code, error) {
@@ -186,7 +393,8 @@ func (s *loanService) GetBorrowedLoanByBookIdAndUserId(ctx context.Context, book
 	return loan, codes.OK, nil
 }
 
-func (s *loanService) UpdateLoanStatus(ctx context.Context, id, status string, version int) (*models.LoanRecord, codes.Code, error) {
+// UpdateLoanStatus moves a loan along the state machine on behalf of staff
+func (s *loanService) UpdateLoanStatus(ctx context.Context, id, status, changedBy, note string, version int) (*models.LoanRecord, codes.Code, error) {
 	log.Printf("[%s] Updating status of loan with ID %s to %s\n", utils.GetLocation(), id, status)
 
 	loan, err := s.repo.GetLoan(ctx, id)
@@ -195,91 +403,233 @@ func (s *loanService) UpdateLoanStatus(ctx context.Context, id, status string, v
 		return nil, codes.NotFound, fmt.Errorf("loan '%s' not found", id)
 	}
 
-	loan.Status = status
-	loan.Version = version
+	return s.transitionLoan(ctx, loan, status, changedBy, note, "", version)
+}
+
+// transitionLoan moves the loan to status. Transitions that put the copy back on the shelf check it in first
+// and check it out again if the update fails, an empty condition keeps the recorded condition of the copy.
+func (s *loanService) transitionLoan(ctx context.Context, loan *models.LoanRecord, status, changedBy, note, condition string, version int) (*models.LoanRecord, codes.Code, error) {
+	// Get requestID from context
+	requestID := utils.GetRequestIDFromContext(ctx)
+	id := loan.Id
+
+	if !canTransitionLoan(loan.Status, status) {
+		log.Printf("[%s] Loan '%s' cannot move from %s to %s\n", utils.GetLocation(), id, loan.Status, status)
+		return nil, codes.FailedPrecondition, fmt.Errorf("loan '%s' cannot move from %s to %s", id, loan.Status, status)
+	}
+
+	change := &models.LoanStatusChange{
+		LoanId:     id,
+		FromStatus: loan.Status,
+		ToStatus:   status,
+		ChangedBy:  changedBy,
+		Note:       note,
+	}
+	event, err := newLoanStatusChangedEvent(requestID, loan, change)
+	if err != nil {
+		log.Printf("[%s] Failed to build status change event for loan %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Internal, errors.New("failed to build loan status event")
+	}
+
+	restock := restocksLoan(status)
+	var bookCopy *clients.CopyResponse
+	if restock {
+		if bookCopy, err = s.bookClient.CheckInBookCopy(ctx, loan.CopyId, condition); err != nil {
+			log.Printf("[%s] Failed to check in copy %s of book %s: %v\n", utils.GetLocation(), loan.CopyId, loan.BookId, err)
+			return nil, codes.Internal, fmt.Errorf("failed when checking in the copy of book '%s'", loan.BookId)
+		}
+	}
 
-	updatedLoan, err := s.repo.UpdateLoanStatus(ctx, loan)
+	updatedLoan, err := s.repo.TransitionLoanStatus(ctx, version, change, []*models.OutboxMessage{event}, loanFines)
 	if err != nil {
 		log.Printf("[%s] Failed to update loan status for loan with ID %s: %v\n", utils.GetLocation(), id, err)
-		return nil, codes.Internal, errors.New("failed to update loan status")
+		if restock {
+			if _, compensateErr := s.bookClient.CheckOutBookCopy(ctx, loan.BookId, bookCopy.Barcode); compensateErr != nil {
+				log.Printf("[%s] Failed to check copy %s out again, it must be corrected manually: %v\n", utils.GetLocation(), bookCopy.Barcode, compensateErr)
+				return nil, codes.Internal, errors.New("failed to update loan status and to check the copy out again")
+			}
+		}
+		return nil, codes.Aborted, errors.New("failed to update loan status")
 	}
 
-	log.Printf("[%s] Loan with ID %s status updated to %s\n", utils.GetLocation(), id, status)
+	s.afterLoanTransition(ctx, updatedLoan)
+
+	log.Printf("[%s] Loan with ID %s status updated from %s to %s\n", utils.GetLocation(), id, change.FromStatus, status)
 	return updatedLoan, codes.OK, nil
 }
 
-func (s *loanService) ListUserLoans(ctx context.Context, userId string, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error) {
-	log.Printf("[%s] Fetching all loans for user %s with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, page, pageSize)
+func (s *loanService) GetLoanHistory(ctx context.Context, id string) ([]*models.LoanStatusChange, codes.Code, error) {
+	log.Printf("[%s] Fetching history of loan with ID: %s\n", utils.GetLocation(), id)
+
+	if _, err := s.repo.GetLoan(ctx, id); err != nil {
+		log.Printf("[%s] Loan with ID %s not found: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.NotFound, fmt.Errorf("loan '%s' not found", id)
+	}
+
+	history, err := s.repo.GetLoanHistory(ctx, id)
+	if err != nil {
+		log.Printf("[%s] Failed to get history of loan with ID %s: %v\n", utils.GetLocation(), id, err)
+		return nil, codes.Internal, fmt.Errorf("failed to get history of loan with id %s", id)
+	}
+
+	log.Printf("[%s] History of loan with ID %s fetched successfully\n", utils.GetLocation(), id)
+	return history, codes.OK, nil
+}
+
+func (s *loanService) ListUserLoans(ctx context.Context, userId string, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error) {
+	log.Printf("[%s] Fetching all loans for user %s with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, filter.Page, filter.PageSize)
 
-	loans, err = s.repo.ListUserLoans(ctx, userId)
+	if err = applyPageToken(filter, pageToken); err != nil {
+		log.Printf("[%s] Invalid page token for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.InvalidArgument, 0, "", err
+	}
+
+	loans, next, err := s.repo.ListUserLoans(ctx, userId, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to fetch loans for user %s: %v\n", utils.GetLocation(), userId, err)
-		return nil, codes.Internal, 0, errors.New("failed to fetch loans")
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch loans")
 	}
 
-	totalItems, err = s.repo.CountLoansByUserId(ctx, userId)
+	totalItems, err = s.repo.CountLoansByUserId(ctx, userId, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to count loans for user %s: %v\n", utils.GetLocation(), userId, err)
-		return nil, codes.Internal, 0, err
+		return nil, codes.Internal, 0, "", err
+	}
+
+	nextPageToken, err = encodePageToken(next)
+	if err != nil {
+		log.Printf("[%s] Failed to encode next page token for user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch loans")
 	}
 
 	log.Printf("[%s] Found %d loans for user %s\n", utils.GetLocation(), len(loans), userId)
-	return loans, codes.OK, totalItems, nil
+	return loans, codes.OK, totalItems, nextPageToken, nil
 }
 
-func (s *loanService) ListLoans(ctx context.Context, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error) {
-	log.Printf("[%s] Fetching list of books with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), page, pageSize)
+func (s *loanService) ListLoans(ctx context.Context, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error) {
+	log.Printf("[%s] Fetching list of loans with pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), filter.Page, filter.PageSize)
 
-	loans, err = s.repo.ListLoans(ctx)
+	if err = applyPageToken(filter, pageToken); err != nil {
+		log.Printf("[%s] Invalid page token: %v\n", utils.GetLocation(), err)
+		return nil, codes.InvalidArgument, 0, "", err
+	}
+
+	loans, next, err := s.repo.ListLoans(ctx, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to fetch all loans: %v\n", utils.GetLocation(), err)
-		return nil, codes.Internal, 0, errors.New("failed to fetch all loans")
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch all loans")
 	}
 
-	totalItems, err = s.repo.CountLoans(ctx)
+	totalItems, err = s.repo.CountLoans(ctx, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to count loans data %v\n", utils.GetLocation(), err)
-		return nil, codes.Internal, 0, err
+		return nil, codes.Internal, 0, "", err
+	}
+
+	nextPageToken, err = encodePageToken(next)
+	if err != nil {
+		log.Printf("[%s] Failed to encode next page token: %v\n", utils.GetLocation(), err)
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch all loans")
 	}
 
 	log.Printf("[%s] Found %d loans\n", utils.GetLocation(), len(loans))
-	return loans, codes.OK, totalItems, nil
+	return loans, codes.OK, totalItems, nextPageToken, nil
 }
 
-func (s *loanService) GetUserLoansByStatus(ctx context.Context, userId, status string, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error) {
-	log.Printf("[%s] Fetching loans for user %s with status %s and pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, status, page, pageSize)
+func (s *loanService) GetUserLoansByStatus(ctx context.Context, userId, status string, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error) {
+	log.Printf("[%s] Fetching loans for user %s with status %s and pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), userId, status, filter.Page, filter.PageSize)
 
-	loans, err = s.repo.GetUserLoansByStatus(ctx, userId, status)
+	if err = applyPageToken(filter, pageToken); err != nil {
+		log.Printf("[%s] Invalid page token for user %s with status %s: %v\n", utils.GetLocation(), userId, status, err)
+		return nil, codes.InvalidArgument, 0, "", err
+	}
+
+	loans, next, err := s.repo.GetUserLoansByStatus(ctx, userId, status, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to fetch loans for user %s with status %s: %v\n", utils.GetLocation(), userId, status, err)
-		return nil, codes.Internal, 0, errors.New("failed to fetch loans")
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch loans")
 	}
 
-	totalItems, err = s.repo.CountLoansByUserIdAndStatus(ctx, userId, status)
+	totalItems, err = s.repo.CountLoansByUserIdAndStatus(ctx, userId, status, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to count loans for user %s with status %s: %v\n", utils.GetLocation(), userId, status, err)
-		return nil, codes.Internal, 0, err
+		return nil, codes.Internal, 0, "", err
+	}
+
+	nextPageToken, err = encodePageToken(next)
+	if err != nil {
+		log.Printf("[%s] Failed to encode next page token for user %s with status %s: %v\n", utils.GetLocation(), userId, status, err)
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch loans")
 	}
 
 	log.Printf("[%s] Found %d loans for user %s with status %s\n", utils.GetLocation(), len(loans), userId, status)
-	return loans, codes.OK, totalItems, nil
+	return loans, codes.OK, totalItems, nextPageToken, nil
 }
 
-func (s *loanService) GetLoansByStatus(ctx context.Context, status string, page int, pageSize int) (loans []*models.LoanRecord, code codes.Code, totalItems int, err error) {
-	log.Printf("[%s] Fetching loans with status %s and pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), status, page, pageSize)
+func (s *loanService) GetLoansByStatus(ctx context.Context, status string, filter *models.LoanFilter, pageToken string) (loans []*models.LoanRecord, code codes.Code, totalItems int, nextPageToken string, err error) {
+	log.Printf("[%s] Fetching loans with status %s and pagination (Page: %d, PageSize: %d)\n", utils.GetLocation(), status, filter.Page, filter.PageSize)
 
-	loans, err = s.repo.GetLoansByStatus(ctx, status)
+	if err = applyPageToken(filter, pageToken); err != nil {
+		log.Printf("[%s] Invalid page token for status %s: %v\n", utils.GetLocation(), status, err)
+		return nil, codes.InvalidArgument, 0, "", err
+	}
+
+	loans, next, err := s.repo.GetLoansByStatus(ctx, status, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to fetch loans with status %s: %v\n", utils.GetLocation(), status, err)
-		return nil, codes.Internal, 0, errors.New("failed to fetch loans")
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch loans")
 	}
 
-	totalItems, err = s.repo.CountLoansByStatus(ctx, status)
+	totalItems, err = s.repo.CountLoansByStatus(ctx, status, filter)
 	if err != nil {
 		log.Printf("[%s] Failed to count loans with status %s: %v\n", utils.GetLocation(), status, err)
-		return nil, codes.Internal, 0, err
+		return nil, codes.Internal, 0, "", err
+	}
+
+	nextPageToken, err = encodePageToken(next)
+	if err != nil {
+		log.Printf("[%s] Failed to encode next page token for status %s: %v\n", utils.GetLocation(), status, err)
+		return nil, codes.Internal, 0, "", errors.New("failed to fetch loans")
 	}
 
 	log.Printf("[%s] Found %d loans with status %s\n", utils.GetLocation(), len(loans), status)
-	return loans, codes.OK, totalItems, nil
+	return loans, codes.OK, totalItems, nextPageToken, nil
+}
+
+// applyPageToken decodes the keyset cursor carried by the page token into the filter
+func applyPageToken(filter *models.LoanFilter, pageToken string) error {
+	if pageToken == "" {
+		return nil
+	}
+
+	cursor := &models.LoanCursor{}
+	if err := utils.DecodePageToken(pageToken, cursor); err != nil {
+		return err
+	}
+
+	// A token replayed under another sort would resume at an unrelated position
+	sortBy, sortOrder := repository.ResolveLoanSort(filter)
+	if cursor.SortBy != sortBy || cursor.SortOrder != sortOrder {
+		return errors.New("page token does not match the requested sort")
+	}
+	filter.Cursor = cursor
+
+	return nil
+}
+
+// encodePageToken turns the cursor of the next page into an opaque token, empty on the last page
+func encodePageToken(cursor *models.LoanCursor) (string, error) {
+	if cursor == nil {
+		return "", nil
+	}
+
+	return utils.EncodePageToken(cursor)
+}
+
+// overdueDays counts every started day between the due date and the return date
+func overdueDays(dueDate, returnDate time.Time) int {
+	if !returnDate.After(dueDate) {
+		return 0
+	}
+	return int((returnDate.Sub(dueDate) + 24*time.Hour - 1) / (24 * time.Hour))
 }
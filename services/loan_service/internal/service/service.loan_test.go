@@ -0,0 +1,667 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"loan_service/configs"
+	"loan_service/internal/clients"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/internal/repository"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBookClient records the copy calls made by the saga and fails the ones it is told to
+type fakeBookClient struct {
+	book        *clients.BookResponse
+	checkOutErr error
+	checkInErr  error
+	calls       []string
+}
+
+func (c *fakeBookClient) GetBook(ctx context.Context, id string) (*clients.BookResponse, error) {
+	if c.book == nil {
+		return nil, errors.New("book not found")
+	}
+	return c.book, nil
+}
+
+func (c *fakeBookClient) GetBookCopyByBarcode(ctx context.Context, barcode string) (*clients.CopyResponse, error) {
+	if barcode != testCopy().Barcode {
+		return nil, errors.New("copy not found")
+	}
+	return testCopy(), nil
+}
+
+func (c *fakeBookClient) CheckOutBookCopy(ctx context.Context, bookId, barcode string) (*clients.CopyResponse, error) {
+	c.calls = append(c.calls, "checkout")
+	if c.checkOutErr != nil {
+		return nil, c.checkOutErr
+	}
+	return testCopy(), nil
+}
+
+func (c *fakeBookClient) CheckInBookCopy(ctx context.Context, id, condition string) (*clients.CopyResponse, error) {
+	c.calls = append(c.calls, "checkin")
+	if c.checkInErr != nil {
+		return nil, c.checkInErr
+	}
+	return testCopy(), nil
+}
+
+// fakeUserClient hands out the same entitlements to every user
+type fakeUserClient struct {
+	entitlements *clients.EntitlementsResponse
+}
+
+func (c *fakeUserClient) GetUserEntitlements(ctx context.Context, userId string) (*clients.EntitlementsResponse, error) {
+	return c.entitlements, nil
+}
+
+func (c *fakeUserClient) GetUser(ctx context.Context, userId string) (*clients.UserResponse, error) {
+	return &clients.UserResponse{Id: userId, Email: "user@mail.com"}, nil
+}
+
+// fakeLoanRepository implements the calls made by the loan saga and status transitions, any other call panics
+type fakeLoanRepository struct {
+	repository.LoanRepository
+	loan        *models.LoanRecord
+	activeLoans int
+	createErr   error
+	returnErr   error
+	created     bool
+	holdId      string // hold fulfilled with the created loan
+	returned    bool
+	change      *models.LoanStatusChange
+	outbox      []*models.OutboxMessage
+	fines       []*models.FineEntry
+}
+
+func (r *fakeLoanRepository) GetBorrowedLoanByBookIdAndUserId(ctx context.Context, bookId, userId string) (*models.LoanRecord, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeLoanRepository) GetLoan(ctx context.Context, id string) (*models.LoanRecord, error) {
+	if r.loan == nil {
+		return nil, sql.ErrNoRows
+	}
+	return r.loan, nil
+}
+
+func (r *fakeLoanRepository) GetActiveLoanByCopyId(ctx context.Context, copyId string) (*models.LoanRecord, error) {
+	if r.loan == nil || r.loan.CopyId != copyId {
+		return nil, sql.ErrNoRows
+	}
+	return r.loan, nil
+}
+
+func (r *fakeLoanRepository) CountActiveLoansByUserId(ctx context.Context, userId string) (int, error) {
+	return r.activeLoans, nil
+}
+
+func (r *fakeLoanRepository) CreateLoan(ctx context.Context, loan *models.LoanRecord, maxActiveLoans int, holdId string, outbox []*models.OutboxMessage) (*models.LoanRecord, error) {
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	if r.activeLoans >= maxActiveLoans {
+		return nil, repository.ErrLoanLimitReached
+	}
+	r.created = true
+	r.holdId = holdId
+	r.outbox = outbox
+	createdLoan := *loan
+	createdLoan.Id = "loan-1"
+	return &createdLoan, nil
+}
+
+func (r *fakeLoanRepository) ReturnLoan(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error) {
+	return r.TransitionLoanStatus(ctx, version, change, outbox, fines)
+}
+
+func (r *fakeLoanRepository) TransitionLoanStatus(ctx context.Context, version int, change *models.LoanStatusChange, outbox []*models.OutboxMessage, fines func(loan *models.LoanRecord) []*models.FineEntry) (*models.LoanRecord, error) {
+	if r.returnErr != nil {
+		return nil, r.returnErr
+	}
+	r.returned = true
+	r.change = change
+	r.outbox = outbox
+	updatedLoan := *r.loan
+	updatedLoan.Status = change.ToStatus
+	if change.ToStatus == constants.LoanStatusReturned || change.ToStatus == constants.LoanStatusFound {
+		returnDate := r.loan.DueDate
+		updatedLoan.ReturnDate = &returnDate
+	}
+	r.fines = fines(&updatedLoan)
+	return &updatedLoan, nil
+}
+
+type fakeFineRepository struct {
+	repository.FineRepository
+}
+
+func (r *fakeFineRepository) GetUnpaidFineTotal(ctx context.Context, userId string) (int64, error) {
+	return 0, nil
+}
+
+type fakeHoldService struct {
+	HoldService
+	hold       *models.HoldRecord // the user's own hold returned by ReserveForLoan
+	promoteErr error
+}
+
+func (s *fakeHoldService) ReserveForLoan(ctx context.Context, bookId, userId string, stock int) (*models.HoldRecord, codes.Code, error) {
+	return s.hold, codes.OK, nil
+}
+
+func (s *fakeHoldService) PromoteNextHold(ctx context.Context, bookId string) (*models.HoldRecord, error) {
+	return nil, s.promoteErr
+}
+
+func newTestLoanService(bookClient *fakeBookClient, repo *fakeLoanRepository) LoanService {
+	return newTestLoanServiceWithHolds(bookClient, repo, &fakeHoldService{})
+}
+
+func newTestLoanServiceWithHolds(bookClient *fakeBookClient, repo *fakeLoanRepository, holdService *fakeHoldService) LoanService {
+	configs.AppConfig.MaxUnpaidFineAmount = 50000
+	configs.AppConfig.OverdueFinePerDay = 1000
+	configs.AppConfig.LostItemFine = 100000
+	userClient := &fakeUserClient{entitlements: &clients.EntitlementsResponse{
+		TierName:           "standard",
+		MaxConcurrentLoans: 5,
+		LoanDurationDays:   14,
+		MaxRenewals:        2,
+	}}
+	return NewLoanService(repo, &fakeFineRepository{}, holdService, bookClient, userClient)
+}
+
+func testBook() *clients.BookResponse {
+	return &clients.BookResponse{Id: "book-1", Title: "Dune", Stock: 3}
+}
+
+func testCopy() *clients.CopyResponse {
+	return &clients.CopyResponse{Id: "copy-1", BookId: "book-1", Barcode: "BC-1", Status: "AVAILABLE"}
+}
+
+func assertCalls(t *testing.T, bookClient *fakeBookClient, want ...string) {
+	t.Helper()
+	if len(bookClient.calls) != len(want) {
+		t.Fatalf("book client calls = %v, want %v", bookClient.calls, want)
+	}
+	for i := range want {
+		if bookClient.calls[i] != want[i] {
+			t.Fatalf("book client calls = %v, want %v", bookClient.calls, want)
+		}
+	}
+}
+
+func TestCreateLoanStoresNotificationInOutbox(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{}
+	svc := newTestLoanService(bookClient, repo)
+
+	loan, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err != nil || code != codes.OK {
+		t.Fatalf("CreateLoan() = %v, %v, want OK", code, err)
+	}
+	if loan.Id != "loan-1" {
+		t.Fatalf("loan id = %q, want %q", loan.Id, "loan-1")
+	}
+	assertCalls(t, bookClient, "checkout")
+
+	if len(repo.outbox) != 1 {
+		t.Fatalf("outbox messages = %d, want 1", len(repo.outbox))
+	}
+	message := repo.outbox[0]
+	if message.Exchange != constants.EmailExchange || message.RoutingKey != constants.LoanNotificationQueue {
+		t.Fatalf("outbox message routed to %s/%s", message.Exchange, message.RoutingKey)
+	}
+	var notification models.LoanNotificationMessage
+	if err := json.Unmarshal(message.Payload, &notification); err != nil {
+		t.Fatalf("outbox payload is not a loan notification: %v", err)
+	}
+	if notification.Email != "user@mail.com" || notification.Book != "Dune" {
+		t.Fatalf("notification = %+v", notification)
+	}
+}
+
+func TestCreateLoanUsesTierLoanDuration(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{}
+	svc := newTestLoanService(bookClient, repo)
+
+	loan, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err != nil || code != codes.OK {
+		t.Fatalf("CreateLoan() = %v, %v, want OK", code, err)
+	}
+	if days := loan.DueDate.Sub(loan.LoanDate).Hours() / 24; days != 14 {
+		t.Fatalf("loan duration = %v days, want 14", days)
+	}
+}
+
+func TestCreateLoanRejectsUserAtTierLimit(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{activeLoans: 5}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err == nil || code != codes.FailedPrecondition {
+		t.Fatalf("CreateLoan() = %v, %v, want FailedPrecondition", code, err)
+	}
+	if repo.created {
+		t.Fatal("loan was created above the tier limit")
+	}
+	assertCalls(t, bookClient)
+}
+
+func TestCreateLoanRejectsConcurrentBorrowPastTierLimit(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	// Another borrow took the last loan of the tier after the up-front check
+	repo := &fakeLoanRepository{createErr: repository.ErrLoanLimitReached}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err == nil || code != codes.FailedPrecondition {
+		t.Fatalf("CreateLoan() = %v, %v, want FailedPrecondition", code, err)
+	}
+	assertCalls(t, bookClient, "checkout", "checkin")
+}
+
+func TestCreateLoanChecksCopyBackInWhenInsertFails(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{createErr: errors.New("connection reset")}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err == nil || code != codes.Internal {
+		t.Fatalf("CreateLoan() = %v, %v, want Internal error", code, err)
+	}
+	assertCalls(t, bookClient, "checkout", "checkin")
+}
+
+func TestCreateLoanReportsFailedCompensation(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook(), checkInErr: errors.New("book service down")}
+	repo := &fakeLoanRepository{createErr: errors.New("connection reset")}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err == nil || code != codes.Internal {
+		t.Fatalf("CreateLoan() = %v, %v, want Internal error", code, err)
+	}
+	if err.Error() != "failed to create new loan and to check the copy back in" {
+		t.Fatalf("error = %q", err)
+	}
+	assertCalls(t, bookClient, "checkout", "checkin")
+}
+
+func TestCreateLoanFulfillsHoldWithTheLoan(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{}
+	hold := &models.HoldRecord{Id: "hold-1", UserId: "user-1", BookId: "book-1", Status: constants.HoldStatusReady}
+	svc := newTestLoanServiceWithHolds(bookClient, repo, &fakeHoldService{hold: hold})
+
+	if _, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1"); err != nil || code != codes.OK {
+		t.Fatalf("CreateLoan() = %v, %v, want OK", code, err)
+	}
+	if repo.holdId != "hold-1" {
+		t.Fatalf("hold fulfilled with the loan = %q, want hold-1", repo.holdId)
+	}
+
+	// A hold that cannot be fulfilled rolls the loan back, so the copy is checked back in
+	bookClient.calls = nil
+	repo.createErr = errors.New("hold is no longer active")
+	if _, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1"); err == nil || code != codes.Internal {
+		t.Fatalf("CreateLoan() = %v, %v, want Internal error", code, err)
+	}
+	assertCalls(t, bookClient, "checkout", "checkin")
+}
+
+func TestCreateLoanSkipsInsertWhenCheckOutFails(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook(), checkOutErr: errors.New("connection refused")}
+	repo := &fakeLoanRepository{}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err == nil || code != codes.Internal {
+		t.Fatalf("CreateLoan() = %v, %v, want Internal error", code, err)
+	}
+	if repo.created {
+		t.Fatal("loan was created although no copy was checked out")
+	}
+	assertCalls(t, bookClient, "checkout")
+}
+
+func borrowedLoan() *models.LoanRecord {
+	return &models.LoanRecord{
+		Id:       "loan-1",
+		UserId:   "user-1",
+		BookId:   "book-1",
+		CopyId:   "copy-1",
+		LoanDate: time.Now().AddDate(0, 0, -3),
+		DueDate:  time.Now().AddDate(0, 0, 11),
+		Status:   constants.LoanStatusBorrowed,
+	}
+}
+
+func TestReturnLoanStoresNotificationInOutbox(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: borrowedLoan()}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.ReturnLoan(context.Background(), "loan-1", "user-1", "user@mail.com", 1)
+	if err != nil || code != codes.OK {
+		t.Fatalf("ReturnLoan() = %v, %v, want OK", code, err)
+	}
+	assertCalls(t, bookClient, "checkin")
+	if len(repo.outbox) != 2 || repo.outbox[0].RoutingKey != constants.ReturnNotificationQueue || repo.outbox[1].RoutingKey != constants.LoanStatusChangedQueue {
+		t.Fatalf("outbox = %+v, want a return notification and a status change event", repo.outbox)
+	}
+	if repo.change.FromStatus != constants.LoanStatusBorrowed || repo.change.ChangedBy != "user-1" {
+		t.Fatalf("recorded change = %+v", repo.change)
+	}
+}
+
+func TestReturnLoanSucceedsWhenHoldPromotionFails(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: borrowedLoan()}
+	svc := newTestLoanServiceWithHolds(bookClient, repo, &fakeHoldService{promoteErr: errors.New("broker down")})
+
+	loan, code, err := svc.ReturnLoan(context.Background(), "loan-1", "user-1", "user@mail.com", 1)
+	if err != nil || code != codes.OK {
+		t.Fatalf("ReturnLoan() = %v, %v, want OK", code, err)
+	}
+	if loan.Status != constants.LoanStatusReturned {
+		t.Fatalf("status = %s, want %s", loan.Status, constants.LoanStatusReturned)
+	}
+}
+
+func TestReturnLoanChecksCopyOutWhenReturnFails(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: borrowedLoan(), returnErr: errors.New("version conflict")}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.ReturnLoan(context.Background(), "loan-1", "user-1", "user@mail.com", 1)
+	if err == nil || code != codes.Internal {
+		t.Fatalf("ReturnLoan() = %v, %v, want Internal error", code, err)
+	}
+	assertCalls(t, bookClient, "checkin", "checkout")
+}
+
+func TestReturnLoanSkipsReturnWhenCheckInFails(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook(), checkInErr: errors.New("book service down")}
+	repo := &fakeLoanRepository{loan: borrowedLoan()}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.ReturnLoan(context.Background(), "loan-1", "user-1", "user@mail.com", 1)
+	if err == nil || code != codes.Internal {
+		t.Fatalf("ReturnLoan() = %v, %v, want Internal error", code, err)
+	}
+	if repo.returned {
+		t.Fatal("loan was returned although the copy was not checked in")
+	}
+	assertCalls(t, bookClient, "checkin")
+}
+
+func TestUpdateLoanStatusRejectsIllegalTransitions(t *testing.T) {
+	tests := []struct {
+		from, to string
+	}{
+		{constants.LoanStatusReturned, constants.LoanStatusBorrowed},
+		{constants.LoanStatusReturned, constants.LoanStatusLost},
+		{constants.LoanStatusLost, constants.LoanStatusReturned},
+		{constants.LoanStatusFound, constants.LoanStatusLost},
+		{constants.LoanStatusBorrowed, constants.LoanStatusFound},
+		{constants.LoanStatusOverdue, constants.LoanStatusBorrowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			loan := borrowedLoan()
+			loan.Status = tt.from
+			bookClient := &fakeBookClient{book: testBook()}
+			repo := &fakeLoanRepository{loan: loan}
+			svc := newTestLoanService(bookClient, repo)
+
+			_, code, err := svc.UpdateLoanStatus(context.Background(), "loan-1", tt.to, "admin-1", "", 1)
+			if err == nil || code != codes.FailedPrecondition {
+				t.Fatalf("UpdateLoanStatus() = %v, %v, want FailedPrecondition", code, err)
+			}
+			if repo.returned {
+				t.Fatal("loan status was changed")
+			}
+			assertCalls(t, bookClient)
+		})
+	}
+}
+
+func TestUpdateLoanStatusToLostChargesFineWithoutCheckIn(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: borrowedLoan()}
+	svc := newTestLoanService(bookClient, repo)
+
+	loan, code, err := svc.UpdateLoanStatus(context.Background(), "loan-1", constants.LoanStatusLost, "admin-1", "patron reported", 1)
+	if err != nil || code != codes.OK {
+		t.Fatalf("UpdateLoanStatus() = %v, %v, want OK", code, err)
+	}
+	if loan.Status != constants.LoanStatusLost {
+		t.Fatalf("status = %s, want %s", loan.Status, constants.LoanStatusLost)
+	}
+	assertCalls(t, bookClient)
+	if len(repo.fines) != 1 || repo.fines[0].Type != constants.FineTypeLost {
+		t.Fatalf("fines = %+v, want one lost item fine", repo.fines)
+	}
+	if repo.change.Note != "patron reported" || repo.change.ChangedBy != "admin-1" {
+		t.Fatalf("recorded change = %+v", repo.change)
+	}
+}
+
+func TestUpdateLoanStatusFoundChecksCopyIn(t *testing.T) {
+	loan := borrowedLoan()
+	loan.Status = constants.LoanStatusLost
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: loan}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.UpdateLoanStatus(context.Background(), "loan-1", constants.LoanStatusFound, "admin-1", "", 1)
+	if err != nil || code != codes.OK {
+		t.Fatalf("UpdateLoanStatus() = %v, %v, want OK", code, err)
+	}
+	assertCalls(t, bookClient, "checkin")
+}
+
+func TestUpdateLoanStatusChecksCopyOutWhenUpdateFails(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: borrowedLoan(), returnErr: errors.New("version conflict")}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.UpdateLoanStatus(context.Background(), "loan-1", constants.LoanStatusReturned, "admin-1", "", 1)
+	if err == nil || code != codes.Aborted {
+		t.Fatalf("UpdateLoanStatus() = %v, %v, want Aborted", code, err)
+	}
+	assertCalls(t, bookClient, "checkin", "checkout")
+}
+
+func TestCreateLoanReportsUnavailableWhenNoCopyIsFree(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook(), checkOutErr: status.Error(codes.FailedPrecondition, "no available copy")}
+	repo := &fakeLoanRepository{}
+	svc := newTestLoanService(bookClient, repo)
+
+	_, code, err := svc.CreateLoan(context.Background(), "user-1", "user@mail.com", "book-1")
+	if err == nil || code != codes.Unavailable {
+		t.Fatalf("CreateLoan() = %v, %v, want Unavailable", code, err)
+	}
+	if repo.created {
+		t.Fatal("loan was created without a copy")
+	}
+}
+
+func TestCheckOutCopyLendsScannedCopy(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{}
+	svc := newTestLoanService(bookClient, repo)
+
+	loan, code, err := svc.CheckOutCopy(context.Background(), "user-1", "BC-1", "librarian-1")
+	if err != nil || code != codes.OK {
+		t.Fatalf("CheckOutCopy() = %v, %v, want OK", code, err)
+	}
+	if loan.CopyId != "copy-1" || loan.BookId != "book-1" {
+		t.Fatalf("loan = %+v, want copy-1 of book-1", loan)
+	}
+	assertCalls(t, bookClient, "checkout")
+
+	_, code, err = svc.CheckOutCopy(context.Background(), "user-1", "BC-404", "librarian-1")
+	if err == nil || code != codes.NotFound {
+		t.Fatalf("CheckOutCopy() of unknown barcode = %v, %v, want NotFound", code, err)
+	}
+}
+
+func TestCheckInCopyReturnsBorrowedLoan(t *testing.T) {
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: borrowedLoan()}
+	svc := newTestLoanService(bookClient, repo)
+
+	loan, code, err := svc.CheckInCopy(context.Background(), "BC-1", "librarian-1", "GOOD")
+	if err != nil || code != codes.OK {
+		t.Fatalf("CheckInCopy() = %v, %v, want OK", code, err)
+	}
+	if loan.Status != constants.LoanStatusReturned || repo.change.ChangedBy != "librarian-1" {
+		t.Fatalf("loan status = %s, change = %+v", loan.Status, repo.change)
+	}
+	assertCalls(t, bookClient, "checkin")
+}
+
+func TestCheckInCopyFindsLostLoan(t *testing.T) {
+	loan := borrowedLoan()
+	loan.Status = constants.LoanStatusLost
+	bookClient := &fakeBookClient{book: testBook()}
+	repo := &fakeLoanRepository{loan: loan}
+	svc := newTestLoanService(bookClient, repo)
+
+	foundLoan, code, err := svc.CheckInCopy(context.Background(), "BC-1", "librarian-1", "")
+	if err != nil || code != codes.OK {
+		t.Fatalf("CheckInCopy() = %v, %v, want OK", code, err)
+	}
+	if foundLoan.Status != constants.LoanStatusFound {
+		t.Fatalf("status = %s, want %s", foundLoan.Status, constants.LoanStatusFound)
+	}
+	assertCalls(t, bookClient, "checkin")
+}
+
+func TestApplyPageTokenRejectsTokenOfAnotherSort(t *testing.T) {
+	token, err := encodePageToken(&models.LoanCursor{
+		SortBy:    constants.SortByLoanDate,
+		SortOrder: constants.SortOrderDesc,
+		SortValue: time.Now(),
+		Id:        "loan-1",
+	})
+	if err != nil {
+		t.Fatalf("encode page token: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		filter  models.LoanFilter
+		wantErr bool
+	}{
+		{"same sort", models.LoanFilter{SortBy: constants.SortByLoanDate, SortOrder: constants.SortOrderDesc}, false},
+		{"default sort", models.LoanFilter{}, false},
+		{"other field", models.LoanFilter{SortBy: constants.SortByUpdatedAt, SortOrder: constants.SortOrderDesc}, true},
+		{"other direction", models.LoanFilter{SortBy: constants.SortByLoanDate, SortOrder: constants.SortOrderAsc}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := tt.filter
+			err := applyPageToken(&filter, token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyPageToken error = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (filter.Cursor == nil || filter.Cursor.Id != "loan-1") {
+				t.Fatalf("cursor not applied: %+v", filter.Cursor)
+			}
+		})
+	}
+}
+
+func TestTransitionFines(t *testing.T) {
+	configs.AppConfig.OverdueFinePerDay = 1000
+	configs.AppConfig.LostItemFine = 100000
+
+	now := time.Date(2024, 5, 20, 12, 0, 0, 0, time.UTC)
+	onTime := now.AddDate(0, 0, 1)
+	late := now.AddDate(0, 0, -3)
+
+	tests := []struct {
+		name    string
+		status  string
+		dueDate time.Time
+		want    []models.FineEntry
+	}{
+		{
+			name:    "returned on time",
+			status:  constants.LoanStatusReturned,
+			dueDate: onTime,
+		},
+		{
+			name:    "returned late",
+			status:  constants.LoanStatusReturned,
+			dueDate: late,
+			want: []models.FineEntry{
+				{EntryType: constants.FineEntryCharge, Type: constants.FineTypeOverdue, Amount: 3000},
+			},
+		},
+		{
+			name:    "lost before the due date",
+			status:  constants.LoanStatusLost,
+			dueDate: onTime,
+			want: []models.FineEntry{
+				{EntryType: constants.FineEntryCharge, Type: constants.FineTypeLost, Amount: 100000},
+			},
+		},
+		{
+			name:    "lost while overdue",
+			status:  constants.LoanStatusLost,
+			dueDate: late,
+			want: []models.FineEntry{
+				{EntryType: constants.FineEntryCharge, Type: constants.FineTypeOverdue, Amount: 3000},
+				{EntryType: constants.FineEntryCharge, Type: constants.FineTypeLost, Amount: 100000},
+			},
+		},
+		{
+			name:    "found",
+			status:  constants.LoanStatusFound,
+			dueDate: late,
+			want: []models.FineEntry{
+				{EntryType: constants.FineEntryWaiver, Type: constants.FineTypeLost},
+			},
+		},
+		{
+			name:    "overdue",
+			status:  constants.LoanStatusOverdue,
+			dueDate: late,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loan := borrowedLoan()
+			loan.Status = tt.status
+			loan.DueDate = tt.dueDate
+			loan.ReturnDate = &now
+
+			got := transitionFines(loan, now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("transitionFines() = %d entries, want %d", len(got), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if got[i].EntryType != want.EntryType || got[i].Type != want.Type || got[i].Amount != want.Amount {
+					t.Fatalf("entry %d = %+v, want %+v", i, *got[i], want)
+				}
+			}
+		})
+	}
+}
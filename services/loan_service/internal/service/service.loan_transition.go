@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"loan_service/configs"
+	"loan_service/internal/constants"
+	"loan_service/internal/models"
+	"loan_service/pkg/utils"
+	"log"
+	"slices"
+	"time"
+)
+
+// loanTransitions is the loan state machine, RETURNED and FOUND are final
+var loanTransitions = map[string][]string{
+	constants.LoanStatusBorrowed: {constants.LoanStatusReturned, constants.LoanStatusOverdue, constants.LoanStatusLost},
+	constants.LoanStatusOverdue:  {constants.LoanStatusReturned, constants.LoanStatusLost},
+	constants.LoanStatusLost:     {constants.LoanStatusFound},
+}
+
+func canTransitionLoan(from, to string) bool {
+	return slices.Contains(loanTransitions[from], to)
+}
+
+// restocksLoan tells whether moving a loan to status puts its copy back on the shelf
+func restocksLoan(status string) bool {
+	return status == constants.LoanStatusReturned || status == constants.LoanStatusFound
+}
+
+func newLoanStatusChangedEvent(requestID string, loan *models.LoanRecord, change *models.LoanStatusChange) (*models.OutboxMessage, error) {
+	return models.NewOutboxMessage(constants.LoanEventExchange, constants.LoanStatusChangedQueue, models.LoanStatusChangedEvent{
+		RequestID:  requestID,
+		LoanId:     loan.Id,
+		UserId:     loan.UserId,
+		BookId:     loan.BookId,
+		FromStatus: change.FromStatus,
+		ToStatus:   change.ToStatus,
+		ChangedBy:  change.ChangedBy,
+		ChangedAt:  time.Now(),
+	})
+}
+
+// transitionFines settles the fines of a loan that just moved to its status at now: late returns and lost copies are fined,
+// the overdue days up to the loss included, and the replacement fine of a lost copy that was found again is waived.
+// Whatever was already paid towards that fine stays paid.
+func transitionFines(loan *models.LoanRecord, now time.Time) []*models.FineEntry {
+	var entries []*models.FineEntry
+	switch loan.Status {
+	case constants.LoanStatusReturned:
+		if lateDays := overdueDays(loan.DueDate, *loan.ReturnDate); lateDays > 0 {
+			entries = append(entries, &models.FineEntry{
+				EntryType: constants.FineEntryCharge,
+				Type:      constants.FineTypeOverdue,
+				Amount:    int64(lateDays * configs.AppConfig.OverdueFinePerDay),
+				Note:      fmt.Sprintf("returned %d day(s) late", lateDays),
+			})
+		}
+	case constants.LoanStatusLost:
+		if lateDays := overdueDays(loan.DueDate, now); lateDays > 0 {
+			entries = append(entries, &models.FineEntry{
+				EntryType: constants.FineEntryCharge,
+				Type:      constants.FineTypeOverdue,
+				Amount:    int64(lateDays * configs.AppConfig.OverdueFinePerDay),
+				Note:      fmt.Sprintf("lost %d day(s) after the due date", lateDays),
+			})
+		}
+		// A lost copy stays out of stock from lending and the patron pays for a replacement
+		entries = append(entries, &models.FineEntry{
+			EntryType: constants.FineEntryCharge,
+			Type:      constants.FineTypeLost,
+			Amount:    int64(configs.AppConfig.LostItemFine),
+			Note:      "lost item replacement",
+		})
+	case constants.LoanStatusFound:
+		entries = append(entries, &models.FineEntry{
+			EntryType: constants.FineEntryWaiver,
+			Type:      constants.FineTypeLost,
+			Note:      "lost item found",
+		})
+	}
+	return entries
+}
+
+// loanFines builds the fines of a transition committed now
+func loanFines(loan *models.LoanRecord) []*models.FineEntry {
+	return transitionFines(loan, time.Now())
+}
+
+// afterLoanTransition hands a copy back on the shelf to the next patron in the hold queue. The transition is committed
+// by then, so a failed promotion is only logged and retried by the next return or expiry.
+func (s *loanService) afterLoanTransition(ctx context.Context, loan *models.LoanRecord) {
+	if !restocksLoan(loan.Status) {
+		return
+	}
+	if _, err := s.holdService.PromoteNextHold(ctx, loan.BookId); err != nil {
+		log.Printf("[%s] Failed to promote next hold for book %s: %v\n", utils.GetLocation(), loan.BookId, err)
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"loan_service/configs"
+	"loan_service/internal/models"
+	"loan_service/internal/repository"
+	"loan_service/pkg/rabbitmq"
+	"loan_service/pkg/utils"
+	"log"
+
+	"google.golang.org/grpc/codes"
+)
+
+type OutboxService interface {
+	RelayPending(ctx context.Context) (int, codes.Code, error)
+}
+
+type outboxService struct {
+	repo      repository.OutboxRepository
+	publisher *rabbitmq.Publisher
+}
+
+func NewOutboxService(repo repository.OutboxRepository, publisher *rabbitmq.Publisher) OutboxService {
+	return &outboxService{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+// RelayPending publishes the next batch of committed outbox messages to RabbitMQ
+func (s *outboxService) RelayPending(ctx context.Context) (int, codes.Code, error) {
+	published, err := s.repo.RelayPending(ctx, configs.AppConfig.OutboxRelayBatchSize, func(message *models.OutboxMessage) error {
+		return s.publisher.Publish(message.Exchange, message.RoutingKey, message.Payload)
+	})
+	if err != nil {
+		log.Printf("[%s] Failed to relay outbox messages: %v\n", utils.GetLocation(), err)
+		return published, codes.Internal, errors.New("failed to relay outbox messages")
+	}
+
+	if published > 0 {
+		log.Printf("[%s] %d outbox messages relayed\n", utils.GetLocation(), published)
+	}
+	return published, codes.OK, nil
+}
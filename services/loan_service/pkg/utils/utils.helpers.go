@@ -2,6 +2,8 @@ package utils
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"loan_service/internal/constants"
 	"path/filepath"
@@ -66,3 +68,27 @@ func CalculateTotalPages(totalItems, pageSize int) int {
 
 	return totalPages
 }
+
+// EncodePageToken serializes a pagination cursor into an opaque URL-safe token.
+func EncodePageToken(cursor any) (string, error) {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodePageToken restores a pagination cursor previously produced by EncodePageToken.
+func DecodePageToken(token string, cursor any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("invalid page token: %v", err)
+	}
+
+	if err := json.Unmarshal(raw, cursor); err != nil {
+		return fmt.Errorf("invalid page token: %v", err)
+	}
+
+	return nil
+}
@@ -24,12 +24,22 @@ const _ = grpc.SupportPackageIsVersion7
 type LoanServiceClient interface {
 	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*LoanResponse, error)
 	ReturnLoan(ctx context.Context, in *ReturnLoanRequest, opts ...grpc.CallOption) (*LoanResponse, error)
+	RenewLoan(ctx context.Context, in *RenewLoanRequest, opts ...grpc.CallOption) (*LoanResponse, error)
 	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*LoanResponse, error)
 	UpdateLoanStatus(ctx context.Context, in *UpdateLoanStatusRequest, opts ...grpc.CallOption) (*LoanResponse, error)
+	GetLoanHistory(ctx context.Context, in *GetLoanHistoryRequest, opts ...grpc.CallOption) (*LoanHistoryResponse, error)
+	CheckOutCopy(ctx context.Context, in *CheckOutCopyRequest, opts ...grpc.CallOption) (*LoanResponse, error)
+	CheckInCopy(ctx context.Context, in *CheckInCopyRequest, opts ...grpc.CallOption) (*LoanResponse, error)
 	ListUserLoans(ctx context.Context, in *ListUserLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
 	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
 	GetUserLoansByStatus(ctx context.Context, in *GetUserLoansByStatusRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
 	GetLoansByStatus(ctx context.Context, in *GetLoansByStatusRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	ListUserFines(ctx context.Context, in *ListUserFinesRequest, opts ...grpc.CallOption) (*ListFinesResponse, error)
+	PayFine(ctx context.Context, in *PayFineRequest, opts ...grpc.CallOption) (*FineResponse, error)
+	WaiveFine(ctx context.Context, in *WaiveFineRequest, opts ...grpc.CallOption) (*FineResponse, error)
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error)
+	CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error)
+	ListUserHolds(ctx context.Context, in *ListUserHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error)
 }
 
 type loanServiceClient struct {
@@ -58,6 +68,15 @@ func (c *loanServiceClient) ReturnLoan(ctx context.Context, in *ReturnLoanReques
 	return out, nil
 }
 
+func (c *loanServiceClient) RenewLoan(ctx context.Context, in *RenewLoanRequest, opts ...grpc.CallOption) (*LoanResponse, error) {
+	out := new(LoanResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/RenewLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loanServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*LoanResponse, error) {
 	out := new(LoanResponse)
 	err := c.cc.Invoke(ctx, "/loan_service.LoanService/GetLoan", in, out, opts...)
@@ -76,6 +95,33 @@ func (c *loanServiceClient) UpdateLoanStatus(ctx context.Context, in *UpdateLoan
 	return out, nil
 }
 
+func (c *loanServiceClient) GetLoanHistory(ctx context.Context, in *GetLoanHistoryRequest, opts ...grpc.CallOption) (*LoanHistoryResponse, error) {
+	out := new(LoanHistoryResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/GetLoanHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CheckOutCopy(ctx context.Context, in *CheckOutCopyRequest, opts ...grpc.CallOption) (*LoanResponse, error) {
+	out := new(LoanResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/CheckOutCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CheckInCopy(ctx context.Context, in *CheckInCopyRequest, opts ...grpc.CallOption) (*LoanResponse, error) {
+	out := new(LoanResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/CheckInCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loanServiceClient) ListUserLoans(ctx context.Context, in *ListUserLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
 	out := new(ListLoansResponse)
 	err := c.cc.Invoke(ctx, "/loan_service.LoanService/ListUserLoans", in, out, opts...)
@@ -112,18 +158,82 @@ func (c *loanServiceClient) GetLoansByStatus(ctx context.Context, in *GetLoansBy
 	return out, nil
 }
 
+func (c *loanServiceClient) ListUserFines(ctx context.Context, in *ListUserFinesRequest, opts ...grpc.CallOption) (*ListFinesResponse, error) {
+	out := new(ListFinesResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/ListUserFines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) PayFine(ctx context.Context, in *PayFineRequest, opts ...grpc.CallOption) (*FineResponse, error) {
+	out := new(FineResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/PayFine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) WaiveFine(ctx context.Context, in *WaiveFineRequest, opts ...grpc.CallOption) (*FineResponse, error) {
+	out := new(FineResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/WaiveFine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error) {
+	out := new(HoldResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/PlaceHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CancelHold(ctx context.Context, in *CancelHoldRequest, opts ...grpc.CallOption) (*HoldResponse, error) {
+	out := new(HoldResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/CancelHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListUserHolds(ctx context.Context, in *ListUserHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error) {
+	out := new(ListHoldsResponse)
+	err := c.cc.Invoke(ctx, "/loan_service.LoanService/ListUserHolds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanServiceServer is the server API for LoanService service.
 // All implementations must embed UnimplementedLoanServiceServer
 // for forward compatibility
 type LoanServiceServer interface {
 	CreateLoan(context.Context, *CreateLoanRequest) (*LoanResponse, error)
 	ReturnLoan(context.Context, *ReturnLoanRequest) (*LoanResponse, error)
+	RenewLoan(context.Context, *RenewLoanRequest) (*LoanResponse, error)
 	GetLoan(context.Context, *GetLoanRequest) (*LoanResponse, error)
 	UpdateLoanStatus(context.Context, *UpdateLoanStatusRequest) (*LoanResponse, error)
+	GetLoanHistory(context.Context, *GetLoanHistoryRequest) (*LoanHistoryResponse, error)
+	CheckOutCopy(context.Context, *CheckOutCopyRequest) (*LoanResponse, error)
+	CheckInCopy(context.Context, *CheckInCopyRequest) (*LoanResponse, error)
 	ListUserLoans(context.Context, *ListUserLoansRequest) (*ListLoansResponse, error)
 	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
 	GetUserLoansByStatus(context.Context, *GetUserLoansByStatusRequest) (*ListLoansResponse, error)
 	GetLoansByStatus(context.Context, *GetLoansByStatusRequest) (*ListLoansResponse, error)
+	ListUserFines(context.Context, *ListUserFinesRequest) (*ListFinesResponse, error)
+	PayFine(context.Context, *PayFineRequest) (*FineResponse, error)
+	WaiveFine(context.Context, *WaiveFineRequest) (*FineResponse, error)
+	PlaceHold(context.Context, *PlaceHoldRequest) (*HoldResponse, error)
+	CancelHold(context.Context, *CancelHoldRequest) (*HoldResponse, error)
+	ListUserHolds(context.Context, *ListUserHoldsRequest) (*ListHoldsResponse, error)
 	mustEmbedUnimplementedLoanServiceServer()
 }
 
@@ -137,12 +247,24 @@ func (UnimplementedLoanServiceServer) CreateLoan(context.Context, *CreateLoanReq
 func (UnimplementedLoanServiceServer) ReturnLoan(context.Context, *ReturnLoanRequest) (*LoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnLoan not implemented")
 }
+func (UnimplementedLoanServiceServer) RenewLoan(context.Context, *RenewLoanRequest) (*LoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLoan not implemented")
+}
 func (UnimplementedLoanServiceServer) GetLoan(context.Context, *GetLoanRequest) (*LoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
 }
 func (UnimplementedLoanServiceServer) UpdateLoanStatus(context.Context, *UpdateLoanStatusRequest) (*LoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLoanStatus not implemented")
 }
+func (UnimplementedLoanServiceServer) GetLoanHistory(context.Context, *GetLoanHistoryRequest) (*LoanHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanHistory not implemented")
+}
+func (UnimplementedLoanServiceServer) CheckOutCopy(context.Context, *CheckOutCopyRequest) (*LoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOutCopy not implemented")
+}
+func (UnimplementedLoanServiceServer) CheckInCopy(context.Context, *CheckInCopyRequest) (*LoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInCopy not implemented")
+}
 func (UnimplementedLoanServiceServer) ListUserLoans(context.Context, *ListUserLoansRequest) (*ListLoansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserLoans not implemented")
 }
@@ -155,6 +277,24 @@ func (UnimplementedLoanServiceServer) GetUserLoansByStatus(context.Context, *Get
 func (UnimplementedLoanServiceServer) GetLoansByStatus(context.Context, *GetLoansByStatusRequest) (*ListLoansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoansByStatus not implemented")
 }
+func (UnimplementedLoanServiceServer) ListUserFines(context.Context, *ListUserFinesRequest) (*ListFinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserFines not implemented")
+}
+func (UnimplementedLoanServiceServer) PayFine(context.Context, *PayFineRequest) (*FineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayFine not implemented")
+}
+func (UnimplementedLoanServiceServer) WaiveFine(context.Context, *WaiveFineRequest) (*FineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaiveFine not implemented")
+}
+func (UnimplementedLoanServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*HoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedLoanServiceServer) CancelHold(context.Context, *CancelHoldRequest) (*HoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHold not implemented")
+}
+func (UnimplementedLoanServiceServer) ListUserHolds(context.Context, *ListUserHoldsRequest) (*ListHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserHolds not implemented")
+}
 func (UnimplementedLoanServiceServer) mustEmbedUnimplementedLoanServiceServer() {}
 
 // UnsafeLoanServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -204,6 +344,24 @@ func _LoanService_ReturnLoan_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanService_RenewLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).RenewLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/RenewLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).RenewLoan(ctx, req.(*RenewLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoanService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLoanRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +398,60 @@ func _LoanService_UpdateLoanStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanService_GetLoanHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetLoanHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/GetLoanHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetLoanHistory(ctx, req.(*GetLoanHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CheckOutCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CheckOutCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/CheckOutCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CheckOutCopy(ctx, req.(*CheckOutCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CheckInCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CheckInCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/CheckInCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CheckInCopy(ctx, req.(*CheckInCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoanService_ListUserLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserLoansRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +524,114 @@ func _LoanService_GetLoansByStatus_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanService_ListUserFines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserFinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListUserFines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/ListUserFines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListUserFines(ctx, req.(*ListUserFinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_PayFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayFineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).PayFine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/PayFine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).PayFine(ctx, req.(*PayFineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_WaiveFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaiveFineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).WaiveFine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/WaiveFine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).WaiveFine(ctx, req.(*WaiveFineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/PlaceHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CancelHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CancelHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/CancelHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CancelHold(ctx, req.(*CancelHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListUserHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListUserHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/loan_service.LoanService/ListUserHolds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListUserHolds(ctx, req.(*ListUserHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoanService_ServiceDesc is the grpc.ServiceDesc for LoanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +647,10 @@ var LoanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReturnLoan",
 			Handler:    _LoanService_ReturnLoan_Handler,
 		},
+		{
+			MethodName: "RenewLoan",
+			Handler:    _LoanService_RenewLoan_Handler,
+		},
 		{
 			MethodName: "GetLoan",
 			Handler:    _LoanService_GetLoan_Handler,
@@ -335,6 +659,18 @@ var LoanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLoanStatus",
 			Handler:    _LoanService_UpdateLoanStatus_Handler,
 		},
+		{
+			MethodName: "GetLoanHistory",
+			Handler:    _LoanService_GetLoanHistory_Handler,
+		},
+		{
+			MethodName: "CheckOutCopy",
+			Handler:    _LoanService_CheckOutCopy_Handler,
+		},
+		{
+			MethodName: "CheckInCopy",
+			Handler:    _LoanService_CheckInCopy_Handler,
+		},
 		{
 			MethodName: "ListUserLoans",
 			Handler:    _LoanService_ListUserLoans_Handler,
@@ -351,6 +687,30 @@ var LoanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLoansByStatus",
 			Handler:    _LoanService_GetLoansByStatus_Handler,
 		},
+		{
+			MethodName: "ListUserFines",
+			Handler:    _LoanService_ListUserFines_Handler,
+		},
+		{
+			MethodName: "PayFine",
+			Handler:    _LoanService_PayFine_Handler,
+		},
+		{
+			MethodName: "WaiveFine",
+			Handler:    _LoanService_WaiveFine_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _LoanService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CancelHold",
+			Handler:    _LoanService_CancelHold_Handler,
+		},
+		{
+			MethodName: "ListUserHolds",
+			Handler:    _LoanService_ListUserHolds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loan_service.proto",
@@ -10,6 +10,9 @@ const (
 	LogQueue                = "log_queue"
 	LoanNotificationQueue   = "loan_notification"
 	ReturnNotificationQueue = "return_notification"
+	HoldReadyQueue          = "hold_ready_notification"
+	PasswordResetQueue      = "password_reset"
+	EmailChangeQueue        = "email_change_otp"
 
 	LogServiceMailer = "mailer-service"
 
@@ -20,6 +20,12 @@ type OTPMessage struct {
 	OTP       string `json:"otp"`
 }
 
+type PasswordResetMessage struct {
+	RequestID string `json:"X-Correlation-ID"` // for logging purpose
+	Email     string `json:"email"`
+	Token     string `json:"token"`
+}
+
 type LoanNotificationMessage struct {
 	RequestID string    `json:"X-Correlation-ID"` // for logging purpose
 	Email     string    `json:"email"`
@@ -33,6 +39,13 @@ type ReturnNotificationMessage struct {
 	Book      string `json:"book"`
 }
 
+type HoldReadyMessage struct {
+	RequestID string    `json:"X-Correlation-ID"` // for logging purpose
+	Email     string    `json:"email"`
+	Book      string    `json:"book"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 func StartConsuming(ctx context.Context, ch *amqp.Channel, mailerService mailer.MailerService, logger *logger.Logger) error {
 	// Declare exchange (e.g., direct exchange)
 	err := ch.ExchangeDeclare(
@@ -49,7 +62,7 @@ func StartConsuming(ctx context.Context, ch *amqp.Channel, mailerService mailer.
 	}
 
 	// Declare queues and bind them to the exchange
-	queues := []string{constants.OTPQueue, constants.LoanNotificationQueue, constants.ReturnNotificationQueue}
+	queues := []string{constants.OTPQueue, constants.LoanNotificationQueue, constants.ReturnNotificationQueue, constants.HoldReadyQueue, constants.PasswordResetQueue, constants.EmailChangeQueue}
 	for _, queueName := range queues {
 		// Declare queue
 		_, err := ch.QueueDeclare(
@@ -160,6 +173,54 @@ func consumeQueue(ctx context.Context, ch *amqp.Channel, queueName string, maile
 					"book_title": message.Book,
 				}
 				err = mailerService.SendReturnNotification(message.Email, message.Book)
+
+			case constants.HoldReadyQueue:
+				var message HoldReadyMessage
+				if err := json.Unmarshal(d.Body, &message); err != nil {
+					log.Printf("Failed to parse hold ready message: %v", err)
+					d.Nack(false, true)
+					log.Println("Retry later...")
+					logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to parse hold ready message: %v", err), extra, err)
+					continue
+				}
+				requestID = message.RequestID
+				extra = map[string]interface{}{
+					"email":      message.Email,
+					"book_title": message.Book,
+					"expires_at": message.ExpiresAt,
+				}
+				err = mailerService.SendHoldReadyNotification(message.Email, message.Book, message.ExpiresAt)
+
+			case constants.PasswordResetQueue:
+				var message PasswordResetMessage
+				if err := json.Unmarshal(d.Body, &message); err != nil {
+					log.Printf("Failed to parse password reset message: %v", err)
+					d.Nack(false, true)
+					log.Println("Retry later...")
+					logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to parse password reset message: %v", err), extra, err)
+					continue
+				}
+				requestID = message.RequestID
+				// The token is a credential, it is not logged
+				extra = map[string]interface{}{
+					"email": message.Email,
+				}
+				err = mailerService.SendPasswordReset(message.Email, message.Token)
+
+			case constants.EmailChangeQueue:
+				var message OTPMessage
+				if err := json.Unmarshal(d.Body, &message); err != nil {
+					log.Printf("Failed to parse email change message: %v", err)
+					d.Nack(false, true)
+					log.Println("Retry later...")
+					logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to parse email change message: %v", err), extra, err)
+					continue
+				}
+				requestID = message.RequestID
+				extra = map[string]interface{}{
+					"email": message.Email,
+				}
+				err = mailerService.SendEmailChangeOTP(message.Email, message.OTP)
 			}
 
 			if err != nil {
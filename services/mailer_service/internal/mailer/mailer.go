@@ -13,6 +13,9 @@ type MailerService interface {
 	SendOTP(email, otp string) error
 	SendLoanNotification(email, book string, due time.Time) error
 	SendReturnNotification(email, book string) error
+	SendHoldReadyNotification(email, book string, expiresAt time.Time) error
+	SendPasswordReset(email, token string) error
+	SendEmailChangeOTP(email, otp string) error
 }
 
 type mailerService struct {
@@ -30,6 +33,9 @@ func NewMailerService(email, password string) (MailerService, error) {
 		"otp":    "./templates/otp_notification.html.gohtml",
 		"loan":   "./templates/loan_notification.html.gohtml",
 		"return": "./templates/return_notification.html.gohtml",
+		"hold":   "./templates/hold_ready_notification.html.gohtml",
+		"reset":  "./templates/password_reset_notification.html.gohtml",
+		"email":  "./templates/email_change_notification.html.gohtml",
 	}
 
 	for key, path := range files {
@@ -95,3 +101,30 @@ func (ms *mailerService) SendReturnNotification(email, book string) error {
 	}
 	return ms.sendEmail(email, "Return Confirmation", "return", data)
 }
+
+// SendHoldReadyNotification tells a patron that their held book is waiting for pickup
+func (ms *mailerService) SendHoldReadyNotification(email, book string, expiresAt time.Time) error {
+	data := map[string]interface{}{
+		"Book":      book,
+		"ExpiresAt": expiresAt,
+	}
+	return ms.sendEmail(email, "Your Hold Is Ready", "hold", data)
+}
+
+// SendPasswordReset sends the token that lets a user choose a new password
+func (ms *mailerService) SendPasswordReset(email, token string) error {
+	data := map[string]interface{}{
+		"Token": token,
+		"Year":  time.Now().Year(),
+	}
+	return ms.sendEmail(email, "Password Reset", "reset", data)
+}
+
+// SendEmailChangeOTP sends the OTP that confirms a new email address
+func (ms *mailerService) SendEmailChangeOTP(email, otp string) error {
+	data := map[string]interface{}{
+		"OTP":  otp,
+		"Year": time.Now().Year(),
+	}
+	return ms.sendEmail(email, "Confirm Your New Email", "email", data)
+}
@@ -79,7 +79,9 @@ func main() {
 
 	// Repository and Service Layer
 	userRepo := repository.NewUserRepository(db)
+	tierRepo := repository.NewMembershipTierRepository(db)
 	userService := service.NewUserService(userRepo)
+	tierService := service.NewMembershipTierService(tierRepo, userRepo)
 
 	// gRPC Server
 	address := fmt.Sprintf(":%s", configs.AppConfig.GrpcPort)
@@ -89,7 +91,7 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	userServer := grpc_server.NewUserGRPCServer(userService, logger)
+	userServer := grpc_server.NewUserGRPCServer(userService, tierService, logger)
 	protoUser.RegisterUserServiceServer(grpcServer, userServer)
 	healthCheckServer := grpc_server.NewHealthGRPCServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthCheckServer)
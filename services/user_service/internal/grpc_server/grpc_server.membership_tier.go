@@ -0,0 +1,152 @@
+package grpc_server
+
+import (
+	"context"
+	"fmt"
+	"user_service/internal/constants"
+	"user_service/internal/models"
+	"user_service/pkg/utils"
+	protoUser "user_service/proto/user_service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userGRPCServer) GetUserEntitlements(ctx context.Context, req *protoUser.GetUserEntitlementsRequest) (*protoUser.GetUserEntitlementsResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received GetUserEntitlements request", map[string]interface{}{"user_id": req.UserId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid GetUserEntitlements request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	entitlements, err := s.tierService.GetUserEntitlements(ctx, req.UserId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to retrieve entitlements of user with id '%s'", req.UserId), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to retrieve entitlements of user with id '%s'", req.UserId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User entitlements retrieved successfully", map[string]interface{}{"user_id": req.UserId, "tier": entitlements.TierName}, nil)
+
+	return &protoUser.GetUserEntitlementsResponse{
+		UserId:             entitlements.UserId,
+		TierId:             entitlements.TierId,
+		TierName:           entitlements.TierName,
+		MaxConcurrentLoans: int32(entitlements.MaxConcurrentLoans),
+		LoanDurationDays:   int32(entitlements.LoanDurationDays),
+		MaxRenewals:        int32(entitlements.MaxRenewals),
+	}, nil
+}
+
+func (s *userGRPCServer) ListMembershipTiers(ctx context.Context, req *protoUser.ListMembershipTiersRequest) (*protoUser.ListMembershipTiersResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received ListMembershipTiers request", nil, nil)
+
+	tiers, err := s.tierService.ListTiers(ctx)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Failed to retrieve membership tier list", nil, err)
+		return nil, status.Error(codes.Internal, "failed to retrieve membership tier list")
+	}
+
+	var protoTiers []*protoUser.MembershipTier
+	for _, tier := range tiers {
+		protoTiers = append(protoTiers, toProtoMembershipTier(tier))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier list retrieved successfully", nil, nil)
+
+	return &protoUser.ListMembershipTiersResponse{
+		Tiers: protoTiers,
+	}, nil
+}
+
+func (s *userGRPCServer) CreateMembershipTier(ctx context.Context, req *protoUser.CreateMembershipTierRequest) (*protoUser.MembershipTierResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received CreateMembershipTier request", map[string]interface{}{"name": req.Name}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid CreateMembershipTier request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	tier, err := s.tierService.CreateTier(ctx, &models.MembershipTierRecord{
+		Name:               req.Name,
+		MaxConcurrentLoans: int(req.MaxConcurrentLoans),
+		LoanDurationDays:   int(req.LoanDurationDays),
+		MaxRenewals:        int(req.MaxRenewals),
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to create membership tier '%s'", req.Name), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create membership tier '%s'", req.Name))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier created successfully", map[string]interface{}{"tier_id": tier.Id}, nil)
+
+	return &protoUser.MembershipTierResponse{
+		Tier: toProtoMembershipTier(tier),
+	}, nil
+}
+
+func (s *userGRPCServer) UpdateMembershipTier(ctx context.Context, req *protoUser.UpdateMembershipTierRequest) (*protoUser.MembershipTierResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received UpdateMembershipTier request", map[string]interface{}{"tier_id": req.Id}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid UpdateMembershipTier request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	tier, err := s.tierService.UpdateTier(ctx, &models.MembershipTierRecord{
+		Id:                 req.Id,
+		Name:               req.Name,
+		MaxConcurrentLoans: int(req.MaxConcurrentLoans),
+		LoanDurationDays:   int(req.LoanDurationDays),
+		MaxRenewals:        int(req.MaxRenewals),
+	})
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to update membership tier with id '%s'", req.Id), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update membership tier with id '%s'", req.Id))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier updated successfully", map[string]interface{}{"tier_id": tier.Id}, nil)
+
+	return &protoUser.MembershipTierResponse{
+		Tier: toProtoMembershipTier(tier),
+	}, nil
+}
+
+func (s *userGRPCServer) AssignMembershipTier(ctx context.Context, req *protoUser.AssignMembershipTierRequest) (*protoUser.GetUserByIdResponse, error) {
+	requestID := utils.GetRequestIDFromMetadataContext(ctx)
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Received AssignMembershipTier request", map[string]interface{}{"user_id": req.UserId, "tier_id": req.TierId}, nil)
+
+	if err := req.Validate(); err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, "Invalid AssignMembershipTier request", nil, err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+
+	user, err := s.tierService.AssignTier(ctx, req.UserId, req.TierId)
+	if err != nil {
+		s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelError, fmt.Sprintf("Failed to assign membership tier to user with id '%s'", req.UserId), nil, err)
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to assign membership tier to user with id '%s'", req.UserId))
+	}
+
+	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "Membership tier assigned successfully", map[string]interface{}{"user_id": user.Id}, nil)
+
+	return &protoUser.GetUserByIdResponse{
+		User: toProtoUser(user),
+	}, nil
+}
+
+func toProtoMembershipTier(tier *models.MembershipTierRecord) *protoUser.MembershipTier {
+	return &protoUser.MembershipTier{
+		Id:                 tier.Id,
+		Name:               tier.Name,
+		MaxConcurrentLoans: int32(tier.MaxConcurrentLoans),
+		LoanDurationDays:   int32(tier.LoanDurationDays),
+		MaxRenewals:        int32(tier.MaxRenewals),
+		IsDefault:          tier.IsDefault,
+		CreatedAt:          tier.CreatedAt.Unix(),
+		UpdatedAt:          tier.UpdatedAt.Unix(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"user_service/internal/constants"
+	"user_service/internal/models"
 	"user_service/internal/service"
 	"user_service/pkg/logger"
 	"user_service/pkg/utils"
@@ -15,13 +16,15 @@ import (
 
 type userGRPCServer struct {
 	userService service.UserService
+	tierService service.MembershipTierService
 	logger      *logger.Logger
 	protoUser.UnimplementedUserServiceServer
 }
 
-func NewUserGRPCServer(userService service.UserService, logger *logger.Logger) protoUser.UserServiceServer {
+func NewUserGRPCServer(userService service.UserService, tierService service.MembershipTierService, logger *logger.Logger) protoUser.UserServiceServer {
 	return &userGRPCServer{
 		userService: userService,
+		tierService: tierService,
 		logger:      logger,
 	}
 }
@@ -44,16 +47,7 @@ func (s *userGRPCServer) GetUserById(ctx context.Context, req *protoUser.GetUser
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User retrieved successfully", map[string]interface{}{"user_id": user.Id}, nil)
 
 	return &protoUser.GetUserByIdResponse{
-		User: &protoUser.User{
-			Id:        user.Id,
-			Email:     user.Email,
-			Username:  user.Username,
-			Password:  user.Password,
-			Verified:  user.Verified,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt.Unix(),
-			UpdatedAt: user.UpdatedAt.Unix(),
-		},
+		User: toProtoUser(user),
 	}, nil
 }
 
@@ -75,16 +69,7 @@ func (s *userGRPCServer) GetUserByEmail(ctx context.Context, req *protoUser.GetU
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User retrieved successfully", map[string]interface{}{"user_id": user.Id}, nil)
 
 	return &protoUser.GetUserByEmailResponse{
-		User: &protoUser.User{
-			Id:        user.Id,
-			Email:     user.Email,
-			Username:  user.Username,
-			Password:  user.Password,
-			Verified:  user.Verified,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt.Unix(),
-			UpdatedAt: user.UpdatedAt.Unix(),
-		},
+		User: toProtoUser(user),
 	}, nil
 }
 
@@ -105,16 +90,7 @@ func (s *userGRPCServer) ListUsers(ctx context.Context, req *protoUser.ListUsers
 
 	var protoUsers []*protoUser.User
 	for _, user := range users {
-		protoUsers = append(protoUsers, &protoUser.User{
-			Id:        user.Id,
-			Email:     user.Email,
-			Username:  user.Username,
-			Password:  user.Password,
-			Verified:  user.Verified,
-			Role:      user.Role,
-			CreatedAt: user.CreatedAt.Unix(),
-			UpdatedAt: user.UpdatedAt.Unix(),
-		})
+		protoUsers = append(protoUsers, toProtoUser(user))
 	}
 
 	s.logger.LogMessage(utils.GetLocation(), requestID, constants.LogLevelInfo, "User list retrieved successfully", nil, nil)
@@ -125,3 +101,23 @@ func (s *userGRPCServer) ListUsers(ctx context.Context, req *protoUser.ListUsers
 		TotalPages: int32(utils.CalculateTotalPages(totalItems, int(req.PageSize))),
 	}, nil
 }
+
+// toProtoUser converts a user record into its protobuf representation, a default tier is sent as an empty tier ID
+func toProtoUser(user *models.UserRecord) *protoUser.User {
+	var tierId string
+	if user.TierId != nil {
+		tierId = *user.TierId
+	}
+
+	return &protoUser.User{
+		Id:        user.Id,
+		Email:     user.Email,
+		Username:  user.Username,
+		Password:  user.Password,
+		Verified:  user.Verified,
+		Roles:     user.Roles,
+		CreatedAt: user.CreatedAt.Unix(),
+		UpdatedAt: user.UpdatedAt.Unix(),
+		TierId:    tierId,
+	}
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type MembershipTierRecord struct {
+	Id                 string    `db:"id"`
+	Name               string    `db:"name"`
+	MaxConcurrentLoans int       `db:"max_concurrent_loans"`
+	LoanDurationDays   int       `db:"loan_duration_days"`
+	MaxRenewals        int       `db:"max_renewals"`
+	IsDefault          bool      `db:"is_default"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
+
+// UserEntitlements are the borrowing limits a user gets from their tier
+type UserEntitlements struct {
+	UserId             string `db:"user_id"`
+	TierId             string `db:"tier_id"`
+	TierName           string `db:"tier_name"`
+	MaxConcurrentLoans int    `db:"max_concurrent_loans"`
+	LoanDurationDays   int    `db:"loan_duration_days"`
+	MaxRenewals        int    `db:"max_renewals"`
+}
@@ -8,7 +8,8 @@ type UserRecord struct {
 	Username  string    `db:"username"`
 	Password  string    `db:"password"`
 	Verified  bool      `db:"verified"`
-	Role      string    `db:"role"`
+	Roles     []string  `db:"roles"`
+	TierId    *string   `db:"tier_id"` // nil means the default tier
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
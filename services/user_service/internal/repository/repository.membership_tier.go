@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"user_service/internal/models"
+	"user_service/pkg/utils"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type MembershipTierRepository interface {
+	ListTiers(ctx context.Context) ([]*models.MembershipTierRecord, error)
+	CreateTier(ctx context.Context, tier *models.MembershipTierRecord) (*models.MembershipTierRecord, error)
+	UpdateTier(ctx context.Context, tier *models.MembershipTierRecord) (*models.MembershipTierRecord, error)
+	AssignTier(ctx context.Context, userId, tierId string) error
+	GetUserEntitlements(ctx context.Context, userId string) (*models.UserEntitlements, error)
+}
+
+type membershipTierRepository struct {
+	db *sqlx.DB
+}
+
+func NewMembershipTierRepository(db *sqlx.DB) MembershipTierRepository {
+	return &membershipTierRepository{
+		db: db,
+	}
+}
+
+// ListTiers returns every membership tier, the default tier first
+func (r *membershipTierRepository) ListTiers(ctx context.Context) ([]*models.MembershipTierRecord, error) {
+	query := `SELECT id, name, max_concurrent_loans, loan_duration_days, max_renewals, is_default, created_at, updated_at FROM membership_tiers ORDER BY is_default DESC, name`
+	log.Printf("[%s] Executing query: %s\n", utils.GetLocation(), query)
+
+	var tiers []*models.MembershipTierRecord
+	if err := r.db.SelectContext(ctx, &tiers, query); err != nil {
+		log.Printf("[%s] Error executing query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully fetched %d membership tiers\n", utils.GetLocation(), len(tiers))
+	return tiers, nil
+}
+
+// CreateTier inserts a new, non default, membership tier
+func (r *membershipTierRepository) CreateTier(ctx context.Context, req *models.MembershipTierRecord) (*models.MembershipTierRecord, error) {
+	query := `INSERT INTO membership_tiers (name, max_concurrent_loans, loan_duration_days, max_renewals)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, max_concurrent_loans, loan_duration_days, max_renewals, is_default, created_at, updated_at`
+	log.Printf("[%s] Executing query: %s with tier: %+v\n", utils.GetLocation(), query, req)
+
+	tier := &models.MembershipTierRecord{}
+	if err := r.db.GetContext(ctx, tier, query, req.Name, req.MaxConcurrentLoans, req.LoanDurationDays, req.MaxRenewals); err != nil {
+		log.Printf("[%s] Error executing query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully created membership tier with ID: %s\n", utils.GetLocation(), tier.Id)
+	return tier, nil
+}
+
+// UpdateTier changes the name and limits of a membership tier
+func (r *membershipTierRepository) UpdateTier(ctx context.Context, req *models.MembershipTierRecord) (*models.MembershipTierRecord, error) {
+	query := `UPDATE membership_tiers
+		SET name = $1, max_concurrent_loans = $2, loan_duration_days = $3, max_renewals = $4
+		WHERE id = $5
+		RETURNING id, name, max_concurrent_loans, loan_duration_days, max_renewals, is_default, created_at, updated_at`
+	log.Printf("[%s] Executing query: %s with tier: %+v\n", utils.GetLocation(), query, req)
+
+	tier := &models.MembershipTierRecord{}
+	if err := r.db.GetContext(ctx, tier, query, req.Name, req.MaxConcurrentLoans, req.LoanDurationDays, req.MaxRenewals, req.Id); err != nil {
+		if err == sql.ErrNoRows {
+			log.Printf("[%s] Membership tier with ID %s not found\n", utils.GetLocation(), req.Id)
+			return nil, errors.New("membership tier not found")
+		}
+		log.Printf("[%s] Error executing query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully updated membership tier with ID: %s\n", utils.GetLocation(), tier.Id)
+	return tier, nil
+}
+
+// AssignTier puts a user on a tier, an empty tierId moves the user back to the default tier
+func (r *membershipTierRepository) AssignTier(ctx context.Context, userId, tierId string) error {
+	query := `UPDATE users SET tier_id = NULLIF($1, '')::uuid WHERE id = $2`
+	log.Printf("[%s] Executing query: %s with userId: %s and tierId: %s\n", utils.GetLocation(), query, userId, tierId)
+
+	result, err := r.db.ExecContext(ctx, query, tierId, userId)
+	if err != nil {
+		log.Printf("[%s] Error executing query: %v\n", utils.GetLocation(), err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		log.Printf("[%s] User with ID %s not found\n", utils.GetLocation(), userId)
+		return errors.New("user not found")
+	}
+
+	log.Printf("[%s] Successfully assigned tier %q to user %s\n", utils.GetLocation(), tierId, userId)
+	return nil
+}
+
+// GetUserEntitlements returns the limits of the user's tier, falling back to the default tier
+func (r *membershipTierRepository) GetUserEntitlements(ctx context.Context, userId string) (*models.UserEntitlements, error) {
+	query := `SELECT u.id AS user_id, t.id AS tier_id, t.name AS tier_name, t.max_concurrent_loans, t.loan_duration_days, t.max_renewals
+		FROM users u
+		JOIN membership_tiers t ON t.id = u.tier_id OR (u.tier_id IS NULL AND t.is_default)
+		WHERE u.id = $1`
+	log.Printf("[%s] Executing query: %s with userId: %s\n", utils.GetLocation(), query, userId)
+
+	entitlements := &models.UserEntitlements{}
+	if err := r.db.GetContext(ctx, entitlements, query, userId); err != nil {
+		if err == sql.ErrNoRows {
+			log.Printf("[%s] No entitlements for user with ID %s\n", utils.GetLocation(), userId)
+			return nil, errors.New("user not found or no default membership tier configured")
+		}
+		log.Printf("[%s] Error executing query: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully fetched entitlements for user with ID: %s\n", utils.GetLocation(), userId)
+	return entitlements, nil
+}
@@ -9,8 +9,14 @@ import (
 	"user_service/pkg/utils"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+// userColumns selects a user along with the names of their roles, which auth_service assigns
+const userColumns = `id, email, username, password, verified,
+	ARRAY(SELECT ur.role FROM user_roles ur WHERE ur.user_id = users.id ORDER BY ur.role) AS roles,
+	tier_id, created_at, updated_at`
+
 type UserRepository interface {
 	GetUserById(ctx context.Context, userId string) (*models.UserRecord, error)
 	GetUserByEmail(ctx context.Context, email string) (*models.UserRecord, error)
@@ -30,7 +36,7 @@ func NewUserRepository(db *sqlx.DB) UserRepository {
 
 // GetUserById returns a user from the database by ID
 func (r *userRepository) GetUserById(ctx context.Context, userId string) (*models.UserRecord, error) {
-	query := `SELECT id, email, username, password, verified, role, created_at, updated_at FROM users WHERE id = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
 	log.Printf("[%s] Executing query: %s with userId: %s\n", utils.GetLocation(), query, userId)
 
 	user := &models.UserRecord{}
@@ -40,7 +46,8 @@ func (r *userRepository) GetUserById(ctx context.Context, userId string) (*model
 		&user.Username,
 		&user.Password,
 		&user.Verified,
-		&user.Role,
+		pq.Array(&user.Roles),
+		&user.TierId,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -59,7 +66,7 @@ func (r *userRepository) GetUserById(ctx context.Context, userId string) (*model
 
 // GetUserByEmail returns a user from the database by email
 func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*models.UserRecord, error) {
-	query := `SELECT id, email, username, password, verified, role, created_at, updated_at FROM users WHERE email = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
 	log.Printf("[%s] Executing query: %s with email: %s\n", utils.GetLocation(), query, email)
 
 	user := &models.UserRecord{}
@@ -69,7 +76,8 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 		&user.Username,
 		&user.Password,
 		&user.Verified,
-		&user.Role,
+		pq.Array(&user.Roles),
+		&user.TierId,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -88,7 +96,7 @@ func (r *userRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 
 // ListUsers returns a list of users from the database
 func (r *userRepository) ListUsers(ctx context.Context) ([]*models.UserRecord, error) {
-	query := `SELECT id, email, username, password, verified, role, created_at, updated_at FROM users`
+	query := `SELECT ` + userColumns + ` FROM users`
 	log.Printf("[%s] Executing query: %s\n", utils.GetLocation(), query)
 
 	rows, err := r.db.QueryContext(ctx, query)
@@ -107,7 +115,8 @@ func (r *userRepository) ListUsers(ctx context.Context) ([]*models.UserRecord, e
 			&user.Username,
 			&user.Password,
 			&user.Verified,
-			&user.Role,
+			pq.Array(&user.Roles),
+			&user.TierId,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		); err != nil {
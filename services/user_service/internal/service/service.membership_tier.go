@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"log"
+	"user_service/internal/models"
+	"user_service/internal/repository"
+	"user_service/pkg/utils"
+)
+
+type MembershipTierService interface {
+	ListTiers(ctx context.Context) ([]*models.MembershipTierRecord, error)
+	CreateTier(ctx context.Context, tier *models.MembershipTierRecord) (*models.MembershipTierRecord, error)
+	UpdateTier(ctx context.Context, tier *models.MembershipTierRecord) (*models.MembershipTierRecord, error)
+	AssignTier(ctx context.Context, userId, tierId string) (*models.UserRecord, error)
+	GetUserEntitlements(ctx context.Context, userId string) (*models.UserEntitlements, error)
+}
+
+type membershipTierService struct {
+	repo     repository.MembershipTierRepository
+	userRepo repository.UserRepository
+}
+
+func NewMembershipTierService(repo repository.MembershipTierRepository, userRepo repository.UserRepository) MembershipTierService {
+	return &membershipTierService{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+func (s *membershipTierService) ListTiers(ctx context.Context) ([]*models.MembershipTierRecord, error) {
+	log.Printf("[%s] Fetching list of membership tiers\n", utils.GetLocation())
+
+	tiers, err := s.repo.ListTiers(ctx)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch list of membership tiers: %v\n", utils.GetLocation(), err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully fetched %d membership tiers\n", utils.GetLocation(), len(tiers))
+	return tiers, nil
+}
+
+func (s *membershipTierService) CreateTier(ctx context.Context, tier *models.MembershipTierRecord) (*models.MembershipTierRecord, error) {
+	log.Printf("[%s] Creating membership tier: %s\n", utils.GetLocation(), tier.Name)
+
+	createdTier, err := s.repo.CreateTier(ctx, tier)
+	if err != nil {
+		log.Printf("[%s] Failed to create membership tier %s: %v\n", utils.GetLocation(), tier.Name, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully created membership tier %s\n", utils.GetLocation(), createdTier.Id)
+	return createdTier, nil
+}
+
+func (s *membershipTierService) UpdateTier(ctx context.Context, tier *models.MembershipTierRecord) (*models.MembershipTierRecord, error) {
+	log.Printf("[%s] Updating membership tier: %s\n", utils.GetLocation(), tier.Id)
+
+	updatedTier, err := s.repo.UpdateTier(ctx, tier)
+	if err != nil {
+		log.Printf("[%s] Failed to update membership tier %s: %v\n", utils.GetLocation(), tier.Id, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully updated membership tier %s\n", utils.GetLocation(), updatedTier.Id)
+	return updatedTier, nil
+}
+
+func (s *membershipTierService) AssignTier(ctx context.Context, userId, tierId string) (*models.UserRecord, error) {
+	log.Printf("[%s] Assigning tier %q to user %s\n", utils.GetLocation(), tierId, userId)
+
+	if err := s.repo.AssignTier(ctx, userId, tierId); err != nil {
+		log.Printf("[%s] Failed to assign tier %q to user %s: %v\n", utils.GetLocation(), tierId, userId, err)
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserById(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch user by ID %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully assigned tier %q to user %s\n", utils.GetLocation(), tierId, userId)
+	return user, nil
+}
+
+func (s *membershipTierService) GetUserEntitlements(ctx context.Context, userId string) (*models.UserEntitlements, error) {
+	log.Printf("[%s] Fetching entitlements of user: %s\n", utils.GetLocation(), userId)
+
+	entitlements, err := s.repo.GetUserEntitlements(ctx, userId)
+	if err != nil {
+		log.Printf("[%s] Failed to fetch entitlements of user %s: %v\n", utils.GetLocation(), userId, err)
+		return nil, err
+	}
+
+	log.Printf("[%s] Successfully fetched entitlements of user %s on tier %s\n", utils.GetLocation(), userId, entitlements.TierName)
+	return entitlements, nil
+}
@@ -66,12 +66,12 @@ func (m *User) validate(all bool) error {
 
 	// no validation rules for Verified
 
-	// no validation rules for Role
-
 	// no validation rules for CreatedAt
 
 	// no validation rules for UpdatedAt
 
+	// no validation rules for TierId
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -949,3 +949,1138 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListUsersResponseValidationError{}
+
+// Validate checks the field values on MembershipTier with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MembershipTier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MembershipTier with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MembershipTierMultiError,
+// or nil if none found.
+func (m *MembershipTier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MembershipTier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for MaxConcurrentLoans
+
+	// no validation rules for LoanDurationDays
+
+	// no validation rules for MaxRenewals
+
+	// no validation rules for IsDefault
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return MembershipTierMultiError(errors)
+	}
+
+	return nil
+}
+
+// MembershipTierMultiError is an error wrapping multiple validation errors
+// returned by MembershipTier.ValidateAll() if the designated constraints
+// aren't met.
+type MembershipTierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MembershipTierMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MembershipTierMultiError) AllErrors() []error { return m }
+
+// MembershipTierValidationError is the validation error returned by
+// MembershipTier.Validate if the designated constraints aren't met.
+type MembershipTierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MembershipTierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MembershipTierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MembershipTierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MembershipTierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MembershipTierValidationError) ErrorName() string { return "MembershipTierValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MembershipTierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMembershipTier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MembershipTierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MembershipTierValidationError{}
+
+// Validate checks the field values on GetUserEntitlementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserEntitlementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserEntitlementsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserEntitlementsRequestMultiError, or nil if none found.
+func (m *GetUserEntitlementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserEntitlementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := GetUserEntitlementsRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserEntitlementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserEntitlementsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUserEntitlementsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserEntitlementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserEntitlementsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserEntitlementsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserEntitlementsRequestValidationError is the validation error returned
+// by GetUserEntitlementsRequest.Validate if the designated constraints aren't met.
+type GetUserEntitlementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserEntitlementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserEntitlementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserEntitlementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserEntitlementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserEntitlementsRequestValidationError) ErrorName() string {
+	return "GetUserEntitlementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserEntitlementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserEntitlementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserEntitlementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserEntitlementsRequestValidationError{}
+
+// Validate checks the field values on GetUserEntitlementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserEntitlementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserEntitlementsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserEntitlementsResponseMultiError, or nil if none found.
+func (m *GetUserEntitlementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserEntitlementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TierId
+
+	// no validation rules for TierName
+
+	// no validation rules for MaxConcurrentLoans
+
+	// no validation rules for LoanDurationDays
+
+	// no validation rules for MaxRenewals
+
+	if len(errors) > 0 {
+		return GetUserEntitlementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserEntitlementsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUserEntitlementsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUserEntitlementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserEntitlementsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserEntitlementsResponseMultiError) AllErrors() []error { return m }
+
+// GetUserEntitlementsResponseValidationError is the validation error returned
+// by GetUserEntitlementsResponse.Validate if the designated constraints
+// aren't met.
+type GetUserEntitlementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserEntitlementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserEntitlementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserEntitlementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserEntitlementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserEntitlementsResponseValidationError) ErrorName() string {
+	return "GetUserEntitlementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserEntitlementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserEntitlementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserEntitlementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserEntitlementsResponseValidationError{}
+
+// Validate checks the field values on ListMembershipTiersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembershipTiersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembershipTiersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembershipTiersRequestMultiError, or nil if none found.
+func (m *ListMembershipTiersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembershipTiersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListMembershipTiersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembershipTiersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMembershipTiersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMembershipTiersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembershipTiersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembershipTiersRequestMultiError) AllErrors() []error { return m }
+
+// ListMembershipTiersRequestValidationError is the validation error returned
+// by ListMembershipTiersRequest.Validate if the designated constraints aren't met.
+type ListMembershipTiersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembershipTiersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembershipTiersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembershipTiersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembershipTiersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembershipTiersRequestValidationError) ErrorName() string {
+	return "ListMembershipTiersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembershipTiersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembershipTiersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembershipTiersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembershipTiersRequestValidationError{}
+
+// Validate checks the field values on ListMembershipTiersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembershipTiersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembershipTiersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembershipTiersResponseMultiError, or nil if none found.
+func (m *ListMembershipTiersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembershipTiersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMembershipTiersResponseValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMembershipTiersResponseValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMembershipTiersResponseValidationError{
+					field:  fmt.Sprintf("Tiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMembershipTiersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembershipTiersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMembershipTiersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListMembershipTiersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembershipTiersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembershipTiersResponseMultiError) AllErrors() []error { return m }
+
+// ListMembershipTiersResponseValidationError is the validation error returned
+// by ListMembershipTiersResponse.Validate if the designated constraints
+// aren't met.
+type ListMembershipTiersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembershipTiersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembershipTiersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembershipTiersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembershipTiersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembershipTiersResponseValidationError) ErrorName() string {
+	return "ListMembershipTiersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembershipTiersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembershipTiersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembershipTiersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembershipTiersResponseValidationError{}
+
+// Validate checks the field values on CreateMembershipTierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMembershipTierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMembershipTierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMembershipTierRequestMultiError, or nil if none found.
+func (m *CreateMembershipTierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMembershipTierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateMembershipTierRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConcurrentLoans() < 1 {
+		err := CreateMembershipTierRequestValidationError{
+			field:  "MaxConcurrentLoans",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLoanDurationDays() < 1 {
+		err := CreateMembershipTierRequestValidationError{
+			field:  "LoanDurationDays",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxRenewals() < 0 {
+		err := CreateMembershipTierRequestValidationError{
+			field:  "MaxRenewals",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateMembershipTierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMembershipTierRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateMembershipTierRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateMembershipTierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMembershipTierRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMembershipTierRequestMultiError) AllErrors() []error { return m }
+
+// CreateMembershipTierRequestValidationError is the validation error returned
+// by CreateMembershipTierRequest.Validate if the designated constraints
+// aren't met.
+type CreateMembershipTierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMembershipTierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMembershipTierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMembershipTierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMembershipTierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMembershipTierRequestValidationError) ErrorName() string {
+	return "CreateMembershipTierRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMembershipTierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMembershipTierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMembershipTierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMembershipTierRequestValidationError{}
+
+// Validate checks the field values on UpdateMembershipTierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateMembershipTierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateMembershipTierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateMembershipTierRequestMultiError, or nil if none found.
+func (m *UpdateMembershipTierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateMembershipTierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UpdateMembershipTierRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdateMembershipTierRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxConcurrentLoans() < 1 {
+		err := UpdateMembershipTierRequestValidationError{
+			field:  "MaxConcurrentLoans",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLoanDurationDays() < 1 {
+		err := UpdateMembershipTierRequestValidationError{
+			field:  "LoanDurationDays",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxRenewals() < 0 {
+		err := UpdateMembershipTierRequestValidationError{
+			field:  "MaxRenewals",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateMembershipTierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateMembershipTierRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateMembershipTierRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateMembershipTierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateMembershipTierRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateMembershipTierRequestMultiError) AllErrors() []error { return m }
+
+// UpdateMembershipTierRequestValidationError is the validation error returned
+// by UpdateMembershipTierRequest.Validate if the designated constraints
+// aren't met.
+type UpdateMembershipTierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateMembershipTierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateMembershipTierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateMembershipTierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateMembershipTierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateMembershipTierRequestValidationError) ErrorName() string {
+	return "UpdateMembershipTierRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateMembershipTierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateMembershipTierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateMembershipTierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateMembershipTierRequestValidationError{}
+
+// Validate checks the field values on MembershipTierResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MembershipTierResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MembershipTierResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MembershipTierResponseMultiError, or nil if none found.
+func (m *MembershipTierResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MembershipTierResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTier()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MembershipTierResponseValidationError{
+					field:  "Tier",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MembershipTierResponseValidationError{
+					field:  "Tier",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTier()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MembershipTierResponseValidationError{
+				field:  "Tier",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MembershipTierResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MembershipTierResponseMultiError is an error wrapping multiple validation
+// errors returned by MembershipTierResponse.ValidateAll() if the designated
+// constraints aren't met.
+type MembershipTierResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MembershipTierResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MembershipTierResponseMultiError) AllErrors() []error { return m }
+
+// MembershipTierResponseValidationError is the validation error returned by
+// MembershipTierResponse.Validate if the designated constraints aren't met.
+type MembershipTierResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MembershipTierResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MembershipTierResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MembershipTierResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MembershipTierResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MembershipTierResponseValidationError) ErrorName() string {
+	return "MembershipTierResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MembershipTierResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMembershipTierResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MembershipTierResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MembershipTierResponseValidationError{}
+
+// Validate checks the field values on AssignMembershipTierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignMembershipTierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignMembershipTierRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignMembershipTierRequestMultiError, or nil if none found.
+func (m *AssignMembershipTierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignMembershipTierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := AssignMembershipTierRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TierId
+
+	if len(errors) > 0 {
+		return AssignMembershipTierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignMembershipTierRequestMultiError is an error wrapping multiple
+// validation errors returned by AssignMembershipTierRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AssignMembershipTierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignMembershipTierRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignMembershipTierRequestMultiError) AllErrors() []error { return m }
+
+// AssignMembershipTierRequestValidationError is the validation error returned
+// by AssignMembershipTierRequest.Validate if the designated constraints
+// aren't met.
+type AssignMembershipTierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignMembershipTierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignMembershipTierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignMembershipTierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignMembershipTierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignMembershipTierRequestValidationError) ErrorName() string {
+	return "AssignMembershipTierRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignMembershipTierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignMembershipTierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignMembershipTierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignMembershipTierRequestValidationError{}